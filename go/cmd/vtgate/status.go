@@ -35,6 +35,9 @@ func addStatusParts(vtg *vtgate.VTGate) {
 	servenv.AddStatusPart("VSchema", vtgate.VSchemaTemplate, func() interface{} {
 		return vtg.VSchemaStats()
 	})
+	servenv.AddStatusPart("Advisory Locks", vtgate.LockSessionsTemplate, func() interface{} {
+		return vtg.LockSessionStats()
+	})
 	servenv.AddStatusFuncs(srvtopo.StatusFuncs)
 	servenv.AddStatusPart("Topology Cache", srvtopo.TopoTemplate, func() interface{} {
 		return resilientServer.CacheStatus()
@@ -190,6 +190,25 @@ func (nu *Numbered) GetIdle(timeout time.Duration, purpose string) (vals []inter
 	return vals
 }
 
+// GetByFilter returns a list of resources that match the filter, and
+// locks them. The filter receives the resource and the time it was last
+// used. It does not return any resources that are already locked.
+func (nu *Numbered) GetByFilter(filter func(val interface{}, timeUsed time.Time) bool, purpose string) (vals []interface{}) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+	for _, nw := range nu.resources {
+		if nw.inUse {
+			continue
+		}
+		if filter(nw.val, nw.timeUsed) {
+			nw.inUse = true
+			nw.purpose = purpose
+			vals = append(vals, nw.val)
+		}
+	}
+	return vals
+}
+
 // WaitForEmpty returns as soon as the pool becomes empty
 func (nu *Numbered) WaitForEmpty() {
 	nu.mu.Lock()
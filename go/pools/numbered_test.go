@@ -93,6 +93,16 @@ func TestNumbered(t *testing.T) {
 	}
 	p.Unregister(vals[0].(int64), "test")
 
+	// p has 0, 1, and 2 (1 matches the filter)
+	vals = p.GetByFilter(func(val interface{}, _ time.Time) bool { return val.(int64) == 1 }, "by filter")
+	if len(vals) != 1 || vals[0].(int64) != 1 {
+		t.Errorf("want [1], got %v", vals)
+	}
+	if _, err = p.Get(1, "test1"); err.Error() != "in use: by filter" {
+		t.Errorf("want 'in use: by filter', got '%v'", err)
+	}
+	p.Put(1)
+
 	// p has 0, 1, and 2
 	if p.Size() != 3 {
 		t.Errorf("want 3, got %v", p.Size())
@@ -4190,6 +4190,407 @@ func (m *TransactionMetadata) GetParticipants() []*Target {
 	return nil
 }
 
+// ReserveExecuteRequest is the payload to ReserveExecute
+type ReserveExecuteRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Query             *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// transaction_id is the id of the transaction or reserved
+	// connection to reserve. If it's 0, a new connection is reserved.
+	TransactionId int64           `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Options       *ExecuteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	// pre_queries are executed on the connection before the query,
+	// to set up the session state of a new connection.
+	PreQueries           []string `protobuf:"bytes,7,rep,name=pre_queries,json=preQueries,proto3" json:"pre_queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveExecuteRequest) Reset()         { *m = ReserveExecuteRequest{} }
+func (m *ReserveExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveExecuteRequest) ProtoMessage()    {}
+func (*ReserveExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{60}
+}
+
+func (m *ReserveExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveExecuteRequest.Unmarshal(m, b)
+}
+func (m *ReserveExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveExecuteRequest.Merge(m, src)
+}
+func (m *ReserveExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveExecuteRequest.Size(m)
+}
+func (m *ReserveExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveExecuteRequest proto.InternalMessageInfo
+
+func (m *ReserveExecuteRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetQuery() *BoundQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetTransactionId() int64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *ReserveExecuteRequest) GetOptions() *ExecuteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetPreQueries() []string {
+	if m != nil {
+		return m.PreQueries
+	}
+	return nil
+}
+
+// ReserveExecuteResponse is the returned value from ReserveExecute
+type ReserveExecuteResponse struct {
+	// error contains an application level error if necessary. Note the
+	// reserved_id may be set, even when an error is returned, if the
+	// reserve worked but the execute failed.
+	Error  *vtrpc.RPCError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *QueryResult    `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// reserved_id might be non-zero even if an error is present.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveExecuteResponse) Reset()         { *m = ReserveExecuteResponse{} }
+func (m *ReserveExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveExecuteResponse) ProtoMessage()    {}
+func (*ReserveExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{61}
+}
+
+func (m *ReserveExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveExecuteResponse.Unmarshal(m, b)
+}
+func (m *ReserveExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveExecuteResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveExecuteResponse.Merge(m, src)
+}
+func (m *ReserveExecuteResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveExecuteResponse.Size(m)
+}
+func (m *ReserveExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveExecuteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveExecuteResponse proto.InternalMessageInfo
+
+func (m *ReserveExecuteResponse) GetError() *vtrpc.RPCError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReserveExecuteResponse) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReserveExecuteResponse) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReserveBeginExecuteRequest is the payload to ReserveBeginExecute
+type ReserveBeginExecuteRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Query             *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// reserved_id is the id of the reserved connection to begin
+	// the transaction on. If it's 0, a new connection is reserved.
+	ReservedId int64           `protobuf:"varint,5,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	Options    *ExecuteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	// pre_queries are executed on the connection before the query,
+	// to set up the session state of a new connection.
+	PreQueries           []string `protobuf:"bytes,7,rep,name=pre_queries,json=preQueries,proto3" json:"pre_queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveBeginExecuteRequest) Reset()         { *m = ReserveBeginExecuteRequest{} }
+func (m *ReserveBeginExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveBeginExecuteRequest) ProtoMessage()    {}
+func (*ReserveBeginExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{62}
+}
+
+func (m *ReserveBeginExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Unmarshal(m, b)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveBeginExecuteRequest.Merge(m, src)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Size(m)
+}
+func (m *ReserveBeginExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveBeginExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveBeginExecuteRequest proto.InternalMessageInfo
+
+func (m *ReserveBeginExecuteRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetQuery() *BoundQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+func (m *ReserveBeginExecuteRequest) GetOptions() *ExecuteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetPreQueries() []string {
+	if m != nil {
+		return m.PreQueries
+	}
+	return nil
+}
+
+// ReserveBeginExecuteResponse is the returned value from ReserveBeginExecute
+type ReserveBeginExecuteResponse struct {
+	// error contains an application level error if necessary. Note the
+	// reserved_id may be set, even when an error is returned, if the
+	// reserve worked but the begin or execute failed.
+	Error  *vtrpc.RPCError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *QueryResult    `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// reserved_id might be non-zero even if an error is present.
+	// It's also the id of the transaction.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveBeginExecuteResponse) Reset()         { *m = ReserveBeginExecuteResponse{} }
+func (m *ReserveBeginExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveBeginExecuteResponse) ProtoMessage()    {}
+func (*ReserveBeginExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{63}
+}
+
+func (m *ReserveBeginExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Unmarshal(m, b)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveBeginExecuteResponse.Merge(m, src)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Size(m)
+}
+func (m *ReserveBeginExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveBeginExecuteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveBeginExecuteResponse proto.InternalMessageInfo
+
+func (m *ReserveBeginExecuteResponse) GetError() *vtrpc.RPCError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteResponse) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteResponse) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReleaseRequest is the payload to Release
+type ReleaseRequest struct {
+	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId    *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target               *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ReservedId           int64           `protobuf:"varint,4,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseRequest) Reset()         { *m = ReleaseRequest{} }
+func (m *ReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRequest) ProtoMessage()    {}
+func (*ReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{64}
+}
+
+func (m *ReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseRequest.Unmarshal(m, b)
+}
+func (m *ReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRequest.Merge(m, src)
+}
+func (m *ReleaseRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseRequest.Size(m)
+}
+func (m *ReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRequest proto.InternalMessageInfo
+
+func (m *ReleaseRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReleaseResponse is the returned value from Release
+type ReleaseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseResponse) Reset()         { *m = ReleaseResponse{} }
+func (m *ReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResponse) ProtoMessage()    {}
+func (*ReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{65}
+}
+
+func (m *ReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseResponse.Unmarshal(m, b)
+}
+func (m *ReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResponse.Merge(m, src)
+}
+func (m *ReleaseResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseResponse.Size(m)
+}
+func (m *ReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("query.MySqlFlag", MySqlFlag_name, MySqlFlag_value)
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
@@ -4262,215 +4663,227 @@ func init() {
 	proto.RegisterType((*UpdateStreamRequest)(nil), "query.UpdateStreamRequest")
 	proto.RegisterType((*UpdateStreamResponse)(nil), "query.UpdateStreamResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
+	proto.RegisterType((*ReserveExecuteRequest)(nil), "query.ReserveExecuteRequest")
+	proto.RegisterType((*ReserveExecuteResponse)(nil), "query.ReserveExecuteResponse")
+	proto.RegisterType((*ReserveBeginExecuteRequest)(nil), "query.ReserveBeginExecuteRequest")
+	proto.RegisterType((*ReserveBeginExecuteResponse)(nil), "query.ReserveBeginExecuteResponse")
+	proto.RegisterType((*ReleaseRequest)(nil), "query.ReleaseRequest")
+	proto.RegisterType((*ReleaseResponse)(nil), "query.ReleaseResponse")
 }
 
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x1b, 0xc9,
-	0x79, 0xd7, 0xe0, 0x45, 0xe0, 0x03, 0x01, 0x36, 0x1b, 0xa4, 0x84, 0xe5, 0xbe, 0xe8, 0xb1, 0xd7,
-	0x66, 0x68, 0x87, 0xd2, 0x72, 0x65, 0x45, 0x59, 0x3b, 0x8e, 0x86, 0xe0, 0x50, 0x0b, 0x0b, 0x18,
-	0x40, 0x8d, 0x81, 0x64, 0x6d, 0xb9, 0x6a, 0x6a, 0x08, 0xb4, 0xc0, 0x29, 0x0e, 0x66, 0xa0, 0x99,
-	0x01, 0x29, 0xde, 0x94, 0x38, 0xce, 0xfb, 0xb1, 0x79, 0x6e, 0x9c, 0x54, 0x36, 0xa9, 0xca, 0x21,
-	0xb7, 0xfc, 0x0d, 0xa9, 0x1c, 0x72, 0xcc, 0x2d, 0x87, 0x24, 0x87, 0x1c, 0x52, 0xa9, 0xdc, 0x5c,
-	0x39, 0xe5, 0x90, 0x43, 0x2a, 0xd5, 0x8f, 0x19, 0x0c, 0x48, 0xec, 0x4a, 0x56, 0x7c, 0xa1, 0x76,
-	0x6f, 0xdd, 0xdf, 0xf7, 0xf5, 0xe3, 0xf7, 0xfb, 0xbe, 0xf9, 0xba, 0xa7, 0xbb, 0xa1, 0xfc, 0x64,
-	0x4a, 0x83, 0xb3, 0x9d, 0x49, 0xe0, 0x47, 0x3e, 0xce, 0xf3, 0xca, 0x46, 0x35, 0xf2, 0x27, 0xfe,
-	0xd0, 0x8e, 0x6c, 0x21, 0xde, 0x28, 0x9f, 0x44, 0xc1, 0x64, 0x20, 0x2a, 0xea, 0x0f, 0x15, 0x28,
-	0x98, 0x76, 0x30, 0xa2, 0x11, 0xde, 0x80, 0xe2, 0x31, 0x3d, 0x0b, 0x27, 0xf6, 0x80, 0xd6, 0x95,
-	0x4d, 0x65, 0xab, 0x44, 0x92, 0x3a, 0x5e, 0x83, 0x7c, 0x78, 0x64, 0x07, 0xc3, 0x7a, 0x86, 0x2b,
-	0x44, 0x05, 0x7f, 0x13, 0xca, 0x91, 0x7d, 0xe8, 0xd2, 0xc8, 0x8a, 0xce, 0x26, 0xb4, 0x9e, 0xdd,
-	0x54, 0xb6, 0xaa, 0xbb, 0x6b, 0x3b, 0xc9, 0x78, 0x26, 0x57, 0x9a, 0x67, 0x13, 0x4a, 0x20, 0x4a,
-	0xca, 0x18, 0x43, 0x6e, 0x40, 0x5d, 0xb7, 0x9e, 0xe3, 0x7d, 0xf1, 0xb2, 0xba, 0x0f, 0xd5, 0x07,
-	0xe6, 0x5d, 0x3b, 0xa2, 0x0d, 0xdb, 0x75, 0x69, 0xd0, 0xdc, 0x67, 0xd3, 0x99, 0x86, 0x34, 0xf0,
-	0xec, 0x71, 0x32, 0x9d, 0xb8, 0x8e, 0xaf, 0x42, 0x61, 0x14, 0xf8, 0xd3, 0x49, 0x58, 0xcf, 0x6c,
-	0x66, 0xb7, 0x4a, 0x44, 0xd6, 0xd4, 0xef, 0x03, 0xe8, 0x27, 0xd4, 0x8b, 0x4c, 0xff, 0x98, 0x7a,
-	0xf8, 0x0d, 0x28, 0x45, 0xce, 0x98, 0x86, 0x91, 0x3d, 0x9e, 0xf0, 0x2e, 0xb2, 0x64, 0x26, 0xf8,
-	0x14, 0x48, 0x1b, 0x50, 0x9c, 0xf8, 0xa1, 0x13, 0x39, 0xbe, 0xc7, 0xf1, 0x94, 0x48, 0x52, 0x57,
-	0xbf, 0x03, 0xf9, 0x07, 0xb6, 0x3b, 0xa5, 0xf8, 0x6d, 0xc8, 0x71, 0xc0, 0x0a, 0x07, 0x5c, 0xde,
-	0x11, 0xa4, 0x73, 0x9c, 0x5c, 0xc1, 0xfa, 0x3e, 0x61, 0x96, 0xbc, 0xef, 0x65, 0x22, 0x2a, 0xea,
-	0x31, 0x2c, 0xef, 0x39, 0xde, 0xf0, 0x81, 0x1d, 0x38, 0x8c, 0x8c, 0x97, 0xec, 0x06, 0x7f, 0x05,
-	0x0a, 0xbc, 0x10, 0xd6, 0xb3, 0x9b, 0xd9, 0xad, 0xf2, 0xee, 0xb2, 0x6c, 0xc8, 0xe7, 0x46, 0xa4,
-	0x4e, 0xfd, 0x7b, 0x05, 0x60, 0xcf, 0x9f, 0x7a, 0xc3, 0xfb, 0x4c, 0x89, 0x11, 0x64, 0xc3, 0x27,
-	0xae, 0x24, 0x92, 0x15, 0xf1, 0x3d, 0xa8, 0x1e, 0x3a, 0xde, 0xd0, 0x3a, 0x91, 0xd3, 0x11, 0x5c,
-	0x96, 0x77, 0xbf, 0x22, 0xbb, 0x9b, 0x35, 0xde, 0x49, 0xcf, 0x3a, 0xd4, 0xbd, 0x28, 0x38, 0x23,
-	0x95, 0xc3, 0xb4, 0x6c, 0xa3, 0x0f, 0xf8, 0xa2, 0x11, 0x1b, 0xf4, 0x98, 0x9e, 0xc5, 0x83, 0x1e,
-	0xd3, 0x33, 0xfc, 0x33, 0x69, 0x44, 0xe5, 0xdd, 0x5a, 0x3c, 0x56, 0xaa, 0xad, 0x84, 0xf9, 0x7e,
-	0xe6, 0xb6, 0xa2, 0xfe, 0x65, 0x01, 0xaa, 0xfa, 0x53, 0x3a, 0x98, 0x46, 0xb4, 0x33, 0x61, 0x3e,
-	0x08, 0xf1, 0x0e, 0xd4, 0x1c, 0x6f, 0xe0, 0x4e, 0x87, 0xd4, 0xa2, 0xcc, 0xd5, 0x56, 0xc4, 0x7c,
-	0xcd, 0xfb, 0x2b, 0x92, 0x55, 0xa9, 0x4a, 0x05, 0x81, 0x06, 0xb5, 0x81, 0x3f, 0x9e, 0xd8, 0xc1,
-	0xbc, 0x7d, 0x96, 0x8f, 0xbf, 0x2a, 0xc7, 0x9f, 0xd9, 0x93, 0x55, 0x69, 0x9d, 0xea, 0xa2, 0x0d,
-	0x2b, 0xb2, 0xdf, 0xa1, 0xf5, 0xd8, 0xa1, 0xee, 0x30, 0xe4, 0xa1, 0x5b, 0x4d, 0xa8, 0x9a, 0x9f,
-	0xe2, 0x4e, 0x53, 0x1a, 0x1f, 0x70, 0x5b, 0x52, 0x75, 0xe6, 0xea, 0x78, 0x1b, 0x56, 0x07, 0xae,
-	0xc3, 0xa6, 0xf2, 0x98, 0x51, 0x6c, 0x05, 0xfe, 0x69, 0x58, 0xcf, 0xf3, 0xf9, 0xaf, 0x08, 0xc5,
-	0x01, 0x93, 0x13, 0xff, 0x34, 0xc4, 0xef, 0x43, 0xf1, 0xd4, 0x0f, 0x8e, 0x5d, 0xdf, 0x1e, 0xd6,
-	0x0b, 0x7c, 0xcc, 0xb7, 0x16, 0x8f, 0xf9, 0x50, 0x5a, 0x91, 0xc4, 0x1e, 0x6f, 0x01, 0x0a, 0x9f,
-	0xb8, 0x56, 0x48, 0x5d, 0x3a, 0x88, 0x2c, 0xd7, 0x19, 0x3b, 0x51, 0xbd, 0xc8, 0xbf, 0x82, 0x6a,
-	0xf8, 0xc4, 0xed, 0x71, 0x71, 0x8b, 0x49, 0xb1, 0x05, 0xeb, 0x51, 0x60, 0x7b, 0xa1, 0x3d, 0x60,
-	0x9d, 0x59, 0x4e, 0xe8, 0xbb, 0x36, 0xff, 0x02, 0x4a, 0x7c, 0xc8, 0xed, 0xc5, 0x43, 0x9a, 0xb3,
-	0x26, 0xcd, 0xb8, 0x05, 0x59, 0x8b, 0x16, 0x48, 0xf1, 0xbb, 0xb0, 0x1e, 0x1e, 0x3b, 0x13, 0x8b,
-	0xf7, 0x63, 0x4d, 0x5c, 0xdb, 0xb3, 0x06, 0xf6, 0xe0, 0x88, 0xd6, 0x81, 0xc3, 0xc6, 0x4c, 0xc9,
-	0x43, 0xad, 0xeb, 0xda, 0x5e, 0x83, 0x69, 0xd4, 0x6f, 0x41, 0x75, 0x9e, 0x47, 0xbc, 0x0a, 0x15,
-	0xf3, 0x51, 0x57, 0xb7, 0x34, 0x63, 0xdf, 0x32, 0xb4, 0xb6, 0x8e, 0xae, 0xe0, 0x0a, 0x94, 0xb8,
-	0xa8, 0x63, 0xb4, 0x1e, 0x21, 0x05, 0x2f, 0x41, 0x56, 0x6b, 0xb5, 0x50, 0x46, 0xbd, 0x0d, 0xc5,
-	0x98, 0x10, 0xbc, 0x02, 0xe5, 0xbe, 0xd1, 0xeb, 0xea, 0x8d, 0xe6, 0x41, 0x53, 0xdf, 0x47, 0x57,
-	0x70, 0x11, 0x72, 0x9d, 0x96, 0xd9, 0x45, 0x8a, 0x28, 0x69, 0x5d, 0x94, 0x61, 0x2d, 0xf7, 0xf7,
-	0x34, 0x94, 0x55, 0xff, 0x46, 0x81, 0xb5, 0x45, 0xc0, 0x70, 0x19, 0x96, 0xf6, 0xf5, 0x03, 0xad,
-	0xdf, 0x32, 0xd1, 0x15, 0x5c, 0x83, 0x15, 0xa2, 0x77, 0x75, 0xcd, 0xd4, 0xf6, 0x5a, 0xba, 0x45,
-	0x74, 0x6d, 0x1f, 0x29, 0x18, 0x43, 0x95, 0x95, 0xac, 0x46, 0xa7, 0xdd, 0x6e, 0x9a, 0xa6, 0xbe,
-	0x8f, 0x32, 0x78, 0x0d, 0x10, 0x97, 0xf5, 0x8d, 0x99, 0x34, 0x8b, 0x11, 0x2c, 0xf7, 0x74, 0xd2,
-	0xd4, 0x5a, 0xcd, 0x0f, 0x59, 0x07, 0x28, 0x87, 0xbf, 0x04, 0x6f, 0x36, 0x3a, 0x46, 0xaf, 0xd9,
-	0x33, 0x75, 0xc3, 0xb4, 0x7a, 0x86, 0xd6, 0xed, 0x7d, 0xd0, 0x31, 0x79, 0xcf, 0x02, 0x5c, 0x1e,
-	0x57, 0x01, 0xb4, 0xbe, 0xd9, 0x11, 0xfd, 0xa0, 0xc2, 0x77, 0x73, 0x45, 0x05, 0x65, 0xd4, 0x8f,
-	0x33, 0x90, 0xe7, 0xfc, 0xb0, 0xac, 0x9a, 0xca, 0x95, 0xbc, 0x9c, 0x64, 0x98, 0xcc, 0x67, 0x64,
-	0x18, 0x9e, 0x98, 0x65, 0xae, 0x13, 0x15, 0xfc, 0x3a, 0x94, 0xfc, 0x60, 0x64, 0x09, 0x8d, 0xc8,
-	0xd2, 0x45, 0x3f, 0x18, 0xf1, 0x74, 0xce, 0x32, 0x24, 0x4b, 0xee, 0x87, 0x76, 0x48, 0x79, 0xd4,
-	0x96, 0x48, 0x52, 0xc7, 0xaf, 0x01, 0xb3, 0xb3, 0xf8, 0x3c, 0x0a, 0x5c, 0xb7, 0xe4, 0x07, 0x23,
-	0x83, 0x4d, 0xe5, 0xcb, 0x50, 0x19, 0xf8, 0xee, 0x74, 0xec, 0x59, 0x2e, 0xf5, 0x46, 0xd1, 0x51,
-	0x7d, 0x69, 0x53, 0xd9, 0xaa, 0x90, 0x65, 0x21, 0x6c, 0x71, 0x19, 0xae, 0xc3, 0xd2, 0xe0, 0xc8,
-	0x0e, 0x42, 0x2a, 0x22, 0xb5, 0x42, 0xe2, 0x2a, 0x1f, 0x95, 0x0e, 0x9c, 0xb1, 0xed, 0x86, 0x3c,
-	0x2a, 0x2b, 0x24, 0xa9, 0x33, 0x10, 0x8f, 0x5d, 0x7b, 0x14, 0xf2, 0x68, 0xaa, 0x10, 0x51, 0x51,
-	0x7f, 0x0e, 0xb2, 0xc4, 0x3f, 0x65, 0x5d, 0x8a, 0x01, 0xc3, 0xba, 0xb2, 0x99, 0xdd, 0xc2, 0x24,
-	0xae, 0xb2, 0x45, 0x44, 0xe6, 0x51, 0x91, 0x5e, 0xe3, 0xcc, 0xf9, 0x7d, 0x58, 0x26, 0x34, 0x9c,
-	0xba, 0x91, 0xfe, 0x34, 0x0a, 0xec, 0x10, 0xef, 0x42, 0x39, 0x9d, 0x39, 0x94, 0x4f, 0xcb, 0x1c,
-	0x40, 0x67, 0x29, 0xa3, 0x0e, 0x4b, 0x8f, 0x03, 0x1a, 0x1e, 0xd1, 0x40, 0x66, 0xa6, 0xb8, 0xca,
-	0xf2, 0x72, 0x99, 0x87, 0xba, 0x18, 0x83, 0x65, 0x73, 0x99, 0x53, 0x94, 0xb9, 0x6c, 0xce, 0x9d,
-	0x4a, 0xa4, 0x8e, 0xb1, 0xc7, 0xd2, 0x84, 0x65, 0x3f, 0x7e, 0x4c, 0x07, 0x11, 0x15, 0x8b, 0x56,
-	0x8e, 0x2c, 0x33, 0xa1, 0x26, 0x65, 0xcc, 0x6d, 0x8e, 0x17, 0xd2, 0x20, 0xb2, 0x9c, 0x21, 0x77,
-	0x68, 0x8e, 0x14, 0x85, 0xa0, 0x39, 0xc4, 0x6f, 0x41, 0x8e, 0x27, 0x9a, 0x1c, 0x1f, 0x05, 0xe4,
-	0x28, 0xc4, 0x3f, 0x25, 0x5c, 0x8e, 0xbf, 0x0e, 0x05, 0xca, 0xf1, 0x72, 0xa7, 0xce, 0x52, 0x73,
-	0x9a, 0x0a, 0x22, 0x4d, 0xd4, 0x6f, 0xc3, 0x32, 0xc7, 0xf0, 0xd0, 0x0e, 0x3c, 0xc7, 0x1b, 0xf1,
-	0x15, 0xdd, 0x1f, 0x8a, 0xd8, 0xab, 0x10, 0x5e, 0x66, 0x14, 0x8c, 0x69, 0x18, 0xda, 0x23, 0x2a,
-	0x57, 0xd8, 0xb8, 0xaa, 0xfe, 0x55, 0x16, 0xca, 0xbd, 0x28, 0xa0, 0xf6, 0x98, 0xb3, 0x87, 0xbf,
-	0x0d, 0x10, 0x46, 0x76, 0x44, 0xc7, 0xd4, 0x8b, 0x62, 0x1a, 0xde, 0x90, 0xc3, 0xa7, 0xec, 0x76,
-	0x7a, 0xb1, 0x11, 0x49, 0xd9, 0x9f, 0x77, 0x4f, 0xe6, 0x05, 0xdc, 0xb3, 0xf1, 0x49, 0x06, 0x4a,
-	0x49, 0x6f, 0x58, 0x83, 0xe2, 0xc0, 0x8e, 0xe8, 0xc8, 0x0f, 0xce, 0xe4, 0x5a, 0xfc, 0xce, 0x67,
-	0x8d, 0xbe, 0xd3, 0x90, 0xc6, 0x24, 0x69, 0x86, 0xdf, 0x04, 0xb1, 0xc1, 0x11, 0xa1, 0x2f, 0xf0,
-	0x96, 0xb8, 0x84, 0x07, 0xff, 0xfb, 0x80, 0x27, 0x81, 0x33, 0xb6, 0x83, 0x33, 0xeb, 0x98, 0x9e,
-	0xc5, 0x8b, 0x48, 0x76, 0x81, 0xc3, 0x91, 0xb4, 0xbb, 0x47, 0xcf, 0x64, 0xda, 0xbb, 0x3d, 0xdf,
-	0x56, 0x86, 0xec, 0x45, 0x37, 0xa6, 0x5a, 0xf2, 0x9d, 0x40, 0x18, 0xaf, 0xf9, 0x79, 0x1e, 0xdd,
-	0xac, 0xa8, 0x7e, 0x0d, 0x8a, 0xf1, 0xe4, 0x71, 0x09, 0xf2, 0x7a, 0x10, 0xf8, 0x01, 0xba, 0xc2,
-	0xb3, 0x5f, 0xbb, 0x25, 0x12, 0xe8, 0xfe, 0x3e, 0x4b, 0xa0, 0x7f, 0x97, 0x49, 0x16, 0x5e, 0x42,
-	0x9f, 0x4c, 0x69, 0x18, 0xe1, 0x5f, 0x84, 0x1a, 0xe5, 0x91, 0xe6, 0x9c, 0x50, 0x6b, 0xc0, 0x77,
-	0x69, 0x2c, 0xce, 0xc4, 0xe7, 0xb0, 0xb2, 0x23, 0x36, 0x95, 0xf1, 0xee, 0x8d, 0xac, 0x26, 0xb6,
-	0x52, 0x34, 0xc4, 0x3a, 0xd4, 0x9c, 0xf1, 0x98, 0x0e, 0x1d, 0x3b, 0x4a, 0x77, 0x20, 0x1c, 0xb6,
-	0x1e, 0x6f, 0x62, 0xe6, 0x36, 0x81, 0x64, 0x35, 0x69, 0x91, 0x74, 0xf3, 0x0e, 0x14, 0x22, 0xbe,
-	0x61, 0x95, 0x6b, 0x78, 0x25, 0xce, 0x6a, 0x5c, 0x48, 0xa4, 0x12, 0x7f, 0x0d, 0xc4, 0xf6, 0x97,
-	0xe7, 0xaf, 0x59, 0x40, 0xcc, 0x76, 0x35, 0x44, 0xe8, 0xf1, 0x3b, 0x50, 0x9d, 0x5b, 0xfc, 0x86,
-	0x9c, 0xb0, 0x2c, 0xa9, 0xa4, 0x57, 0xb2, 0x21, 0xbe, 0x0e, 0x4b, 0xbe, 0x58, 0xf8, 0x78, 0x66,
-	0x9b, 0xcd, 0x78, 0x7e, 0x55, 0x24, 0xb1, 0x95, 0xfa, 0x0b, 0xb0, 0x92, 0x30, 0x18, 0x4e, 0x7c,
-	0x2f, 0xa4, 0x78, 0x1b, 0x0a, 0x01, 0xff, 0x9c, 0x24, 0x6b, 0x58, 0x76, 0x91, 0xca, 0x07, 0x44,
-	0x5a, 0xa8, 0x43, 0x58, 0x11, 0x92, 0x87, 0x4e, 0x74, 0xc4, 0x1d, 0x85, 0xdf, 0x81, 0x3c, 0x65,
-	0x85, 0x73, 0x9c, 0x93, 0x6e, 0x83, 0xeb, 0x89, 0xd0, 0xa6, 0x46, 0xc9, 0x3c, 0x77, 0x94, 0xff,
-	0xca, 0x40, 0x4d, 0xce, 0x72, 0xcf, 0x8e, 0x06, 0x47, 0x97, 0xd4, 0xd9, 0x5f, 0x87, 0x25, 0x26,
-	0x77, 0x92, 0x0f, 0x63, 0x81, 0xbb, 0x63, 0x0b, 0xe6, 0x70, 0x3b, 0xb4, 0x52, 0xde, 0x95, 0x9b,
-	0xaf, 0x8a, 0x1d, 0xa6, 0x56, 0xfe, 0x05, 0x71, 0x51, 0x78, 0x4e, 0x5c, 0x2c, 0xbd, 0x50, 0x5c,
-	0xec, 0xc3, 0xda, 0x3c, 0xe3, 0x32, 0x38, 0xbe, 0x01, 0x4b, 0xc2, 0x29, 0x71, 0x0a, 0x5c, 0xe4,
-	0xb7, 0xd8, 0x44, 0xfd, 0x87, 0x0c, 0xac, 0xc9, 0xec, 0xf4, 0xf9, 0xf8, 0x4c, 0x53, 0x3c, 0xe7,
-	0x5f, 0x84, 0xe7, 0x17, 0xf4, 0x9f, 0xda, 0x80, 0xf5, 0x73, 0x3c, 0xbe, 0xc4, 0xc7, 0xfa, 0x63,
-	0x05, 0x96, 0xf7, 0xe8, 0xc8, 0xf1, 0x2e, 0xa9, 0x17, 0x52, 0xe4, 0xe6, 0x5e, 0x28, 0x88, 0x6f,
-	0x41, 0x45, 0xe2, 0x95, 0x6c, 0x5d, 0x64, 0x5b, 0x59, 0xc4, 0xf6, 0x7f, 0x28, 0x50, 0x69, 0xf8,
-	0xe3, 0xb1, 0x13, 0x5d, 0x52, 0xa6, 0x2e, 0xe2, 0xcc, 0x2d, 0xc2, 0x89, 0xa0, 0x1a, 0xc3, 0x14,
-	0x04, 0xa9, 0xff, 0xa9, 0xc0, 0x0a, 0xf1, 0x5d, 0xf7, 0xd0, 0x1e, 0x1c, 0xbf, 0xda, 0xd8, 0x31,
-	0xa0, 0x19, 0x50, 0x89, 0xfe, 0x7f, 0x14, 0xa8, 0x76, 0x03, 0xca, 0x7e, 0xac, 0x5f, 0x69, 0xf0,
-	0x6c, 0x27, 0x3c, 0x8c, 0xe4, 0x1e, 0xa2, 0x44, 0x78, 0x59, 0x5d, 0x85, 0x95, 0x04, 0xbb, 0xe4,
-	0xe3, 0x5f, 0x14, 0x58, 0x17, 0x01, 0x22, 0x35, 0xc3, 0x4b, 0x4a, 0x4b, 0x8c, 0x37, 0x97, 0xc2,
-	0x5b, 0x87, 0xab, 0xe7, 0xb1, 0x49, 0xd8, 0x3f, 0xc8, 0xc0, 0xb5, 0x38, 0x36, 0x2e, 0x39, 0xf0,
-	0xff, 0x47, 0x3c, 0x6c, 0x40, 0xfd, 0x22, 0x09, 0x92, 0xa1, 0x8f, 0x32, 0x50, 0x6f, 0x04, 0xd4,
-	0x8e, 0x68, 0x6a, 0x2f, 0xf2, 0xea, 0xc4, 0x06, 0x7e, 0x17, 0x96, 0x27, 0x76, 0x10, 0x39, 0x03,
-	0x67, 0x62, 0xb3, 0xbf, 0xbd, 0x3c, 0xdf, 0xea, 0x9c, 0xeb, 0x60, 0xce, 0x44, 0x7d, 0x1d, 0x5e,
-	0x5b, 0xc0, 0x88, 0xe4, 0xeb, 0x7f, 0x15, 0xc0, 0xbd, 0xc8, 0x0e, 0xa2, 0xcf, 0xc1, 0xaa, 0xb2,
-	0x30, 0x98, 0xd6, 0xa1, 0x36, 0x87, 0x3f, 0xcd, 0x0b, 0x8d, 0x3e, 0x17, 0x2b, 0xce, 0xa7, 0xf2,
-	0x92, 0xc6, 0x2f, 0x79, 0xf9, 0x37, 0x05, 0x36, 0x1a, 0xbe, 0x38, 0x58, 0x7c, 0x25, 0xbf, 0x30,
-	0xf5, 0x4d, 0x78, 0x7d, 0x21, 0x40, 0x49, 0xc0, 0xbf, 0x2a, 0x70, 0x95, 0x50, 0x7b, 0xf8, 0x6a,
-	0x82, 0xbf, 0x0f, 0xd7, 0x2e, 0x80, 0x93, 0x3b, 0xd4, 0x5b, 0x50, 0x1c, 0xd3, 0xc8, 0x1e, 0xda,
-	0x91, 0x2d, 0x21, 0x6d, 0xc4, 0xfd, 0xce, 0xac, 0xdb, 0xd2, 0x82, 0x24, 0xb6, 0xea, 0x27, 0x19,
-	0xa8, 0xf1, 0xbd, 0xee, 0x17, 0x3f, 0x5a, 0x8b, 0xff, 0x05, 0x3e, 0x52, 0x60, 0x6d, 0x9e, 0xa0,
-	0xe4, 0x9f, 0xe0, 0xa7, 0x7d, 0x5e, 0xb1, 0x20, 0x21, 0x64, 0x17, 0x6d, 0x41, 0xff, 0x31, 0x03,
-	0xf5, 0xf4, 0x94, 0xbe, 0x38, 0xdb, 0x98, 0x3f, 0xdb, 0xf8, 0x89, 0x0f, 0xb3, 0x3e, 0x56, 0xe0,
-	0xb5, 0x05, 0x84, 0xfe, 0x64, 0x8e, 0x4e, 0x9d, 0x70, 0x64, 0x9e, 0x7b, 0xc2, 0xf1, 0xa2, 0xae,
-	0xfe, 0x67, 0x05, 0xd6, 0xda, 0xe2, 0x60, 0x59, 0xfc, 0xc7, 0x5f, 0xde, 0x6c, 0xc6, 0xcf, 0x8e,
-	0x73, 0xb3, 0xeb, 0x1b, 0xb5, 0x01, 0xeb, 0xe7, 0xa0, 0xbd, 0xc4, 0xd9, 0xc4, 0x7f, 0x2b, 0xb0,
-	0x2a, 0x7b, 0xd1, 0x2e, 0xed, 0x46, 0x60, 0x01, 0x3b, 0xf8, 0x2d, 0xc8, 0x3a, 0xc3, 0x78, 0x07,
-	0x39, 0x7f, 0x09, 0xce, 0x14, 0xea, 0x1d, 0xc0, 0x69, 0xdc, 0x2f, 0x41, 0xdd, 0x3f, 0x65, 0x61,
-	0xb5, 0x37, 0x71, 0x9d, 0x48, 0x2a, 0x5f, 0xed, 0xc4, 0xff, 0x25, 0x58, 0x0e, 0x19, 0x58, 0x4b,
-	0x5c, 0xc9, 0x71, 0x62, 0x4b, 0xa4, 0xcc, 0x65, 0x0d, 0x2e, 0xc2, 0x6f, 0x43, 0x39, 0x36, 0x99,
-	0x7a, 0x91, 0x3c, 0x50, 0x03, 0x69, 0x31, 0xf5, 0x22, 0x7c, 0x13, 0xae, 0x79, 0xd3, 0x31, 0xbf,
-	0xd2, 0xb6, 0x26, 0x34, 0x88, 0x2f, 0x7c, 0xed, 0x20, 0xbe, 0x7a, 0xae, 0x79, 0xd3, 0x31, 0xf1,
-	0x4f, 0xc3, 0x2e, 0x0d, 0xc4, 0x85, 0xaf, 0x1d, 0x44, 0xf8, 0x0e, 0x94, 0x6c, 0x77, 0xe4, 0x07,
-	0x4e, 0x74, 0x34, 0x96, 0x77, 0xce, 0x6a, 0x7c, 0x03, 0x73, 0x9e, 0xfe, 0x1d, 0x2d, 0xb6, 0x24,
-	0xb3, 0x46, 0xea, 0x37, 0xa0, 0x94, 0xc8, 0x31, 0x82, 0x65, 0xfd, 0x7e, 0x5f, 0x6b, 0x59, 0xbd,
-	0x6e, 0xab, 0x69, 0xf6, 0xc4, 0x3d, 0xf1, 0x41, 0xbf, 0xd5, 0xb2, 0x7a, 0x0d, 0xcd, 0x40, 0x8a,
-	0x4a, 0x00, 0x78, 0x97, 0xbc, 0xf3, 0x19, 0x41, 0xca, 0x73, 0x08, 0x7a, 0x1d, 0x4a, 0x81, 0x7f,
-	0x2a, 0xb1, 0x67, 0x38, 0x9c, 0x62, 0xe0, 0x9f, 0x72, 0xe4, 0xaa, 0x06, 0x38, 0x3d, 0x57, 0x19,
-	0x6d, 0xa9, 0xe4, 0xad, 0xcc, 0x25, 0xef, 0xd9, 0xf8, 0x49, 0xf2, 0x16, 0x5b, 0x79, 0xf6, 0x9d,
-	0x7f, 0x40, 0x6d, 0x37, 0x8a, 0xd7, 0x2b, 0xf5, 0xaf, 0x33, 0x50, 0x21, 0x4c, 0xe2, 0x8c, 0x69,
-	0x2f, 0xb2, 0xa3, 0x90, 0x79, 0xea, 0x88, 0x9b, 0x58, 0xb3, 0xb4, 0x5b, 0x22, 0x65, 0x21, 0x13,
-	0x77, 0x05, 0xbb, 0xb0, 0x1e, 0xd2, 0x81, 0xef, 0x0d, 0x43, 0xeb, 0x90, 0x1e, 0x39, 0xde, 0xd0,
-	0x1a, 0xdb, 0x61, 0x24, 0xaf, 0x23, 0x2b, 0xa4, 0x26, 0x95, 0x7b, 0x5c, 0xd7, 0xe6, 0x2a, 0x7c,
-	0x03, 0xd6, 0x0e, 0x1d, 0xcf, 0xf5, 0x47, 0xd6, 0xc4, 0xb5, 0xcf, 0x68, 0x10, 0x4a, 0xa8, 0x2c,
-	0xbc, 0xf2, 0x04, 0x0b, 0x5d, 0x57, 0xa8, 0x84, 0xbb, 0x3f, 0x84, 0xed, 0x85, 0xa3, 0x58, 0x8f,
-	0x1d, 0x37, 0xa2, 0x01, 0x1d, 0x5a, 0x01, 0x9d, 0xb8, 0xce, 0x40, 0xbc, 0x26, 0x10, 0x7b, 0xf7,
-	0xaf, 0x2e, 0x18, 0xfa, 0x40, 0x9a, 0x93, 0x99, 0x35, 0x63, 0x7b, 0x30, 0x99, 0x5a, 0x53, 0x7e,
-	0x83, 0xc8, 0x56, 0x31, 0x85, 0x14, 0x07, 0x93, 0x69, 0x9f, 0xd5, 0x31, 0x82, 0xec, 0x93, 0x89,
-	0x58, 0xbc, 0x14, 0xc2, 0x8a, 0xea, 0x8f, 0x15, 0xa8, 0x6a, 0xa3, 0x51, 0x40, 0x47, 0x76, 0x24,
-	0x69, 0xba, 0x01, 0x6b, 0x82, 0x92, 0x33, 0x4b, 0x3e, 0x53, 0x12, 0x78, 0x14, 0x81, 0x47, 0xea,
-	0xc4, 0x23, 0xa5, 0x38, 0x7c, 0xaf, 0x4e, 0xbd, 0x85, 0x6d, 0x32, 0xbc, 0xcd, 0x5a, 0xa2, 0x4d,
-	0xb7, 0xfa, 0x79, 0x78, 0x6d, 0x31, 0x0b, 0x63, 0x47, 0x3c, 0x34, 0xa9, 0x90, 0xab, 0x0b, 0x40,
-	0xb7, 0x1d, 0xef, 0x33, 0x9a, 0xda, 0x4f, 0x39, 0x5f, 0x9f, 0xd2, 0xd4, 0x7e, 0xaa, 0xfe, 0x7b,
-	0x72, 0x03, 0x10, 0x87, 0x4b, 0xb2, 0x1a, 0xc7, 0x79, 0x41, 0xf9, 0xac, 0xbc, 0x50, 0x87, 0xa5,
-	0x90, 0x06, 0x27, 0x8e, 0x37, 0x8a, 0xaf, 0xa8, 0x65, 0x15, 0xf7, 0xe0, 0xab, 0x12, 0x3b, 0x7d,
-	0x1a, 0xd1, 0xc0, 0xb3, 0x5d, 0xf7, 0xcc, 0x12, 0x07, 0x15, 0x5e, 0x44, 0x87, 0xd6, 0xec, 0x51,
-	0x95, 0x58, 0x91, 0xbf, 0x2c, 0xac, 0xf5, 0xc4, 0x98, 0x24, 0xb6, 0x66, 0xf2, 0xdc, 0xea, 0x5b,
-	0x50, 0x0d, 0x64, 0x10, 0x5b, 0x21, 0x73, 0x8f, 0xcc, 0x47, 0x6b, 0xc9, 0x3d, 0x73, 0x2a, 0xc2,
-	0x49, 0x25, 0x98, 0x0b, 0xf8, 0xef, 0xc0, 0x8a, 0x1d, 0xfb, 0x56, 0xb6, 0x9e, 0xdf, 0xb7, 0xcc,
-	0x7b, 0x9e, 0x54, 0xed, 0xf9, 0x48, 0xb8, 0x0d, 0xcb, 0x12, 0x91, 0xed, 0x3a, 0xf6, 0x6c, 0x63,
-	0x7b, 0xee, 0xa5, 0x9a, 0xc6, 0x94, 0x44, 0xbe, 0x69, 0xe3, 0x15, 0xf6, 0x1f, 0x5d, 0xeb, 0x4f,
-	0x86, 0xbc, 0xa7, 0x4b, 0xbc, 0xbb, 0x48, 0x3f, 0x6b, 0xcb, 0xcd, 0x3f, 0x6b, 0x9b, 0x7f, 0x26,
-	0x97, 0x3f, 0xf7, 0x4c, 0x4e, 0xbd, 0x03, 0x6b, 0xf3, 0xf8, 0x65, 0x94, 0x6d, 0x41, 0x9e, 0x5f,
-	0xa8, 0x9f, 0x5b, 0x46, 0x53, 0x37, 0xe6, 0x44, 0x18, 0xa8, 0x7f, 0xab, 0x40, 0x6d, 0xc1, 0x2f,
-	0x56, 0xf2, 0xff, 0xa6, 0xa4, 0x8e, 0x87, 0x7e, 0x16, 0xf2, 0xfc, 0x6a, 0x5f, 0xbe, 0x58, 0xb9,
-	0x76, 0xf1, 0x0f, 0x8d, 0x5f, 0xc3, 0x13, 0x61, 0xc5, 0x12, 0x21, 0x0f, 0xa8, 0x01, 0x3f, 0x1f,
-	0x8a, 0x77, 0x88, 0x65, 0x26, 0x13, 0x47, 0x46, 0x17, 0x0f, 0x9c, 0x72, 0xcf, 0x3d, 0x70, 0xda,
-	0xfe, 0x83, 0x2c, 0x94, 0xda, 0x67, 0xbd, 0x27, 0xee, 0x81, 0x6b, 0x8f, 0xf8, 0x3d, 0x79, 0xbb,
-	0x6b, 0x3e, 0x42, 0x57, 0xf0, 0x2a, 0x54, 0x8c, 0x8e, 0x69, 0x19, 0x6c, 0x29, 0x39, 0x68, 0x69,
-	0x77, 0x91, 0xc2, 0xd6, 0x9a, 0x2e, 0x69, 0x5a, 0xf7, 0xf4, 0x47, 0x42, 0x92, 0xc1, 0x35, 0x58,
-	0xe9, 0x1b, 0xcd, 0xfb, 0x7d, 0x7d, 0x26, 0xcc, 0xe1, 0x75, 0x58, 0x6d, 0xf7, 0x5b, 0x66, 0xb3,
-	0xdb, 0x4a, 0x89, 0x8b, 0x6c, 0x5d, 0xda, 0x6b, 0x75, 0xf6, 0x44, 0x15, 0xb1, 0xfe, 0xfb, 0x46,
-	0xaf, 0x79, 0xd7, 0xd0, 0xf7, 0x85, 0x68, 0x93, 0x89, 0x3e, 0xd4, 0x49, 0xe7, 0xa0, 0x19, 0x0f,
-	0x79, 0x07, 0x23, 0x28, 0xef, 0x35, 0x0d, 0x8d, 0xc8, 0x5e, 0x9e, 0x29, 0xb8, 0x0a, 0x25, 0xdd,
-	0xe8, 0xb7, 0x65, 0x3d, 0x83, 0xeb, 0x50, 0xd3, 0xfa, 0x66, 0xc7, 0x6a, 0x1a, 0x0d, 0xa2, 0xb7,
-	0x75, 0xc3, 0x94, 0x9a, 0x1c, 0xae, 0x41, 0xd5, 0x6c, 0xb6, 0xf5, 0x9e, 0xa9, 0xb5, 0xbb, 0x52,
-	0xc8, 0x66, 0x51, 0xec, 0xe9, 0xb1, 0x0d, 0xc2, 0x1b, 0xb0, 0x6e, 0x74, 0x2c, 0xf9, 0xd8, 0xc9,
-	0x7a, 0xa0, 0xb5, 0xfa, 0xba, 0xd4, 0x6d, 0xe2, 0x6b, 0x80, 0x3b, 0x86, 0xd5, 0xef, 0xee, 0x6b,
-	0xa6, 0x6e, 0x19, 0x9d, 0x87, 0x52, 0x71, 0x07, 0x57, 0xa1, 0x38, 0x9b, 0xc1, 0x33, 0xc6, 0x42,
-	0xa5, 0xab, 0x11, 0x73, 0x06, 0xf6, 0xd9, 0x33, 0x46, 0x16, 0xdc, 0x25, 0x9d, 0x7e, 0x77, 0x66,
-	0xb6, 0x0a, 0x65, 0x49, 0x96, 0x14, 0xe5, 0x98, 0x68, 0xaf, 0x69, 0x34, 0x92, 0xf9, 0x3d, 0x2b,
-	0x6e, 0x64, 0x90, 0xb2, 0x7d, 0x0c, 0x39, 0xee, 0x8e, 0x22, 0xe4, 0x8c, 0x8e, 0xa1, 0xa3, 0x2b,
-	0x78, 0x05, 0xa0, 0xd9, 0x6b, 0x1a, 0xa6, 0x7e, 0x97, 0x68, 0x2d, 0x06, 0x9b, 0x0b, 0x62, 0x02,
-	0x19, 0xda, 0x65, 0x58, 0x6a, 0xf6, 0x0e, 0x5a, 0x1d, 0xcd, 0x94, 0x30, 0x9b, 0xbd, 0xfb, 0xfd,
-	0x8e, 0xc9, 0x94, 0x08, 0x97, 0xa1, 0xd0, 0xec, 0x99, 0xfa, 0xf7, 0x4c, 0x86, 0x8b, 0xeb, 0x04,
-	0xab, 0xe8, 0xd9, 0x9d, 0xed, 0x1f, 0x65, 0x21, 0xc7, 0x9f, 0xaa, 0x56, 0xa0, 0xc4, 0xbd, 0x6d,
-	0x3e, 0xea, 0xb2, 0x21, 0x4b, 0x90, 0x6b, 0x1a, 0xe6, 0x6d, 0xf4, 0x4b, 0x19, 0x0c, 0x90, 0xef,
-	0xf3, 0xf2, 0x2f, 0x17, 0x58, 0xb9, 0x69, 0x98, 0xef, 0xde, 0x42, 0x3f, 0xc8, 0xb0, 0x6e, 0xfb,
-	0xa2, 0xf2, 0x2b, 0xb1, 0x62, 0xf7, 0x26, 0xfa, 0x61, 0xa2, 0xd8, 0xbd, 0x89, 0x7e, 0x35, 0x56,
-	0xbc, 0xb7, 0x8b, 0x7e, 0x2d, 0x51, 0xbc, 0xb7, 0x8b, 0x7e, 0x3d, 0x56, 0xdc, 0xba, 0x89, 0x7e,
-	0x23, 0x51, 0xdc, 0xba, 0x89, 0x7e, 0xb3, 0xc0, 0xb0, 0x70, 0x24, 0xef, 0xed, 0xa2, 0xdf, 0x2a,
-	0x26, 0xb5, 0x5b, 0x37, 0xd1, 0x6f, 0x17, 0x99, 0xff, 0x13, 0xaf, 0xa2, 0xdf, 0x41, 0x6c, 0x9a,
-	0xcc, 0x41, 0xe8, 0x77, 0x79, 0x91, 0xa9, 0xd0, 0xef, 0x21, 0x86, 0x91, 0x49, 0x79, 0xf5, 0x23,
-	0xae, 0x79, 0xa4, 0x6b, 0x04, 0xfd, 0x7e, 0x41, 0xbc, 0x6d, 0x6b, 0x34, 0xdb, 0x5a, 0x0b, 0x61,
-	0xde, 0x82, 0xb1, 0xf2, 0x87, 0x37, 0x58, 0x91, 0x85, 0x27, 0xfa, 0xa3, 0x2e, 0x1b, 0xf0, 0x81,
-	0x46, 0x1a, 0x1f, 0x68, 0x04, 0xfd, 0xf1, 0x0d, 0x36, 0xe0, 0x03, 0x8d, 0x48, 0xbe, 0xfe, 0xa4,
-	0xcb, 0x0c, 0xb9, 0xea, 0xe3, 0x1b, 0x6c, 0xd2, 0x52, 0xfe, 0xa7, 0x5d, 0x5c, 0x84, 0xec, 0x5e,
-	0xd3, 0x44, 0x3f, 0xe2, 0xa3, 0xb1, 0x10, 0x45, 0x7f, 0x86, 0x98, 0xb0, 0xa7, 0x9b, 0xe8, 0xcf,
-	0x99, 0x30, 0x6f, 0xf6, 0xbb, 0x2d, 0x1d, 0xbd, 0xc1, 0x26, 0x77, 0x57, 0xef, 0xb4, 0x75, 0x93,
-	0x3c, 0x42, 0x7f, 0xc1, 0xcd, 0xbf, 0xdb, 0xeb, 0x18, 0xe8, 0x13, 0x84, 0xab, 0x00, 0xfa, 0xf7,
-	0xba, 0x44, 0xef, 0xf5, 0x9a, 0x1d, 0x03, 0xbd, 0xbd, 0x7d, 0x00, 0xe8, 0x7c, 0x3a, 0x60, 0x00,
-	0xfa, 0xc6, 0x3d, 0xa3, 0xf3, 0xd0, 0x40, 0x57, 0x58, 0xa5, 0x4b, 0xf4, 0xae, 0x46, 0x74, 0xa4,
-	0x60, 0x80, 0x82, 0x7c, 0x31, 0x97, 0xc1, 0xcb, 0x50, 0x24, 0x9d, 0x56, 0x6b, 0x4f, 0x6b, 0xdc,
-	0x43, 0xd9, 0xbd, 0x6f, 0xc2, 0x8a, 0xe3, 0xef, 0x9c, 0x38, 0x11, 0x0d, 0x43, 0xf1, 0x18, 0xfa,
-	0x43, 0x55, 0xd6, 0x1c, 0xff, 0xba, 0x28, 0x5d, 0x1f, 0xf9, 0xd7, 0x4f, 0xa2, 0xeb, 0x5c, 0x7b,
-	0x9d, 0x67, 0x8c, 0xc3, 0x02, 0xaf, 0xbc, 0xf7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x5b,
-	0xfa, 0xe7, 0x6a, 0x2d, 0x00, 0x00,
+	// 3369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x73, 0xdb, 0x56,
+	0x77, 0x37, 0xf8, 0x12, 0x79, 0x28, 0x52, 0xd0, 0x95, 0x64, 0xd3, 0x72, 0x12, 0xeb, 0x43, 0xe2,
+	0x44, 0x55, 0x52, 0xd9, 0x91, 0x1d, 0xd7, 0x4d, 0xd2, 0xd4, 0x10, 0x05, 0x39, 0x8c, 0x49, 0x90,
+	0xbe, 0x04, 0xed, 0xd8, 0x93, 0x19, 0x0c, 0x44, 0x5e, 0x53, 0x18, 0x81, 0x00, 0x0d, 0x80, 0xb2,
+	0xb5, 0x73, 0x9b, 0xa6, 0xcf, 0xb4, 0x4d, 0xfa, 0x4a, 0xd3, 0x4e, 0xd3, 0xce, 0x74, 0xd1, 0x5d,
+	0xff, 0x86, 0x4e, 0x17, 0x5d, 0x76, 0xd7, 0x45, 0xdb, 0x45, 0x3b, 0xd3, 0xe9, 0xa4, 0xab, 0x4e,
+	0x57, 0x5d, 0x74, 0xd1, 0xe9, 0xdc, 0x07, 0x40, 0x50, 0xa2, 0x1f, 0x71, 0x9a, 0xf9, 0x46, 0x76,
+	0x76, 0xf7, 0x3c, 0xee, 0xe3, 0xfc, 0xce, 0xe1, 0xb9, 0x07, 0xf7, 0x5e, 0x42, 0xf1, 0xde, 0x88,
+	0xf8, 0x07, 0xeb, 0x43, 0xdf, 0x0b, 0x3d, 0x94, 0x65, 0xc4, 0x72, 0x39, 0xf4, 0x86, 0x5e, 0xcf,
+	0x0a, 0x2d, 0xce, 0x5e, 0x2e, 0xee, 0x87, 0xfe, 0xb0, 0xcb, 0x09, 0xe5, 0x33, 0x09, 0x72, 0x86,
+	0xe5, 0xf7, 0x49, 0x88, 0x96, 0x21, 0xbf, 0x47, 0x0e, 0x82, 0xa1, 0xd5, 0x25, 0x15, 0x69, 0x45,
+	0x5a, 0x2d, 0xe0, 0x98, 0x46, 0x8b, 0x90, 0x0d, 0x76, 0x2d, 0xbf, 0x57, 0x49, 0x31, 0x01, 0x27,
+	0xd0, 0x3b, 0x50, 0x0c, 0xad, 0x1d, 0x87, 0x84, 0x66, 0x78, 0x30, 0x24, 0x95, 0xf4, 0x8a, 0xb4,
+	0x5a, 0xde, 0x58, 0x5c, 0x8f, 0xe7, 0x33, 0x98, 0xd0, 0x38, 0x18, 0x12, 0x0c, 0x61, 0xdc, 0x46,
+	0x08, 0x32, 0x5d, 0xe2, 0x38, 0x95, 0x0c, 0x1b, 0x8b, 0xb5, 0x95, 0x2d, 0x28, 0xdf, 0x34, 0xae,
+	0x59, 0x21, 0xa9, 0x5a, 0x8e, 0x43, 0xfc, 0xda, 0x16, 0x5d, 0xce, 0x28, 0x20, 0xbe, 0x6b, 0x0d,
+	0xe2, 0xe5, 0x44, 0x34, 0x3a, 0x09, 0xb9, 0xbe, 0xef, 0x8d, 0x86, 0x41, 0x25, 0xb5, 0x92, 0x5e,
+	0x2d, 0x60, 0x41, 0x29, 0x9f, 0x00, 0x68, 0xfb, 0xc4, 0x0d, 0x0d, 0x6f, 0x8f, 0xb8, 0xe8, 0x25,
+	0x28, 0x84, 0xf6, 0x80, 0x04, 0xa1, 0x35, 0x18, 0xb2, 0x21, 0xd2, 0x78, 0xcc, 0x78, 0x84, 0x49,
+	0xcb, 0x90, 0x1f, 0x7a, 0x81, 0x1d, 0xda, 0x9e, 0xcb, 0xec, 0x29, 0xe0, 0x98, 0x56, 0x3e, 0x80,
+	0xec, 0x4d, 0xcb, 0x19, 0x11, 0x74, 0x16, 0x32, 0xcc, 0x60, 0x89, 0x19, 0x5c, 0x5c, 0xe7, 0xa0,
+	0x33, 0x3b, 0x99, 0x80, 0x8e, 0xbd, 0x4f, 0x35, 0xd9, 0xd8, 0xb3, 0x98, 0x13, 0xca, 0x1e, 0xcc,
+	0x6e, 0xda, 0x6e, 0xef, 0xa6, 0xe5, 0xdb, 0x14, 0x8c, 0x67, 0x1c, 0x06, 0xbd, 0x06, 0x39, 0xd6,
+	0x08, 0x2a, 0xe9, 0x95, 0xf4, 0x6a, 0x71, 0x63, 0x56, 0x74, 0x64, 0x6b, 0xc3, 0x42, 0xa6, 0xfc,
+	0xad, 0x04, 0xb0, 0xe9, 0x8d, 0xdc, 0xde, 0x0d, 0x2a, 0x44, 0x32, 0xa4, 0x83, 0x7b, 0x8e, 0x00,
+	0x92, 0x36, 0xd1, 0x75, 0x28, 0xef, 0xd8, 0x6e, 0xcf, 0xdc, 0x17, 0xcb, 0xe1, 0x58, 0x16, 0x37,
+	0x5e, 0x13, 0xc3, 0x8d, 0x3b, 0xaf, 0x27, 0x57, 0x1d, 0x68, 0x6e, 0xe8, 0x1f, 0xe0, 0xd2, 0x4e,
+	0x92, 0xb7, 0xdc, 0x01, 0x74, 0x54, 0x89, 0x4e, 0xba, 0x47, 0x0e, 0xa2, 0x49, 0xf7, 0xc8, 0x01,
+	0xfa, 0x99, 0xa4, 0x45, 0xc5, 0x8d, 0x85, 0x68, 0xae, 0x44, 0x5f, 0x61, 0xe6, 0xbb, 0xa9, 0x2b,
+	0x92, 0xf2, 0xe7, 0x39, 0x28, 0x6b, 0x0f, 0x48, 0x77, 0x14, 0x92, 0xe6, 0x90, 0xfa, 0x20, 0x40,
+	0xeb, 0xb0, 0x60, 0xbb, 0x5d, 0x67, 0xd4, 0x23, 0x26, 0xa1, 0xae, 0x36, 0x43, 0xea, 0x6b, 0x36,
+	0x5e, 0x1e, 0xcf, 0x0b, 0x51, 0x22, 0x08, 0x54, 0x58, 0xe8, 0x7a, 0x83, 0xa1, 0xe5, 0x4f, 0xea,
+	0xa7, 0xd9, 0xfc, 0xf3, 0x62, 0xfe, 0xb1, 0x3e, 0x9e, 0x17, 0xda, 0x89, 0x21, 0x1a, 0x30, 0x27,
+	0xc6, 0xed, 0x99, 0x77, 0x6d, 0xe2, 0xf4, 0x02, 0x16, 0xba, 0xe5, 0x18, 0xaa, 0xc9, 0x25, 0xae,
+	0xd7, 0x84, 0xf2, 0x36, 0xd3, 0xc5, 0x65, 0x7b, 0x82, 0x46, 0x6b, 0x30, 0xdf, 0x75, 0x6c, 0xba,
+	0x94, 0xbb, 0x14, 0x62, 0xd3, 0xf7, 0xee, 0x07, 0x95, 0x2c, 0x5b, 0xff, 0x1c, 0x17, 0x6c, 0x53,
+	0x3e, 0xf6, 0xee, 0x07, 0xe8, 0x5d, 0xc8, 0xdf, 0xf7, 0xfc, 0x3d, 0xc7, 0xb3, 0x7a, 0x95, 0x1c,
+	0x9b, 0xf3, 0x95, 0xe9, 0x73, 0xde, 0x12, 0x5a, 0x38, 0xd6, 0x47, 0xab, 0x20, 0x07, 0xf7, 0x1c,
+	0x33, 0x20, 0x0e, 0xe9, 0x86, 0xa6, 0x63, 0x0f, 0xec, 0xb0, 0x92, 0x67, 0xbf, 0x82, 0x72, 0x70,
+	0xcf, 0x69, 0x33, 0x76, 0x9d, 0x72, 0x91, 0x09, 0x4b, 0xa1, 0x6f, 0xb9, 0x81, 0xd5, 0xa5, 0x83,
+	0x99, 0x76, 0xe0, 0x39, 0x16, 0x6d, 0x55, 0x0a, 0x6c, 0xca, 0xb5, 0xe9, 0x53, 0x1a, 0xe3, 0x2e,
+	0xb5, 0xa8, 0x07, 0x5e, 0x0c, 0xa7, 0x70, 0xd1, 0xdb, 0xb0, 0x14, 0xec, 0xd9, 0x43, 0x93, 0x8d,
+	0x63, 0x0e, 0x1d, 0xcb, 0x35, 0xbb, 0x56, 0x77, 0x97, 0x54, 0x80, 0x99, 0x8d, 0xa8, 0x90, 0x85,
+	0x5a, 0xcb, 0xb1, 0xdc, 0x2a, 0x95, 0x28, 0xef, 0x41, 0x79, 0x12, 0x47, 0x34, 0x0f, 0x25, 0xe3,
+	0x76, 0x4b, 0x33, 0x55, 0x7d, 0xcb, 0xd4, 0xd5, 0x86, 0x26, 0x9f, 0x40, 0x25, 0x28, 0x30, 0x56,
+	0x53, 0xaf, 0xdf, 0x96, 0x25, 0x34, 0x03, 0x69, 0xb5, 0x5e, 0x97, 0x53, 0xca, 0x15, 0xc8, 0x47,
+	0x80, 0xa0, 0x39, 0x28, 0x76, 0xf4, 0x76, 0x4b, 0xab, 0xd6, 0xb6, 0x6b, 0xda, 0x96, 0x7c, 0x02,
+	0xe5, 0x21, 0xd3, 0xac, 0x1b, 0x2d, 0x59, 0xe2, 0x2d, 0xb5, 0x25, 0xa7, 0x68, 0xcf, 0xad, 0x4d,
+	0x55, 0x4e, 0x2b, 0x7f, 0x25, 0xc1, 0xe2, 0x34, 0xc3, 0x50, 0x11, 0x66, 0xb6, 0xb4, 0x6d, 0xb5,
+	0x53, 0x37, 0xe4, 0x13, 0x68, 0x01, 0xe6, 0xb0, 0xd6, 0xd2, 0x54, 0x43, 0xdd, 0xac, 0x6b, 0x26,
+	0xd6, 0xd4, 0x2d, 0x59, 0x42, 0x08, 0xca, 0xb4, 0x65, 0x56, 0x9b, 0x8d, 0x46, 0xcd, 0x30, 0xb4,
+	0x2d, 0x39, 0x85, 0x16, 0x41, 0x66, 0xbc, 0x8e, 0x3e, 0xe6, 0xa6, 0x91, 0x0c, 0xb3, 0x6d, 0x0d,
+	0xd7, 0xd4, 0x7a, 0xed, 0x0e, 0x1d, 0x40, 0xce, 0xa0, 0x9f, 0xc0, 0xcb, 0xd5, 0xa6, 0xde, 0xae,
+	0xb5, 0x0d, 0x4d, 0x37, 0xcc, 0xb6, 0xae, 0xb6, 0xda, 0x1f, 0x36, 0x0d, 0x36, 0x32, 0x37, 0x2e,
+	0x8b, 0xca, 0x00, 0x6a, 0xc7, 0x68, 0xf2, 0x71, 0xe4, 0xdc, 0x47, 0x99, 0xbc, 0x24, 0xa7, 0x94,
+	0xaf, 0x52, 0x90, 0x65, 0xf8, 0xd0, 0xac, 0x9a, 0xc8, 0x95, 0xac, 0x1d, 0x67, 0x98, 0xd4, 0x63,
+	0x32, 0x0c, 0x4b, 0xcc, 0x22, 0xd7, 0x71, 0x02, 0x9d, 0x81, 0x82, 0xe7, 0xf7, 0x4d, 0x2e, 0xe1,
+	0x59, 0x3a, 0xef, 0xf9, 0x7d, 0x96, 0xce, 0x69, 0x86, 0xa4, 0xc9, 0x7d, 0xc7, 0x0a, 0x08, 0x8b,
+	0xda, 0x02, 0x8e, 0x69, 0x74, 0x1a, 0xa8, 0x9e, 0xc9, 0xd6, 0x91, 0x63, 0xb2, 0x19, 0xcf, 0xef,
+	0xeb, 0x74, 0x29, 0xaf, 0x42, 0xa9, 0xeb, 0x39, 0xa3, 0x81, 0x6b, 0x3a, 0xc4, 0xed, 0x87, 0xbb,
+	0x95, 0x99, 0x15, 0x69, 0xb5, 0x84, 0x67, 0x39, 0xb3, 0xce, 0x78, 0xa8, 0x02, 0x33, 0xdd, 0x5d,
+	0xcb, 0x0f, 0x08, 0x8f, 0xd4, 0x12, 0x8e, 0x48, 0x36, 0x2b, 0xe9, 0xda, 0x03, 0xcb, 0x09, 0x58,
+	0x54, 0x96, 0x70, 0x4c, 0x53, 0x23, 0xee, 0x3a, 0x56, 0x3f, 0x60, 0xd1, 0x54, 0xc2, 0x9c, 0x50,
+	0x7e, 0x0e, 0xd2, 0xd8, 0xbb, 0x4f, 0x87, 0xe4, 0x13, 0x06, 0x15, 0x69, 0x25, 0xbd, 0x8a, 0x70,
+	0x44, 0xd2, 0x4d, 0x44, 0xe4, 0x51, 0x9e, 0x5e, 0xa3, 0xcc, 0xf9, 0x09, 0xcc, 0x62, 0x12, 0x8c,
+	0x9c, 0x50, 0x7b, 0x10, 0xfa, 0x56, 0x80, 0x36, 0xa0, 0x98, 0xcc, 0x1c, 0xd2, 0xa3, 0x32, 0x07,
+	0x90, 0xb8, 0x4d, 0x67, 0xbd, 0xeb, 0x93, 0x60, 0x97, 0xf8, 0x22, 0x33, 0x45, 0x24, 0xcd, 0xcb,
+	0x45, 0x16, 0xea, 0x7c, 0x0e, 0x9a, 0xcd, 0x45, 0x4e, 0x91, 0x26, 0xb2, 0x39, 0x73, 0x2a, 0x16,
+	0x32, 0x8a, 0x1e, 0x4d, 0x13, 0xa6, 0x75, 0xf7, 0x2e, 0xe9, 0x86, 0x84, 0x6f, 0x5a, 0x19, 0x3c,
+	0x4b, 0x99, 0xaa, 0xe0, 0x51, 0xb7, 0xd9, 0x6e, 0x40, 0xfc, 0xd0, 0xb4, 0x7b, 0xcc, 0xa1, 0x19,
+	0x9c, 0xe7, 0x8c, 0x5a, 0x0f, 0xbd, 0x02, 0x19, 0x96, 0x68, 0x32, 0x6c, 0x16, 0x10, 0xb3, 0x60,
+	0xef, 0x3e, 0x66, 0x7c, 0xf4, 0x26, 0xe4, 0x08, 0xb3, 0xb7, 0x92, 0x9d, 0x48, 0xcd, 0x49, 0x28,
+	0xb0, 0x50, 0x51, 0xde, 0x87, 0x59, 0x66, 0xc3, 0x2d, 0xcb, 0x77, 0x6d, 0xb7, 0xcf, 0x76, 0x74,
+	0xaf, 0xc7, 0x63, 0xaf, 0x84, 0x59, 0x9b, 0x42, 0x30, 0x20, 0x41, 0x60, 0xf5, 0x89, 0xd8, 0x61,
+	0x23, 0x52, 0xf9, 0x8b, 0x34, 0x14, 0xdb, 0xa1, 0x4f, 0xac, 0x01, 0x43, 0x0f, 0xbd, 0x0f, 0x10,
+	0x84, 0x56, 0x48, 0x06, 0xc4, 0x0d, 0x23, 0x18, 0x5e, 0x12, 0xd3, 0x27, 0xf4, 0xd6, 0xdb, 0x91,
+	0x12, 0x4e, 0xe8, 0x1f, 0x76, 0x4f, 0xea, 0x29, 0xdc, 0xb3, 0xfc, 0x4d, 0x0a, 0x0a, 0xf1, 0x68,
+	0x48, 0x85, 0x7c, 0xd7, 0x0a, 0x49, 0xdf, 0xf3, 0x0f, 0xc4, 0x5e, 0x7c, 0xee, 0x71, 0xb3, 0xaf,
+	0x57, 0x85, 0x32, 0x8e, 0xbb, 0xa1, 0x97, 0x81, 0x17, 0x38, 0x3c, 0xf4, 0xb9, 0xbd, 0x05, 0xc6,
+	0x61, 0xc1, 0xff, 0x2e, 0xa0, 0xa1, 0x6f, 0x0f, 0x2c, 0xff, 0xc0, 0xdc, 0x23, 0x07, 0xd1, 0x26,
+	0x92, 0x9e, 0xe2, 0x70, 0x59, 0xe8, 0x5d, 0x27, 0x07, 0x22, 0xed, 0x5d, 0x99, 0xec, 0x2b, 0x42,
+	0xf6, 0xa8, 0x1b, 0x13, 0x3d, 0x59, 0x25, 0x10, 0x44, 0x7b, 0x7e, 0x96, 0x45, 0x37, 0x6d, 0x2a,
+	0x6f, 0x40, 0x3e, 0x5a, 0x3c, 0x2a, 0x40, 0x56, 0xf3, 0x7d, 0xcf, 0x97, 0x4f, 0xb0, 0xec, 0xd7,
+	0xa8, 0xf3, 0x04, 0xba, 0xb5, 0x45, 0x13, 0xe8, 0xdf, 0xa4, 0xe2, 0x8d, 0x17, 0x93, 0x7b, 0x23,
+	0x12, 0x84, 0xe8, 0x17, 0x61, 0x81, 0xb0, 0x48, 0xb3, 0xf7, 0x89, 0xd9, 0x65, 0x55, 0x1a, 0x8d,
+	0x33, 0xfe, 0x73, 0x98, 0x5b, 0xe7, 0x45, 0x65, 0x54, 0xbd, 0xe1, 0xf9, 0x58, 0x57, 0xb0, 0x7a,
+	0x48, 0x83, 0x05, 0x7b, 0x30, 0x20, 0x3d, 0xdb, 0x0a, 0x93, 0x03, 0x70, 0x87, 0x2d, 0x45, 0x45,
+	0xcc, 0x44, 0x11, 0x88, 0xe7, 0xe3, 0x1e, 0xf1, 0x30, 0xe7, 0x20, 0x17, 0xb2, 0x82, 0x55, 0xec,
+	0xe1, 0xa5, 0x28, 0xab, 0x31, 0x26, 0x16, 0x42, 0xf4, 0x06, 0xf0, 0xf2, 0x97, 0xe5, 0xaf, 0x71,
+	0x40, 0x8c, 0xab, 0x1a, 0xcc, 0xe5, 0xe8, 0x1c, 0x94, 0x27, 0x36, 0xbf, 0x1e, 0x03, 0x2c, 0x8d,
+	0x4b, 0x09, 0x6e, 0xad, 0x87, 0xce, 0xc3, 0x8c, 0xc7, 0x37, 0xbe, 0x4a, 0x6e, 0x62, 0xc5, 0x93,
+	0xbb, 0x22, 0x8e, 0xb4, 0x94, 0x5f, 0x80, 0xb9, 0x18, 0xc1, 0x60, 0xe8, 0xb9, 0x01, 0x41, 0x6b,
+	0x90, 0xf3, 0xd9, 0xcf, 0x49, 0xa0, 0x86, 0xc4, 0x10, 0x89, 0x7c, 0x80, 0x85, 0x86, 0xd2, 0x83,
+	0x39, 0xce, 0xb9, 0x65, 0x87, 0xbb, 0xcc, 0x51, 0xe8, 0x1c, 0x64, 0x09, 0x6d, 0x1c, 0xc2, 0x1c,
+	0xb7, 0xaa, 0x4c, 0x8e, 0xb9, 0x34, 0x31, 0x4b, 0xea, 0x89, 0xb3, 0xfc, 0x57, 0x0a, 0x16, 0xc4,
+	0x2a, 0x37, 0xad, 0xb0, 0xbb, 0x7b, 0x4c, 0x9d, 0xfd, 0x26, 0xcc, 0x50, 0xbe, 0x1d, 0xff, 0x30,
+	0xa6, 0xb8, 0x3b, 0xd2, 0xa0, 0x0e, 0xb7, 0x02, 0x33, 0xe1, 0x5d, 0x51, 0x7c, 0x95, 0xac, 0x20,
+	0xb1, 0xf3, 0x4f, 0x89, 0x8b, 0xdc, 0x13, 0xe2, 0x62, 0xe6, 0xa9, 0xe2, 0x62, 0x0b, 0x16, 0x27,
+	0x11, 0x17, 0xc1, 0xf1, 0x16, 0xcc, 0x70, 0xa7, 0x44, 0x29, 0x70, 0x9a, 0xdf, 0x22, 0x15, 0xe5,
+	0xef, 0x52, 0xb0, 0x28, 0xb2, 0xd3, 0x8b, 0xf1, 0x33, 0x4d, 0xe0, 0x9c, 0x7d, 0x1a, 0x9c, 0x9f,
+	0xd2, 0x7f, 0x4a, 0x15, 0x96, 0x0e, 0xe1, 0xf8, 0x0c, 0x3f, 0xd6, 0xff, 0x94, 0x60, 0x76, 0x93,
+	0xf4, 0x6d, 0xf7, 0x98, 0x7a, 0x21, 0x01, 0x6e, 0xe6, 0xa9, 0x82, 0xf8, 0x32, 0x94, 0x84, 0xbd,
+	0x02, 0xad, 0xa3, 0x68, 0x4b, 0xd3, 0xd0, 0xfe, 0x77, 0x09, 0x4a, 0x55, 0x6f, 0x30, 0xb0, 0xc3,
+	0x63, 0x8a, 0xd4, 0x51, 0x3b, 0x33, 0xd3, 0xec, 0x94, 0xa1, 0x1c, 0x99, 0xc9, 0x01, 0x52, 0xbe,
+	0x95, 0x60, 0x0e, 0x7b, 0x8e, 0xb3, 0x63, 0x75, 0xf7, 0x9e, 0x6f, 0xdb, 0x11, 0xc8, 0x63, 0x43,
+	0x85, 0xf5, 0xff, 0x23, 0x41, 0xb9, 0xe5, 0x93, 0xa1, 0xe5, 0x93, 0xe7, 0xda, 0x78, 0x5a, 0x09,
+	0xf7, 0x42, 0x51, 0x43, 0x14, 0x30, 0x6b, 0x2b, 0xf3, 0x30, 0x17, 0xdb, 0x2e, 0xf0, 0xf8, 0x27,
+	0x09, 0x96, 0x78, 0x80, 0x08, 0x49, 0xef, 0x98, 0xc2, 0x12, 0xd9, 0x9b, 0x49, 0xd8, 0x5b, 0x81,
+	0x93, 0x87, 0x6d, 0x13, 0x66, 0x7f, 0x9a, 0x82, 0x53, 0x51, 0x6c, 0x1c, 0x73, 0xc3, 0xbf, 0x47,
+	0x3c, 0x2c, 0x43, 0xe5, 0x28, 0x08, 0x02, 0xa1, 0x2f, 0x52, 0x50, 0xa9, 0xfa, 0xc4, 0x0a, 0x49,
+	0xa2, 0x16, 0x79, 0x7e, 0x62, 0x03, 0xbd, 0x0d, 0xb3, 0x43, 0xcb, 0x0f, 0xed, 0xae, 0x3d, 0xb4,
+	0xe8, 0xd7, 0x5e, 0x76, 0x25, 0x7d, 0x74, 0x80, 0x09, 0x15, 0xe5, 0x0c, 0x9c, 0x9e, 0x82, 0x88,
+	0xc0, 0xeb, 0x7f, 0x25, 0x40, 0xed, 0xd0, 0xf2, 0xc3, 0x17, 0x60, 0x57, 0x99, 0x1a, 0x4c, 0x4b,
+	0xb0, 0x30, 0x61, 0x7f, 0x12, 0x17, 0x12, 0xbe, 0x10, 0x3b, 0xce, 0x23, 0x71, 0x49, 0xda, 0x2f,
+	0x70, 0xf9, 0x17, 0x09, 0x96, 0xab, 0x1e, 0x3f, 0x58, 0x7c, 0x2e, 0x7f, 0x61, 0xca, 0xcb, 0x70,
+	0x66, 0xaa, 0x81, 0x02, 0x80, 0x7f, 0x96, 0xe0, 0x24, 0x26, 0x56, 0xef, 0xf9, 0x34, 0xfe, 0x06,
+	0x9c, 0x3a, 0x62, 0x9c, 0xa8, 0x50, 0x2f, 0x43, 0x7e, 0x40, 0x42, 0xab, 0x67, 0x85, 0x96, 0x30,
+	0x69, 0x39, 0x1a, 0x77, 0xac, 0xdd, 0x10, 0x1a, 0x38, 0xd6, 0x55, 0xbe, 0x49, 0xc1, 0x02, 0xab,
+	0x75, 0x7f, 0xfc, 0xd0, 0x9a, 0xfe, 0x2d, 0xf0, 0x85, 0x04, 0x8b, 0x93, 0x00, 0xc5, 0xdf, 0x04,
+	0xff, 0xdf, 0xe7, 0x15, 0x53, 0x12, 0x42, 0x7a, 0x5a, 0x09, 0xfa, 0xf7, 0x29, 0xa8, 0x24, 0x97,
+	0xf4, 0xe3, 0xd9, 0xc6, 0xe4, 0xd9, 0xc6, 0x77, 0x3e, 0xcc, 0xfa, 0x4a, 0x82, 0xd3, 0x53, 0x00,
+	0xfd, 0x6e, 0x8e, 0x4e, 0x9c, 0x70, 0xa4, 0x9e, 0x78, 0xc2, 0xf1, 0xb4, 0xae, 0xfe, 0x47, 0x09,
+	0x16, 0x1b, 0xfc, 0x60, 0x99, 0x7f, 0xc7, 0x1f, 0xdf, 0x6c, 0xc6, 0xce, 0x8e, 0x33, 0xe3, 0xeb,
+	0x1b, 0x7a, 0x36, 0x71, 0xc8, 0xb4, 0x67, 0x38, 0x9b, 0xf8, 0x6f, 0x09, 0xe6, 0xc5, 0x28, 0x6a,
+	0x77, 0xef, 0xf9, 0x41, 0x07, 0xbd, 0x02, 0x69, 0xbb, 0x17, 0x55, 0x90, 0x93, 0x97, 0xe0, 0x54,
+	0xa0, 0x5c, 0x05, 0x94, 0xb4, 0xfb, 0x19, 0xa0, 0xfb, 0x87, 0x34, 0xcc, 0xb7, 0x87, 0x8e, 0x1d,
+	0x0a, 0xe1, 0xf3, 0x9d, 0xf8, 0x7f, 0x02, 0xb3, 0x01, 0x35, 0xd6, 0xe4, 0x57, 0x72, 0x0c, 0xd8,
+	0x02, 0x2e, 0x32, 0x5e, 0x95, 0xb1, 0xd0, 0x59, 0x28, 0x46, 0x2a, 0x23, 0x37, 0x14, 0x07, 0x6a,
+	0x20, 0x34, 0x46, 0x6e, 0x88, 0x2e, 0xc1, 0x29, 0x77, 0x34, 0x60, 0x57, 0xda, 0xe6, 0x90, 0xf8,
+	0xd1, 0x85, 0xaf, 0xe5, 0x47, 0x57, 0xcf, 0x0b, 0xee, 0x68, 0x40, 0x6f, 0xb6, 0x5b, 0xc4, 0xe7,
+	0x17, 0xbe, 0x96, 0x1f, 0xa2, 0xab, 0x50, 0xb0, 0x9c, 0xbe, 0xe7, 0xdb, 0xe1, 0xee, 0x40, 0xdc,
+	0x39, 0x2b, 0xd1, 0x0d, 0xcc, 0x61, 0xf8, 0xd7, 0xd5, 0x48, 0x13, 0x8f, 0x3b, 0x29, 0x6f, 0x41,
+	0x21, 0xe6, 0xd3, 0xeb, 0x55, 0xed, 0x46, 0x47, 0xad, 0x9b, 0xed, 0x56, 0xbd, 0x66, 0xb4, 0xf9,
+	0x3d, 0xf1, 0x76, 0xa7, 0x5e, 0x37, 0xdb, 0x55, 0x55, 0x97, 0x25, 0x05, 0x03, 0xb0, 0x21, 0xd9,
+	0xe0, 0x63, 0x80, 0xa4, 0x27, 0x00, 0x74, 0x06, 0x0a, 0xbe, 0x77, 0x5f, 0xd8, 0x9e, 0x62, 0xe6,
+	0xe4, 0x7d, 0xef, 0x3e, 0xb3, 0x5c, 0x51, 0x01, 0x25, 0xd7, 0x2a, 0xa2, 0x2d, 0x91, 0xbc, 0xa5,
+	0x89, 0xe4, 0x3d, 0x9e, 0x3f, 0x4e, 0xde, 0xbc, 0x94, 0xa7, 0xbf, 0xf3, 0x0f, 0x89, 0xe5, 0x84,
+	0xd1, 0x7e, 0xa5, 0xfc, 0x65, 0x0a, 0x4a, 0x98, 0x72, 0xec, 0x01, 0xa1, 0x97, 0x50, 0x01, 0xf5,
+	0xd4, 0x2e, 0x53, 0x31, 0xc7, 0x69, 0xb7, 0x80, 0x8b, 0x9c, 0xc7, 0xef, 0x0a, 0x36, 0x60, 0x29,
+	0x20, 0x5d, 0xcf, 0xed, 0x05, 0xe6, 0x0e, 0xd9, 0xa5, 0xef, 0x3c, 0x06, 0x56, 0x10, 0x8a, 0xeb,
+	0xc8, 0x12, 0x5e, 0x10, 0xc2, 0x4d, 0x26, 0x6b, 0x30, 0x11, 0xba, 0x00, 0x8b, 0x3b, 0xb6, 0xeb,
+	0x78, 0x7d, 0x7a, 0x43, 0x7f, 0x40, 0xfc, 0x40, 0x98, 0x4a, 0xc3, 0x2b, 0x8b, 0x11, 0x97, 0xb5,
+	0xb8, 0x88, 0xbb, 0xfb, 0x0e, 0xac, 0x4d, 0x9d, 0xc5, 0xbc, 0x6b, 0x3b, 0x21, 0xf1, 0x49, 0xcf,
+	0xf4, 0xc9, 0xd0, 0xb1, 0xbb, 0xfc, 0x35, 0x01, 0xaf, 0xdd, 0x5f, 0x9f, 0x32, 0xf5, 0xb6, 0x50,
+	0xc7, 0x63, 0x6d, 0x8a, 0x76, 0x77, 0x38, 0x32, 0x47, 0xec, 0x06, 0x91, 0xee, 0x62, 0x12, 0xce,
+	0x77, 0x87, 0xa3, 0x0e, 0xa5, 0xe9, 0xd5, 0xd6, 0xbd, 0x21, 0xdf, 0xbc, 0x24, 0x4c, 0x9b, 0xf4,
+	0x08, 0xb6, 0xac, 0xf6, 0xfb, 0x3e, 0xe9, 0x5b, 0xa1, 0x80, 0xe9, 0x02, 0x2c, 0x72, 0x48, 0x0e,
+	0x4c, 0xf1, 0x4c, 0x89, 0xdb, 0x23, 0x71, 0x7b, 0x84, 0x8c, 0x3f, 0x52, 0x8a, 0xc2, 0xf7, 0xe4,
+	0xc8, 0x9d, 0xda, 0x27, 0xc5, 0xfa, 0x2c, 0x8e, 0xdc, 0x29, 0xbd, 0x7e, 0x1e, 0x4e, 0x4f, 0x47,
+	0x61, 0x60, 0xf3, 0x87, 0x26, 0x25, 0x7c, 0x72, 0x8a, 0xd1, 0x0d, 0xdb, 0x7d, 0x4c, 0x57, 0xeb,
+	0x41, 0x25, 0xf3, 0xe8, 0xae, 0xd6, 0x03, 0xe5, 0xdf, 0xe2, 0x1b, 0x80, 0x28, 0x5c, 0xe2, 0xdd,
+	0x38, 0xca, 0x0b, 0xd2, 0xe3, 0xf2, 0x42, 0x05, 0x66, 0x02, 0xe2, 0xef, 0xdb, 0x6e, 0x3f, 0xba,
+	0xa2, 0x16, 0x24, 0x6a, 0xc3, 0xeb, 0xc2, 0x76, 0xf2, 0x20, 0x24, 0xbe, 0x6b, 0x39, 0xce, 0x81,
+	0xc9, 0x0f, 0x2a, 0xdc, 0x90, 0xf4, 0xcc, 0xf1, 0xa3, 0x2a, 0xbe, 0x23, 0xbf, 0xca, 0xb5, 0xb5,
+	0x58, 0x19, 0xc7, 0xba, 0x46, 0xa4, 0x8a, 0xde, 0x83, 0xb2, 0x2f, 0x82, 0xd8, 0x0c, 0xa8, 0x7b,
+	0x44, 0x3e, 0x5a, 0x8c, 0xef, 0x99, 0x13, 0x11, 0x8e, 0x4b, 0x7e, 0x92, 0x44, 0x1f, 0xc0, 0x9c,
+	0x15, 0xf9, 0x56, 0xf4, 0x9e, 0xac, 0x5b, 0x26, 0x3d, 0x8f, 0xcb, 0xd6, 0x04, 0x8d, 0xae, 0xc0,
+	0xac, 0xb0, 0xc8, 0x72, 0x6c, 0x6b, 0x5c, 0xd8, 0x1e, 0x7a, 0xa9, 0xa6, 0x52, 0x21, 0x2e, 0x86,
+	0x63, 0x82, 0x7e, 0x47, 0x2f, 0x74, 0x86, 0x3d, 0x36, 0xd2, 0x31, 0xae, 0x2e, 0x92, 0xcf, 0xda,
+	0x32, 0x93, 0xcf, 0xda, 0x26, 0x9f, 0xc9, 0x65, 0x0f, 0x3d, 0x93, 0x53, 0xae, 0xc2, 0xe2, 0xa4,
+	0xfd, 0x22, 0xca, 0x56, 0x21, 0xcb, 0x2e, 0xd4, 0x0f, 0x6d, 0xa3, 0x89, 0x1b, 0x73, 0xcc, 0x15,
+	0x94, 0xbf, 0x96, 0x60, 0x61, 0xca, 0x27, 0x56, 0xfc, 0xfd, 0x26, 0x25, 0x8e, 0x87, 0x7e, 0x16,
+	0xb2, 0xd4, 0xbd, 0xd1, 0x8b, 0x95, 0x53, 0x47, 0xbf, 0xd0, 0xa8, 0x43, 0x09, 0xe6, 0x5a, 0x34,
+	0x11, 0xb2, 0x80, 0xea, 0xfa, 0xc4, 0x0a, 0x49, 0x54, 0x21, 0x16, 0x29, 0x8f, 0x1f, 0x19, 0x1d,
+	0x3d, 0x70, 0xca, 0x3c, 0xf9, 0xc0, 0xe9, 0x3f, 0x52, 0xb0, 0x84, 0x09, 0xfd, 0x35, 0x90, 0x1f,
+	0xef, 0xc0, 0xbf, 0xcf, 0x1d, 0x38, 0xad, 0x17, 0x86, 0x3e, 0x31, 0xa3, 0x2d, 0x70, 0x86, 0x55,
+	0x14, 0x30, 0xf4, 0xc9, 0x0d, 0xb1, 0xe5, 0x7d, 0xce, 0x4e, 0x23, 0x26, 0xa1, 0xfe, 0xe1, 0xbe,
+	0x1e, 0xcf, 0x42, 0xd1, 0xe7, 0x93, 0xf5, 0xc6, 0xdf, 0x13, 0x10, 0xb1, 0x6a, 0x3d, 0xe5, 0xdb,
+	0x14, 0x2c, 0x8b, 0xe5, 0xbc, 0x48, 0x9f, 0xfc, 0x87, 0x70, 0xc9, 0x1e, 0xc6, 0xe5, 0x07, 0x70,
+	0xfc, 0x97, 0x12, 0x9c, 0x99, 0x8a, 0xf4, 0x4f, 0xd1, 0xfb, 0xff, 0x2a, 0x41, 0x19, 0x13, 0x87,
+	0x58, 0xc1, 0x71, 0xf5, 0xf8, 0x21, 0x13, 0x33, 0x47, 0x4c, 0x9c, 0x87, 0xb9, 0xd8, 0x42, 0x8e,
+	0xf4, 0xda, 0xef, 0xa5, 0xa1, 0xd0, 0x38, 0x68, 0xdf, 0x73, 0xb6, 0x1d, 0xab, 0xcf, 0x5e, 0x05,
+	0x35, 0x5a, 0xc6, 0x6d, 0xf9, 0x04, 0x7d, 0x6f, 0xa9, 0x37, 0x0d, 0x53, 0xa7, 0x85, 0xf3, 0x76,
+	0x5d, 0xbd, 0x26, 0x4b, 0xb4, 0xb2, 0x6e, 0xe1, 0x9a, 0x79, 0x5d, 0xbb, 0xcd, 0x39, 0x29, 0xfa,
+	0x12, 0xb2, 0xa3, 0xd7, 0x6e, 0x74, 0xb4, 0x31, 0x33, 0x83, 0x96, 0x60, 0xbe, 0xd1, 0xa9, 0x1b,
+	0xb5, 0x56, 0x3d, 0xc1, 0xce, 0xd3, 0x2a, 0x7c, 0xb3, 0xde, 0xdc, 0xe4, 0xa4, 0x4c, 0xc7, 0xef,
+	0xe8, 0xed, 0xda, 0x35, 0x5d, 0xdb, 0xe2, 0xac, 0x15, 0xca, 0xba, 0xa3, 0xe1, 0xe6, 0x76, 0x2d,
+	0x9a, 0xf2, 0x2a, 0x92, 0xa1, 0xb8, 0x59, 0xd3, 0x55, 0x2c, 0x46, 0x79, 0x28, 0xa1, 0x32, 0x14,
+	0x34, 0xbd, 0xd3, 0x10, 0x74, 0x0a, 0x55, 0x60, 0x81, 0x3e, 0x8c, 0x34, 0x6b, 0x7a, 0x15, 0x6b,
+	0x0d, 0xfa, 0x7e, 0x92, 0x4b, 0x32, 0x68, 0x01, 0xca, 0x46, 0xad, 0xa1, 0xb5, 0x0d, 0xb5, 0xd1,
+	0x12, 0x4c, 0xba, 0x8a, 0x7c, 0x5b, 0x8b, 0x74, 0x64, 0xb4, 0x0c, 0x4b, 0x7a, 0xd3, 0x14, 0x4f,
+	0x3b, 0xcd, 0x9b, 0x6a, 0xbd, 0xa3, 0x09, 0xd9, 0x0a, 0x3a, 0x05, 0xa8, 0xa9, 0x9b, 0x9d, 0xd6,
+	0x96, 0x6a, 0x68, 0xa6, 0xde, 0xbc, 0x25, 0x04, 0x57, 0x51, 0x19, 0xf2, 0xe3, 0x15, 0x3c, 0xa4,
+	0x28, 0x94, 0x5a, 0x2a, 0x36, 0xc6, 0xc6, 0x3e, 0x7c, 0x48, 0xc1, 0x82, 0x6b, 0xb8, 0xd9, 0x69,
+	0x8d, 0xd5, 0xe6, 0xa1, 0x28, 0xc0, 0x12, 0xac, 0x0c, 0x65, 0x6d, 0xd6, 0xf4, 0x6a, 0xbc, 0xbe,
+	0x87, 0xf9, 0xe5, 0x94, 0x2c, 0xad, 0xed, 0x41, 0x86, 0xb9, 0x23, 0x0f, 0x19, 0xbd, 0xa9, 0xd3,
+	0xa7, 0xae, 0x73, 0x00, 0xb5, 0x76, 0x4d, 0x37, 0xb4, 0x6b, 0x58, 0xad, 0x53, 0xb3, 0x19, 0x23,
+	0x02, 0x90, 0x5a, 0x3b, 0x0b, 0x33, 0xb5, 0xf6, 0x76, 0xbd, 0xa9, 0x1a, 0xc2, 0xcc, 0x5a, 0xfb,
+	0x46, 0xa7, 0x49, 0x5f, 0x9c, 0x3e, 0x94, 0x51, 0x11, 0x72, 0xf4, 0x71, 0xe9, 0xc7, 0x06, 0xb5,
+	0x8b, 0xc9, 0x38, 0xaa, 0xf2, 0xc3, 0xab, 0x6b, 0x5f, 0xa7, 0x21, 0xc3, 0x1e, 0xe6, 0x97, 0xa0,
+	0xc0, 0xbc, 0x4d, 0xdf, 0xd4, 0xca, 0x27, 0x50, 0x01, 0x32, 0x35, 0xdd, 0xb8, 0x22, 0xff, 0x52,
+	0x0a, 0x01, 0x64, 0x3b, 0xac, 0xfd, 0xcb, 0x39, 0xda, 0xae, 0xe9, 0xc6, 0xdb, 0x97, 0xe5, 0x4f,
+	0x53, 0x74, 0xd8, 0x0e, 0x27, 0x7e, 0x25, 0x12, 0x6c, 0x5c, 0x92, 0x3f, 0x8b, 0x05, 0x1b, 0x97,
+	0xe4, 0x5f, 0x8d, 0x04, 0x17, 0x37, 0xe4, 0x5f, 0x8b, 0x05, 0x17, 0x37, 0xe4, 0x5f, 0x8f, 0x04,
+	0x97, 0x2f, 0xc9, 0xbf, 0x11, 0x0b, 0x2e, 0x5f, 0x92, 0x7f, 0x33, 0x47, 0x6d, 0x61, 0x96, 0x5c,
+	0xdc, 0x90, 0x7f, 0x2b, 0x1f, 0x53, 0x97, 0x2f, 0xc9, 0x9f, 0xe7, 0xa9, 0xff, 0x63, 0xaf, 0xca,
+	0xbf, 0x2d, 0xd3, 0x65, 0x52, 0x07, 0xc9, 0xbf, 0xc3, 0x9a, 0x54, 0x24, 0xff, 0xae, 0x4c, 0x6d,
+	0xa4, 0x5c, 0x46, 0x7e, 0xc1, 0x24, 0xb7, 0x35, 0x15, 0xcb, 0x5f, 0xe6, 0xf8, 0x4b, 0xde, 0x6a,
+	0xad, 0xa1, 0xd6, 0x65, 0xc4, 0x7a, 0x50, 0x54, 0x7e, 0xff, 0x02, 0x6d, 0xd2, 0xf0, 0x94, 0xff,
+	0xa0, 0x45, 0x27, 0xbc, 0xa9, 0xe2, 0xea, 0x87, 0x2a, 0x96, 0xff, 0xf0, 0x02, 0x9d, 0xf0, 0xa6,
+	0x8a, 0x05, 0x5e, 0x7f, 0xd4, 0xa2, 0x8a, 0x4c, 0xf4, 0xd5, 0x05, 0xba, 0x68, 0xc1, 0xff, 0xe3,
+	0x16, 0xca, 0x43, 0x7a, 0xb3, 0x66, 0xc8, 0x5f, 0xb3, 0xd9, 0x68, 0x88, 0xca, 0x7f, 0x22, 0x53,
+	0x66, 0x5b, 0x33, 0xe4, 0x3f, 0xa5, 0xcc, 0xac, 0xd1, 0x69, 0xd5, 0x35, 0xf9, 0x25, 0xba, 0xb8,
+	0x6b, 0x5a, 0xb3, 0xa1, 0x19, 0xf8, 0xb6, 0xfc, 0x67, 0x4c, 0xfd, 0xa3, 0x76, 0x53, 0x97, 0xbf,
+	0x91, 0xe9, 0x2b, 0x5f, 0xed, 0xe3, 0x16, 0xd6, 0xda, 0xed, 0x5a, 0x53, 0x97, 0xcf, 0xae, 0x6d,
+	0x83, 0x7c, 0xb8, 0xf8, 0xa1, 0x06, 0x74, 0xf4, 0xeb, 0x7a, 0xf3, 0x96, 0x2e, 0x9f, 0xa0, 0x44,
+	0x0b, 0x6b, 0x2d, 0x15, 0x6b, 0xb2, 0x84, 0x00, 0x72, 0xe2, 0x7d, 0x70, 0x0a, 0xcd, 0x42, 0x1e,
+	0x37, 0xeb, 0xf5, 0x4d, 0xb5, 0x7a, 0x5d, 0x4e, 0x6f, 0xbe, 0x03, 0x73, 0xb6, 0xb7, 0xbe, 0x6f,
+	0x87, 0x24, 0x08, 0xf8, 0x5f, 0x3f, 0xee, 0x28, 0x82, 0xb2, 0xbd, 0xf3, 0xbc, 0x75, 0xbe, 0xef,
+	0x9d, 0xdf, 0x0f, 0xcf, 0x33, 0xe9, 0x79, 0x96, 0x5f, 0x76, 0x72, 0x8c, 0xb8, 0xf8, 0x7f, 0x03,
+	0x00, 0x49, 0x33, 0xfc, 0xf1, 0x58, 0x32, 0x00, 0x00,
 }
@@ -30,44 +30,47 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0xe1, 0x61, 0x2b, 0xba, 0x96, 0x32, 0x3c, 0x06, 0x2c, 0x1b, 0xdd, 0x8f, 0x37, 0x84,
-	0xd4, 0x22, 0x40, 0x42, 0x9a, 0xc4, 0xc3, 0x5a, 0x31, 0x81, 0x26, 0x7e, 0xb5, 0x6c, 0x42, 0x20,
-	0x21, 0xb9, 0xa9, 0x55, 0xa2, 0xa5, 0x71, 0x16, 0x3b, 0x1d, 0xfc, 0x37, 0xfc, 0xa9, 0xd3, 0xe2,
-	0xdc, 0xc5, 0x76, 0x93, 0xbd, 0xcd, 0xdf, 0xef, 0xdd, 0x67, 0x17, 0x5f, 0xef, 0x0c, 0xec, 0x32,
-	0x17, 0xd9, 0x3f, 0x25, 0xb2, 0x65, 0x14, 0x8a, 0x7e, 0x9a, 0x49, 0x2d, 0x59, 0xc7, 0xd6, 0x82,
-	0x76, 0x71, 0x32, 0x56, 0xb0, 0x31, 0x8d, 0x92, 0x58, 0xce, 0x67, 0x5c, 0x73, 0xa3, 0xbc, 0xfa,
-	0xdf, 0x85, 0xb5, 0x6f, 0x37, 0x11, 0xec, 0x08, 0x5a, 0xef, 0xff, 0x8a, 0x30, 0xd7, 0x82, 0x6d,
-	0xf5, 0x4d, 0x52, 0x79, 0x1e, 0x8b, 0xcb, 0x5c, 0x28, 0x1d, 0x3c, 0xf6, 0x65, 0x95, 0xca, 0x44,
-	0x89, 0xc3, 0x3b, 0xec, 0x23, 0x74, 0x4a, 0x71, 0xc8, 0x75, 0xf8, 0x87, 0x05, 0x6e, 0x64, 0x21,
-	0x22, 0x65, 0xa7, 0xd6, 0x23, 0xd4, 0x67, 0xb8, 0x3f, 0xd1, 0x99, 0xe0, 0x0b, 0x2c, 0x06, 0xe3,
-	0x1d, 0x15, 0x61, 0xbb, 0xf5, 0x26, 0xd2, 0x5e, 0xde, 0x65, 0x6f, 0x60, 0x6d, 0x28, 0xe6, 0x51,
-	0xc2, 0x36, 0xcb, 0xd0, 0xe2, 0x84, 0xf9, 0x8f, 0x5c, 0x91, 0xaa, 0x78, 0x0b, 0xeb, 0x23, 0xb9,
-	0x58, 0x44, 0x9a, 0x61, 0x84, 0x39, 0x62, 0xde, 0x96, 0xa7, 0x52, 0xe2, 0x3b, 0xb8, 0x37, 0x96,
-	0x71, 0x3c, 0xe5, 0xe1, 0x05, 0xc3, 0xfb, 0x42, 0x01, 0x93, 0x9f, 0xac, 0xe8, 0x94, 0x7e, 0x04,
-	0xad, 0xaf, 0x99, 0x48, 0x79, 0x56, 0x35, 0xa1, 0x3c, 0xfb, 0x4d, 0x20, 0x99, 0x72, 0xbf, 0x40,
-	0xd7, 0x94, 0x53, 0x5a, 0x33, 0xb6, 0xeb, 0x54, 0x89, 0x32, 0x92, 0x9e, 0x35, 0xb8, 0x04, 0x3c,
-	0x83, 0x0d, 0x2c, 0x91, 0x90, 0x3d, 0xaf, 0x76, 0x1f, 0xba, 0xd7, 0xe8, 0x13, 0xf6, 0x07, 0x3c,
-	0x1c, 0x65, 0x82, 0x6b, 0xf1, 0x3d, 0xe3, 0x89, 0xe2, 0xa1, 0x8e, 0x64, 0xc2, 0x30, 0x6f, 0xc5,
-	0x41, 0xf0, 0x7e, 0x73, 0x00, 0x91, 0x4f, 0xa0, 0x3d, 0xd1, 0x3c, 0xd3, 0x65, 0xeb, 0xb6, 0xe9,
-	0xc7, 0x41, 0x1a, 0xd2, 0x82, 0x3a, 0xcb, 0xe1, 0x08, 0x4d, 0x7d, 0x24, 0x4e, 0xa5, 0xad, 0x70,
-	0x6c, 0x8b, 0x38, 0xbf, 0x61, 0x73, 0x24, 0x93, 0x30, 0xce, 0x67, 0xce, 0xb7, 0x1e, 0xd0, 0xc5,
-	0xaf, 0x78, 0xc8, 0x3d, 0xbc, 0x2d, 0x84, 0xf8, 0x63, 0x78, 0x30, 0x16, 0x7c, 0x66, 0xb3, 0xb1,
-	0xa9, 0x9e, 0x8e, 0xdc, 0x5e, 0x93, 0x6d, 0x8f, 0x72, 0x31, 0x0c, 0x38, 0x7e, 0x81, 0x3d, 0x21,
-	0xde, 0xf4, 0xed, 0xd4, 0x7a, 0x76, 0xa3, 0x6d, 0xc7, 0xac, 0x86, 0xbd, 0x9a, 0x1c, 0x67, 0x3f,
-	0xec, 0x37, 0x07, 0xd8, 0x4b, 0xe2, 0x93, 0x50, 0x8a, 0xcf, 0x85, 0x19, 0x7c, 0x5a, 0x12, 0x8e,
-	0xea, 0x2f, 0x09, 0xcf, 0xb4, 0x96, 0xc4, 0x08, 0xa0, 0x34, 0x8f, 0xc3, 0x0b, 0xf6, 0xd4, 0x8d,
-	0x3f, 0xae, 0xda, 0xbd, 0x5d, 0xe3, 0x50, 0x51, 0x23, 0x80, 0x49, 0x1a, 0x47, 0xda, 0xac, 0x53,
-	0x84, 0x54, 0x92, 0x0f, 0xb1, 0x1d, 0x82, 0x9c, 0x42, 0xc7, 0xd4, 0xf7, 0x41, 0xf0, 0x58, 0x57,
-	0x9b, 0xd4, 0x16, 0xfd, 0xeb, 0x77, 0x3d, 0xeb, 0xb3, 0x4e, 0xa1, 0x73, 0x96, 0xce, 0xb8, 0xc6,
-	0x5b, 0x42, 0x98, 0x2d, 0xfa, 0x30, 0xd7, 0xb3, 0x60, 0x27, 0xd0, 0x3a, 0x27, 0x8e, 0xf5, 0x8e,
-	0x9c, 0xfb, 0x9c, 0x3a, 0xcf, 0xe2, 0x8c, 0xa1, 0x8d, 0xb2, 0xbc, 0x52, 0xac, 0x57, 0x17, 0x2f,
-	0xaf, 0x54, 0xb5, 0x50, 0x9a, 0x7c, 0x8b, 0xf9, 0x0b, 0xba, 0xd5, 0xbf, 0xca, 0x63, 0xad, 0xd8,
-	0x41, 0x7d, 0x19, 0x37, 0x5e, 0x35, 0x63, 0xb7, 0x84, 0x54, 0xf0, 0xe1, 0x8b, 0x9f, 0xcf, 0x97,
-	0x91, 0x16, 0x4a, 0xf5, 0x23, 0x39, 0x30, 0x7f, 0x0d, 0xe6, 0x72, 0xb0, 0xd4, 0x83, 0xe2, 0x09,
-	0x1d, 0xd8, 0xcf, 0xed, 0x74, 0xbd, 0xd0, 0x5e, 0x5f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb4,
-	0xc0, 0x34, 0x99, 0x07, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0x5b, 0x6f, 0xd3, 0x4c,
+	0x10, 0x86, 0xbf, 0xef, 0xa2, 0x2d, 0x9a, 0x86, 0x52, 0xb6, 0x14, 0xa8, 0x5b, 0x7a, 0xc8, 0x1d,
+	0x42, 0x4a, 0x10, 0x20, 0x21, 0x55, 0xe2, 0xa2, 0x89, 0xa8, 0x40, 0x15, 0x27, 0x87, 0x56, 0x08,
+	0x24, 0xa4, 0x8d, 0x33, 0x0a, 0x56, 0x1d, 0x6f, 0xea, 0xdd, 0xa4, 0xf0, 0x23, 0xf8, 0xcf, 0x28,
+	0xb6, 0x67, 0xbc, 0xbb, 0xb1, 0x73, 0xd7, 0x7d, 0xdf, 0x99, 0xa7, 0xb3, 0x87, 0x19, 0x07, 0xc4,
+	0xcd, 0x0c, 0xb3, 0x3f, 0x1a, 0xb3, 0x79, 0x1c, 0x61, 0x67, 0x9a, 0x29, 0xa3, 0x44, 0xcb, 0xd6,
+	0x82, 0xcd, 0x7c, 0x55, 0x58, 0xc1, 0xf6, 0x30, 0x4e, 0x13, 0x35, 0x1e, 0x49, 0x23, 0x0b, 0xe5,
+	0xc5, 0xdf, 0x6d, 0x58, 0xfb, 0xb2, 0x88, 0x10, 0xa7, 0xb0, 0xf1, 0xf6, 0x37, 0x46, 0x33, 0x83,
+	0x62, 0xb7, 0x53, 0x24, 0x95, 0xeb, 0x10, 0x6f, 0x66, 0xa8, 0x4d, 0xf0, 0xd0, 0x97, 0xf5, 0x54,
+	0xa5, 0x1a, 0xdb, 0xff, 0x89, 0xf7, 0xd0, 0x2a, 0xc5, 0x9e, 0x34, 0xd1, 0x2f, 0x11, 0xb8, 0x91,
+	0xb9, 0x48, 0x94, 0xfd, 0x5a, 0x8f, 0x51, 0x1f, 0xe1, 0xee, 0xc0, 0x64, 0x28, 0x27, 0x54, 0x0c,
+	0xc5, 0x3b, 0x2a, 0xc1, 0x0e, 0xea, 0x4d, 0xa2, 0x3d, 0xff, 0x5f, 0xbc, 0x82, 0xb5, 0x1e, 0x8e,
+	0xe3, 0x54, 0xec, 0x94, 0xa1, 0xf9, 0x8a, 0xf2, 0x1f, 0xb8, 0x22, 0x57, 0xf1, 0x1a, 0xd6, 0xfb,
+	0x6a, 0x32, 0x89, 0x8d, 0xa0, 0x88, 0x62, 0x49, 0x79, 0xbb, 0x9e, 0xca, 0x89, 0x6f, 0xe0, 0x4e,
+	0xa8, 0x92, 0x64, 0x28, 0xa3, 0x6b, 0x41, 0xe7, 0x45, 0x02, 0x25, 0x3f, 0x5a, 0xd2, 0x39, 0xfd,
+	0x14, 0x36, 0x3e, 0x67, 0x38, 0x95, 0x59, 0x75, 0x09, 0xe5, 0xda, 0xbf, 0x04, 0x96, 0x39, 0xf7,
+	0x13, 0x6c, 0x15, 0xe5, 0x94, 0xd6, 0x48, 0x1c, 0x38, 0x55, 0x92, 0x4c, 0xa4, 0x27, 0x0d, 0x2e,
+	0x03, 0x2f, 0x61, 0x9b, 0x4a, 0x64, 0xe4, 0xa1, 0x57, 0xbb, 0x0f, 0x3d, 0x6a, 0xf4, 0x19, 0xfb,
+	0x0d, 0xee, 0xf7, 0x33, 0x94, 0x06, 0xbf, 0x66, 0x32, 0xd5, 0x32, 0x32, 0xb1, 0x4a, 0x05, 0xe5,
+	0x2d, 0x39, 0x04, 0x3e, 0x6e, 0x0e, 0x60, 0xf2, 0x39, 0x6c, 0x0e, 0x8c, 0xcc, 0x4c, 0x79, 0x75,
+	0x7b, 0xfc, 0x38, 0x58, 0x23, 0x5a, 0x50, 0x67, 0x39, 0x1c, 0x34, 0x7c, 0x8f, 0xcc, 0xa9, 0xb4,
+	0x25, 0x8e, 0x6d, 0x31, 0xe7, 0x27, 0xec, 0xf4, 0x55, 0x1a, 0x25, 0xb3, 0x91, 0xb3, 0xd7, 0x13,
+	0x3e, 0xf8, 0x25, 0x8f, 0xb8, 0xed, 0x55, 0x21, 0xcc, 0x0f, 0xe1, 0x5e, 0x88, 0x72, 0x64, 0xb3,
+	0xe9, 0x52, 0x3d, 0x9d, 0xb8, 0x87, 0x4d, 0xb6, 0xdd, 0xca, 0x79, 0x33, 0x50, 0xfb, 0x05, 0x76,
+	0x87, 0x78, 0xdd, 0xb7, 0x5f, 0xeb, 0xd9, 0x17, 0x6d, 0x3b, 0xc5, 0x68, 0x38, 0xaa, 0xc9, 0x71,
+	0xe6, 0xc3, 0x71, 0x73, 0x80, 0xfd, 0xd4, 0x43, 0x5c, 0x4c, 0x38, 0xa4, 0x32, 0x0f, 0x78, 0x63,
+	0xb6, 0xec, 0x3f, 0x75, 0xdf, 0xb5, 0x6f, 0xaa, 0xf4, 0x9c, 0xcd, 0x9f, 0xb8, 0x79, 0x75, 0x67,
+	0xd0, 0x5e, 0x15, 0x62, 0xf7, 0x75, 0x88, 0x09, 0x4a, 0x5d, 0xf5, 0x75, 0xb9, 0xf6, 0xfb, 0x9a,
+	0x65, 0x7b, 0x22, 0x7e, 0x40, 0xad, 0xe5, 0x18, 0x8b, 0x29, 0xc7, 0x13, 0xd1, 0x51, 0xfd, 0x89,
+	0xe8, 0x99, 0xd6, 0x44, 0xec, 0x03, 0x94, 0xe6, 0x59, 0x74, 0x2d, 0x1e, 0xbb, 0xf1, 0x67, 0xd5,
+	0xdb, 0xde, 0xab, 0x71, 0xb8, 0xa8, 0x3e, 0xc0, 0x60, 0x9a, 0xc4, 0xa6, 0xf8, 0x76, 0x10, 0xa4,
+	0x92, 0x7c, 0x88, 0xed, 0x30, 0xe4, 0x02, 0x5a, 0x45, 0x7d, 0xef, 0x50, 0x26, 0xa6, 0xfa, 0x6c,
+	0xd8, 0xa2, 0xff, 0xd6, 0x5c, 0xcf, 0xda, 0xd6, 0x05, 0xb4, 0x2e, 0xa7, 0x23, 0x69, 0xe8, 0x94,
+	0x08, 0x66, 0x8b, 0x3e, 0xcc, 0xf5, 0x2c, 0xd8, 0x39, 0x6c, 0x5c, 0x31, 0xc7, 0xfa, 0x68, 0x5e,
+	0xf9, 0x9c, 0x3a, 0xcf, 0xe2, 0x84, 0xb0, 0x49, 0xb2, 0xba, 0xd5, 0xe2, 0xb0, 0x2e, 0x5e, 0xdd,
+	0xea, 0x6a, 0x7a, 0x36, 0xf9, 0x16, 0xf3, 0x07, 0x6c, 0x55, 0xff, 0x6a, 0x96, 0x18, 0x2d, 0x4e,
+	0xea, 0xcb, 0x58, 0x78, 0xd5, 0x33, 0x5d, 0x11, 0x52, 0xc1, 0x7b, 0xcf, 0xbe, 0x3f, 0x9d, 0xc7,
+	0x06, 0xb5, 0xee, 0xc4, 0xaa, 0x5b, 0xfc, 0xd5, 0x1d, 0xab, 0xee, 0xdc, 0x74, 0xf3, 0xdf, 0x0b,
+	0x5d, 0xfb, 0xb7, 0xc5, 0x70, 0x3d, 0xd7, 0x5e, 0xfe, 0x1b, 0x00, 0x74, 0xf0, 0xf6, 0xf0, 0x86,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -119,6 +122,12 @@ type QueryClient interface {
 	BeginExecute(ctx context.Context, in *query.BeginExecuteRequest, opts ...grpc.CallOption) (*query.BeginExecuteResponse, error)
 	// BeginExecuteBatch executes a begin and a list of queries.
 	BeginExecuteBatch(ctx context.Context, in *query.BeginExecuteBatchRequest, opts ...grpc.CallOption) (*query.BeginExecuteBatchResponse, error)
+	// ReserveExecute reserves a connection and executes the specified SQL query.
+	ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error)
+	// ReserveBeginExecute reserves a connection, executes a begin and the specified SQL query.
+	ReserveBeginExecute(ctx context.Context, in *query.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (*query.ReserveBeginExecuteResponse, error)
+	// Release releases a reserved connection, rolling back its transaction if any.
+	Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error)
 	// MessageStream streams messages from a message table.
 	MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error)
 	// MessageAck acks messages for a table.
@@ -314,6 +323,33 @@ func (c *queryClient) BeginExecuteBatch(ctx context.Context, in *query.BeginExec
 	return out, nil
 }
 
+func (c *queryClient) ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error) {
+	out := new(query.ReserveExecuteResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveBeginExecute(ctx context.Context, in *query.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (*query.ReserveBeginExecuteResponse, error) {
+	out := new(query.ReserveBeginExecuteResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveBeginExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error) {
+	out := new(query.ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MessageStream(ctx context.Context, in *query.MessageStreamRequest, opts ...grpc.CallOption) (Query_MessageStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[1], "/queryservice.Query/MessageStream", opts...)
 	if err != nil {
@@ -563,6 +599,12 @@ type QueryServer interface {
 	BeginExecute(context.Context, *query.BeginExecuteRequest) (*query.BeginExecuteResponse, error)
 	// BeginExecuteBatch executes a begin and a list of queries.
 	BeginExecuteBatch(context.Context, *query.BeginExecuteBatchRequest) (*query.BeginExecuteBatchResponse, error)
+	// ReserveExecute reserves a connection and executes the specified SQL query.
+	ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error)
+	// ReserveBeginExecute reserves a connection, executes a begin and the specified SQL query.
+	ReserveBeginExecute(context.Context, *query.ReserveBeginExecuteRequest) (*query.ReserveBeginExecuteResponse, error)
+	// Release releases a reserved connection, rolling back its transaction if any.
+	Release(context.Context, *query.ReleaseRequest) (*query.ReleaseResponse, error)
 	// MessageStream streams messages from a message table.
 	MessageStream(*query.MessageStreamRequest, Query_MessageStreamServer) error
 	// MessageAck acks messages for a table.
@@ -635,6 +677,15 @@ func (*UnimplementedQueryServer) BeginExecute(ctx context.Context, req *query.Be
 func (*UnimplementedQueryServer) BeginExecuteBatch(ctx context.Context, req *query.BeginExecuteBatchRequest) (*query.BeginExecuteBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginExecuteBatch not implemented")
 }
+func (*UnimplementedQueryServer) ReserveExecute(ctx context.Context, req *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveExecute not implemented")
+}
+func (*UnimplementedQueryServer) ReserveBeginExecute(ctx context.Context, req *query.ReserveBeginExecuteRequest) (*query.ReserveBeginExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveBeginExecute not implemented")
+}
+func (*UnimplementedQueryServer) Release(ctx context.Context, req *query.ReleaseRequest) (*query.ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
 func (*UnimplementedQueryServer) MessageStream(req *query.MessageStreamRequest, srv Query_MessageStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method MessageStream not implemented")
 }
@@ -955,6 +1006,60 @@ func _Query_BeginExecuteBatch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReserveExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReserveExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/ReserveExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveExecute(ctx, req.(*query.ReserveExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveBeginExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReserveBeginExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveBeginExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/ReserveBeginExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveBeginExecute(ctx, req.(*query.ReserveBeginExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Release(ctx, req.(*query.ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MessageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(query.MessageStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1181,6 +1286,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeginExecuteBatch",
 			Handler:    _Query_BeginExecuteBatch_Handler,
 		},
+		{
+			MethodName: "ReserveExecute",
+			Handler:    _Query_ReserveExecute_Handler,
+		},
+		{
+			MethodName: "ReserveBeginExecute",
+			Handler:    _Query_ReserveBeginExecute_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Query_Release_Handler,
+		},
 		{
 			MethodName: "MessageAck",
 			Handler:    _Query_MessageAck_Handler,
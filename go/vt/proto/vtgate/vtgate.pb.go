@@ -5,9 +5,8 @@ package vtgate
 
 import (
 	fmt "fmt"
-	math "math"
-
 	proto "github.com/golang/protobuf/proto"
+	math "math"
 	binlogdata "vitess.io/vitess/go/vt/proto/binlogdata"
 	query "vitess.io/vitess/go/vt/proto/query"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
@@ -135,10 +134,36 @@ type Session struct {
 	// pre_sessions contains sessions that have to be committed first.
 	PreSessions []*Session_ShardSession `protobuf:"bytes,9,rep,name=pre_sessions,json=preSessions,proto3" json:"pre_sessions,omitempty"`
 	// post_sessions contains sessions that have to be committed last.
-	PostSessions         []*Session_ShardSession `protobuf:"bytes,10,rep,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	PostSessions []*Session_ShardSession `protobuf:"bytes,10,rep,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
+	// in_reserved_conn is set to true if the session must execute its
+	// queries on connections reserved for it.
+	InReservedConn bool `protobuf:"varint,11,opt,name=in_reserved_conn,json=inReservedConn,proto3" json:"in_reserved_conn,omitempty"`
+	// system_variables keeps track of the session variables that were
+	// set by the session, along with their values as SQL expressions.
+	// They are replayed on every connection that gets reserved.
+	SystemVariables map[string]string `protobuf:"bytes,12,rep,name=system_variables,json=systemVariables,proto3" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// reserved_sessions keep track of the connections reserved per shard.
+	ReservedSessions []*Session_ShardSession `protobuf:"bytes,13,rep,name=reserved_sessions,json=reservedSessions,proto3" json:"reserved_sessions,omitempty"`
+	// lock_session is the connection reserved for the advisory locks
+	// of the session, like the ones taken with GET_LOCK. All of them
+	// are taken on the same shard, and the connection is kept until
+	// the session is closed.
+	LockSession *Session_ShardSession `protobuf:"bytes,14,opt,name=lock_session,json=lockSession,proto3" json:"lock_session,omitempty"`
+	// savepoints keeps track of the savepoints of the transaction, in
+	// the order they were created. They are replayed on the shards that
+	// join the transaction after they were created.
+	Savepoints []string `protobuf:"bytes,15,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	// last_insert_id is the last auto-increment value generated for
+	// the session, either by a vtgate sequence or by MySQL. It's the
+	// value returned by LAST_INSERT_ID().
+	LastInsertId uint64 `protobuf:"varint,16,opt,name=last_insert_id,json=lastInsertId,proto3" json:"last_insert_id,omitempty"`
+	// has_temporary_tables is set to true once the session creates a
+	// temporary table. They only exist on the connection that created
+	// them, so the session stays on its reserved connections.
+	HasTemporaryTables   bool     `protobuf:"varint,17,opt,name=has_temporary_tables,json=hasTemporaryTables,proto3" json:"has_temporary_tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -236,12 +261,64 @@ func (m *Session) GetPostSessions() []*Session_ShardSession {
 	return nil
 }
 
+func (m *Session) GetInReservedConn() bool {
+	if m != nil {
+		return m.InReservedConn
+	}
+	return false
+}
+
+func (m *Session) GetSystemVariables() map[string]string {
+	if m != nil {
+		return m.SystemVariables
+	}
+	return nil
+}
+
+func (m *Session) GetReservedSessions() []*Session_ShardSession {
+	if m != nil {
+		return m.ReservedSessions
+	}
+	return nil
+}
+
+func (m *Session) GetLockSession() *Session_ShardSession {
+	if m != nil {
+		return m.LockSession
+	}
+	return nil
+}
+
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetLastInsertId() uint64 {
+	if m != nil {
+		return m.LastInsertId
+	}
+	return 0
+}
+
+func (m *Session) GetHasTemporaryTables() bool {
+	if m != nil {
+		return m.HasTemporaryTables
+	}
+	return false
+}
+
 type Session_ShardSession struct {
-	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Target        *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	TransactionId int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// reserved_id is the id of the connection reserved for the
+	// session on the shard.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Session_ShardSession) Reset()         { *m = Session_ShardSession{} }
@@ -283,6 +360,13 @@ func (m *Session_ShardSession) GetTransactionId() int64 {
 	return 0
 }
 
+func (m *Session_ShardSession) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -3630,6 +3714,7 @@ func init() {
 	proto.RegisterEnum("vtgate.TransactionMode", TransactionMode_name, TransactionMode_value)
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
@@ -3686,133 +3771,146 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 2041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
-	0x15, 0x4f, 0x77, 0xfb, 0xf3, 0xf9, 0x73, 0x6b, 0xbc, 0x1b, 0xc7, 0x19, 0x76, 0x26, 0x1d, 0x46,
-	0xeb, 0x6c, 0x56, 0x1e, 0xe2, 0x40, 0x40, 0x28, 0x28, 0xcc, 0x78, 0x27, 0x2b, 0x2b, 0x3b, 0x1f,
-	0x94, 0xbd, 0xb3, 0x80, 0x88, 0x5a, 0x3d, 0x76, 0xe1, 0x6d, 0xc6, 0xee, 0x76, 0xba, 0xca, 0x5e,
-	0x86, 0x03, 0xca, 0x7f, 0x10, 0x71, 0x40, 0x42, 0x11, 0x12, 0x42, 0x42, 0xe2, 0xc4, 0x15, 0x09,
-	0xb8, 0x70, 0x43, 0xe2, 0x82, 0x38, 0x71, 0x44, 0xe2, 0x1f, 0x40, 0xe2, 0x2f, 0x40, 0x5d, 0x55,
-	0xfd, 0xe1, 0x9e, 0x2f, 0x8f, 0x67, 0x67, 0xe5, 0xbd, 0x58, 0x5d, 0xf5, 0x5e, 0x55, 0xbd, 0xf7,
-	0x7b, 0xbf, 0x7a, 0xf5, 0xba, 0xdc, 0x90, 0x9f, 0xb2, 0x81, 0xc9, 0x48, 0x63, 0xec, 0x3a, 0xcc,
-	0x41, 0x29, 0xd1, 0xaa, 0x95, 0x8f, 0x2c, 0x7b, 0xe8, 0x0c, 0xfa, 0x26, 0x33, 0x85, 0xa4, 0x96,
-	0xfb, 0x6c, 0x42, 0xdc, 0x13, 0xd9, 0x28, 0x32, 0x67, 0xec, 0x44, 0x85, 0x53, 0xe6, 0x8e, 0x7b,
-	0xa2, 0xa1, 0xff, 0x3b, 0x01, 0xe9, 0x0e, 0xa1, 0xd4, 0x72, 0x6c, 0xb4, 0x01, 0x45, 0xcb, 0x36,
-	0x98, 0x6b, 0xda, 0xd4, 0xec, 0x31, 0xcb, 0xb1, 0xab, 0xca, 0xba, 0x52, 0xcf, 0xe0, 0x82, 0x65,
-	0x77, 0xc3, 0x4e, 0xd4, 0x82, 0x22, 0x7d, 0x66, 0xba, 0x7d, 0x83, 0x8a, 0x71, 0xb4, 0xaa, 0xae,
-	0x6b, 0xf5, 0x5c, 0x73, 0xb5, 0x21, 0xad, 0x93, 0xf3, 0x35, 0x3a, 0x9e, 0x96, 0x6c, 0xe0, 0x02,
-	0x8d, 0xb4, 0x28, 0x7a, 0x13, 0xb2, 0xd4, 0xb2, 0x07, 0x43, 0x62, 0xf4, 0x8f, 0xaa, 0x1a, 0x5f,
-	0x26, 0x23, 0x3a, 0x1e, 0x1e, 0xa1, 0xbb, 0x00, 0xe6, 0x84, 0x39, 0x3d, 0x67, 0x34, 0xb2, 0x58,
-	0x35, 0xc1, 0xa5, 0x91, 0x1e, 0xf4, 0x36, 0x14, 0x98, 0xe9, 0x0e, 0x08, 0x33, 0x28, 0x73, 0x2d,
-	0x7b, 0x50, 0x4d, 0xae, 0x2b, 0xf5, 0x2c, 0xce, 0x8b, 0xce, 0x0e, 0xef, 0x43, 0x9b, 0x90, 0x76,
-	0xc6, 0x8c, 0xdb, 0x97, 0x5a, 0x57, 0xea, 0xb9, 0xe6, 0xed, 0x86, 0x40, 0x65, 0xe7, 0xa7, 0xa4,
-	0x37, 0x61, 0x64, 0x5f, 0x08, 0xb1, 0xaf, 0x85, 0xb6, 0xa1, 0x1c, 0xf1, 0xdd, 0x18, 0x39, 0x7d,
-	0x52, 0x4d, 0xaf, 0x2b, 0xf5, 0x62, 0xf3, 0x75, 0xdf, 0xb3, 0x08, 0x0c, 0xbb, 0x4e, 0x9f, 0xe0,
-	0x12, 0x9b, 0xed, 0x40, 0x9b, 0x90, 0x79, 0x6e, 0xba, 0xb6, 0x65, 0x0f, 0x68, 0x35, 0xc3, 0x51,
-	0x59, 0x91, 0xab, 0x7e, 0xcf, 0xfb, 0x7d, 0x2a, 0x64, 0x38, 0x50, 0x42, 0x1f, 0x41, 0x7e, 0xec,
-	0x92, 0x10, 0xca, 0xec, 0x1c, 0x50, 0xe6, 0xc6, 0x2e, 0x09, 0x80, 0xdc, 0x82, 0xc2, 0xd8, 0xa1,
-	0x2c, 0x9c, 0x01, 0xe6, 0x98, 0x21, 0xef, 0x0d, 0xf1, 0xa7, 0xa8, 0xfd, 0x08, 0xf2, 0x51, 0x29,
-	0xda, 0x80, 0x94, 0x40, 0x92, 0xc7, 0x3f, 0xd7, 0x2c, 0x48, 0x17, 0xba, 0xbc, 0x13, 0x4b, 0xa1,
-	0x47, 0x97, 0x28, 0x5e, 0x56, 0xbf, 0xaa, 0xae, 0x2b, 0x75, 0x0d, 0x17, 0x22, 0xbd, 0xed, 0xbe,
-	0xfe, 0x0f, 0x15, 0x8a, 0x12, 0x72, 0x4c, 0x3e, 0x9b, 0x10, 0xca, 0xd0, 0x03, 0xc8, 0xf6, 0xcc,
-	0xe1, 0x90, 0xb8, 0xde, 0x20, 0xb1, 0x46, 0xa9, 0x21, 0x58, 0xd9, 0xe2, 0xfd, 0xed, 0x87, 0x38,
-	0x23, 0x34, 0xda, 0x7d, 0xf4, 0x0e, 0xa4, 0xa5, 0x73, 0x7c, 0x01, 0xa1, 0x1b, 0xf5, 0x0d, 0xfb,
-	0x72, 0x74, 0x0f, 0x92, 0xdc, 0x54, 0xce, 0xa8, 0x5c, 0xf3, 0x96, 0x34, 0x7c, 0xdb, 0x99, 0xd8,
-	0x7d, 0x1e, 0x00, 0x2c, 0xe4, 0xe8, 0x1b, 0x90, 0x63, 0xe6, 0xd1, 0x90, 0x30, 0x83, 0x9d, 0x8c,
-	0x09, 0xa7, 0x58, 0xb1, 0x59, 0x69, 0x04, 0x3b, 0xa5, 0xcb, 0x85, 0xdd, 0x93, 0x31, 0xc1, 0xc0,
-	0x82, 0x67, 0xf4, 0x00, 0x90, 0xed, 0x30, 0x23, 0xb6, 0x4b, 0x92, 0x9c, 0xa0, 0x65, 0xdb, 0x61,
-	0xed, 0x99, 0x8d, 0xb2, 0x01, 0xc5, 0x63, 0x72, 0x42, 0xc7, 0x66, 0x8f, 0x18, 0x9c, 0xfd, 0x9c,
-	0x88, 0x59, 0x5c, 0xf0, 0x7b, 0x39, 0xea, 0x51, 0xa2, 0xa6, 0xe7, 0x21, 0xaa, 0xfe, 0x85, 0x02,
-	0xa5, 0x00, 0x51, 0x3a, 0x76, 0x6c, 0x4a, 0xd0, 0x06, 0x24, 0x89, 0xeb, 0x3a, 0x6e, 0x0c, 0x4e,
-	0x7c, 0xd0, 0xda, 0xf1, 0xba, 0xb1, 0x90, 0x5e, 0x05, 0xcb, 0xfb, 0x90, 0x72, 0x09, 0x9d, 0x0c,
-	0x99, 0x04, 0x13, 0x45, 0x89, 0x8c, 0xb9, 0x04, 0x4b, 0x0d, 0xfd, 0x3f, 0x2a, 0x54, 0xa4, 0x45,
-	0xdc, 0x27, 0xba, 0x3c, 0x91, 0xae, 0x41, 0xc6, 0x87, 0x9b, 0x87, 0x39, 0x8b, 0x83, 0x36, 0xba,
-	0x03, 0x29, 0x1e, 0x17, 0x5a, 0x4d, 0xae, 0x6b, 0xf5, 0x2c, 0x96, 0xad, 0x38, 0x3b, 0x52, 0xd7,
-	0x62, 0x47, 0xfa, 0x1c, 0x76, 0x44, 0xc2, 0x9e, 0x99, 0x2b, 0xec, 0xbf, 0x54, 0xe0, 0x76, 0x0c,
-	0xe4, 0xa5, 0x08, 0xfe, 0xff, 0x54, 0x78, 0x43, 0xda, 0xf5, 0x89, 0x44, 0xb6, 0xfd, 0xaa, 0x30,
-	0xe0, 0x2d, 0xc8, 0x07, 0x5b, 0xd4, 0x92, 0x3c, 0xc8, 0xe3, 0xdc, 0x71, 0xe8, 0xc7, 0x92, 0x92,
-	0xe1, 0x4b, 0x05, 0x6a, 0x67, 0x81, 0xbe, 0x14, 0x8c, 0xf8, 0x5c, 0x83, 0xd7, 0x43, 0xe3, 0xb0,
-	0x69, 0x0f, 0xc8, 0x2b, 0xc2, 0x87, 0xf7, 0x00, 0x8e, 0xc9, 0x89, 0xe1, 0x72, 0x93, 0x39, 0x1b,
-	0x3c, 0x4f, 0x83, 0x58, 0xfb, 0xde, 0xe0, 0xec, 0xb1, 0xef, 0xd7, 0x92, 0xf2, 0xe3, 0x57, 0x0a,
-	0x54, 0x4f, 0x87, 0x60, 0x29, 0xd8, 0xf1, 0xa7, 0x44, 0xc0, 0x8e, 0x1d, 0x9b, 0x59, 0xec, 0xe4,
-	0x95, 0xc9, 0x16, 0x0f, 0x00, 0x11, 0x6e, 0xb1, 0xd1, 0x73, 0x86, 0x93, 0x91, 0x6d, 0xd8, 0xe6,
-	0x88, 0xc8, 0xe2, 0xb3, 0x2c, 0x24, 0x2d, 0x2e, 0xd8, 0x33, 0x47, 0x04, 0x7d, 0x1f, 0x56, 0xa4,
-	0xf6, 0x4c, 0x8a, 0x49, 0x71, 0x52, 0xd5, 0x7d, 0x4b, 0xcf, 0x41, 0xa2, 0xe1, 0x77, 0xe0, 0x5b,
-	0x62, 0x92, 0x4f, 0xce, 0x4f, 0x49, 0xe9, 0x6b, 0x51, 0x2e, 0x73, 0x39, 0xe5, 0xb2, 0xf3, 0x50,
-	0xae, 0x76, 0x04, 0x19, 0xdf, 0x68, 0xb4, 0x06, 0x09, 0x6e, 0x9a, 0xc2, 0x4d, 0xcb, 0xf9, 0x05,
-	0xa4, 0x67, 0x11, 0x17, 0xa0, 0x0a, 0x24, 0xa7, 0xe6, 0x70, 0x42, 0x78, 0xe0, 0xf2, 0x58, 0x34,
-	0xd0, 0x1a, 0xe4, 0x22, 0x58, 0xf1, 0x58, 0xe5, 0x31, 0x84, 0xd9, 0x38, 0x4a, 0xeb, 0x08, 0x62,
-	0x4b, 0x41, 0xeb, 0x7f, 0xaa, 0xb0, 0x22, 0x4d, 0xdb, 0x36, 0x59, 0xef, 0xd9, 0x8d, 0x53, 0xfa,
-	0x5d, 0x48, 0x7b, 0xd6, 0x58, 0x84, 0x56, 0x35, 0xce, 0xa9, 0x33, 0x48, 0xed, 0x6b, 0x2c, 0x5a,
-	0xf0, 0x6e, 0x40, 0xd1, 0xa4, 0x67, 0x14, 0xbb, 0x05, 0x93, 0xbe, 0x8c, 0x4a, 0xf7, 0x4b, 0x25,
-	0xa8, 0x2b, 0x25, 0xa6, 0x37, 0x16, 0xea, 0xaf, 0x41, 0x5a, 0x04, 0xd2, 0x47, 0xf3, 0x8e, 0xb4,
-	0x4d, 0x84, 0xf9, 0xa9, 0xc5, 0x9e, 0x89, 0xa9, 0x7d, 0x35, 0xdd, 0x86, 0x12, 0x47, 0x9a, 0xfb,
-	0xc6, 0xe1, 0x0e, 0xb3, 0x8c, 0x72, 0x85, 0x2c, 0xa3, 0x9e, 0x5b, 0x95, 0x6a, 0xd1, 0xaa, 0x54,
-	0xff, 0x63, 0x58, 0x67, 0x71, 0x30, 0x5e, 0x52, 0xa5, 0xfd, 0x5e, 0x9c, 0x66, 0xc1, 0xdb, 0x70,
-	0xcc, 0xfb, 0x97, 0x45, 0xb6, 0xab, 0xbe, 0xd8, 0xeb, 0xbf, 0x0e, 0x6b, 0xa5, 0x19, 0xe0, 0x6e,
-	0x8c, 0x4b, 0x0f, 0xe2, 0x5c, 0x3a, 0x2b, 0x6f, 0x04, 0x3c, 0xfa, 0x39, 0x54, 0x38, 0x92, 0x61,
-	0x86, 0x7f, 0x81, 0x64, 0x8a, 0x17, 0xb8, 0xda, 0xa9, 0x02, 0x57, 0xff, 0xab, 0x0a, 0x77, 0xa3,
-	0xf0, 0xbc, 0xcc, 0x22, 0xfe, 0x83, 0x38, 0xb9, 0x56, 0x67, 0xc8, 0x15, 0x83, 0x64, 0x69, 0x19,
-	0xf6, 0x5b, 0x05, 0xd6, 0xce, 0x85, 0x70, 0x49, 0x68, 0xf6, 0x7b, 0x15, 0x2a, 0x1d, 0xe6, 0x12,
-	0x73, 0x74, 0xad, 0xdb, 0x98, 0x80, 0x95, 0xea, 0xd5, 0xae, 0x58, 0xb4, 0xf9, 0x43, 0x14, 0x3b,
-	0x4a, 0x12, 0x97, 0x1c, 0x25, 0xc9, 0xb9, 0x6e, 0xf7, 0x22, 0xb8, 0xa6, 0x2e, 0xc6, 0x55, 0x6f,
-	0xc1, 0xed, 0x18, 0x50, 0x32, 0x84, 0x61, 0x39, 0xa0, 0x5c, 0x5a, 0x0e, 0x7c, 0xa1, 0x42, 0x6d,
-	0x66, 0x96, 0xeb, 0xa4, 0xeb, 0xb9, 0x41, 0x8f, 0xa6, 0x02, 0xed, 0xdc, 0x73, 0x25, 0x71, 0xd1,
-	0x6d, 0x47, 0x72, 0xce, 0x40, 0x5d, 0x79, 0x93, 0xb4, 0xe1, 0xcd, 0x33, 0x01, 0x59, 0x00, 0xdc,
-	0xdf, 0xa8, 0xb0, 0x36, 0x33, 0xd7, 0xb5, 0x73, 0xd6, 0x0b, 0x41, 0x38, 0x9e, 0x6c, 0x13, 0x97,
-	0xde, 0x26, 0xdc, 0x18, 0xd8, 0x7b, 0xb0, 0x7e, 0x3e, 0x40, 0x0b, 0x20, 0xfe, 0x07, 0x15, 0xbe,
-	0x12, 0x9f, 0xf0, 0x3a, 0x2f, 0xf6, 0x2f, 0x04, 0xef, 0xd9, 0xb7, 0xf5, 0xc4, 0x02, 0x6f, 0xeb,
-	0x37, 0x86, 0xff, 0x63, 0xb8, 0x7b, 0x1e, 0x5c, 0x0b, 0xa0, 0xff, 0x03, 0xc8, 0x6f, 0x93, 0x81,
-	0x65, 0x2f, 0x86, 0xf5, 0xcc, 0x7f, 0x2d, 0xea, 0xec, 0x7f, 0x2d, 0xfa, 0xb7, 0xa1, 0x20, 0xa7,
-	0x96, 0x76, 0x45, 0x12, 0xa5, 0x72, 0x49, 0xa2, 0xfc, 0x5c, 0x81, 0x42, 0x8b, 0xff, 0x25, 0x73,
-	0xe3, 0x85, 0xc2, 0x1d, 0x48, 0x99, 0xcc, 0x19, 0x59, 0x3d, 0xf9, 0x67, 0x91, 0x6c, 0xe9, 0x65,
-	0x28, 0xfa, 0x16, 0x08, 0xfb, 0xf5, 0x9f, 0x40, 0x09, 0x3b, 0xc3, 0xe1, 0x91, 0xd9, 0x3b, 0xbe,
-	0x69, 0xab, 0x74, 0x04, 0xe5, 0x70, 0x2d, 0xb9, 0xfe, 0xa7, 0xf0, 0x06, 0x26, 0xd4, 0x19, 0x4e,
-	0x49, 0xa4, 0xa4, 0x58, 0xcc, 0x12, 0x04, 0x89, 0x3e, 0x93, 0xff, 0xab, 0x64, 0x31, 0x7f, 0xd6,
-	0xff, 0xa2, 0x40, 0x65, 0x97, 0x50, 0x6a, 0x0e, 0x88, 0x20, 0xd8, 0x62, 0x53, 0x5f, 0x54, 0x33,
-	0x56, 0x20, 0x29, 0x4e, 0x5e, 0xb1, 0xdf, 0x44, 0x03, 0x6d, 0x42, 0x36, 0xd8, 0x6c, 0xfc, 0x4c,
-	0x3e, 0x7b, 0xaf, 0x65, 0xfc, 0xbd, 0xe6, 0x59, 0x1f, 0xb9, 0x1f, 0xe1, 0xcf, 0xfa, 0x2f, 0x14,
-	0xb8, 0x25, 0xad, 0xdf, 0x5a, 0x34, 0x3e, 0x17, 0x99, 0xee, 0xaf, 0xa9, 0x85, 0x6b, 0xa2, 0xbb,
-	0xa0, 0xf9, 0xc9, 0x38, 0xd7, 0xcc, 0xcb, 0x5d, 0x76, 0x68, 0x0e, 0x27, 0x04, 0x7b, 0x02, 0x7d,
-	0x17, 0xf2, 0xed, 0x48, 0xa5, 0x89, 0x56, 0x41, 0x0d, 0xcc, 0x98, 0x55, 0x57, 0xad, 0x7e, 0xfc,
-	0x8a, 0x42, 0x3d, 0x75, 0x45, 0xf1, 0x67, 0x05, 0x56, 0x43, 0x17, 0xaf, 0x7d, 0x30, 0x5d, 0xd5,
-	0xdb, 0x0f, 0xa1, 0x64, 0xf5, 0x8d, 0x53, 0xc7, 0x50, 0xae, 0x59, 0xf1, 0x59, 0x1c, 0x75, 0x16,
-	0x17, 0xac, 0x48, 0x8b, 0xea, 0xab, 0x50, 0x3b, 0x8b, 0xbc, 0x92, 0xda, 0xff, 0x55, 0xe1, 0x56,
-	0x67, 0x3c, 0xb4, 0x98, 0xcc, 0x51, 0x2f, 0xda, 0x9f, 0xb9, 0x2f, 0xe9, 0xde, 0x82, 0x3c, 0xf5,
-	0xec, 0x90, 0xf7, 0x70, 0xb2, 0xa0, 0xc9, 0xf1, 0x3e, 0x71, 0x03, 0xe7, 0xc5, 0xc9, 0x57, 0x99,
-	0xd8, 0x8c, 0x93, 0x50, 0xc3, 0x20, 0x35, 0x26, 0x36, 0x43, 0x5f, 0x87, 0xd7, 0xed, 0xc9, 0xc8,
-	0x70, 0x9d, 0xe7, 0xd4, 0x18, 0x13, 0xd7, 0xe0, 0x33, 0x1b, 0x63, 0xd3, 0x65, 0x3c, 0xc5, 0x6b,
-	0x78, 0xc5, 0x9e, 0x8c, 0xb0, 0xf3, 0x9c, 0x1e, 0x10, 0x97, 0x2f, 0x7e, 0x60, 0xba, 0x0c, 0x7d,
-	0x17, 0xb2, 0xe6, 0x70, 0xe0, 0xb8, 0x16, 0x7b, 0x36, 0x92, 0x17, 0x6f, 0xba, 0x34, 0xf3, 0x14,
-	0x32, 0x8d, 0x2d, 0x5f, 0x13, 0x87, 0x83, 0xd0, 0xbb, 0x80, 0x26, 0x94, 0x18, 0xc2, 0x38, 0xb1,
-	0xe8, 0xb4, 0x29, 0x6f, 0xe1, 0x4a, 0x13, 0x4a, 0xc2, 0x69, 0x0e, 0x9b, 0xfa, 0xdf, 0x34, 0x40,
-	0xd1, 0x79, 0x65, 0x8e, 0xfe, 0x26, 0xa4, 0xf8, 0x78, 0x5a, 0x55, 0x78, 0x6c, 0xd7, 0x82, 0x0c,
-	0x75, 0x4a, 0xb7, 0xe1, 0x99, 0x8d, 0xa5, 0x7a, 0xed, 0x53, 0xc8, 0xfb, 0x3b, 0x95, 0xbb, 0x13,
-	0x8d, 0x86, 0x72, 0xe1, 0xe9, 0xaa, 0xce, 0x71, 0xba, 0xd6, 0x3e, 0x82, 0x2c, 0xaf, 0xea, 0x2e,
-	0x9d, 0x3b, 0xac, 0x45, 0xd5, 0x68, 0x2d, 0x5a, 0xfb, 0x97, 0x02, 0x09, 0x3e, 0x78, 0xee, 0x97,
-	0xdf, 0x5d, 0xfe, 0xbe, 0x20, 0xac, 0x14, 0xd1, 0x13, 0x49, 0xfb, 0xde, 0x05, 0x90, 0x44, 0x21,
-	0xc0, 0xf9, 0xe3, 0x28, 0x20, 0x2d, 0x00, 0xf1, 0x71, 0x03, 0x9f, 0x4a, 0xf0, 0xf0, 0xab, 0x17,
-	0x4c, 0x15, 0xb8, 0x8b, 0xb3, 0x34, 0xf0, 0x1c, 0x41, 0x82, 0x5a, 0x3f, 0x13, 0x59, 0x52, 0xc3,
-	0xfc, 0x59, 0x7f, 0x1f, 0x6e, 0x3f, 0x22, 0xac, 0xe3, 0x4e, 0xfd, 0xed, 0xe6, 0x6f, 0x9f, 0x0b,
-	0x60, 0xd2, 0x31, 0xdc, 0x89, 0x0f, 0x92, 0x0c, 0xf8, 0x16, 0xe4, 0xa9, 0x3b, 0x35, 0x66, 0x46,
-	0x7a, 0x55, 0x49, 0x10, 0x9e, 0xe8, 0xa0, 0x1c, 0x0d, 0x1b, 0xfa, 0xdf, 0x15, 0x28, 0x1e, 0x5e,
-	0xe7, 0xe8, 0x88, 0x95, 0x50, 0xea, 0x9c, 0x25, 0xd4, 0x3d, 0x48, 0x4e, 0x07, 0x4c, 0xde, 0xea,
-	0x7a, 0x11, 0x8d, 0x7c, 0xb5, 0x72, 0xf8, 0x88, 0x59, 0x7d, 0x2c, 0xe4, 0x5e, 0x61, 0xf4, 0x63,
-	0x6b, 0xc8, 0x88, 0x1b, 0x9c, 0x32, 0x11, 0xcd, 0x8f, 0xb9, 0x04, 0x4b, 0x0d, 0xfd, 0x3b, 0x50,
-	0x0a, 0x7c, 0x09, 0xeb, 0x2a, 0x32, 0x25, 0x76, 0xb0, 0x37, 0x66, 0x86, 0x1f, 0xee, 0x78, 0x22,
-	0x2c, 0x35, 0xf4, 0xdf, 0xa9, 0xb0, 0xf2, 0x64, 0xdc, 0x37, 0xd9, 0xb2, 0x9f, 0xa5, 0x0b, 0x96,
-	0xad, 0xab, 0x90, 0x65, 0xd6, 0x88, 0x50, 0x66, 0x8e, 0xc6, 0x32, 0xab, 0x85, 0x1d, 0x5e, 0x44,
-	0x38, 0x0e, 0xf2, 0x32, 0xd6, 0xdf, 0x63, 0x1c, 0xa2, 0xae, 0x73, 0x4c, 0x6c, 0x2c, 0xe4, 0xfa,
-	0x31, 0x54, 0x66, 0x51, 0x92, 0x50, 0xd7, 0xfd, 0x09, 0x66, 0x2b, 0x58, 0x59, 0xf8, 0x72, 0xa4,
-	0x85, 0x02, 0x7a, 0x07, 0xca, 0x5e, 0x29, 0x3b, 0x22, 0x46, 0x68, 0x8f, 0xf8, 0x5a, 0xa4, 0x24,
-	0xfa, 0xbb, 0x7e, 0xf7, 0xfd, 0x87, 0x50, 0x8a, 0x7d, 0x66, 0x83, 0x4a, 0x90, 0x7b, 0xb2, 0xd7,
-	0x39, 0xd8, 0x69, 0xb5, 0x3f, 0x6e, 0xef, 0x3c, 0x2c, 0xbf, 0x86, 0x00, 0x52, 0x9d, 0xf6, 0xde,
-	0xa3, 0xc7, 0x3b, 0x65, 0x05, 0x65, 0x21, 0xb9, 0xfb, 0xe4, 0x71, 0xb7, 0x5d, 0x56, 0xbd, 0xc7,
-	0xee, 0xd3, 0xfd, 0x83, 0x56, 0x59, 0xbb, 0xff, 0x21, 0xe4, 0x44, 0x5d, 0xb8, 0xef, 0xf6, 0x89,
-	0xeb, 0x0d, 0xd8, 0xdb, 0xc7, 0xbb, 0x5b, 0x8f, 0xcb, 0xaf, 0xa1, 0x34, 0x68, 0x07, 0xd8, 0x1b,
-	0x99, 0x81, 0xc4, 0xc1, 0x7e, 0xa7, 0x5b, 0x56, 0x51, 0x11, 0x60, 0xeb, 0x49, 0x77, 0xbf, 0xb5,
-	0xbf, 0xbb, 0xdb, 0xee, 0x96, 0xb5, 0xed, 0x0f, 0xa0, 0x64, 0x39, 0x8d, 0xa9, 0xc5, 0x08, 0xa5,
-	0xe2, 0x43, 0xa9, 0x1f, 0xbe, 0x2d, 0x5b, 0x96, 0xb3, 0x29, 0x9e, 0x36, 0x07, 0xce, 0xe6, 0x94,
-	0x6d, 0x72, 0xe9, 0xa6, 0x48, 0x10, 0x47, 0x29, 0xde, 0x7a, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4b, 0xc0, 0xed, 0xac, 0xa8, 0x25, 0x00, 0x00,
+	// 2241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5b, 0x8f, 0x23, 0x47,
+	0x15, 0x4e, 0x77, 0xfb, 0x7a, 0x7c, 0x9d, 0x5a, 0xef, 0xc6, 0x71, 0x86, 0x5d, 0xa7, 0xb3, 0xab,
+	0x75, 0x36, 0xab, 0x99, 0xc4, 0x81, 0x10, 0x45, 0x41, 0xcb, 0x8e, 0x77, 0xb2, 0xb2, 0xb2, 0xb3,
+	0x33, 0xd4, 0x78, 0x67, 0x01, 0x29, 0x6a, 0xf5, 0xb8, 0x0b, 0x4f, 0x63, 0xbb, 0xdb, 0xe9, 0x2a,
+	0x7b, 0x31, 0x12, 0x28, 0xff, 0x20, 0xe2, 0x01, 0x09, 0x45, 0x48, 0x08, 0x09, 0x89, 0x27, 0x5e,
+	0x91, 0x80, 0x17, 0x24, 0x1e, 0x90, 0x78, 0x41, 0x3c, 0xf1, 0xce, 0x1f, 0x40, 0xe2, 0x17, 0xa0,
+	0xae, 0xaa, 0xbe, 0xd8, 0x73, 0xf3, 0xdc, 0x56, 0xde, 0x17, 0xab, 0xeb, 0xd4, 0xa9, 0xaa, 0x73,
+	0xbe, 0xf3, 0xd5, 0xa9, 0xd3, 0xe5, 0x86, 0xfc, 0x84, 0xf5, 0x4c, 0x46, 0xd6, 0x46, 0x9e, 0xcb,
+	0x5c, 0x94, 0x12, 0xad, 0x5a, 0x79, 0xdf, 0x76, 0x06, 0x6e, 0xcf, 0x32, 0x99, 0x29, 0x7a, 0x6a,
+	0xb9, 0x2f, 0xc6, 0xc4, 0x9b, 0xca, 0x46, 0x91, 0xb9, 0x23, 0x37, 0xde, 0x39, 0x61, 0xde, 0xa8,
+	0x2b, 0x1a, 0xfa, 0xdf, 0x32, 0x90, 0xde, 0x25, 0x94, 0xda, 0xae, 0x83, 0xee, 0x40, 0xd1, 0x76,
+	0x0c, 0xe6, 0x99, 0x0e, 0x35, 0xbb, 0xcc, 0x76, 0x9d, 0xaa, 0x52, 0x57, 0x1a, 0x19, 0x5c, 0xb0,
+	0x9d, 0x4e, 0x24, 0x44, 0x2d, 0x28, 0xd2, 0x03, 0xd3, 0xb3, 0x0c, 0x2a, 0xc6, 0xd1, 0xaa, 0x5a,
+	0xd7, 0x1a, 0xb9, 0xe6, 0xea, 0x9a, 0xb4, 0x4e, 0xce, 0xb7, 0xb6, 0xeb, 0x6b, 0xc9, 0x06, 0x2e,
+	0xd0, 0x58, 0x8b, 0xa2, 0x37, 0x21, 0x4b, 0x6d, 0xa7, 0x37, 0x20, 0x86, 0xb5, 0x5f, 0xd5, 0xf8,
+	0x32, 0x19, 0x21, 0x78, 0xb4, 0x8f, 0x6e, 0x02, 0x98, 0x63, 0xe6, 0x76, 0xdd, 0xe1, 0xd0, 0x66,
+	0xd5, 0x04, 0xef, 0x8d, 0x49, 0xd0, 0xdb, 0x50, 0x60, 0xa6, 0xd7, 0x23, 0xcc, 0xa0, 0xcc, 0xb3,
+	0x9d, 0x5e, 0x35, 0x59, 0x57, 0x1a, 0x59, 0x9c, 0x17, 0xc2, 0x5d, 0x2e, 0x43, 0xeb, 0x90, 0x76,
+	0x47, 0x8c, 0xdb, 0x97, 0xaa, 0x2b, 0x8d, 0x5c, 0xf3, 0xfa, 0x9a, 0x40, 0x65, 0xf3, 0x27, 0xa4,
+	0x3b, 0x66, 0x64, 0x5b, 0x74, 0xe2, 0x40, 0x0b, 0x6d, 0x40, 0x39, 0xe6, 0xbb, 0x31, 0x74, 0x2d,
+	0x52, 0x4d, 0xd7, 0x95, 0x46, 0xb1, 0xf9, 0x7a, 0xe0, 0x59, 0x0c, 0x86, 0x2d, 0xd7, 0x22, 0xb8,
+	0xc4, 0x66, 0x05, 0x68, 0x1d, 0x32, 0x2f, 0x4c, 0xcf, 0xb1, 0x9d, 0x1e, 0xad, 0x66, 0x38, 0x2a,
+	0xd7, 0xe4, 0xaa, 0xdf, 0xf3, 0x7f, 0x9f, 0x8b, 0x3e, 0x1c, 0x2a, 0xa1, 0x07, 0x90, 0x1f, 0x79,
+	0x24, 0x82, 0x32, 0xbb, 0x00, 0x94, 0xb9, 0x91, 0x47, 0x42, 0x20, 0x1f, 0x42, 0x61, 0xe4, 0x52,
+	0x16, 0xcd, 0x00, 0x0b, 0xcc, 0x90, 0xf7, 0x87, 0x84, 0x53, 0x34, 0xa0, 0x6c, 0x3b, 0x86, 0x47,
+	0x28, 0xf1, 0x26, 0xc4, 0x32, 0xba, 0xae, 0xe3, 0x54, 0x73, 0x1c, 0xf4, 0xa2, 0xed, 0x60, 0x29,
+	0x6e, 0xb9, 0x8e, 0x83, 0xb6, 0xa1, 0x4c, 0xa7, 0x94, 0x91, 0xa1, 0x31, 0x31, 0x3d, 0xdb, 0xdc,
+	0x1f, 0x10, 0x5a, 0xcd, 0xf3, 0xf5, 0x6e, 0x1f, 0x5a, 0x8f, 0xeb, 0xed, 0x05, 0x6a, 0x9b, 0x0e,
+	0xf3, 0xa6, 0xb8, 0x44, 0x67, 0xa5, 0xa8, 0x0d, 0x2b, 0xe1, 0xba, 0xa1, 0x07, 0x85, 0x05, 0x3c,
+	0x28, 0x07, 0xc3, 0x42, 0x2f, 0x1e, 0x40, 0x7e, 0xe0, 0x76, 0xfb, 0xc1, 0x34, 0xd5, 0x62, 0x5d,
+	0x39, 0x75, 0x96, 0x9c, 0x3f, 0x42, 0x36, 0x7c, 0xd6, 0x51, 0x73, 0x42, 0x46, 0xae, 0xed, 0x30,
+	0x5a, 0x2d, 0xd5, 0xb5, 0x46, 0x16, 0xc7, 0x24, 0xe8, 0x36, 0x14, 0x07, 0x26, 0x65, 0x86, 0xed,
+	0x50, 0xe2, 0x31, 0xc3, 0xb6, 0xaa, 0xe5, 0xba, 0xd2, 0x48, 0xe0, 0xbc, 0x2f, 0x6d, 0x73, 0x61,
+	0xdb, 0x42, 0xef, 0x41, 0xe5, 0xc0, 0xa4, 0x06, 0x23, 0xc3, 0x91, 0xeb, 0x99, 0xde, 0xd4, 0x60,
+	0x02, 0xa6, 0x15, 0x0e, 0x28, 0x3a, 0x30, 0x69, 0x27, 0xe8, 0xea, 0xf0, 0x9e, 0xda, 0xcf, 0x20,
+	0x1f, 0x37, 0x0a, 0xdd, 0x81, 0x94, 0x20, 0x32, 0xdf, 0x7e, 0xb9, 0x66, 0x41, 0x32, 0xa8, 0xc3,
+	0x85, 0x58, 0x76, 0xfa, 0xbb, 0x35, 0x4e, 0x57, 0xdb, 0xaa, 0xaa, 0x75, 0xa5, 0xa1, 0xe1, 0x42,
+	0x4c, 0xda, 0xb6, 0xd0, 0x2d, 0xc8, 0x85, 0x08, 0xdb, 0x16, 0xdf, 0x6a, 0x1a, 0x86, 0x40, 0xd4,
+	0xb6, 0x6a, 0x1b, 0x50, 0x39, 0x2a, 0x56, 0xa8, 0x0c, 0x5a, 0x9f, 0x4c, 0xb9, 0x0d, 0x59, 0xec,
+	0x3f, 0xa2, 0x0a, 0x24, 0x27, 0xe6, 0x60, 0x4c, 0xf8, 0x42, 0x59, 0x2c, 0x1a, 0x1f, 0xab, 0x1f,
+	0x29, 0xfa, 0x3f, 0x55, 0x28, 0xca, 0x6d, 0x85, 0xc9, 0x17, 0x63, 0x42, 0x19, 0xba, 0x0f, 0xd9,
+	0xae, 0x39, 0x18, 0x10, 0xcf, 0x5f, 0x55, 0x38, 0x52, 0x5a, 0x13, 0x99, 0xa7, 0xc5, 0xe5, 0xed,
+	0x47, 0x38, 0x23, 0x34, 0xda, 0x16, 0x7a, 0x07, 0xd2, 0x41, 0xdc, 0xd4, 0x50, 0x37, 0x1e, 0x37,
+	0x1c, 0xf4, 0xa3, 0xbb, 0x90, 0xe4, 0x78, 0x70, 0x57, 0x72, 0xcd, 0x15, 0x89, 0xce, 0x86, 0x3b,
+	0x76, 0x2c, 0xbe, 0xc9, 0xb0, 0xe8, 0x47, 0xdf, 0x82, 0x1c, 0xc7, 0x9e, 0x19, 0x6c, 0x3a, 0x22,
+	0x3c, 0x8d, 0x14, 0x9b, 0x95, 0xb5, 0x30, 0x1b, 0x72, 0xf8, 0x59, 0x67, 0x3a, 0x22, 0x18, 0x58,
+	0xf8, 0x8c, 0xee, 0x03, 0x72, 0x5c, 0x66, 0xcc, 0x65, 0xc2, 0x24, 0x0f, 0x5f, 0xd9, 0x71, 0x59,
+	0x7b, 0x26, 0x19, 0xde, 0x81, 0x62, 0x9f, 0x4c, 0xe9, 0xc8, 0xec, 0x12, 0x83, 0x67, 0x38, 0x9e,
+	0x6c, 0xb2, 0xb8, 0x10, 0x48, 0x79, 0x68, 0xe3, 0xc9, 0x28, 0xbd, 0x48, 0x32, 0xd2, 0xbf, 0x52,
+	0xa0, 0x14, 0x22, 0x4a, 0x47, 0xae, 0x43, 0x09, 0xba, 0x03, 0x49, 0xe2, 0x79, 0xae, 0x37, 0x07,
+	0x27, 0xde, 0x69, 0x6d, 0xfa, 0x62, 0x2c, 0x7a, 0xcf, 0x82, 0xe5, 0x3d, 0x48, 0x79, 0x84, 0x8e,
+	0x07, 0x4c, 0x82, 0x89, 0xe2, 0xc9, 0x0a, 0xf3, 0x1e, 0x2c, 0x35, 0xf4, 0xff, 0xa8, 0x50, 0x91,
+	0x16, 0x71, 0x9f, 0xe8, 0xf2, 0x44, 0xba, 0x06, 0x99, 0x00, 0x6e, 0x1e, 0xe6, 0x2c, 0x0e, 0xdb,
+	0xe8, 0x06, 0xa4, 0x78, 0x5c, 0x68, 0x35, 0xc9, 0x77, 0xb4, 0x6c, 0xcd, 0xb3, 0x23, 0x75, 0x21,
+	0x76, 0xa4, 0x8f, 0x61, 0x47, 0x2c, 0xec, 0x99, 0x85, 0xc2, 0xfe, 0x4b, 0x05, 0xae, 0xcf, 0x81,
+	0xbc, 0x14, 0xc1, 0xff, 0x9f, 0x0a, 0x6f, 0x48, 0xbb, 0x3e, 0x93, 0xc8, 0xb6, 0x5f, 0x15, 0x06,
+	0xbc, 0x05, 0xf9, 0x70, 0x8b, 0xda, 0x92, 0x07, 0x79, 0x9c, 0xeb, 0x47, 0x7e, 0x2c, 0x29, 0x19,
+	0xbe, 0x56, 0xa0, 0x76, 0x14, 0xe8, 0x4b, 0xc1, 0x88, 0x2f, 0x35, 0x78, 0x3d, 0x32, 0x0e, 0x9b,
+	0x4e, 0x8f, 0xbc, 0x22, 0x7c, 0x78, 0x1f, 0xa0, 0x4f, 0xa6, 0x86, 0xc7, 0x4d, 0xe6, 0x6c, 0xf0,
+	0x3d, 0x0d, 0x63, 0x1d, 0x78, 0x83, 0xb3, 0x7d, 0xf9, 0xb4, 0xac, 0xfc, 0xf8, 0x95, 0x02, 0xd5,
+	0xc3, 0x21, 0x58, 0x0a, 0x76, 0xfc, 0x29, 0x11, 0xb2, 0x63, 0xd3, 0x61, 0x36, 0x9b, 0xbe, 0x32,
+	0xd9, 0xe2, 0x3e, 0x20, 0xc2, 0x2d, 0x36, 0xba, 0xee, 0x60, 0x3c, 0x74, 0x0c, 0xc7, 0x1c, 0x12,
+	0xf9, 0x82, 0x51, 0x16, 0x3d, 0x2d, 0xde, 0xf1, 0xd4, 0x1c, 0x12, 0xf4, 0x7d, 0xb8, 0x26, 0xb5,
+	0x67, 0x52, 0x4c, 0x8a, 0x93, 0xaa, 0x11, 0x58, 0x7a, 0x0c, 0x12, 0x6b, 0x81, 0x00, 0xaf, 0x88,
+	0x49, 0x3e, 0x3b, 0x3e, 0x25, 0xa5, 0x2f, 0x44, 0xb9, 0xcc, 0xe9, 0x94, 0xcb, 0x2e, 0x42, 0xb9,
+	0xda, 0x3e, 0x64, 0x02, 0xa3, 0xd1, 0x2d, 0x48, 0x70, 0xd3, 0x14, 0x6e, 0x5a, 0x2e, 0xa8, 0x52,
+	0x7d, 0x8b, 0x78, 0xc7, 0x6c, 0xbd, 0x98, 0x97, 0xf5, 0xa2, 0x5f, 0x90, 0xc6, 0xb0, 0xe2, 0xb1,
+	0xca, 0x63, 0x88, 0xb2, 0x71, 0x9c, 0xd6, 0x31, 0xc4, 0x96, 0x82, 0xd6, 0xff, 0x52, 0xe1, 0x9a,
+	0x34, 0x6d, 0xc3, 0x64, 0xdd, 0x83, 0x2b, 0xa7, 0xf4, 0xbb, 0x90, 0xf6, 0xad, 0xb1, 0x09, 0xad,
+	0x6a, 0x75, 0xed, 0x68, 0x52, 0x07, 0x1a, 0xe7, 0x2d, 0x78, 0xef, 0x40, 0xd1, 0x7f, 0x61, 0x39,
+	0x54, 0xec, 0x16, 0x4c, 0xfa, 0x32, 0x2a, 0xdd, 0xaf, 0x15, 0xa8, 0xcc, 0x62, 0x7a, 0x65, 0xa1,
+	0x7e, 0x0f, 0xd2, 0x22, 0x90, 0x01, 0x9a, 0x37, 0xa4, 0x6d, 0x22, 0xcc, 0xcf, 0x6d, 0x76, 0x20,
+	0xa6, 0x0e, 0xd4, 0x74, 0x07, 0x4a, 0x1c, 0x69, 0xee, 0x1b, 0x87, 0x3b, 0xca, 0x32, 0xca, 0x19,
+	0xb2, 0x8c, 0x7a, 0x6c, 0x55, 0xaa, 0xc5, 0xab, 0x52, 0xfd, 0x8f, 0x51, 0x9d, 0xc5, 0xc1, 0x78,
+	0x49, 0x95, 0xf6, 0xfb, 0xf3, 0x34, 0x0b, 0x6f, 0x3c, 0xe6, 0xbc, 0x7f, 0x59, 0x64, 0x3b, 0xeb,
+	0xe5, 0x8d, 0xfe, 0xeb, 0xa8, 0x56, 0x9a, 0x01, 0xee, 0xca, 0xb8, 0x74, 0x7f, 0x9e, 0x4b, 0x47,
+	0xe5, 0x8d, 0x90, 0x47, 0x3f, 0x87, 0x0a, 0x47, 0x32, 0xca, 0xf0, 0x97, 0x48, 0xa6, 0xf9, 0x02,
+	0x57, 0x3b, 0x54, 0xe0, 0xea, 0x7f, 0x55, 0xe1, 0x66, 0x1c, 0x9e, 0x97, 0x59, 0xc4, 0x7f, 0x38,
+	0x4f, 0xae, 0xd5, 0x19, 0x72, 0xcd, 0x41, 0xb2, 0xb4, 0x0c, 0xfb, 0xad, 0x02, 0xb7, 0x8e, 0x85,
+	0x70, 0x49, 0x68, 0xf6, 0x7b, 0x15, 0x2a, 0xbb, 0xcc, 0x23, 0xe6, 0xf0, 0x42, 0xb7, 0x31, 0x21,
+	0x2b, 0xd5, 0xb3, 0x5d, 0xb1, 0x68, 0x8b, 0x87, 0x68, 0xee, 0x28, 0x49, 0x9c, 0x72, 0x94, 0x24,
+	0x17, 0xba, 0xc1, 0x8d, 0xe1, 0x9a, 0x3a, 0x19, 0x57, 0xbd, 0x05, 0xd7, 0xe7, 0x80, 0x92, 0x21,
+	0x8c, 0xca, 0x01, 0xe5, 0xd4, 0x72, 0xe0, 0x2b, 0x15, 0x6a, 0x33, 0xb3, 0x5c, 0x24, 0x5d, 0x2f,
+	0x0c, 0x7a, 0x3c, 0x15, 0x68, 0xc7, 0x9e, 0x2b, 0x89, 0x93, 0x6e, 0x3b, 0x92, 0x0b, 0x06, 0xea,
+	0xcc, 0x9b, 0xa4, 0x0d, 0x6f, 0x1e, 0x09, 0xc8, 0x39, 0xc0, 0xfd, 0x8d, 0x0a, 0xb7, 0x66, 0xe6,
+	0xba, 0x70, 0xce, 0xba, 0x14, 0x84, 0xe7, 0x93, 0x6d, 0xe2, 0xd4, 0xdb, 0x84, 0x2b, 0x03, 0xfb,
+	0x29, 0xd4, 0x8f, 0x07, 0xe8, 0x1c, 0x88, 0xff, 0x41, 0x85, 0x6f, 0xcc, 0x4f, 0x78, 0x91, 0x17,
+	0xfb, 0x4b, 0xc1, 0x7b, 0xf6, 0x6d, 0x3d, 0x71, 0x8e, 0xb7, 0xf5, 0x2b, 0xc3, 0xff, 0x09, 0xdc,
+	0x3c, 0x0e, 0xae, 0x73, 0xa0, 0xff, 0x03, 0xc8, 0x6f, 0x90, 0x9e, 0xed, 0x9c, 0x0f, 0xeb, 0x99,
+	0xff, 0xd3, 0xd4, 0xd9, 0xff, 0xd3, 0xf4, 0x8f, 0xa1, 0x20, 0xa7, 0x96, 0x76, 0xc5, 0x12, 0xa5,
+	0x72, 0x4a, 0xa2, 0xfc, 0x52, 0x81, 0x42, 0x8b, 0xff, 0xed, 0x76, 0xe5, 0x85, 0xc2, 0x0d, 0x48,
+	0x99, 0xcc, 0x1d, 0xda, 0x5d, 0xf9, 0x87, 0xa0, 0x6c, 0xe9, 0x65, 0x28, 0x06, 0x16, 0x08, 0xfb,
+	0xf5, 0x1f, 0x43, 0x09, 0xbb, 0x83, 0xc1, 0xbe, 0xd9, 0xed, 0x5f, 0xb5, 0x55, 0x3a, 0x82, 0x72,
+	0xb4, 0x96, 0x5c, 0xff, 0x73, 0x78, 0x03, 0x13, 0xea, 0x0e, 0x26, 0x24, 0x56, 0x52, 0x9c, 0xcf,
+	0x12, 0x04, 0x09, 0x8b, 0xc9, 0x3f, 0x6f, 0xb2, 0x98, 0x3f, 0xeb, 0x7f, 0x51, 0xa0, 0xb2, 0x45,
+	0x28, 0x35, 0x7b, 0x44, 0x10, 0xec, 0x7c, 0x53, 0x9f, 0x54, 0x33, 0x56, 0x20, 0x29, 0x4e, 0x5e,
+	0xb1, 0xdf, 0x44, 0x03, 0xad, 0x43, 0x36, 0xdc, 0x6c, 0xd5, 0x84, 0xa4, 0xec, 0xe1, 0xbd, 0x96,
+	0x09, 0xf6, 0x9a, 0x6f, 0x7d, 0xec, 0x7e, 0x84, 0x3f, 0xeb, 0xbf, 0x50, 0x60, 0x45, 0x5a, 0xff,
+	0xb0, 0xdb, 0xbf, 0x7c, 0xd3, 0x83, 0x35, 0xb5, 0x68, 0x4d, 0x74, 0x13, 0xb4, 0x20, 0x19, 0xe7,
+	0x9a, 0x79, 0xb9, 0xcb, 0xf6, 0xcc, 0xc1, 0x98, 0x60, 0xbf, 0x43, 0xdf, 0x82, 0x7c, 0x3b, 0x56,
+	0x69, 0xa2, 0x55, 0x50, 0x43, 0x33, 0x66, 0xd5, 0x55, 0xdb, 0x9a, 0xbf, 0xa2, 0x50, 0x0f, 0x5d,
+	0x51, 0xfc, 0x59, 0x81, 0xd5, 0xc8, 0xc5, 0x0b, 0x1f, 0x4c, 0x67, 0xf5, 0xf6, 0x13, 0x28, 0xd9,
+	0x96, 0x71, 0xe8, 0x18, 0xca, 0x35, 0x2b, 0x01, 0x8b, 0xe3, 0xce, 0xe2, 0x82, 0x1d, 0x6b, 0x51,
+	0x7d, 0x15, 0x6a, 0x47, 0x91, 0x57, 0x52, 0xfb, 0xbf, 0x2a, 0xac, 0xec, 0x8e, 0x06, 0x36, 0x93,
+	0x39, 0xea, 0xb2, 0xfd, 0x59, 0xf8, 0x92, 0xee, 0x2d, 0xc8, 0x53, 0xdf, 0x0e, 0x79, 0x0f, 0x27,
+	0x0b, 0x9a, 0x1c, 0x97, 0x89, 0x1b, 0x38, 0x3f, 0x4e, 0x81, 0xca, 0xd8, 0x61, 0x9c, 0x84, 0x1a,
+	0x06, 0xa9, 0x31, 0x76, 0x18, 0xfa, 0x26, 0xbc, 0xee, 0x8c, 0x87, 0x86, 0xe7, 0xbe, 0xa0, 0xc6,
+	0x88, 0x78, 0x06, 0x9f, 0xd9, 0x18, 0x99, 0x1e, 0xe3, 0x29, 0x5e, 0xc3, 0xd7, 0x9c, 0xf1, 0x10,
+	0xbb, 0x2f, 0xe8, 0x0e, 0xf1, 0xf8, 0xe2, 0x3b, 0xa6, 0xc7, 0xd0, 0x77, 0x21, 0x6b, 0x0e, 0x7a,
+	0xae, 0x67, 0xb3, 0x83, 0xa1, 0xbc, 0x78, 0xd3, 0xa5, 0x99, 0x87, 0x90, 0x59, 0x7b, 0x18, 0x68,
+	0xe2, 0x68, 0x10, 0x7a, 0x17, 0xd0, 0x98, 0x12, 0x43, 0x18, 0x27, 0x16, 0x9d, 0x34, 0xe5, 0x2d,
+	0x5c, 0x69, 0x4c, 0x49, 0x34, 0xcd, 0x5e, 0x53, 0xff, 0xbb, 0x06, 0x28, 0x3e, 0xaf, 0xcc, 0xd1,
+	0xdf, 0x86, 0x14, 0x1f, 0x4f, 0xab, 0x0a, 0x8f, 0xed, 0xad, 0x30, 0x43, 0x1d, 0xd2, 0x5d, 0xf3,
+	0xcd, 0xc6, 0x52, 0xbd, 0xf6, 0x39, 0xe4, 0x83, 0x9d, 0xca, 0xdd, 0x89, 0x47, 0x43, 0x39, 0xf1,
+	0x74, 0x55, 0x17, 0x38, 0x5d, 0x6b, 0x0f, 0x20, 0xcb, 0xab, 0xba, 0x53, 0xe7, 0x8e, 0x6a, 0x51,
+	0x35, 0x5e, 0x8b, 0xd6, 0xfe, 0xad, 0x40, 0x82, 0x0f, 0x5e, 0xf8, 0xe5, 0x77, 0x0b, 0x8a, 0xa1,
+	0x95, 0x22, 0x7a, 0x22, 0x69, 0xdf, 0x3d, 0x01, 0x92, 0x38, 0x04, 0x38, 0xdf, 0x8f, 0xb5, 0x50,
+	0x0b, 0x40, 0x7c, 0xc0, 0xc2, 0xa7, 0x12, 0x3c, 0xbc, 0x7d, 0xc2, 0x54, 0xa1, 0xbb, 0x38, 0x4b,
+	0x43, 0xcf, 0x11, 0x24, 0xa8, 0xfd, 0x53, 0x91, 0x25, 0x35, 0xcc, 0x9f, 0xf5, 0x0f, 0xe0, 0xfa,
+	0x63, 0xc2, 0x76, 0xbd, 0x49, 0xb0, 0xdd, 0x82, 0xed, 0x73, 0x02, 0x4c, 0x3a, 0x86, 0x1b, 0xf3,
+	0x83, 0x24, 0x03, 0x3e, 0x82, 0x3c, 0xf5, 0x26, 0xc6, 0xcc, 0x48, 0xbf, 0x2a, 0x09, 0xc3, 0x13,
+	0x1f, 0x94, 0xa3, 0x51, 0x43, 0xff, 0x87, 0x02, 0xc5, 0xbd, 0x8b, 0x1c, 0x1d, 0x73, 0x25, 0x94,
+	0xba, 0x60, 0x09, 0x75, 0x17, 0x92, 0x93, 0x1e, 0x93, 0xb7, 0xba, 0x7e, 0x44, 0x63, 0x5f, 0x26,
+	0xed, 0x3d, 0x66, 0xb6, 0x85, 0x45, 0xbf, 0x5f, 0x18, 0xfd, 0xc8, 0x1e, 0x30, 0xe2, 0x85, 0xa7,
+	0x4c, 0x4c, 0xf3, 0x53, 0xde, 0x83, 0xa5, 0x86, 0xfe, 0x1d, 0x28, 0x85, 0xbe, 0x44, 0x75, 0x15,
+	0x99, 0x10, 0x27, 0xdc, 0x1b, 0x33, 0xc3, 0xf7, 0x36, 0xfd, 0x2e, 0x2c, 0x35, 0xf4, 0xdf, 0xa9,
+	0x70, 0xed, 0xd9, 0xc8, 0x32, 0xd9, 0xb2, 0x9f, 0xa5, 0xe7, 0x2c, 0x5b, 0x57, 0x21, 0xcb, 0xec,
+	0x21, 0xa1, 0xcc, 0x1c, 0x8e, 0x64, 0x56, 0x8b, 0x04, 0x7e, 0x44, 0x38, 0x0e, 0xd5, 0xf4, 0xcc,
+	0x1e, 0xe3, 0x10, 0x75, 0xdc, 0x3e, 0x71, 0xb0, 0xe8, 0xd7, 0xfb, 0x50, 0x99, 0x45, 0x49, 0x42,
+	0xdd, 0x08, 0x26, 0x98, 0xad, 0x60, 0x65, 0xe1, 0xcb, 0x91, 0x16, 0x0a, 0xe8, 0x1d, 0xf0, 0x3f,
+	0xca, 0x19, 0x0f, 0x89, 0x11, 0xd9, 0x23, 0x3e, 0x49, 0x29, 0x09, 0x79, 0x27, 0x10, 0xdf, 0x7b,
+	0x04, 0xa5, 0xb9, 0x4f, 0xa9, 0x50, 0x09, 0x72, 0xcf, 0x9e, 0xee, 0xee, 0x6c, 0xb6, 0xda, 0x9f,
+	0xb6, 0x37, 0x1f, 0x95, 0x5f, 0x43, 0x00, 0xa9, 0xdd, 0xf6, 0xd3, 0xc7, 0x4f, 0x36, 0xcb, 0x0a,
+	0xca, 0x42, 0x72, 0xeb, 0xd9, 0x93, 0x4e, 0xbb, 0xac, 0xfa, 0x8f, 0x9d, 0xe7, 0xdb, 0x3b, 0xad,
+	0xb2, 0x76, 0xef, 0x13, 0xc8, 0x89, 0xba, 0x70, 0xdb, 0xb3, 0x88, 0xe7, 0x0f, 0x78, 0xba, 0x8d,
+	0xb7, 0x1e, 0x3e, 0x29, 0xbf, 0x86, 0xd2, 0xa0, 0xed, 0x60, 0x7f, 0x64, 0x06, 0x12, 0x3b, 0xdb,
+	0xbb, 0x9d, 0xb2, 0x8a, 0x8a, 0x00, 0x0f, 0x9f, 0x75, 0xb6, 0x5b, 0xdb, 0x5b, 0x5b, 0xed, 0x4e,
+	0x59, 0xdb, 0xf8, 0x10, 0x4a, 0xb6, 0xbb, 0x36, 0xb1, 0x19, 0xa1, 0x54, 0x7c, 0x0c, 0xf7, 0xc3,
+	0xb7, 0x65, 0xcb, 0x76, 0xd7, 0xc5, 0xd3, 0x7a, 0xcf, 0x5d, 0x9f, 0xb0, 0x75, 0xde, 0xbb, 0x2e,
+	0x12, 0xc4, 0x7e, 0x8a, 0xb7, 0x3e, 0xf8, 0xff, 0x00, 0x15, 0x2d, 0x8d, 0xbb, 0x8c, 0x27, 0x00,
+	0x00,
 }
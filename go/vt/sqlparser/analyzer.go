@@ -52,6 +52,9 @@ const (
 	StmtOther
 	StmtUnknown
 	StmtComment
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -108,6 +111,12 @@ func Preview(sql string) StatementType {
 		return StmtShow
 	case "use":
 		return StmtUse
+	case "savepoint":
+		return StmtSavepoint
+	case "rollback":
+		return StmtSRollback
+	case "release":
+		return StmtRelease
 	case "analyze", "describe", "desc", "explain", "repair", "optimize":
 		return StmtOther
 	}
@@ -144,6 +153,12 @@ func (s StatementType) String() string {
 		return "USE"
 	case StmtOther:
 		return "OTHER"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
 	default:
 		return "UNKNOWN"
 	}
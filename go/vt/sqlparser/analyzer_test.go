@@ -55,6 +55,9 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback to s1", StmtSRollback},
+		{"savepoint s1", StmtSavepoint},
+		{"release savepoint s1", StmtRelease},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -291,8 +291,12 @@ func (*Use) iStatement()        {}
 func (*Begin) iStatement()      {}
 func (*Commit) iStatement()     {}
 func (*Rollback) iStatement()   {}
+func (*SRollback) iStatement()  {}
+func (*Savepoint) iStatement()  {}
+func (*Release) iStatement()    {}
 func (*OtherRead) iStatement()  {}
 func (*OtherAdmin) iStatement() {}
+func (*Explain) iStatement()    {}
 
 // ParenSelect can actually not be a top level statement,
 // but we have to allow it because it's a requirement
@@ -306,6 +310,7 @@ type SelectStatement interface {
 	iInsertRows()
 	AddOrder(*Order)
 	SetLimit(*Limit)
+	SetWith(*With)
 	SQLNode
 }
 
@@ -315,6 +320,7 @@ func (*ParenSelect) iSelectStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Cache       string
 	Comments    Comments
 	Distinct    string
@@ -357,10 +363,15 @@ func (node *Select) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
 
+// SetWith sets the with clause
+func (node *Select) SetWith(with *With) {
+	node.With = with
+}
+
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
 		node.Limit, node.Lock)
@@ -372,6 +383,7 @@ func (node *Select) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
@@ -442,6 +454,11 @@ func (node *ParenSelect) SetLimit(limit *Limit) {
 	panic("unreachable")
 }
 
+// SetWith sets the with clause
+func (node *ParenSelect) SetWith(with *With) {
+	panic("unreachable")
+}
+
 // Format formats the node.
 func (node *ParenSelect) Format(buf *TrackedBuffer) {
 	buf.Myprintf("(%v)", node.Select)
@@ -459,6 +476,7 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 
 // Union represents a UNION statement.
 type Union struct {
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -483,9 +501,14 @@ func (node *Union) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
 
+// SetWith sets the with clause
+func (node *Union) SetWith(with *With) {
+	node.With = with
+}
+
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -495,11 +518,86 @@ func (node *Union) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
 }
 
+// With represents a WITH clause.
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf("with ")
+	if node.Recursive {
+		buf.Myprintf("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.Myprintf(" ")
+}
+
+func (node *With) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if err := Walk(visit, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find returns the common table expression of the
+// specified name, or nil if there's none.
+func (node *With) Find(name TableIdent) *CommonTableExpr {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if cte.Name == name {
+			return cte
+		}
+	}
+	return nil
+}
+
+// CommonTableExpr represents a common table expression
+// of a WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+func (node *CommonTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Columns,
+		node.Subquery,
+	)
+}
+
 // Stream represents a SELECT statement.
 type Stream struct {
 	Comments   Comments
@@ -1678,6 +1776,57 @@ func (node *Rollback) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// SRollback represents a ROLLBACK TO SAVEPOINT statement.
+type SRollback struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+func (node *SRollback) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// Savepoint represents a SAVEPOINT statement.
+type Savepoint struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+func (node *Savepoint) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
+// Release represents a RELEASE SAVEPOINT statement.
+type Release struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
+func (node *Release) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
 // OtherRead represents a DESCRIBE, or EXPLAIN statement.
 // It should be used only as an indicator. It does not contain
 // the full AST for the statement.
@@ -1692,6 +1841,38 @@ func (node *OtherRead) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// Explain represents an EXPLAIN statement that has an explicit
+// format. Other EXPLAIN statements are parsed as OtherRead.
+type Explain struct {
+	Type      string
+	Analyze   bool
+	Statement Statement
+}
+
+// Explain.Type
+const (
+	ExplainJSONStr        = "json"
+	ExplainTreeStr        = "tree"
+	ExplainTraditionalStr = "traditional"
+	ExplainVitessStr      = "vitess"
+)
+
+// Format formats the node.
+func (node *Explain) Format(buf *TrackedBuffer) {
+	analyze := ""
+	if node.Analyze {
+		analyze = "analyze "
+	}
+	buf.Myprintf("explain %sformat = %s %v", analyze, node.Type, node.Statement)
+}
+
+func (node *Explain) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Statement)
+}
+
 // OtherAdmin represents a misc statement that relies on ADMIN privileges,
 // such as REPAIR, OPTIMIZE, or TRUNCATE statement.
 // It should be used only as an indicator. It does not contain
@@ -2997,6 +3178,7 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	Over      *OverClause
 }
 
 // Format formats the node.
@@ -3012,6 +3194,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// if they match a reserved word. So, print the
 	// name as is.
 	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	if node.Over != nil {
+		buf.Myprintf(" %v", node.Over)
+	}
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3023,6 +3208,7 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -3036,6 +3222,18 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 			return true
 		}
 	}
+	if node.Over != nil {
+		for i := range node.Over.PartitionBy {
+			if replaceExprs(from, to, &node.Over.PartitionBy[i]) {
+				return true
+			}
+		}
+		for _, order := range node.Over.OrderBy {
+			if replaceExprs(from, to, &order.Expr) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -3060,8 +3258,121 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate function with an OVER clause is a window function.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function has an OVER clause.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
+}
+
+// OverClause represents the window specification of a window function.
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	buf.WriteString("over (")
+	var prefix string
+	if len(node.PartitionBy) != 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+		prefix = " "
+	}
+	for i, order := range node.OrderBy {
+		if i == 0 {
+			buf.Myprintf("%sorder by %v", prefix, order)
+		} else {
+			buf.Myprintf(", %v", order)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", prefix, node.Frame)
+	}
+	buf.WriteString(")")
+}
+
+func (node *OverClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
+		node.Frame,
+	)
+}
+
+// FrameClause represents the frame of a window: the rows of the
+// partition a window function is computed on. End is nil if the
+// frame has no BETWEEN.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameClause.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *FrameClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Start,
+		node.End,
+	)
+}
+
+// FramePoint represents the start or the end of a window frame.
+// Expr is only set for PrecedingStr and FollowingStr.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// FramePoint.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	PrecedingStr          = "preceding"
+	CurrentRowStr         = "current row"
+	FollowingStr          = "following"
+	UnboundedFollowingStr = "unbounded following"
+)
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.Myprintf("%v ", node.Expr)
+	}
+	buf.WriteString(node.Type)
+}
+
+func (node *FramePoint) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Expr)
 }
 
 // GroupConcatExpr represents a call to GROUP_CONCAT
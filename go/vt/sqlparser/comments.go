@@ -32,6 +32,10 @@ const (
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
+	// DirectiveAllowHashJoin allows cross-shard joins to be executed as hash joins.
+	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
+	// DirectiveHashJoinMaxRows sets the max number of rows a hash join can hold in memory.
+	DirectiveHashJoinMaxRows = "HASH_JOIN_MAX_ROWS"
 )
 
 func isNonSpace(r rune) bool {
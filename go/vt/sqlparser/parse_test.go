@@ -1327,6 +1327,50 @@ var (
 	}, {
 		input:  "explain foobar",
 		output: "otherread",
+	}, {
+		input:  "explain foobar col",
+		output: "otherread",
+	}, {
+		input:  "explain select * from t",
+		output: "otherread",
+	}, {
+		input:  "explain (select 1 from t)",
+		output: "otherread",
+	}, {
+		input:  "explain insert into t values (1)",
+		output: "otherread",
+	}, {
+		input:  "explain for connection 10",
+		output: "otherread",
+	}, {
+		input:  "explain analyze select * from t",
+		output: "otherread",
+	}, {
+		input:  "explain format",
+		output: "otherread",
+	}, {
+		input: "explain format = vitess select * from t",
+	}, {
+		input: "explain format = json select * from t",
+	}, {
+		input: "explain format = tree select * from t",
+	}, {
+		input: "explain format = traditional select * from t",
+	}, {
+		input:  "EXPLAIN FORMAT=VITESS SELECT * FROM t",
+		output: "explain format = vitess select * from t",
+	}, {
+		input: "explain format = vitess insert into t(a) values (1)",
+	}, {
+		input: "explain format = vitess update t set a = 1",
+	}, {
+		input: "explain format = vitess delete from t where a = 1",
+	}, {
+		input: "explain format = vitess select a from t union select b from u",
+	}, {
+		input: "explain format = vitess with cte as (select a from t) select a from cte",
+	}, {
+		input: "explain analyze format = vitess select * from t",
 	}, {
 		input:  "truncate table foo",
 		output: "truncate table foo",
@@ -1418,6 +1462,36 @@ var (
 		input: "select name, group_concat(score) from t group by name",
 	}, {
 		input: "select name, group_concat(distinct id, score order by id desc separator ':') from t group by name",
+	}, {
+		input: "select id, row_number() over () from t",
+	}, {
+		input: "select id, rank() over (partition by a, b order by c asc) from t",
+	}, {
+		input:  "select id, lag(val, 1, 0) over (order by id) from t",
+		output: "select id, lag(val, 1, 0) over (order by id asc) from t",
+	}, {
+		input: "select sum(val) over (partition by a order by id asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select avg(val) over (order by id asc rows between 2 preceding and 2 following) from t",
+	}, {
+		input: "select count(*) over (partition by a range unbounded preceding) from t",
+	}, {
+		input: "select first_value(val) over (order by d asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input: "with cte as (select a from t) select * from cte",
+	}, {
+		input:  "WITH RECURSIVE cte (n) AS (SELECT 1 FROM dual UNION ALL SELECT n + 1 FROM cte WHERE n < 5) SELECT n FROM cte",
+		output: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select n from cte",
+	}, {
+		input: "with a as (select 1 from t), b(x, y) as (select * from a) select * from a join b",
+	}, {
+		input: "with cte as (select a from t) select a from cte union select a from t2 order by a asc",
+	}, {
+		input: "select * from (with cte as (select 1 from t) select * from cte) as x",
+	}, {
+		input: "select 1 from t where a in (with cte as (select a from t) select a from cte)",
+	}, {
+		input: "insert into t with cte as (select 1 from t2) select * from cte",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1449,6 +1523,20 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint abc",
+	}, {
+		input: "savepoint `ab c`",
+	}, {
+		input: "rollback to abc",
+	}, {
+		input:  "rollback to savepoint abc",
+		output: "rollback to abc",
+	}, {
+		input:  "rollback to savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input: "release savepoint abc",
 	}, {
 		input: "create database test_db",
 	}, {
@@ -2387,6 +2475,21 @@ var (
 	}{{
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
+	}, {
+		input:  "select sum(a) over (rows between 1 preceding) from t",
+		output: "syntax error at position 46",
+	}, {
+		input:  "with cte as select 1 from t select * from cte",
+		output: "syntax error at position 19 near 'select'",
+	}, {
+		input:  "select 1 from t union with cte as (select 1 from t) select * from cte",
+		output: "syntax error at position 27 near 'with'",
+	}, {
+		input:  "explain analyze format = vitess insert into t values (1)",
+		output: "syntax error at position 39 near 'insert'",
+	}, {
+		input:  "explain format = yaml select * from t",
+		output: "syntax error at position 22 near 'yaml'",
 	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
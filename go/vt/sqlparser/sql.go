@@ -105,6 +105,12 @@ type yySymType struct {
 	vindexParams         []VindexParam
 	showFilter           *ShowFilter
 	optLike              *OptLike
+	overClause           *OverClause
+	frameClause          *FrameClause
+	framePoint           *FramePoint
+	with                 *With
+	ctes                 []*CommonTableExpr
+	cte                  *CommonTableExpr
 }
 
 const LEX_ERROR = 57346
@@ -255,184 +261,194 @@ const START = 57490
 const TRANSACTION = 57491
 const COMMIT = 57492
 const ROLLBACK = 57493
-const BIT = 57494
-const TINYINT = 57495
-const SMALLINT = 57496
-const MEDIUMINT = 57497
-const INT = 57498
-const INTEGER = 57499
-const BIGINT = 57500
-const INTNUM = 57501
-const REAL = 57502
-const DOUBLE = 57503
-const FLOAT_TYPE = 57504
-const DECIMAL = 57505
-const NUMERIC = 57506
-const TIME = 57507
-const TIMESTAMP = 57508
-const DATETIME = 57509
-const YEAR = 57510
-const CHAR = 57511
-const VARCHAR = 57512
-const BOOL = 57513
-const CHARACTER = 57514
-const VARBINARY = 57515
-const NCHAR = 57516
-const TEXT = 57517
-const TINYTEXT = 57518
-const MEDIUMTEXT = 57519
-const LONGTEXT = 57520
-const BLOB = 57521
-const TINYBLOB = 57522
-const MEDIUMBLOB = 57523
-const LONGBLOB = 57524
-const JSON = 57525
-const ENUM = 57526
-const GEOMETRY = 57527
-const POINT = 57528
-const LINESTRING = 57529
-const POLYGON = 57530
-const GEOMETRYCOLLECTION = 57531
-const MULTIPOINT = 57532
-const MULTILINESTRING = 57533
-const MULTIPOLYGON = 57534
-const NULLX = 57535
-const AUTO_INCREMENT = 57536
-const APPROXNUM = 57537
-const SIGNED = 57538
-const UNSIGNED = 57539
-const ZEROFILL = 57540
-const COLLATION = 57541
-const DATABASES = 57542
-const TABLES = 57543
-const VITESS_METADATA = 57544
-const VSCHEMA = 57545
-const FULL = 57546
-const PROCESSLIST = 57547
-const COLUMNS = 57548
-const FIELDS = 57549
-const ENGINES = 57550
-const PLUGINS = 57551
-const NAMES = 57552
-const CHARSET = 57553
-const GLOBAL = 57554
-const SESSION = 57555
-const ISOLATION = 57556
-const LEVEL = 57557
-const READ = 57558
-const WRITE = 57559
-const ONLY = 57560
-const REPEATABLE = 57561
-const COMMITTED = 57562
-const UNCOMMITTED = 57563
-const SERIALIZABLE = 57564
-const CURRENT_TIMESTAMP = 57565
-const DATABASE = 57566
-const CURRENT_DATE = 57567
-const CURRENT_TIME = 57568
-const LOCALTIME = 57569
-const LOCALTIMESTAMP = 57570
-const UTC_DATE = 57571
-const UTC_TIME = 57572
-const UTC_TIMESTAMP = 57573
-const REPLACE = 57574
-const CONVERT = 57575
-const CAST = 57576
-const SUBSTR = 57577
-const SUBSTRING = 57578
-const GROUP_CONCAT = 57579
-const SEPARATOR = 57580
-const TIMESTAMPADD = 57581
-const TIMESTAMPDIFF = 57582
-const MATCH = 57583
-const AGAINST = 57584
-const BOOLEAN = 57585
-const LANGUAGE = 57586
-const WITH = 57587
-const QUERY = 57588
-const EXPANSION = 57589
-const UNUSED = 57590
-const ARRAY = 57591
-const CUME_DIST = 57592
-const DESCRIPTION = 57593
-const DENSE_RANK = 57594
-const EMPTY = 57595
-const EXCEPT = 57596
-const FIRST_VALUE = 57597
-const GROUPING = 57598
-const GROUPS = 57599
-const JSON_TABLE = 57600
-const LAG = 57601
-const LAST_VALUE = 57602
-const LATERAL = 57603
-const LEAD = 57604
-const MEMBER = 57605
-const NTH_VALUE = 57606
-const NTILE = 57607
-const OF = 57608
-const OVER = 57609
-const PERCENT_RANK = 57610
-const RANK = 57611
-const RECURSIVE = 57612
-const ROW_NUMBER = 57613
-const SYSTEM = 57614
-const WINDOW = 57615
-const ACTIVE = 57616
-const ADMIN = 57617
-const BUCKETS = 57618
-const CLONE = 57619
-const COMPONENT = 57620
-const DEFINITION = 57621
-const ENFORCED = 57622
-const EXCLUDE = 57623
-const FOLLOWING = 57624
-const GEOMCOLLECTION = 57625
-const GET_MASTER_PUBLIC_KEY = 57626
-const HISTOGRAM = 57627
-const HISTORY = 57628
-const INACTIVE = 57629
-const INVISIBLE = 57630
-const LOCKED = 57631
-const MASTER_COMPRESSION_ALGORITHMS = 57632
-const MASTER_PUBLIC_KEY_PATH = 57633
-const MASTER_TLS_CIPHERSUITES = 57634
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57635
-const NESTED = 57636
-const NETWORK_NAMESPACE = 57637
-const NOWAIT = 57638
-const NULLS = 57639
-const OJ = 57640
-const OLD = 57641
-const OPTIONAL = 57642
-const ORDINALITY = 57643
-const ORGANIZATION = 57644
-const OTHERS = 57645
-const PATH = 57646
-const PERSIST = 57647
-const PERSIST_ONLY = 57648
-const PRECEDING = 57649
-const PRIVILEGE_CHECKS_USER = 57650
-const PROCESS = 57651
-const RANDOM = 57652
-const REFERENCE = 57653
-const REQUIRE_ROW_FORMAT = 57654
-const RESOURCE = 57655
-const RESPECT = 57656
-const RESTART = 57657
-const RETAIN = 57658
-const REUSE = 57659
-const ROLE = 57660
-const SECONDARY = 57661
-const SECONDARY_ENGINE = 57662
-const SECONDARY_LOAD = 57663
-const SECONDARY_UNLOAD = 57664
-const SKIP = 57665
-const SRID = 57666
-const THREAD_PRIORITY = 57667
-const TIES = 57668
-const UNBOUNDED = 57669
-const VCPU = 57670
-const VISIBLE = 57671
+const SAVEPOINT = 57494
+const RELEASE = 57495
+const BIT = 57496
+const TINYINT = 57497
+const SMALLINT = 57498
+const MEDIUMINT = 57499
+const INT = 57500
+const INTEGER = 57501
+const BIGINT = 57502
+const INTNUM = 57503
+const REAL = 57504
+const DOUBLE = 57505
+const FLOAT_TYPE = 57506
+const DECIMAL = 57507
+const NUMERIC = 57508
+const TIME = 57509
+const TIMESTAMP = 57510
+const DATETIME = 57511
+const YEAR = 57512
+const CHAR = 57513
+const VARCHAR = 57514
+const BOOL = 57515
+const CHARACTER = 57516
+const VARBINARY = 57517
+const NCHAR = 57518
+const TEXT = 57519
+const TINYTEXT = 57520
+const MEDIUMTEXT = 57521
+const LONGTEXT = 57522
+const BLOB = 57523
+const TINYBLOB = 57524
+const MEDIUMBLOB = 57525
+const LONGBLOB = 57526
+const JSON = 57527
+const ENUM = 57528
+const GEOMETRY = 57529
+const POINT = 57530
+const LINESTRING = 57531
+const POLYGON = 57532
+const GEOMETRYCOLLECTION = 57533
+const MULTIPOINT = 57534
+const MULTILINESTRING = 57535
+const MULTIPOLYGON = 57536
+const NULLX = 57537
+const AUTO_INCREMENT = 57538
+const APPROXNUM = 57539
+const SIGNED = 57540
+const UNSIGNED = 57541
+const ZEROFILL = 57542
+const COLLATION = 57543
+const DATABASES = 57544
+const TABLES = 57545
+const VITESS_METADATA = 57546
+const VSCHEMA = 57547
+const FULL = 57548
+const PROCESSLIST = 57549
+const COLUMNS = 57550
+const FIELDS = 57551
+const ENGINES = 57552
+const PLUGINS = 57553
+const NAMES = 57554
+const CHARSET = 57555
+const GLOBAL = 57556
+const SESSION = 57557
+const ISOLATION = 57558
+const LEVEL = 57559
+const READ = 57560
+const WRITE = 57561
+const ONLY = 57562
+const REPEATABLE = 57563
+const COMMITTED = 57564
+const UNCOMMITTED = 57565
+const SERIALIZABLE = 57566
+const CURRENT_TIMESTAMP = 57567
+const DATABASE = 57568
+const CURRENT_DATE = 57569
+const CURRENT_TIME = 57570
+const LOCALTIME = 57571
+const LOCALTIMESTAMP = 57572
+const UTC_DATE = 57573
+const UTC_TIME = 57574
+const UTC_TIMESTAMP = 57575
+const REPLACE = 57576
+const CONVERT = 57577
+const CAST = 57578
+const SUBSTR = 57579
+const SUBSTRING = 57580
+const GROUP_CONCAT = 57581
+const SEPARATOR = 57582
+const TIMESTAMPADD = 57583
+const TIMESTAMPDIFF = 57584
+const MATCH = 57585
+const AGAINST = 57586
+const BOOLEAN = 57587
+const LANGUAGE = 57588
+const WITH = 57589
+const QUERY = 57590
+const EXPANSION = 57591
+const OVER = 57592
+const ROWS = 57593
+const RANGE = 57594
+const CURRENT = 57595
+const ROW = 57596
+const RECURSIVE = 57597
+const FORMAT = 57598
+const TREE = 57599
+const TRADITIONAL = 57600
+const VITESS = 57601
+const UNUSED = 57602
+const ARRAY = 57603
+const CUME_DIST = 57604
+const DESCRIPTION = 57605
+const DENSE_RANK = 57606
+const EMPTY = 57607
+const EXCEPT = 57608
+const FIRST_VALUE = 57609
+const GROUPING = 57610
+const GROUPS = 57611
+const JSON_TABLE = 57612
+const LAG = 57613
+const LAST_VALUE = 57614
+const LATERAL = 57615
+const LEAD = 57616
+const MEMBER = 57617
+const NTH_VALUE = 57618
+const NTILE = 57619
+const OF = 57620
+const PERCENT_RANK = 57621
+const RANK = 57622
+const ROW_NUMBER = 57623
+const SYSTEM = 57624
+const WINDOW = 57625
+const ACTIVE = 57626
+const ADMIN = 57627
+const BUCKETS = 57628
+const CLONE = 57629
+const COMPONENT = 57630
+const DEFINITION = 57631
+const ENFORCED = 57632
+const EXCLUDE = 57633
+const FOLLOWING = 57634
+const GEOMCOLLECTION = 57635
+const GET_MASTER_PUBLIC_KEY = 57636
+const HISTOGRAM = 57637
+const HISTORY = 57638
+const INACTIVE = 57639
+const INVISIBLE = 57640
+const LOCKED = 57641
+const MASTER_COMPRESSION_ALGORITHMS = 57642
+const MASTER_PUBLIC_KEY_PATH = 57643
+const MASTER_TLS_CIPHERSUITES = 57644
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57645
+const NESTED = 57646
+const NETWORK_NAMESPACE = 57647
+const NOWAIT = 57648
+const NULLS = 57649
+const OJ = 57650
+const OLD = 57651
+const OPTIONAL = 57652
+const ORDINALITY = 57653
+const ORGANIZATION = 57654
+const OTHERS = 57655
+const PATH = 57656
+const PERSIST = 57657
+const PERSIST_ONLY = 57658
+const PRECEDING = 57659
+const PRIVILEGE_CHECKS_USER = 57660
+const PROCESS = 57661
+const RANDOM = 57662
+const REFERENCE = 57663
+const REQUIRE_ROW_FORMAT = 57664
+const RESOURCE = 57665
+const RESPECT = 57666
+const RESTART = 57667
+const RETAIN = 57668
+const REUSE = 57669
+const ROLE = 57670
+const SECONDARY = 57671
+const SECONDARY_ENGINE = 57672
+const SECONDARY_LOAD = 57673
+const SECONDARY_UNLOAD = 57674
+const SKIP = 57675
+const SRID = 57676
+const THREAD_PRIORITY = 57677
+const TIES = 57678
+const UNBOUNDED = 57679
+const VCPU = 57680
+const VISIBLE = 57681
 
 var yyToknames = [...]string{
 	"$end",
@@ -603,6 +619,8 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -699,6 +717,16 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"OVER",
+	"ROWS",
+	"RANGE",
+	"CURRENT",
+	"ROW",
+	"RECURSIVE",
+	"FORMAT",
+	"TREE",
+	"TRADITIONAL",
+	"VITESS",
 	"UNUSED",
 	"ARRAY",
 	"CUME_DIST",
@@ -718,10 +746,8 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
-	"RECURSIVE",
 	"ROW_NUMBER",
 	"SYSTEM",
 	"WINDOW",
@@ -794,1757 +820,1959 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 3,
-	5, 29,
-	-2, 4,
-	-1, 37,
-	160, 300,
-	161, 300,
-	-2, 288,
-	-1, 320,
-	112, 640,
-	-2, 636,
-	-1, 321,
-	112, 641,
-	-2, 637,
-	-1, 389,
-	82, 888,
-	-2, 63,
-	-1, 390,
-	82, 806,
-	-2, 64,
-	-1, 395,
-	82, 775,
-	-2, 602,
-	-1, 397,
-	82, 836,
-	-2, 604,
-	-1, 689,
-	1, 352,
-	5, 352,
-	12, 352,
-	13, 352,
-	14, 352,
-	15, 352,
-	17, 352,
-	19, 352,
-	30, 352,
-	31, 352,
-	42, 352,
-	43, 352,
-	44, 352,
-	45, 352,
-	46, 352,
-	48, 352,
-	49, 352,
-	52, 352,
-	53, 352,
-	55, 352,
-	56, 352,
-	347, 352,
-	-2, 370,
-	-1, 692,
-	53, 44,
-	55, 44,
-	-2, 48,
-	-1, 840,
-	112, 643,
-	-2, 639,
-	-1, 1069,
-	5, 30,
-	-2, 437,
-	-1, 1099,
-	5, 29,
-	-2, 576,
-	-1, 1344,
-	5, 30,
-	-2, 577,
-	-1, 1397,
-	5, 29,
-	-2, 579,
-	-1, 1475,
-	5, 30,
-	-2, 580,
+	-1, 25,
+	5, 41,
+	-2, 27,
+	-1, 39,
+	160, 312,
+	161, 312,
+	-2, 300,
+	-1, 65,
+	5, 41,
+	-2, 28,
+	-1, 457,
+	112, 683,
+	-2, 679,
+	-1, 458,
+	112, 684,
+	-2, 680,
+	-1, 527,
+	82, 939,
+	-2, 75,
+	-1, 528,
+	82, 855,
+	-2, 76,
+	-1, 531,
+	82, 822,
+	-2, 645,
+	-1, 533,
+	82, 885,
+	-2, 647,
+	-1, 720,
+	1, 379,
+	5, 379,
+	12, 379,
+	13, 379,
+	14, 379,
+	15, 379,
+	17, 379,
+	19, 379,
+	30, 379,
+	31, 379,
+	42, 379,
+	43, 379,
+	44, 379,
+	45, 379,
+	46, 379,
+	48, 379,
+	49, 379,
+	52, 379,
+	53, 379,
+	55, 379,
+	56, 379,
+	357, 379,
+	-2, 397,
+	-1, 723,
+	53, 56,
+	55, 56,
+	-2, 60,
+	-1, 876,
+	112, 686,
+	-2, 682,
+	-1, 1115,
+	5, 42,
+	-2, 464,
+	-1, 1403,
+	5, 42,
+	-2, 620,
+	-1, 1540,
+	5, 42,
+	-2, 623,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16596
+const yyLast = 18643
 
 var yyAct = [...]int{
 
-	321, 1509, 1499, 1306, 1463, 1102, 1194, 645, 1377, 1364,
-	953, 1120, 1280, 351, 1246, 1409, 338, 926, 299, 982,
-	546, 1243, 1247, 996, 1103, 325, 924, 57, 1033, 1147,
-	962, 952, 81, 1253, 1218, 1259, 264, 865, 789, 264,
-	1061, 872, 803, 1126, 1173, 875, 686, 1164, 966, 705,
-	842, 992, 394, 290, 928, 644, 3, 913, 893, 577,
-	388, 352, 51, 704, 583, 517, 589, 264, 81, 597,
-	323, 685, 264, 383, 264, 385, 694, 659, 906, 949,
-	56, 308, 1502, 1486, 660, 1497, 1473, 1494, 380, 1307,
-	1485, 1015, 691, 1472, 1235, 1336, 61, 535, 291, 292,
-	293, 294, 522, 1274, 297, 1014, 943, 298, 1135, 312,
-	1155, 1134, 550, 51, 1136, 571, 976, 259, 255, 256,
-	257, 304, 63, 64, 65, 66, 67, 296, 261, 1275,
-	1276, 944, 945, 1019, 706, 566, 707, 548, 295, 567,
-	564, 565, 1013, 1438, 610, 609, 619, 620, 612, 613,
-	614, 615, 616, 617, 618, 611, 975, 1196, 621, 382,
-	1367, 251, 983, 253, 519, 363, 521, 369, 370, 367,
-	368, 366, 365, 364, 570, 1384, 1327, 1325, 552, 289,
-	554, 371, 372, 778, 559, 560, 569, 777, 1198, 775,
-	1496, 1493, 1010, 1007, 1008, 1464, 1006, 1193, 907, 1456,
-	967, 1517, 1410, 536, 253, 391, 1219, 1513, 524, 1199,
-	1197, 551, 553, 1121, 1123, 1412, 782, 768, 1269, 1418,
-	1190, 1268, 776, 779, 1267, 520, 1192, 969, 1017, 1020,
-	527, 969, 266, 254, 1027, 1445, 258, 1026, 532, 1347,
-	1078, 633, 634, 1205, 1221, 1131, 1088, 1055, 814, 1075,
-	700, 601, 264, 542, 950, 264, 252, 1292, 621, 611,
-	1148, 264, 621, 939, 811, 1012, 808, 264, 596, 518,
-	81, 1454, 81, 804, 81, 81, 1427, 81, 1223, 81,
-	1227, 969, 1222, 1411, 1220, 81, 1257, 1011, 708, 1225,
-	1122, 1237, 555, 894, 556, 557, 549, 558, 1224, 561,
-	594, 529, 516, 530, 1471, 572, 531, 983, 1293, 70,
-	518, 1226, 1228, 1439, 1511, 81, 596, 1512, 770, 1510,
-	1419, 1417, 1191, 968, 1189, 1035, 1016, 968, 1153, 318,
-	585, 547, 894, 547, 1085, 547, 547, 1459, 547, 591,
-	547, 1018, 633, 634, 528, 71, 547, 534, 849, 573,
-	574, 633, 634, 541, 805, 538, 539, 540, 1477, 543,
-	586, 972, 847, 848, 846, 1373, 51, 973, 614, 615,
-	616, 617, 618, 611, 1518, 1181, 621, 968, 264, 264,
-	264, 630, 965, 963, 632, 964, 1372, 81, 523, 595,
-	594, 961, 967, 81, 595, 594, 1239, 1052, 1053, 1054,
-	54, 1168, 587, 1034, 1179, 684, 596, 22, 817, 818,
-	845, 596, 643, 1519, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 1167, 658, 661, 661, 661, 667, 661,
-	661, 667, 661, 675, 676, 677, 678, 679, 680, 866,
-	690, 867, 662, 664, 666, 668, 670, 672, 673, 663,
-	665, 1156, 669, 671, 1479, 674, 595, 594, 698, 1137,
-	250, 1138, 702, 1455, 813, 525, 526, 303, 693, 1391,
-	683, 1180, 692, 596, 1370, 1202, 1185, 1182, 1175, 1183,
-	1178, 1074, 1174, 1165, 1038, 1176, 1177, 1415, 1495, 350,
-	612, 613, 614, 615, 616, 617, 618, 611, 1452, 1184,
-	621, 812, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 1309, 1148, 621, 264, 595, 594,
-	1143, 79, 81, 868, 391, 377, 378, 264, 264, 81,
-	788, 595, 594, 264, 787, 596, 264, 1481, 576, 264,
-	1415, 1467, 767, 264, 771, 81, 81, 769, 596, 774,
-	81, 81, 81, 264, 81, 81, 1062, 393, 1415, 576,
-	81, 81, 832, 834, 835, 792, 793, 1073, 833, 1072,
-	794, 795, 796, 766, 798, 799, 1415, 1446, 1415, 1414,
-	800, 801, 544, 547, 1362, 1361, 595, 594, 537, 81,
-	547, 1349, 576, 264, 576, 791, 1346, 576, 1424, 81,
-	1299, 1298, 1423, 596, 819, 1244, 547, 547, 1256, 716,
-	1289, 547, 547, 547, 970, 547, 547, 1256, 843, 772,
-	773, 547, 547, 898, 783, 780, 1295, 1296, 382, 1195,
-	877, 786, 341, 340, 343, 344, 345, 346, 1295, 1294,
-	838, 342, 347, 81, 1127, 797, 915, 918, 919, 920,
-	916, 1342, 917, 921, 840, 1127, 1260, 1261, 635, 636,
-	637, 638, 639, 640, 641, 642, 1067, 576, 836, 821,
-	910, 576, 877, 576, 715, 714, 81, 81, 884, 887,
-	1208, 1426, 909, 264, 895, 828, 910, 910, 54, 576,
-	24, 264, 264, 24, 51, 264, 264, 1297, 1256, 264,
-	264, 264, 81, 879, 869, 870, 58, 910, 933, 647,
-	695, 1139, 942, 696, 1097, 81, 1091, 24, 1098, 1090,
-	903, 934, 891, 1067, 1067, 936, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 54, 839,
-	621, 54, 1504, 695, 701, 1396, 1067, 815, 781, 984,
-	985, 986, 925, 791, 696, 697, 690, 699, 1487, 393,
-	690, 393, 940, 393, 393, 54, 393, 941, 393, 264,
-	81, 932, 81, 937, 393, 908, 264, 264, 264, 264,
-	264, 957, 264, 264, 305, 1379, 264, 81, 935, 998,
-	1002, 977, 1004, 1354, 997, 1285, 697, 1380, 695, 1260,
-	1261, 1500, 1142, 264, 599, 264, 264, 1031, 993, 988,
-	264, 987, 1000, 1287, 994, 995, 609, 619, 620, 612,
-	613, 614, 615, 616, 617, 618, 611, 314, 1263, 621,
-	827, 547, 54, 547, 1244, 1169, 809, 785, 1266, 391,
-	1114, 978, 979, 980, 981, 1115, 1112, 1116, 547, 919,
-	920, 1113, 954, 1043, 1265, 1111, 1110, 989, 990, 991,
-	843, 1001, 309, 310, 1491, 1484, 1204, 840, 1021, 1022,
-	1023, 1024, 1025, 1040, 1028, 1029, 393, 1489, 1030, 1045,
-	1044, 1050, 710, 590, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 1049, 1032, 621, 1057, 588, 1160,
-	713, 1056, 1039, 545, 578, 1152, 327, 1461, 1460, 1394,
-	1150, 264, 264, 264, 264, 264, 579, 1144, 874, 1340,
-	1375, 1003, 575, 264, 784, 923, 264, 306, 307, 590,
-	264, 300, 1432, 841, 264, 1104, 850, 851, 852, 853,
-	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
-	864, 1084, 839, 81, 301, 58, 1048, 1431, 1106, 1107,
-	1128, 1109, 1099, 1140, 1047, 1382, 1127, 568, 1100, 1101,
-	1079, 1076, 690, 690, 690, 690, 690, 802, 1129, 1117,
-	1130, 879, 1105, 592, 1125, 1108, 1506, 925, 1442, 1124,
-	1368, 899, 1132, 1506, 1505, 690, 1149, 810, 1157, 1158,
-	60, 81, 81, 915, 918, 919, 920, 916, 62, 917,
-	921, 393, 55, 1145, 1146, 1, 1498, 1308, 393, 1376,
-	1009, 1462, 1171, 1408, 1279, 960, 951, 69, 515, 68,
-	1453, 81, 959, 958, 393, 393, 1166, 1416, 1366, 393,
-	393, 393, 971, 393, 393, 1154, 974, 264, 1286, 393,
-	393, 1200, 1186, 1151, 1458, 721, 81, 719, 720, 1172,
-	718, 723, 1159, 547, 1161, 1162, 1163, 722, 717, 277,
-	386, 922, 709, 999, 593, 1201, 72, 1188, 823, 1187,
-	1005, 807, 562, 563, 279, 629, 1046, 1133, 599, 392,
-	954, 393, 547, 1251, 816, 582, 1430, 1381, 1211, 1212,
-	1083, 81, 81, 1245, 656, 892, 326, 831, 339, 1230,
-	1236, 1229, 1248, 1217, 336, 337, 822, 1096, 603, 324,
-	316, 688, 681, 1104, 914, 81, 912, 1043, 580, 584,
-	911, 381, 871, 1262, 1258, 687, 1207, 1335, 1437, 1206,
-	81, 840, 81, 81, 1264, 602, 1255, 826, 896, 26,
-	59, 311, 1278, 19, 1271, 18, 1250, 17, 1270, 20,
-	1249, 16, 51, 15, 14, 900, 901, 533, 30, 21,
-	264, 1277, 1273, 1339, 1282, 1283, 1284, 1058, 1059, 1060,
-	646, 13, 12, 11, 10, 9, 8, 7, 264, 657,
-	6, 393, 5, 1210, 81, 4, 302, 81, 81, 81,
-	264, 23, 2, 0, 393, 0, 81, 0, 0, 264,
-	820, 610, 609, 619, 620, 612, 613, 614, 615, 616,
-	617, 618, 611, 0, 0, 621, 1240, 1301, 0, 631,
-	1314, 0, 0, 0, 0, 1316, 0, 1290, 1291, 0,
-	1302, 0, 1304, 0, 0, 0, 0, 1323, 0, 0,
-	0, 1315, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 393, 1300, 0, 0, 0, 0, 1341, 876, 878,
-	690, 0, 0, 0, 0, 0, 393, 954, 81, 954,
-	1303, 1351, 1104, 1350, 0, 689, 81, 0, 1140, 0,
-	1360, 0, 1313, 0, 0, 0, 0, 1334, 0, 0,
-	0, 81, 0, 0, 393, 0, 0, 0, 81, 0,
-	0, 0, 0, 0, 1369, 0, 1371, 0, 0, 0,
-	0, 1374, 0, 0, 0, 0, 0, 0, 0, 1356,
-	1357, 1358, 0, 0, 0, 0, 1320, 1321, 0, 1322,
-	0, 1383, 1324, 1210, 1326, 0, 0, 81, 81, 0,
-	81, 0, 0, 0, 0, 81, 1248, 81, 81, 81,
-	264, 0, 547, 81, 1403, 1395, 1404, 1405, 1406, 1402,
-	0, 0, 0, 0, 0, 1413, 0, 1407, 0, 0,
-	81, 264, 0, 0, 0, 1420, 0, 0, 0, 1428,
-	0, 0, 1214, 1215, 0, 0, 806, 0, 1363, 896,
-	0, 1397, 0, 0, 1249, 1231, 1232, 1398, 1233, 1234,
-	1248, 1443, 0, 0, 0, 954, 81, 0, 0, 1450,
-	1241, 1242, 829, 830, 1451, 0, 0, 81, 81, 0,
-	0, 0, 0, 0, 0, 1465, 0, 1425, 1466, 0,
-	1469, 0, 393, 0, 0, 1378, 81, 0, 1474, 1421,
-	0, 1422, 0, 0, 1444, 0, 0, 264, 1249, 0,
-	51, 0, 0, 0, 0, 81, 0, 0, 1104, 0,
-	0, 0, 0, 1429, 1483, 646, 0, 0, 882, 883,
-	0, 0, 1288, 0, 0, 0, 1488, 1490, 81, 0,
-	1170, 393, 1064, 0, 0, 0, 1065, 1492, 0, 0,
-	0, 1503, 0, 1069, 1070, 1071, 0, 0, 1514, 0,
-	1077, 844, 0, 1080, 1081, 0, 0, 0, 0, 1087,
-	393, 581, 0, 1089, 0, 0, 1092, 1093, 1094, 1095,
-	0, 0, 0, 0, 0, 0, 948, 0, 0, 0,
-	0, 0, 0, 1318, 0, 393, 0, 0, 1119, 1478,
-	0, 0, 0, 0, 0, 274, 0, 262, 1501, 0,
-	288, 0, 0, 0, 1378, 954, 0, 880, 881, 0,
-	0, 886, 889, 890, 0, 0, 0, 0, 393, 284,
-	0, 0, 0, 0, 0, 315, 0, 896, 384, 0,
-	1252, 1254, 0, 262, 0, 262, 902, 689, 904, 905,
-	0, 689, 0, 0, 0, 689, 0, 0, 0, 0,
-	0, 0, 0, 0, 1254, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	267, 393, 1281, 0, 0, 0, 0, 270, 0, 0,
-	1041, 1042, 0, 584, 0, 278, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1385, 1386, 1387, 1388, 1389, 0, 0, 0, 1392, 1393,
-	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
-	0, 0, 0, 1305, 283, 0, 1310, 1311, 1312, 0,
-	1216, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1068, 0, 605, 0, 608,
-	0, 268, 0, 0, 0, 622, 623, 624, 625, 626,
-	627, 628, 1086, 606, 607, 604, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 280, 271,
-	621, 281, 282, 287, 0, 1051, 896, 272, 275, 0,
-	269, 286, 285, 844, 0, 0, 0, 0, 0, 0,
-	1338, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 262, 0, 1365, 262, 0, 0, 0,
-	0, 0, 262, 0, 0, 0, 0, 0, 262, 0,
-	393, 0, 1066, 0, 0, 0, 0, 393, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	1082, 0, 621, 0, 0, 0, 0, 689, 689, 689,
-	689, 689, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1507, 689, 0, 0, 1317, 1399, 1400, 0, 1401,
-	689, 0, 1319, 0, 1365, 0, 1365, 1365, 1365, 0,
-	0, 0, 1281, 1328, 1329, 0, 0, 0, 0, 0,
-	0, 0, 1333, 1203, 0, 0, 0, 0, 0, 1365,
-	0, 0, 0, 1343, 1344, 1345, 0, 1348, 0, 0,
-	0, 0, 0, 24, 25, 52, 27, 28, 1332, 0,
-	0, 0, 0, 0, 1359, 0, 0, 0, 0, 262,
-	262, 262, 43, 0, 0, 1457, 0, 29, 48, 49,
-	0, 0, 0, 1238, 0, 0, 393, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 38, 0, 0,
-	0, 54, 896, 0, 0, 1476, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 0, 0,
-	621, 0, 0, 0, 1482, 1272, 0, 0, 0, 1390,
-	0, 0, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 0, 0, 621, 1365, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 31, 32, 34, 33, 36, 0, 50, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1433,
-	1434, 1435, 1436, 0, 0, 0, 1440, 1441, 1331, 0,
-	37, 44, 45, 0, 0, 46, 47, 35, 1447, 1448,
-	1449, 0, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 39, 40, 0, 41, 42, 0, 0, 262, 262,
-	0, 0, 0, 0, 262, 0, 0, 262, 0, 1330,
-	262, 1470, 0, 0, 790, 0, 0, 1337, 1475, 0,
-	0, 0, 0, 0, 262, 0, 0, 646, 0, 0,
-	0, 0, 0, 0, 0, 1352, 1480, 0, 1353, 0,
-	0, 1355, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 0, 0, 621, 0, 0, 0,
-	0, 0, 0, 0, 262, 689, 0, 0, 0, 0,
-	0, 0, 0, 790, 0, 0, 53, 0, 1213, 0,
-	0, 1515, 1516, 610, 609, 619, 620, 612, 613, 614,
-	615, 616, 617, 618, 611, 0, 0, 621, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	0, 1063, 621, 0, 0, 315, 0, 0, 0, 0,
-	315, 315, 0, 0, 315, 315, 315, 0, 0, 0,
-	897, 610, 609, 619, 620, 612, 613, 614, 615, 616,
-	617, 618, 611, 0, 0, 621, 0, 0, 0, 315,
-	315, 315, 315, 0, 262, 0, 0, 0, 0, 0,
-	0, 0, 262, 930, 0, 0, 262, 262, 0, 0,
-	262, 938, 790, 610, 609, 619, 620, 612, 613, 614,
-	615, 616, 617, 618, 611, 0, 0, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1468,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 0, 0, 0, 0, 262, 262, 262,
-	262, 262, 0, 262, 262, 0, 0, 262, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 738, 0, 0, 262, 0, 1036, 1037, 0, 0,
-	0, 262, 0, 0, 0, 0, 790, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 726,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 897, 262, 262, 262, 262, 262, 739, 0, 0,
-	0, 0, 0, 0, 1118, 0, 0, 262, 0, 0,
-	0, 930, 0, 0, 0, 262, 0, 0, 0, 0,
-	752, 755, 756, 757, 758, 759, 760, 0, 761, 762,
-	763, 764, 765, 740, 741, 742, 743, 724, 725, 753,
-	0, 727, 0, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 744, 745, 746, 747, 748, 749, 750,
-	751, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 754, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	790, 0, 0, 0, 0, 0, 0, 0, 0, 897,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 897, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 930, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 262, 0, 0, 0, 0, 0, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	80, 0, 955, 956, 897, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 262, 404,
-	407, 512, 497, 428, 429, 1141, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 0, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 955, 956, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 0, 425, 401,
-	431, 402, 423, 449, 111, 453, 420, 492, 463, 504,
-	137, 511, 139, 469, 0, 211, 153, 0, 0, 451,
-	494, 458, 487, 446, 479, 411, 468, 506, 436, 476,
-	507, 54, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 473, 501, 433, 475, 477,
-	400, 470, 0, 404, 407, 512, 497, 428, 429, 0,
-	0, 0, 0, 0, 0, 0, 450, 459, 484, 444,
-	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
-	467, 0, 0, 0, 408, 405, 0, 0, 448, 0,
-	0, 0, 410, 0, 427, 485, 0, 398, 119, 489,
-	496, 445, 265, 500, 443, 442, 503, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	493, 424, 432, 105, 430, 193, 172, 231, 466, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 403, 0,
-	212, 234, 249, 99, 419, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 415, 418, 413,
-	414, 461, 462, 508, 509, 510, 486, 409, 0, 416,
-	417, 0, 491, 498, 499, 465, 82, 91, 138, 246,
-	186, 116, 235, 399, 412, 109, 422, 0, 0, 434,
-	439, 440, 452, 454, 455, 456, 457, 464, 471, 472,
-	474, 480, 481, 482, 483, 488, 495, 514, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 502, 490, 0, 447, 505, 421,
-	437, 513, 438, 441, 478, 406, 460, 165, 435, 0,
-	425, 401, 431, 402, 423, 449, 111, 453, 420, 492,
-	463, 504, 137, 511, 139, 469, 0, 211, 153, 0,
-	0, 451, 494, 458, 487, 446, 479, 411, 468, 506,
-	436, 476, 507, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 473, 501, 433,
-	475, 477, 400, 470, 0, 404, 407, 512, 497, 428,
-	429, 0, 0, 0, 0, 0, 0, 0, 450, 459,
-	484, 444, 0, 0, 0, 0, 0, 0, 1209, 0,
-	426, 0, 467, 0, 0, 0, 408, 405, 0, 0,
-	448, 0, 0, 0, 410, 0, 427, 485, 0, 398,
-	119, 489, 496, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	403, 0, 212, 234, 249, 99, 419, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 415,
-	418, 413, 414, 461, 462, 508, 509, 510, 486, 409,
-	0, 416, 417, 0, 491, 498, 499, 465, 82, 91,
-	138, 246, 186, 116, 235, 399, 412, 109, 422, 0,
-	0, 434, 439, 440, 452, 454, 455, 456, 457, 464,
-	471, 472, 474, 480, 481, 482, 483, 488, 495, 514,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 502, 490, 0, 447,
-	505, 421, 437, 513, 438, 441, 478, 406, 460, 165,
-	435, 0, 425, 401, 431, 402, 423, 449, 111, 453,
-	420, 492, 463, 504, 137, 511, 139, 469, 0, 211,
-	153, 0, 0, 451, 494, 458, 487, 446, 479, 411,
-	468, 506, 436, 476, 507, 0, 0, 0, 263, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 473,
-	501, 433, 475, 477, 400, 470, 0, 404, 407, 512,
-	497, 428, 429, 0, 0, 0, 0, 0, 0, 0,
-	450, 459, 484, 444, 0, 0, 0, 0, 0, 0,
-	939, 0, 426, 0, 467, 0, 0, 0, 408, 405,
-	0, 0, 448, 0, 0, 0, 410, 0, 427, 485,
-	0, 398, 119, 489, 496, 445, 265, 500, 443, 442,
-	503, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 493, 424, 432, 105, 430, 193,
-	172, 231, 466, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 403, 0, 212, 234, 249, 99, 419, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 415, 418, 413, 414, 461, 462, 508, 509, 510,
-	486, 409, 0, 416, 417, 0, 491, 498, 499, 465,
-	82, 91, 138, 246, 186, 116, 235, 399, 412, 109,
-	422, 0, 0, 434, 439, 440, 452, 454, 455, 456,
-	457, 464, 471, 472, 474, 480, 481, 482, 483, 488,
-	495, 514, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	320, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 0, 404,
-	407, 512, 497, 428, 429, 0, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 837, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 0, 425, 401,
-	431, 402, 423, 449, 111, 453, 420, 492, 463, 504,
-	137, 511, 139, 469, 0, 211, 153, 0, 0, 451,
-	494, 458, 487, 446, 479, 411, 468, 506, 436, 476,
-	507, 0, 0, 0, 320, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 473, 501, 433, 475, 477,
-	400, 470, 0, 404, 407, 512, 497, 428, 429, 0,
-	0, 0, 0, 0, 0, 0, 450, 459, 484, 444,
-	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
-	467, 0, 0, 0, 408, 405, 0, 0, 448, 0,
-	0, 0, 410, 0, 427, 485, 0, 398, 119, 489,
-	496, 445, 265, 500, 443, 442, 503, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	493, 424, 432, 105, 430, 193, 172, 231, 466, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 403, 0,
-	212, 234, 249, 99, 419, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 415, 418, 413,
-	414, 461, 462, 508, 509, 510, 486, 409, 0, 416,
-	417, 0, 491, 498, 499, 465, 82, 91, 138, 246,
-	186, 116, 235, 399, 412, 109, 422, 0, 0, 434,
-	439, 440, 452, 454, 455, 456, 457, 464, 471, 472,
-	474, 480, 481, 482, 483, 488, 495, 514, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 502, 490, 0, 447, 505, 421,
-	437, 513, 438, 441, 478, 406, 460, 165, 435, 0,
-	425, 401, 431, 402, 423, 449, 111, 453, 420, 492,
-	463, 504, 137, 511, 139, 469, 0, 211, 153, 0,
-	0, 451, 494, 458, 487, 446, 479, 411, 468, 506,
-	436, 476, 507, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 473, 501, 433,
-	475, 477, 400, 470, 0, 404, 407, 512, 497, 428,
-	429, 0, 0, 0, 0, 0, 0, 0, 450, 459,
-	484, 444, 0, 0, 0, 0, 0, 0, 0, 0,
-	426, 0, 467, 0, 0, 0, 408, 405, 0, 0,
-	448, 0, 0, 0, 410, 0, 427, 485, 0, 398,
-	119, 489, 496, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 396, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	403, 0, 212, 234, 249, 99, 419, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 397, 395, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 415,
-	418, 413, 414, 461, 462, 508, 509, 510, 486, 409,
-	0, 416, 417, 0, 491, 498, 499, 465, 82, 91,
-	138, 246, 186, 116, 235, 399, 412, 109, 422, 0,
-	0, 434, 439, 440, 452, 454, 455, 456, 457, 464,
-	471, 472, 474, 480, 481, 482, 483, 488, 495, 514,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 502, 490, 0, 447,
-	505, 421, 437, 513, 438, 441, 478, 406, 460, 165,
-	435, 0, 425, 401, 431, 402, 423, 449, 111, 453,
-	420, 492, 463, 504, 137, 511, 139, 469, 0, 211,
-	153, 0, 0, 451, 494, 458, 487, 446, 479, 411,
-	468, 506, 436, 476, 507, 0, 0, 0, 263, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 473,
-	501, 433, 475, 477, 400, 470, 0, 404, 407, 512,
-	497, 428, 429, 0, 0, 0, 0, 0, 0, 0,
-	450, 459, 484, 444, 0, 0, 0, 0, 0, 0,
-	0, 0, 426, 0, 467, 0, 0, 0, 408, 405,
-	0, 0, 448, 0, 0, 0, 410, 0, 427, 485,
-	0, 398, 119, 489, 496, 445, 265, 500, 443, 442,
-	503, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 493, 424, 432, 105, 430, 193,
-	172, 231, 466, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 403, 0, 212, 234, 249, 99, 419, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 415, 418, 413, 414, 461, 462, 508, 509, 510,
-	486, 409, 0, 416, 417, 0, 491, 498, 499, 465,
-	82, 91, 138, 246, 186, 116, 235, 399, 412, 109,
-	422, 0, 0, 434, 439, 440, 452, 454, 455, 456,
-	457, 464, 471, 472, 474, 480, 481, 482, 483, 488,
-	495, 514, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 0, 404,
-	407, 512, 497, 428, 429, 0, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 0, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 703,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 396, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	397, 395, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 387, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 396, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 397, 395, 390, 389, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 322, 0, 0,
-	0, 111, 0, 319, 0, 0, 0, 137, 362, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 353, 354,
-	0, 0, 0, 0, 0, 0, 946, 0, 54, 0,
-	0, 320, 341, 340, 343, 344, 345, 346, 0, 0,
-	101, 342, 347, 348, 349, 947, 0, 0, 317, 334,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 332, 0, 0, 0, 0, 375, 0, 333,
-	0, 0, 328, 329, 330, 335, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 373, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 363, 374, 369, 370, 367, 368,
-	366, 365, 364, 376, 355, 356, 357, 358, 360, 0,
-	371, 372, 359, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 873, 0, 322, 0, 0, 0,
-	111, 0, 319, 0, 0, 0, 137, 362, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 353, 354, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	320, 341, 340, 343, 344, 345, 346, 0, 0, 101,
-	342, 347, 348, 349, 0, 0, 0, 317, 334, 0,
-	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	331, 332, 313, 0, 0, 0, 375, 0, 333, 0,
-	0, 328, 329, 330, 335, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 373, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 363, 374, 369, 370, 367, 368, 366,
-	365, 364, 376, 355, 356, 357, 358, 360, 0, 371,
-	372, 359, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 322, 0, 0, 0, 111,
-	0, 319, 0, 0, 0, 137, 362, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 353, 354, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 576, 320,
-	341, 340, 343, 344, 345, 346, 0, 0, 101, 342,
-	347, 348, 349, 0, 0, 0, 317, 334, 0, 361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 331,
-	332, 0, 0, 0, 0, 375, 0, 333, 0, 0,
-	328, 329, 330, 335, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	373, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 363, 374, 369, 370, 367, 368, 366, 365,
-	364, 376, 355, 356, 357, 358, 360, 0, 371, 372,
-	359, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 322, 0, 0, 0, 111, 0,
-	319, 0, 0, 0, 137, 362, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 353, 354, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 320, 341,
-	340, 343, 344, 345, 346, 0, 0, 101, 342, 347,
-	348, 349, 0, 0, 0, 317, 334, 0, 361, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 332,
-	313, 0, 0, 0, 375, 0, 333, 0, 0, 328,
-	329, 330, 335, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 373,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 363, 374, 369, 370, 367, 368, 366, 365, 364,
-	376, 355, 356, 357, 358, 360, 0, 371, 372, 359,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 888,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 313,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 373, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 322, 0, 0, 0, 111, 0, 319, 0,
-	0, 0, 137, 362, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 353, 354, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 320, 341, 885, 343,
-	344, 345, 346, 0, 0, 101, 342, 347, 348, 349,
-	0, 0, 0, 317, 334, 0, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 331, 332, 313, 0,
-	0, 0, 375, 0, 333, 0, 0, 328, 329, 330,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 363,
-	374, 369, 370, 367, 368, 366, 365, 364, 376, 355,
-	356, 357, 358, 360, 0, 371, 372, 359, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 24, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 340,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 0,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 373, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 322, 0, 0, 0, 111, 0, 319, 0,
-	0, 0, 137, 362, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 353, 354, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 320, 341, 340, 343,
-	344, 345, 346, 0, 0, 101, 342, 347, 348, 349,
-	0, 0, 0, 317, 334, 0, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 331, 332, 0, 0,
-	0, 0, 375, 0, 333, 0, 0, 328, 329, 330,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 363,
-	374, 369, 370, 367, 368, 366, 365, 364, 376, 355,
-	356, 357, 358, 360, 0, 371, 372, 359, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 165, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 362, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 353, 354, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 320, 341, 340, 343, 344,
-	345, 346, 0, 0, 101, 342, 347, 348, 349, 0,
-	0, 0, 0, 334, 0, 361, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 332, 0, 0, 0,
-	0, 375, 0, 333, 0, 0, 328, 329, 330, 335,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 265, 0, 0, 373, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 1508,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 363, 374,
-	369, 370, 367, 368, 366, 365, 364, 376, 355, 356,
-	357, 358, 360, 0, 371, 372, 359, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	458, 1594, 1584, 1522, 1362, 1240, 1148, 862, 1552, 675,
+	460, 1435, 1469, 475, 990, 1302, 1166, 963, 488, 1336,
+	1019, 986, 1149, 1033, 1303, 1299, 1070, 1309, 462, 989,
+	1315, 1193, 999, 847, 85, 1274, 852, 902, 274, 1219,
+	834, 274, 603, 303, 1210, 912, 85, 316, 439, 736,
+	950, 965, 820, 1003, 85, 1107, 1172, 930, 612, 274,
+	909, 674, 3, 717, 526, 1029, 943, 735, 716, 961,
+	879, 274, 85, 521, 536, 858, 274, 626, 274, 446,
+	518, 523, 725, 444, 55, 451, 64, 309, 690, 7,
+	1571, 1568, 304, 305, 306, 6, 5, 843, 438, 554,
+	1569, 1532, 1533, 66, 478, 477, 480, 481, 482, 483,
+	1271, 1587, 449, 479, 484, 1570, 1567, 1561, 57, 314,
+	57, 1582, 1553, 448, 1538, 57, 1578, 59, 29, 30,
+	1363, 1560, 68, 69, 70, 71, 478, 477, 480, 481,
+	482, 483, 1537, 911, 689, 479, 484, 500, 1456, 506,
+	507, 504, 505, 503, 502, 501, 1395, 1558, 1291, 541,
+	1330, 443, 57, 508, 509, 737, 62, 738, 62, 844,
+	845, 846, 1181, 62, 308, 1180, 981, 982, 1182, 567,
+	311, 57, 27, 59, 29, 30, 1143, 1331, 1332, 1558,
+	1144, 529, 980, 583, 588, 307, 1201, 584, 581, 582,
+	47, 269, 265, 266, 267, 31, 51, 52, 1012, 261,
+	62, 263, 1052, 1242, 1425, 1020, 1386, 1384, 302, 1444,
+	299, 809, 576, 577, 586, 40, 1051, 1244, 806, 62,
+	455, 1498, 640, 639, 649, 650, 642, 643, 644, 645,
+	646, 647, 648, 641, 1580, 569, 651, 571, 808, 1575,
+	1598, 1523, 1442, 1239, 1056, 587, 944, 1516, 555, 1004,
+	1602, 810, 274, 1050, 543, 274, 799, 263, 1243, 1167,
+	1169, 274, 1245, 813, 300, 1470, 1006, 274, 568, 570,
+	85, 1006, 85, 807, 85, 85, 546, 85, 1472, 85,
+	33, 34, 36, 35, 38, 85, 54, 1325, 1324, 276,
+	1477, 85, 1323, 85, 539, 1194, 262, 264, 1505, 1064,
+	1406, 85, 1063, 1047, 1044, 1045, 1555, 1043, 39, 48,
+	53, 1236, 268, 49, 50, 37, 551, 1238, 663, 664,
+	1265, 641, 1006, 1177, 651, 1124, 1134, 1275, 1121, 41,
+	42, 1348, 43, 44, 45, 46, 1168, 1100, 1555, 1054,
+	1057, 593, 594, 1020, 877, 1536, 1471, 1596, 731, 630,
+	1597, 537, 1595, 561, 987, 566, 651, 557, 558, 559,
+	60, 596, 1005, 464, 976, 1277, 58, 1005, 58, 1078,
+	624, 623, 835, 58, 839, 1227, 625, 1295, 1049, 548,
+	1072, 549, 1349, 886, 550, 1514, 1013, 625, 600, 599,
+	1554, 1478, 1476, 1499, 1486, 598, 597, 884, 885, 883,
+	1048, 1279, 74, 1283, 1225, 1278, 1313, 1276, 624, 623,
+	58, 739, 1281, 1237, 602, 1235, 60, 931, 1005, 1083,
+	1084, 1280, 1554, 1002, 1000, 625, 1001, 663, 664, 58,
+	663, 664, 998, 1004, 1282, 1284, 274, 537, 75, 1053,
+	623, 1293, 85, 644, 645, 646, 647, 648, 641, 801,
+	565, 651, 572, 836, 573, 574, 625, 575, 1071, 578,
+	1009, 1120, 542, 1080, 1055, 589, 1010, 624, 623, 1576,
+	535, 1226, 1097, 1098, 1099, 1603, 1231, 1228, 1221, 1229,
+	1224, 601, 1220, 1199, 625, 1222, 1223, 640, 639, 649,
+	650, 642, 643, 644, 645, 646, 647, 648, 641, 1230,
+	1079, 651, 931, 1119, 1131, 1118, 274, 274, 274, 260,
+	1518, 624, 623, 711, 1604, 85, 607, 624, 623, 616,
+	1542, 85, 624, 623, 1431, 62, 274, 935, 625, 1430,
+	631, 85, 1214, 1213, 625, 882, 274, 274, 85, 625,
+	1202, 1108, 274, 544, 545, 274, 1544, 903, 274, 904,
+	1515, 1183, 274, 1184, 85, 85, 1451, 85, 85, 85,
+	274, 85, 85, 1428, 1248, 676, 1211, 85, 85, 868,
+	870, 871, 1075, 1512, 687, 869, 715, 1365, 515, 516,
+	693, 695, 85, 699, 701, 1194, 704, 1189, 724, 1096,
+	1579, 620, 729, 1546, 620, 1483, 733, 1096, 1526, 822,
+	1096, 620, 1096, 1506, 1096, 1474, 274, 1421, 1420, 85,
+	1408, 620, 274, 1405, 620, 1355, 1354, 1482, 85, 1351,
+	1352, 1345, 489, 61, 905, 814, 1351, 1350, 1113, 620,
+	947, 620, 914, 620, 529, 819, 692, 694, 696, 698,
+	700, 702, 703, 880, 818, 854, 802, 800, 797, 61,
+	746, 745, 842, 563, 556, 1300, 1007, 970, 1312, 726,
+	855, 837, 1374, 85, 906, 907, 727, 914, 876, 440,
+	1312, 1173, 1173, 874, 1401, 947, 313, 1485, 1353, 1185,
+	850, 853, 727, 979, 61, 860, 665, 666, 667, 668,
+	669, 670, 671, 672, 946, 872, 85, 85, 1137, 865,
+	866, 921, 924, 856, 274, 1136, 1113, 932, 728, 1113,
+	730, 798, 274, 274, 947, 1312, 274, 274, 805, 947,
+	274, 274, 274, 85, 728, 1113, 726, 1081, 726, 916,
+	732, 615, 812, 609, 823, 824, 85, 825, 826, 827,
+	875, 829, 830, 62, 1562, 1437, 1014, 831, 832, 1413,
+	1034, 928, 1341, 676, 57, 1188, 919, 920, 1030, 940,
+	642, 643, 644, 645, 646, 647, 648, 641, 1025, 822,
+	651, 1021, 1022, 1023, 478, 477, 480, 481, 482, 483,
+	969, 62, 1024, 479, 484, 971, 1241, 977, 1438, 973,
+	274, 85, 978, 85, 974, 1316, 1317, 274, 274, 274,
+	274, 274, 62, 274, 274, 1037, 1589, 274, 85, 1585,
+	1035, 994, 917, 918, 1343, 985, 923, 926, 927, 1319,
+	1300, 1215, 840, 661, 274, 816, 274, 274, 1093, 1322,
+	1321, 274, 952, 955, 956, 957, 953, 1157, 954, 958,
+	1160, 939, 529, 941, 942, 1161, 1158, 1156, 85, 1031,
+	1032, 1159, 613, 614, 878, 991, 1573, 887, 888, 889,
+	890, 891, 892, 893, 894, 895, 896, 897, 898, 899,
+	900, 901, 952, 955, 956, 957, 953, 1559, 954, 958,
+	720, 1371, 1316, 1317, 1250, 859, 1162, 1085, 956, 957,
+	1564, 1259, 880, 1258, 1520, 1206, 848, 744, 564, 1198,
+	857, 1519, 313, 1454, 313, 1087, 313, 313, 849, 313,
+	1196, 313, 936, 1190, 1399, 876, 25, 313, 1433, 1040,
+	1102, 639, 649, 650, 642, 643, 644, 645, 646, 647,
+	648, 641, 61, 313, 651, 815, 960, 861, 274, 274,
+	274, 274, 274, 65, 1103, 610, 611, 859, 1257, 604,
+	274, 1529, 1492, 274, 605, 440, 1256, 274, 1528, 1490,
+	1173, 274, 585, 1591, 1590, 1150, 1125, 1122, 833, 621,
+	487, 1039, 1591, 1041, 1502, 1426, 619, 1130, 1077, 442,
+	85, 67, 63, 1, 1583, 1364, 1434, 875, 1068, 1046,
+	1114, 1521, 1468, 1335, 1186, 1145, 997, 988, 881, 1163,
+	73, 534, 1152, 1153, 83, 1155, 1151, 1132, 1171, 1154,
+	72, 1513, 996, 995, 916, 1475, 301, 1424, 1175, 1178,
+	1176, 1008, 1200, 1011, 315, 1195, 1203, 1204, 85, 85,
+	1174, 1205, 1342, 1207, 1208, 1209, 1197, 1517, 752, 750,
+	751, 749, 315, 754, 1112, 1191, 1192, 649, 650, 642,
+	643, 644, 645, 646, 647, 648, 641, 753, 85, 651,
+	1212, 748, 1128, 287, 608, 524, 959, 740, 1036, 622,
+	618, 76, 1234, 1233, 1232, 1042, 1218, 838, 579, 660,
+	580, 289, 662, 659, 1255, 720, 1179, 85, 530, 720,
+	1307, 85, 617, 720, 26, 1556, 1531, 1530, 1441, 991,
+	1247, 1270, 1082, 851, 1527, 1104, 1105, 1106, 1253, 1254,
+	673, 1489, 677, 678, 679, 680, 681, 682, 683, 684,
+	685, 1129, 688, 691, 691, 691, 697, 691, 691, 697,
+	691, 705, 706, 707, 708, 709, 710, 85, 85, 721,
+	1301, 686, 1015, 1016, 1017, 1018, 1286, 1285, 1249, 1273,
+	1266, 929, 1264, 1304, 463, 1251, 1252, 853, 1026, 1027,
+	1028, 85, 1150, 313, 867, 476, 473, 1292, 474, 1088,
+	313, 1142, 876, 633, 461, 1320, 85, 1102, 85, 85,
+	453, 719, 712, 951, 949, 948, 313, 313, 1326, 313,
+	313, 313, 1334, 313, 313, 1311, 519, 1318, 1306, 313,
+	313, 1314, 913, 915, 718, 1333, 274, 1294, 1373, 1217,
+	1263, 1327, 1338, 1339, 1340, 1346, 1347, 1394, 1497, 1092,
+	28, 1329, 441, 61, 274, 1094, 1260, 514, 21, 20,
+	85, 61, 19, 85, 85, 85, 274, 18, 1246, 17,
+	595, 23, 22, 16, 1296, 15, 274, 881, 14, 1328,
+	315, 552, 315, 1357, 315, 315, 85, 315, 32, 315,
+	24, 13, 12, 11, 1370, 315, 1358, 10, 1360, 9,
+	8, 590, 4, 592, 606, 56, 2, 0, 0, 0,
+	0, 315, 0, 0, 0, 61, 0, 0, 0, 0,
+	0, 0, 0, 1382, 0, 991, 0, 991, 0, 0,
+	677, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 720, 720, 720, 720, 720, 0, 1400, 0, 0,
+	0, 0, 0, 0, 85, 1410, 720, 0, 0, 1268,
+	1269, 1150, 85, 0, 720, 0, 0, 0, 1186, 1423,
+	0, 0, 1287, 1288, 962, 1289, 1290, 85, 721, 0,
+	1409, 0, 721, 0, 85, 0, 0, 1297, 1298, 0,
+	1419, 1427, 0, 1429, 0, 274, 0, 0, 0, 0,
+	0, 1396, 0, 0, 0, 1263, 0, 0, 0, 0,
+	0, 676, 0, 1086, 0, 0, 0, 0, 0, 1411,
+	1095, 1443, 1412, 0, 0, 1414, 85, 85, 0, 85,
+	0, 0, 0, 0, 85, 0, 85, 85, 85, 274,
+	1304, 1463, 85, 1464, 1465, 1466, 1455, 0, 0, 1344,
+	1439, 0, 628, 313, 0, 313, 1467, 0, 85, 274,
+	1479, 1473, 0, 1110, 1440, 1487, 0, 1111, 0, 1480,
+	313, 1481, 1462, 991, 1115, 1116, 1117, 0, 0, 0,
+	0, 1123, 0, 0, 1126, 1127, 1457, 0, 0, 0,
+	1133, 0, 1503, 0, 1135, 1304, 0, 1138, 1139, 1140,
+	1141, 1511, 0, 1436, 1510, 0, 85, 85, 0, 0,
+	1491, 0, 0, 0, 662, 0, 0, 1524, 1376, 1165,
+	0, 1525, 1534, 0, 0, 315, 85, 0, 1101, 1539,
+	0, 741, 0, 0, 0, 0, 0, 274, 0, 0,
+	1504, 315, 0, 0, 85, 0, 0, 0, 315, 0,
+	0, 1150, 1548, 0, 0, 0, 0, 1432, 0, 1550,
+	0, 0, 1557, 0, 315, 315, 0, 315, 315, 315,
+	1563, 315, 315, 1565, 0, 0, 0, 315, 315, 0,
+	0, 0, 1566, 85, 1557, 0, 0, 284, 0, 0,
+	1574, 0, 841, 85, 0, 0, 1146, 1147, 676, 0,
+	721, 721, 721, 721, 721, 1581, 1588, 1557, 0, 0,
+	0, 294, 0, 1599, 0, 962, 0, 1170, 0, 863,
+	0, 0, 0, 721, 0, 1436, 991, 0, 628, 0,
+	0, 315, 0, 0, 1549, 676, 0, 0, 0, 0,
+	1445, 1446, 1447, 1448, 1449, 0, 0, 0, 1452, 1453,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 632, 0, 0, 0, 0, 280,
+	1272, 0, 0, 908, 0, 0, 0, 288, 283, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 933,
+	0, 313, 1379, 1380, 0, 1381, 0, 0, 1383, 0,
+	1385, 0, 272, 0, 0, 298, 937, 938, 0, 0,
+	286, 0, 0, 0, 0, 0, 293, 0, 620, 0,
+	313, 0, 0, 447, 0, 0, 0, 0, 0, 0,
+	0, 452, 0, 315, 0, 522, 722, 0, 0, 0,
+	272, 0, 272, 0, 0, 278, 315, 0, 0, 0,
+	0, 0, 0, 0, 1422, 640, 639, 649, 650, 642,
+	643, 644, 645, 646, 647, 648, 641, 0, 720, 651,
+	0, 0, 290, 281, 271, 291, 292, 297, 0, 0,
+	0, 282, 285, 0, 279, 296, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1305, 0, 61,
+	0, 315, 0, 315, 0, 0, 0, 520, 1398, 1572,
+	0, 0, 538, 0, 540, 0, 0, 0, 315, 0,
+	0, 0, 1375, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1378, 0, 0, 0, 0, 1592, 0, 0,
+	0, 0, 0, 1387, 1388, 0, 640, 639, 649, 650,
+	642, 643, 644, 645, 646, 647, 648, 641, 1089, 0,
+	651, 0, 0, 1402, 1403, 1404, 0, 1407, 0, 0,
+	0, 0, 0, 1397, 0, 0, 0, 635, 315, 638,
+	1392, 0, 0, 0, 1418, 652, 653, 654, 655, 656,
+	657, 658, 0, 636, 637, 634, 640, 639, 649, 650,
+	642, 643, 644, 645, 646, 647, 648, 641, 0, 0,
+	651, 640, 639, 649, 650, 642, 643, 644, 645, 646,
+	647, 648, 641, 0, 1377, 651, 272, 0, 0, 272,
+	0, 0, 0, 0, 0, 272, 0, 0, 0, 0,
+	0, 272, 0, 0, 1393, 0, 0, 933, 0, 0,
+	0, 0, 1450, 0, 640, 639, 649, 650, 642, 643,
+	644, 645, 646, 647, 648, 641, 0, 0, 651, 0,
+	0, 0, 0, 0, 0, 0, 1415, 1416, 1417, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 547, 0,
+	0, 553, 0, 1493, 1494, 1495, 1496, 560, 0, 313,
+	1500, 1501, 0, 562, 0, 0, 0, 0, 0, 0,
+	0, 0, 1507, 1508, 1509, 0, 0, 721, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1216, 315,
+	1391, 0, 640, 639, 649, 650, 642, 643, 644, 645,
+	646, 647, 648, 641, 1305, 1535, 651, 1458, 0, 0,
+	0, 0, 1540, 0, 0, 0, 0, 0, 315, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1390, 1545,
+	0, 0, 0, 0, 0, 0, 1484, 0, 1551, 0,
+	0, 0, 0, 0, 0, 0, 0, 1261, 0, 0,
+	0, 315, 0, 0, 0, 0, 0, 0, 0, 1305,
+	447, 61, 0, 0, 640, 639, 649, 650, 642, 643,
+	644, 645, 646, 647, 648, 641, 0, 0, 651, 0,
+	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 933, 0, 0, 1308, 1310, 0,
+	1600, 1601, 640, 639, 649, 650, 642, 643, 644, 645,
+	646, 647, 648, 641, 0, 0, 651, 0, 0, 0,
+	0, 1310, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 272, 272, 0, 0, 0, 315, 0, 315, 1337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 272, 0, 0, 0, 0, 272, 0, 0, 272,
+	0, 0, 272, 0, 0, 0, 821, 0, 0, 0,
+	0, 0, 0, 1586, 272, 0, 0, 0, 0, 0,
+	1361, 1389, 0, 1366, 1367, 1368, 0, 0, 0, 0,
+	0, 0, 714, 0, 723, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 747, 0, 0, 0, 0, 0, 0, 0,
+	447, 0, 803, 804, 0, 0, 272, 0, 811, 0,
+	0, 520, 0, 0, 817, 821, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 828, 0, 0, 0,
+	0, 0, 0, 933, 0, 640, 639, 649, 650, 642,
+	643, 644, 645, 646, 647, 648, 641, 0, 0, 651,
+	0, 0, 0, 0, 315, 0, 0, 0, 452, 0,
+	0, 0, 863, 452, 452, 0, 0, 452, 452, 452,
+	0, 0, 0, 934, 0, 0, 0, 315, 864, 0,
+	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 452, 452, 452, 452, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 0, 272, 967, 0, 0,
+	272, 272, 0, 0, 272, 975, 821, 0, 0, 0,
+	0, 0, 0, 1267, 0, 0, 1459, 1460, 0, 1461,
+	0, 0, 0, 0, 863, 0, 863, 863, 863, 0,
+	0, 0, 1337, 640, 639, 649, 650, 642, 643, 644,
+	645, 646, 647, 648, 641, 0, 0, 651, 863, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	945, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 972, 272, 0, 0, 0, 0, 0,
+	0, 272, 272, 272, 272, 272, 0, 272, 272, 0,
+	0, 272, 0, 0, 0, 0, 315, 315, 1109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	1073, 1074, 769, 933, 0, 272, 1541, 0, 640, 639,
+	649, 650, 642, 643, 644, 645, 646, 647, 648, 641,
+	0, 0, 651, 0, 1547, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1038, 0, 0, 0,
+	0, 0, 821, 1058, 1059, 1060, 1061, 1062, 0, 1065,
+	1066, 0, 0, 1067, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 863, 0, 0, 0, 0, 0, 0,
+	1069, 0, 0, 1577, 0, 452, 0, 1076, 0, 0,
+	757, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 934, 272, 272, 272, 272, 272, 0, 770, 0,
+	0, 0, 0, 0, 1164, 0, 0, 272, 0, 0,
+	0, 967, 0, 0, 0, 272, 0, 0, 0, 0,
+	0, 0, 0, 783, 786, 787, 788, 789, 790, 791,
+	0, 792, 793, 794, 795, 796, 771, 772, 773, 774,
+	755, 756, 784, 0, 758, 0, 759, 760, 761, 762,
+	763, 764, 765, 766, 767, 768, 775, 776, 777, 778,
+	779, 780, 781, 782, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 785, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 452, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 821,
+	0, 0, 0, 0, 0, 0, 0, 0, 934, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 272, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1356, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1359, 0, 0, 0, 0, 0, 0, 934, 0, 0,
+	0, 0, 1369, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1372, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 272,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 967, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 934, 0, 0,
+	0, 0, 0, 0, 0, 1488, 0, 0, 0, 0,
+	0, 272, 0, 424, 412, 0, 366, 427, 340, 356,
+	435, 357, 360, 397, 325, 379, 171, 354, 0, 344,
+	320, 350, 321, 342, 368, 116, 372, 339, 414, 382,
+	426, 143, 433, 145, 388, 0, 219, 159, 0, 0,
+	370, 416, 377, 408, 365, 398, 330, 387, 428, 355,
+	395, 429, 0, 0, 0, 84, 0, 992, 993, 0,
+	0, 0, 0, 0, 105, 0, 392, 423, 352, 394,
+	396, 319, 389, 1543, 323, 326, 434, 419, 347, 348,
+	1187, 0, 0, 0, 0, 0, 0, 369, 378, 404,
+	363, 0, 0, 0, 0, 0, 0, 0, 0, 345,
+	0, 386, 0, 0, 0, 327, 324, 0, 0, 367,
+	0, 0, 0, 329, 0, 346, 406, 0, 317, 125,
+	411, 418, 364, 275, 422, 362, 361, 425, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 415, 343, 351, 110, 349, 199, 178, 241, 385,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 405, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 99,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 322, 0, 220, 244, 259, 103, 338, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 163, 100, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	334, 337, 332, 333, 380, 381, 430, 431, 432, 407,
+	328, 0, 335, 336, 0, 413, 420, 421, 384, 86,
+	95, 144, 437, 192, 121, 399, 409, 401, 109, 210,
+	403, 124, 239, 237, 253, 245, 318, 331, 114, 341,
+	0, 0, 353, 358, 359, 371, 373, 374, 375, 376,
+	383, 390, 391, 393, 400, 402, 410, 417, 436, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 424, 412, 0, 366, 427,
+	340, 356, 435, 357, 360, 397, 325, 379, 171, 354,
+	0, 344, 320, 350, 321, 342, 368, 116, 372, 339,
+	414, 382, 426, 143, 433, 145, 388, 0, 219, 159,
+	0, 0, 370, 416, 377, 408, 365, 398, 330, 387,
+	428, 355, 395, 429, 0, 0, 0, 84, 0, 992,
+	993, 0, 0, 0, 0, 0, 105, 0, 392, 423,
+	352, 394, 396, 319, 389, 0, 323, 326, 434, 419,
+	347, 348, 0, 0, 0, 0, 0, 0, 0, 369,
+	378, 404, 363, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 0, 386, 0, 0, 0, 327, 324, 0,
+	0, 367, 0, 0, 0, 329, 0, 346, 406, 0,
+	317, 125, 411, 418, 364, 275, 422, 362, 361, 425,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 415, 343, 351, 110, 349, 199, 178,
+	241, 385, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	405, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 322, 0, 220, 244, 259, 103, 338,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 334, 337, 332, 333, 380, 381, 430, 431,
+	432, 407, 328, 0, 335, 336, 0, 413, 420, 421,
+	384, 86, 95, 144, 437, 192, 121, 399, 409, 401,
+	109, 210, 403, 124, 239, 237, 253, 245, 318, 331,
+	114, 341, 0, 0, 353, 358, 359, 371, 373, 374,
+	375, 376, 383, 390, 391, 393, 400, 402, 410, 417,
+	436, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 424, 412, 0,
+	366, 427, 340, 356, 435, 357, 360, 397, 325, 379,
+	171, 354, 0, 344, 320, 350, 321, 342, 368, 116,
+	372, 339, 414, 382, 426, 143, 433, 145, 388, 0,
+	219, 159, 0, 0, 370, 416, 377, 408, 365, 398,
+	330, 387, 428, 355, 395, 429, 62, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	392, 423, 352, 394, 396, 319, 389, 0, 323, 326,
+	434, 419, 347, 348, 0, 0, 0, 0, 0, 0,
+	0, 369, 378, 404, 363, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 0, 386, 0, 0, 0, 327,
+	324, 0, 0, 367, 0, 0, 0, 329, 0, 346,
+	406, 310, 317, 125, 411, 418, 364, 275, 422, 362,
+	361, 425, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 415, 343, 351, 110, 349,
+	199, 178, 241, 385, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 405, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 322, 0, 220, 244, 259,
+	103, 338, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 334, 337, 332, 333, 380, 381,
+	430, 431, 432, 407, 328, 0, 335, 336, 0, 413,
+	420, 421, 384, 86, 95, 144, 437, 192, 121, 399,
+	409, 401, 109, 210, 403, 312, 239, 237, 253, 245,
+	318, 331, 114, 341, 0, 0, 353, 358, 359, 371,
+	373, 374, 375, 376, 383, 390, 391, 393, 400, 402,
+	410, 417, 436, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 424,
+	412, 0, 366, 427, 340, 356, 435, 357, 360, 397,
+	325, 379, 171, 354, 0, 344, 320, 350, 321, 342,
+	368, 116, 372, 339, 414, 382, 426, 143, 433, 145,
+	388, 0, 219, 159, 0, 0, 370, 416, 377, 408,
+	365, 398, 330, 387, 428, 355, 395, 429, 62, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 392, 423, 352, 394, 396, 319, 389, 0,
+	323, 326, 434, 419, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 369, 378, 404, 363, 0, 0, 0,
+	0, 0, 0, 0, 0, 345, 0, 386, 0, 0,
+	0, 327, 324, 0, 0, 367, 0, 0, 0, 329,
+	0, 346, 406, 0, 317, 125, 411, 418, 364, 275,
+	422, 362, 361, 425, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 415, 343, 351,
+	110, 349, 199, 178, 241, 385, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	238, 106, 209, 211, 405, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 99, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 322, 0, 220,
+	244, 259, 103, 338, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 163, 100, 133, 217, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 334, 337, 332, 333,
+	380, 381, 430, 431, 432, 407, 328, 0, 335, 336,
+	0, 413, 420, 421, 384, 86, 95, 144, 437, 192,
+	121, 399, 409, 401, 109, 210, 403, 124, 239, 237,
+	253, 245, 318, 331, 114, 341, 0, 0, 353, 358,
+	359, 371, 373, 374, 375, 376, 383, 390, 391, 393,
+	400, 402, 410, 417, 436, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 424, 412, 0, 366, 427, 340, 356, 435, 357,
+	360, 397, 325, 379, 171, 354, 0, 344, 320, 350,
+	321, 342, 368, 116, 372, 339, 414, 382, 426, 143,
+	433, 145, 388, 0, 219, 159, 0, 0, 370, 416,
+	377, 408, 365, 398, 330, 387, 428, 355, 395, 429,
+	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 392, 423, 352, 394, 396, 319,
+	389, 0, 323, 326, 434, 419, 347, 348, 0, 0,
+	0, 0, 0, 0, 0, 369, 378, 404, 363, 0,
+	0, 0, 0, 0, 0, 1262, 0, 345, 0, 386,
+	0, 0, 0, 327, 324, 0, 0, 367, 0, 0,
+	0, 329, 0, 346, 406, 0, 317, 125, 411, 418,
+	364, 275, 422, 362, 361, 425, 190, 0, 223, 128,
+	142, 101, 87, 97, 0, 127, 168, 197, 201, 415,
+	343, 351, 110, 349, 199, 178, 241, 385, 180, 198,
+	146, 229, 191, 240, 250, 251, 226, 248, 256, 216,
+	90, 225, 238, 106, 209, 211, 405, 92, 235, 222,
+	157, 137, 138, 91, 0, 195, 115, 122, 112, 170,
+	232, 233, 111, 258, 98, 247, 94, 99, 246, 164,
+	228, 236, 158, 151, 93, 234, 156, 150, 141, 119,
+	130, 188, 148, 189, 131, 161, 160, 162, 0, 322,
+	0, 220, 244, 259, 103, 338, 227, 254, 255, 0,
+	0, 104, 123, 118, 187, 163, 100, 133, 217, 140,
+	147, 194, 257, 177, 200, 107, 243, 218, 334, 337,
+	332, 333, 380, 381, 430, 431, 432, 407, 328, 0,
+	335, 336, 0, 413, 420, 421, 384, 86, 95, 144,
+	437, 192, 121, 399, 409, 401, 109, 210, 403, 124,
+	239, 237, 253, 245, 318, 331, 114, 341, 0, 0,
+	353, 358, 359, 371, 373, 374, 375, 376, 383, 390,
+	391, 393, 400, 402, 410, 417, 436, 88, 89, 96,
+	102, 108, 113, 117, 120, 126, 129, 132, 134, 135,
+	136, 139, 149, 152, 153, 154, 155, 165, 166, 167,
+	169, 172, 173, 174, 175, 176, 179, 181, 182, 183,
+	184, 185, 186, 193, 196, 202, 203, 204, 205, 206,
+	207, 208, 212, 213, 214, 215, 221, 224, 230, 231,
+	242, 249, 252, 424, 412, 0, 366, 427, 340, 356,
+	435, 357, 360, 397, 325, 379, 171, 354, 0, 344,
+	320, 350, 321, 342, 368, 116, 372, 339, 414, 382,
+	426, 143, 433, 145, 388, 0, 219, 159, 0, 0,
+	370, 416, 377, 408, 365, 398, 330, 387, 428, 355,
+	395, 429, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 392, 423, 352, 394,
+	396, 319, 389, 0, 323, 326, 434, 419, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 369, 378, 404,
+	363, 0, 0, 0, 0, 0, 0, 976, 0, 345,
+	0, 386, 0, 0, 0, 327, 324, 0, 0, 367,
+	0, 0, 0, 329, 0, 346, 406, 0, 317, 125,
+	411, 418, 364, 275, 422, 362, 361, 425, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 415, 343, 351, 110, 349, 199, 178, 241, 385,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 405, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 99,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 322, 0, 220, 244, 259, 103, 338, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 163, 100, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	334, 337, 332, 333, 380, 381, 430, 431, 432, 407,
+	328, 0, 335, 336, 0, 413, 420, 421, 384, 86,
+	95, 144, 437, 192, 121, 399, 409, 401, 109, 210,
+	403, 124, 239, 237, 253, 245, 318, 331, 114, 341,
+	0, 0, 353, 358, 359, 371, 373, 374, 375, 376,
+	383, 390, 391, 393, 400, 402, 410, 417, 436, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 424, 412, 0, 366, 427,
+	340, 356, 435, 357, 360, 397, 325, 379, 171, 354,
+	0, 344, 320, 350, 321, 342, 368, 116, 372, 339,
+	414, 382, 426, 143, 433, 145, 388, 0, 219, 159,
+	0, 0, 370, 416, 377, 408, 365, 398, 330, 387,
+	428, 355, 395, 429, 0, 0, 0, 457, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 392, 423,
+	352, 394, 396, 319, 389, 0, 323, 326, 434, 419,
+	347, 348, 0, 0, 0, 0, 0, 0, 0, 369,
+	378, 404, 363, 0, 0, 0, 0, 0, 0, 873,
+	0, 345, 0, 386, 0, 0, 0, 327, 324, 0,
+	0, 367, 0, 0, 0, 329, 0, 346, 406, 0,
+	317, 125, 411, 418, 364, 275, 422, 362, 361, 425,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 415, 343, 351, 110, 349, 199, 178,
+	241, 385, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	405, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 322, 0, 220, 244, 259, 103, 338,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 334, 337, 332, 333, 380, 381, 430, 431,
+	432, 407, 328, 0, 335, 336, 0, 413, 420, 421,
+	384, 86, 95, 144, 437, 192, 121, 399, 409, 401,
+	109, 210, 403, 124, 239, 237, 253, 245, 318, 331,
+	114, 341, 0, 0, 353, 358, 359, 371, 373, 374,
+	375, 376, 383, 390, 391, 393, 400, 402, 410, 417,
+	436, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 424, 412, 0,
+	366, 427, 340, 356, 435, 357, 360, 397, 325, 379,
+	171, 354, 0, 344, 320, 350, 321, 342, 368, 116,
+	372, 339, 414, 382, 426, 143, 433, 145, 388, 0,
+	219, 159, 0, 0, 370, 416, 377, 408, 365, 398,
+	330, 387, 428, 355, 395, 429, 62, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	392, 423, 352, 394, 396, 319, 389, 0, 323, 326,
+	434, 419, 347, 348, 0, 0, 0, 0, 0, 0,
+	0, 369, 378, 404, 363, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 0, 386, 0, 0, 0, 327,
+	324, 0, 0, 367, 0, 0, 0, 329, 0, 346,
+	406, 0, 317, 125, 411, 418, 364, 275, 422, 362,
+	361, 425, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 415, 343, 351, 110, 349,
+	199, 178, 241, 385, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 405, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 322, 0, 220, 244, 259,
+	103, 338, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 334, 337, 332, 333, 380, 381,
+	430, 431, 432, 407, 328, 0, 335, 336, 0, 413,
+	420, 421, 384, 86, 95, 144, 437, 192, 121, 399,
+	409, 401, 109, 210, 403, 312, 239, 237, 253, 245,
+	318, 331, 114, 341, 0, 0, 353, 358, 359, 371,
+	373, 374, 375, 376, 383, 390, 391, 393, 400, 402,
+	410, 417, 436, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 424,
+	412, 0, 366, 427, 340, 356, 435, 357, 360, 397,
+	325, 379, 171, 354, 0, 344, 320, 350, 321, 342,
+	368, 116, 372, 339, 414, 382, 426, 143, 433, 145,
+	388, 0, 219, 159, 0, 0, 370, 416, 377, 408,
+	365, 398, 330, 387, 428, 355, 395, 429, 0, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 392, 423, 352, 394, 396, 319, 389, 0,
+	323, 326, 434, 419, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 369, 378, 404, 363, 0, 0, 0,
+	0, 0, 0, 0, 0, 345, 0, 386, 0, 0,
+	0, 327, 324, 0, 0, 367, 0, 0, 0, 329,
+	0, 346, 406, 0, 317, 125, 411, 418, 364, 275,
+	422, 362, 361, 425, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 415, 343, 351,
+	110, 349, 199, 178, 241, 385, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	238, 106, 209, 211, 405, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 99, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 322, 0, 220,
+	244, 259, 103, 338, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 163, 100, 133, 217, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 334, 337, 332, 333,
+	380, 381, 430, 431, 432, 407, 328, 0, 335, 336,
+	0, 413, 420, 421, 384, 86, 95, 144, 437, 192,
+	121, 399, 409, 401, 109, 210, 403, 124, 239, 237,
+	253, 245, 318, 331, 114, 341, 0, 0, 353, 358,
+	359, 371, 373, 374, 375, 376, 383, 390, 391, 393,
+	400, 402, 410, 417, 436, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 424, 412, 0, 366, 427, 340, 356, 435, 357,
+	360, 397, 325, 379, 171, 354, 0, 344, 320, 350,
+	321, 342, 368, 116, 372, 339, 414, 382, 426, 143,
+	433, 145, 388, 0, 219, 159, 0, 0, 370, 416,
+	377, 408, 365, 398, 330, 387, 428, 355, 395, 429,
+	0, 0, 0, 457, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 392, 423, 352, 394, 396, 319,
+	389, 0, 323, 326, 434, 419, 347, 348, 0, 0,
+	0, 0, 0, 0, 0, 369, 378, 404, 363, 0,
+	0, 0, 0, 0, 0, 0, 0, 345, 0, 386,
+	0, 0, 0, 327, 324, 0, 0, 367, 0, 0,
+	0, 329, 0, 346, 406, 0, 317, 125, 411, 418,
+	364, 275, 422, 362, 361, 425, 190, 0, 223, 128,
+	142, 101, 87, 97, 0, 127, 168, 197, 201, 415,
+	343, 351, 110, 349, 199, 178, 241, 385, 180, 198,
+	146, 229, 191, 240, 250, 251, 226, 248, 256, 216,
+	90, 225, 238, 106, 209, 211, 405, 92, 235, 222,
+	157, 137, 138, 91, 0, 195, 115, 122, 112, 170,
+	232, 233, 111, 258, 98, 247, 94, 99, 246, 164,
+	228, 236, 158, 151, 93, 234, 156, 150, 141, 119,
+	130, 188, 148, 189, 131, 161, 160, 162, 0, 322,
+	0, 220, 244, 259, 103, 338, 227, 254, 255, 0,
+	0, 104, 123, 118, 187, 163, 100, 133, 217, 140,
+	147, 194, 257, 177, 200, 107, 243, 218, 334, 337,
+	332, 333, 380, 381, 430, 431, 432, 407, 328, 0,
+	335, 336, 0, 413, 420, 421, 384, 86, 95, 144,
+	437, 192, 121, 399, 409, 401, 109, 210, 403, 124,
+	239, 237, 253, 245, 318, 331, 114, 341, 0, 0,
+	353, 358, 359, 371, 373, 374, 375, 376, 383, 390,
+	391, 393, 400, 402, 410, 417, 436, 88, 89, 96,
+	102, 108, 113, 117, 120, 126, 129, 132, 134, 135,
+	136, 139, 149, 152, 153, 154, 155, 165, 166, 167,
+	169, 172, 173, 174, 175, 176, 179, 181, 182, 183,
+	184, 185, 186, 193, 196, 202, 203, 204, 205, 206,
+	207, 208, 212, 213, 214, 215, 221, 224, 230, 231,
+	242, 249, 252, 424, 412, 0, 366, 427, 340, 356,
+	435, 357, 360, 397, 325, 379, 171, 354, 0, 344,
+	320, 350, 321, 342, 368, 116, 372, 339, 414, 382,
+	426, 143, 433, 145, 388, 0, 219, 159, 0, 0,
+	370, 416, 377, 408, 365, 398, 330, 387, 428, 355,
+	395, 429, 0, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 392, 423, 352, 394,
+	396, 319, 389, 0, 323, 326, 434, 419, 347, 348,
+	0, 0, 0, 0, 0, 0, 0, 369, 378, 404,
+	363, 0, 0, 0, 0, 0, 0, 0, 0, 345,
+	0, 386, 0, 0, 0, 327, 324, 0, 0, 367,
+	0, 0, 0, 329, 0, 346, 406, 0, 317, 125,
+	411, 418, 364, 275, 422, 362, 361, 425, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 415, 343, 351, 110, 349, 199, 178, 241, 385,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 405, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 532,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 322, 0, 220, 244, 259, 103, 338, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 533, 531, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	334, 337, 332, 333, 380, 381, 430, 431, 432, 407,
+	328, 0, 335, 336, 0, 413, 420, 421, 384, 86,
+	95, 144, 437, 192, 121, 399, 409, 401, 109, 210,
+	403, 124, 239, 237, 253, 245, 318, 331, 114, 341,
+	0, 0, 353, 358, 359, 371, 373, 374, 375, 376,
+	383, 390, 391, 393, 400, 402, 410, 417, 436, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 424, 412, 0, 366, 427,
+	340, 356, 435, 357, 360, 397, 325, 379, 171, 354,
+	0, 344, 320, 350, 321, 342, 368, 116, 372, 339,
+	414, 382, 426, 143, 433, 145, 388, 0, 219, 159,
+	0, 0, 370, 416, 377, 408, 365, 398, 330, 387,
+	428, 355, 395, 429, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 392, 423,
+	352, 394, 396, 319, 389, 0, 323, 326, 434, 419,
+	347, 348, 0, 0, 0, 0, 0, 0, 0, 369,
+	378, 404, 363, 0, 0, 0, 0, 0, 0, 0,
+	0, 345, 0, 386, 0, 0, 0, 327, 324, 0,
+	0, 367, 0, 0, 0, 329, 0, 346, 406, 0,
+	317, 125, 411, 418, 364, 275, 422, 362, 361, 425,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 415, 343, 351, 110, 349, 199, 178,
+	241, 385, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	405, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 322, 0, 220, 244, 259, 103, 338,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 334, 337, 332, 333, 380, 381, 430, 431,
+	432, 407, 328, 0, 335, 336, 0, 413, 420, 421,
+	384, 86, 95, 144, 437, 192, 121, 399, 409, 401,
+	109, 210, 403, 124, 239, 237, 253, 245, 318, 331,
+	114, 341, 0, 0, 353, 358, 359, 371, 373, 374,
+	375, 376, 383, 390, 391, 393, 400, 402, 410, 417,
+	436, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 424, 412, 0,
+	366, 427, 340, 356, 435, 357, 360, 397, 325, 379,
+	171, 354, 0, 344, 320, 350, 321, 342, 368, 116,
+	372, 339, 414, 382, 426, 143, 433, 145, 388, 0,
+	219, 159, 0, 0, 370, 416, 377, 408, 365, 398,
+	330, 387, 428, 355, 395, 429, 0, 0, 0, 84,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	392, 423, 352, 394, 396, 319, 389, 0, 323, 326,
+	434, 419, 347, 348, 0, 0, 0, 0, 0, 0,
+	0, 369, 378, 404, 363, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 0, 386, 0, 0, 0, 327,
+	324, 0, 0, 367, 0, 0, 0, 329, 0, 346,
+	406, 0, 317, 125, 411, 418, 364, 275, 422, 362,
+	361, 425, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 415, 343, 351, 110, 349,
+	199, 178, 241, 385, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 734, 106,
+	209, 211, 405, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 532, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 322, 0, 220, 244, 259,
+	103, 338, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 533, 531, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 334, 337, 332, 333, 380, 381,
+	430, 431, 432, 407, 328, 0, 335, 336, 0, 413,
+	420, 421, 384, 86, 95, 144, 437, 192, 121, 399,
+	409, 401, 109, 210, 403, 124, 239, 237, 253, 245,
+	318, 331, 114, 341, 0, 0, 353, 358, 359, 371,
+	373, 374, 375, 376, 383, 390, 391, 393, 400, 402,
+	410, 417, 436, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 424,
+	412, 0, 366, 427, 340, 356, 435, 357, 360, 397,
+	325, 379, 171, 354, 0, 344, 320, 350, 321, 342,
+	368, 116, 372, 339, 414, 382, 426, 143, 433, 145,
+	388, 0, 219, 159, 0, 0, 370, 416, 377, 408,
+	365, 398, 330, 387, 428, 355, 395, 429, 0, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 392, 423, 352, 394, 396, 319, 389, 0,
+	323, 326, 434, 419, 347, 348, 0, 0, 0, 0,
+	0, 0, 0, 369, 378, 404, 363, 0, 0, 0,
+	0, 0, 0, 0, 0, 345, 0, 386, 0, 0,
+	0, 327, 324, 0, 0, 367, 0, 0, 0, 329,
+	0, 346, 406, 0, 317, 125, 411, 418, 364, 275,
+	422, 362, 361, 425, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 415, 343, 351,
+	110, 349, 199, 178, 241, 385, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	525, 106, 209, 211, 405, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 532, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 322, 0, 220,
+	244, 259, 103, 338, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 533, 531, 528, 527, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 334, 337, 332, 333,
+	380, 381, 430, 431, 432, 407, 328, 0, 335, 336,
+	0, 413, 420, 421, 384, 86, 95, 144, 437, 192,
+	121, 399, 409, 401, 109, 210, 403, 124, 239, 237,
+	253, 245, 318, 331, 114, 341, 0, 0, 353, 358,
+	359, 371, 373, 374, 375, 376, 383, 390, 391, 393,
+	400, 402, 410, 417, 436, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 171, 0, 0, 0, 0, 459, 0, 0, 0,
+	116, 0, 456, 0, 0, 0, 143, 499, 145, 0,
+	0, 219, 159, 0, 0, 0, 0, 490, 491, 0,
+	0, 0, 0, 0, 0, 983, 0, 62, 0, 0,
+	457, 478, 477, 480, 481, 482, 483, 0, 0, 105,
+	479, 484, 485, 486, 984, 0, 0, 454, 471, 0,
+	498, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	468, 469, 0, 0, 0, 0, 512, 0, 470, 0,
+	0, 465, 466, 467, 472, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 275, 0,
+	0, 510, 0, 190, 0, 223, 128, 142, 101, 87,
+	97, 0, 127, 168, 197, 201, 0, 0, 0, 110,
+	0, 199, 178, 241, 0, 180, 198, 146, 229, 191,
+	240, 250, 251, 226, 248, 256, 216, 90, 225, 238,
+	106, 209, 211, 0, 92, 235, 222, 157, 137, 138,
+	91, 0, 195, 115, 122, 112, 170, 232, 233, 111,
+	258, 98, 247, 94, 99, 246, 164, 228, 236, 158,
+	151, 93, 234, 156, 150, 141, 119, 130, 188, 148,
+	189, 131, 161, 160, 162, 0, 0, 0, 220, 244,
+	259, 103, 0, 227, 254, 255, 0, 0, 104, 123,
+	118, 187, 163, 100, 133, 217, 140, 147, 194, 257,
+	177, 200, 107, 243, 218, 500, 511, 506, 507, 504,
+	505, 503, 502, 501, 513, 492, 493, 494, 495, 497,
+	0, 508, 509, 496, 86, 95, 144, 0, 192, 121,
+	0, 0, 0, 109, 210, 0, 124, 239, 237, 253,
+	245, 0, 0, 114, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 96, 102, 108, 113,
+	117, 120, 126, 129, 132, 134, 135, 136, 139, 149,
+	152, 153, 154, 155, 165, 166, 167, 169, 172, 173,
+	174, 175, 176, 179, 181, 182, 183, 184, 185, 186,
+	193, 196, 202, 203, 204, 205, 206, 207, 208, 212,
+	213, 214, 215, 221, 224, 230, 231, 242, 249, 252,
+	57, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 459, 0, 0,
+	0, 116, 0, 456, 0, 0, 0, 143, 499, 145,
+	0, 0, 219, 159, 0, 0, 0, 0, 490, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 457, 478, 477, 480, 481, 482, 483, 0, 0,
+	105, 479, 484, 485, 486, 0, 0, 0, 454, 471,
+	0, 498, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 468, 469, 0, 0, 0, 0, 512, 0, 470,
+	0, 0, 465, 466, 467, 472, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 275,
+	0, 0, 510, 0, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 0, 0, 0,
+	110, 0, 199, 178, 241, 0, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	238, 106, 209, 211, 0, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 99, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 0, 0, 220,
+	244, 259, 103, 0, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 163, 100, 133, 217, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 500, 511, 506, 507,
+	504, 505, 503, 502, 501, 513, 492, 493, 494, 495,
+	497, 0, 508, 509, 496, 86, 95, 144, 58, 192,
+	121, 0, 0, 0, 109, 210, 0, 124, 239, 237,
+	253, 245, 0, 0, 114, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 171, 0, 0, 910, 0, 459, 0, 0, 0,
+	116, 0, 456, 0, 0, 0, 143, 499, 145, 0,
+	0, 219, 159, 0, 0, 0, 0, 490, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 62, 0, 0,
+	457, 478, 477, 480, 481, 482, 483, 0, 0, 105,
+	479, 484, 485, 486, 0, 0, 0, 454, 471, 0,
+	498, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	468, 469, 450, 0, 0, 0, 512, 0, 470, 0,
+	0, 465, 466, 467, 472, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 275, 0,
+	0, 510, 0, 190, 0, 223, 128, 142, 101, 87,
+	97, 0, 127, 168, 197, 201, 0, 0, 0, 110,
+	0, 199, 178, 241, 0, 180, 198, 146, 229, 191,
+	240, 250, 251, 226, 248, 256, 216, 90, 225, 238,
+	106, 209, 211, 0, 92, 235, 222, 157, 137, 138,
+	91, 0, 195, 115, 122, 112, 170, 232, 233, 111,
+	258, 98, 247, 94, 99, 246, 164, 228, 236, 158,
+	151, 93, 234, 156, 150, 141, 119, 130, 188, 148,
+	189, 131, 161, 160, 162, 0, 0, 0, 220, 244,
+	259, 103, 0, 227, 254, 255, 0, 0, 104, 123,
+	118, 187, 163, 100, 133, 217, 140, 147, 194, 257,
+	177, 200, 107, 243, 218, 500, 511, 506, 507, 504,
+	505, 503, 502, 501, 513, 492, 493, 494, 495, 497,
+	0, 508, 509, 496, 86, 95, 144, 0, 192, 121,
+	0, 0, 0, 109, 210, 0, 124, 239, 237, 253,
+	245, 0, 0, 114, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 96, 102, 108, 113,
+	117, 120, 126, 129, 132, 134, 135, 136, 139, 149,
+	152, 153, 154, 155, 165, 166, 167, 169, 172, 173,
+	174, 175, 176, 179, 181, 182, 183, 184, 185, 186,
+	193, 196, 202, 203, 204, 205, 206, 207, 208, 212,
+	213, 214, 215, 221, 224, 230, 231, 242, 249, 252,
+	171, 0, 0, 0, 0, 459, 0, 0, 0, 116,
+	0, 456, 0, 0, 0, 143, 499, 145, 0, 0,
+	219, 159, 0, 0, 0, 0, 490, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 620, 457,
+	478, 477, 480, 481, 482, 483, 0, 0, 105, 479,
+	484, 485, 486, 0, 0, 0, 454, 471, 0, 498,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 468,
+	469, 0, 0, 0, 0, 512, 0, 470, 0, 0,
+	465, 466, 467, 472, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 0, 0, 275, 0, 0,
+	510, 0, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 0, 0, 0, 110, 0,
+	199, 178, 241, 0, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 0, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 0, 0, 220, 244, 259,
+	103, 0, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 500, 511, 506, 507, 504, 505,
+	503, 502, 501, 513, 492, 493, 494, 495, 497, 0,
+	508, 509, 496, 86, 95, 144, 0, 192, 121, 0,
+	0, 0, 109, 210, 0, 124, 239, 237, 253, 245,
+	0, 0, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 171,
+	0, 0, 0, 0, 459, 0, 0, 0, 116, 0,
+	456, 0, 0, 0, 143, 499, 145, 0, 0, 219,
+	159, 0, 0, 0, 0, 490, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 62, 0, 0, 457, 478,
+	477, 480, 481, 482, 483, 0, 0, 105, 479, 484,
+	485, 486, 0, 0, 0, 454, 471, 0, 498, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 468, 469,
+	450, 0, 0, 0, 512, 0, 470, 0, 0, 465,
+	466, 467, 472, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 0, 0, 275, 0, 0, 510,
+	0, 190, 0, 223, 128, 142, 101, 87, 97, 0,
+	127, 168, 197, 201, 0, 0, 0, 110, 0, 199,
+	178, 241, 0, 180, 198, 146, 229, 191, 240, 250,
+	251, 226, 248, 256, 216, 90, 225, 238, 106, 209,
+	211, 0, 92, 235, 222, 157, 137, 138, 91, 0,
+	195, 115, 122, 112, 170, 232, 233, 111, 258, 98,
+	247, 94, 99, 246, 164, 228, 236, 158, 151, 93,
+	234, 156, 150, 141, 119, 130, 188, 148, 189, 131,
+	161, 160, 162, 0, 0, 0, 220, 244, 259, 103,
+	0, 227, 254, 255, 0, 0, 104, 123, 118, 187,
+	163, 100, 133, 217, 140, 147, 194, 257, 177, 200,
+	107, 243, 218, 500, 511, 506, 507, 504, 505, 503,
+	502, 501, 513, 492, 493, 494, 495, 497, 0, 508,
+	509, 496, 86, 95, 144, 0, 192, 121, 0, 0,
+	0, 109, 210, 0, 124, 239, 237, 253, 245, 0,
+	0, 114, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 89, 96, 102, 108, 113, 117, 120,
+	126, 129, 132, 134, 135, 136, 139, 149, 152, 153,
+	154, 155, 165, 166, 167, 169, 172, 173, 174, 175,
+	176, 179, 181, 182, 183, 184, 185, 186, 193, 196,
+	202, 203, 204, 205, 206, 207, 208, 212, 213, 214,
+	215, 221, 224, 230, 231, 242, 249, 252, 171, 0,
+	0, 0, 0, 459, 0, 0, 0, 116, 0, 456,
+	0, 0, 0, 143, 499, 145, 0, 0, 219, 159,
+	0, 0, 0, 0, 490, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 0, 457, 478, 925,
+	480, 481, 482, 483, 0, 0, 105, 479, 484, 485,
+	486, 0, 0, 0, 454, 471, 0, 498, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 468, 469, 450,
+	0, 0, 0, 512, 0, 470, 0, 0, 465, 466,
+	467, 472, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 0, 0, 275, 0, 0, 510, 0,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 0, 0, 0, 110, 0, 199, 178,
+	241, 0, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	0, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 0, 0, 220, 244, 259, 103, 0,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 500, 511, 506, 507, 504, 505, 503, 502,
+	501, 513, 492, 493, 494, 495, 497, 0, 508, 509,
+	496, 86, 95, 144, 0, 192, 121, 0, 0, 0,
+	109, 210, 0, 124, 239, 237, 253, 245, 0, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 171, 0, 0,
+	0, 0, 459, 0, 0, 0, 116, 0, 456, 0,
+	0, 0, 143, 499, 145, 0, 0, 219, 159, 0,
+	0, 0, 0, 490, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 0, 457, 478, 922, 480,
+	481, 482, 483, 0, 0, 105, 479, 484, 485, 486,
+	0, 0, 0, 454, 471, 0, 498, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 468, 469, 450, 0,
+	0, 0, 512, 0, 470, 0, 0, 465, 466, 467,
+	472, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 0, 0, 275, 0, 0, 510, 0, 190,
+	0, 223, 128, 142, 101, 87, 97, 0, 127, 168,
+	197, 201, 0, 0, 0, 110, 0, 199, 178, 241,
+	0, 180, 198, 146, 229, 191, 240, 250, 251, 226,
+	248, 256, 216, 90, 225, 238, 106, 209, 211, 0,
+	92, 235, 222, 157, 137, 138, 91, 0, 195, 115,
+	122, 112, 170, 232, 233, 111, 258, 98, 247, 94,
+	99, 246, 164, 228, 236, 158, 151, 93, 234, 156,
+	150, 141, 119, 130, 188, 148, 189, 131, 161, 160,
+	162, 0, 0, 0, 220, 244, 259, 103, 0, 227,
+	254, 255, 0, 0, 104, 123, 118, 187, 163, 100,
+	133, 217, 140, 147, 194, 257, 177, 200, 107, 243,
+	218, 500, 511, 506, 507, 504, 505, 503, 502, 501,
+	513, 492, 493, 494, 495, 497, 0, 508, 509, 496,
+	86, 95, 144, 0, 192, 121, 0, 0, 0, 109,
+	210, 0, 124, 239, 237, 253, 245, 0, 0, 114,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 89, 96, 102, 108, 113, 117, 120, 126, 129,
+	132, 134, 135, 136, 139, 149, 152, 153, 154, 155,
+	165, 166, 167, 169, 172, 173, 174, 175, 176, 179,
+	181, 182, 183, 184, 185, 186, 193, 196, 202, 203,
+	204, 205, 206, 207, 208, 212, 213, 214, 215, 221,
+	224, 230, 231, 242, 249, 252, 171, 0, 0, 0,
+	0, 459, 0, 0, 0, 116, 0, 456, 0, 0,
+	0, 143, 499, 145, 0, 0, 219, 159, 0, 0,
+	0, 0, 490, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 457, 478, 477, 480, 481,
+	482, 483, 0, 0, 105, 479, 484, 485, 486, 0,
+	0, 0, 454, 471, 0, 498, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 468, 469, 0, 0, 0,
+	0, 512, 0, 470, 0, 0, 465, 466, 467, 472,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 0, 0, 275, 0, 0, 510, 0, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 0, 0, 0, 110, 0, 199, 178, 241, 0,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 0, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 99,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 0, 0, 220, 244, 259, 103, 0, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 163, 100, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	500, 511, 506, 507, 504, 505, 503, 502, 501, 513,
+	492, 493, 494, 495, 497, 0, 508, 509, 496, 86,
+	95, 144, 0, 192, 121, 0, 0, 0, 109, 210,
+	0, 124, 239, 237, 253, 245, 0, 0, 114, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 116, 0, 0, 0, 0, 0,
+	143, 499, 145, 0, 0, 219, 159, 0, 0, 0,
+	0, 490, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 62, 0, 0, 457, 478, 477, 480, 481, 482,
+	483, 0, 0, 105, 479, 484, 485, 486, 0, 0,
+	0, 0, 471, 0, 498, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 468, 469, 0, 0, 0, 0,
+	512, 0, 470, 0, 0, 465, 466, 467, 472, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	0, 0, 275, 0, 0, 510, 0, 190, 0, 223,
+	128, 142, 101, 87, 97, 0, 127, 168, 197, 201,
+	0, 0, 0, 110, 0, 199, 178, 241, 1593, 180,
+	198, 146, 229, 191, 240, 250, 251, 226, 248, 256,
+	216, 90, 225, 238, 106, 209, 211, 0, 92, 235,
+	222, 157, 137, 138, 91, 0, 195, 115, 122, 112,
+	170, 232, 233, 111, 258, 98, 247, 94, 99, 246,
+	164, 228, 236, 158, 151, 93, 234, 156, 150, 141,
+	119, 130, 188, 148, 189, 131, 161, 160, 162, 0,
+	0, 0, 220, 244, 259, 103, 0, 227, 254, 255,
+	0, 0, 104, 123, 118, 187, 163, 100, 133, 217,
+	140, 147, 194, 257, 177, 200, 107, 243, 218, 500,
+	511, 506, 507, 504, 505, 503, 502, 501, 513, 492,
+	493, 494, 495, 497, 0, 508, 509, 496, 86, 95,
+	144, 0, 192, 121, 0, 0, 0, 109, 210, 0,
+	124, 239, 237, 253, 245, 0, 0, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 89,
+	96, 102, 108, 113, 117, 120, 126, 129, 132, 134,
+	135, 136, 139, 149, 152, 153, 154, 155, 165, 166,
+	167, 169, 172, 173, 174, 175, 176, 179, 181, 182,
+	183, 184, 185, 186, 193, 196, 202, 203, 204, 205,
+	206, 207, 208, 212, 213, 214, 215, 221, 224, 230,
+	231, 242, 249, 252, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 116, 0, 0, 0, 0, 0, 143,
+	499, 145, 0, 0, 219, 159, 0, 0, 0, 0,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 620, 457, 478, 477, 480, 481, 482, 483,
+	0, 0, 105, 479, 484, 485, 486, 0, 0, 0,
+	0, 471, 0, 498, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 468, 469, 0, 0, 0, 0, 512,
+	0, 470, 0, 0, 465, 466, 467, 472, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 0,
+	0, 275, 0, 0, 510, 0, 190, 0, 223, 128,
+	142, 101, 87, 97, 0, 127, 168, 197, 201, 0,
+	0, 0, 110, 0, 199, 178, 241, 0, 180, 198,
+	146, 229, 191, 240, 250, 251, 226, 248, 256, 216,
+	90, 225, 238, 106, 209, 211, 0, 92, 235, 222,
+	157, 137, 138, 91, 0, 195, 115, 122, 112, 170,
+	232, 233, 111, 258, 98, 247, 94, 99, 246, 164,
+	228, 236, 158, 151, 93, 234, 156, 150, 141, 119,
+	130, 188, 148, 189, 131, 161, 160, 162, 0, 0,
+	0, 220, 244, 259, 103, 0, 227, 254, 255, 0,
+	0, 104, 123, 118, 187, 163, 100, 133, 217, 140,
+	147, 194, 257, 177, 200, 107, 243, 218, 500, 511,
+	506, 507, 504, 505, 503, 502, 501, 513, 492, 493,
+	494, 495, 497, 0, 508, 509, 496, 86, 95, 144,
+	0, 192, 121, 0, 0, 0, 109, 210, 0, 124,
+	239, 237, 253, 245, 0, 0, 114, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 89, 96,
+	102, 108, 113, 117, 120, 126, 129, 132, 134, 135,
+	136, 139, 149, 152, 153, 154, 155, 165, 166, 167,
+	169, 172, 173, 174, 175, 176, 179, 181, 182, 183,
+	184, 185, 186, 193, 196, 202, 203, 204, 205, 206,
+	207, 208, 212, 213, 214, 215, 221, 224, 230, 231,
+	242, 249, 252, 171, 0, 0, 0, 0, 0, 0,
+	0, 0, 116, 0, 0, 0, 0, 0, 143, 499,
+	145, 0, 0, 219, 159, 0, 0, 0, 0, 490,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 62,
+	0, 0, 457, 478, 477, 480, 481, 482, 483, 0,
+	0, 105, 479, 484, 485, 486, 0, 0, 0, 0,
+	471, 0, 498, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 468, 469, 0, 0, 0, 0, 512, 0,
+	470, 0, 0, 465, 466, 467, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 0, 0,
+	275, 0, 0, 510, 0, 190, 0, 223, 128, 142,
+	101, 87, 97, 0, 127, 168, 197, 201, 0, 0,
+	0, 110, 0, 199, 178, 241, 0, 180, 198, 146,
+	229, 191, 240, 250, 251, 226, 248, 256, 216, 90,
+	225, 238, 106, 209, 211, 0, 92, 235, 222, 157,
+	137, 138, 91, 0, 195, 115, 122, 112, 170, 232,
+	233, 111, 258, 98, 247, 94, 99, 246, 164, 228,
+	236, 158, 151, 93, 234, 156, 150, 141, 119, 130,
+	188, 148, 189, 131, 161, 160, 162, 0, 0, 0,
+	220, 244, 259, 103, 0, 227, 254, 255, 0, 0,
+	104, 123, 118, 187, 163, 100, 133, 217, 140, 147,
+	194, 257, 177, 200, 107, 243, 218, 500, 511, 506,
+	507, 504, 505, 503, 502, 501, 513, 492, 493, 494,
+	495, 497, 0, 508, 509, 496, 86, 95, 144, 0,
+	192, 121, 0, 0, 0, 109, 210, 0, 124, 239,
+	237, 253, 245, 0, 0, 114, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 89, 96, 102,
+	108, 113, 117, 120, 126, 129, 132, 134, 135, 136,
+	139, 149, 152, 153, 154, 155, 165, 166, 167, 169,
+	172, 173, 174, 175, 176, 179, 181, 182, 183, 184,
+	185, 186, 193, 196, 202, 203, 204, 205, 206, 207,
+	208, 212, 213, 214, 215, 221, 224, 230, 231, 242,
+	249, 252, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 116, 0, 0, 0, 0, 0, 143, 0, 145,
+	0, 0, 219, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 640, 639, 649,
+	650, 642, 643, 644, 645, 646, 647, 648, 641, 0,
+	0, 651, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 275,
+	0, 0, 0, 0, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 0, 0, 0,
+	110, 0, 199, 178, 241, 0, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	238, 106, 209, 211, 0, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 99, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 0, 0, 220,
+	244, 259, 103, 0, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 163, 100, 133, 217, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 95, 144, 0, 192,
+	121, 0, 0, 0, 109, 210, 0, 124, 239, 237,
+	253, 245, 0, 0, 114, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 171, 0, 0, 0, 627, 0, 0, 0, 0,
+	116, 0, 0, 0, 0, 0, 143, 0, 145, 0,
+	0, 219, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 629, 0, 0, 0, 0, 0, 0, 105,
+	0, 0, 0, 0, 0, 624, 623, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 625, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 275, 0,
+	0, 0, 0, 190, 0, 223, 128, 142, 101, 87,
+	97, 0, 127, 168, 197, 201, 0, 0, 0, 110,
+	0, 199, 178, 241, 0, 180, 198, 146, 229, 191,
+	240, 250, 251, 226, 248, 256, 216, 90, 225, 238,
+	106, 209, 211, 0, 92, 235, 222, 157, 137, 138,
+	91, 0, 195, 115, 122, 112, 170, 232, 233, 111,
+	258, 98, 247, 94, 99, 246, 164, 228, 236, 158,
+	151, 93, 234, 156, 150, 141, 119, 130, 188, 148,
+	189, 131, 161, 160, 162, 0, 0, 0, 220, 244,
+	259, 103, 0, 227, 254, 255, 0, 0, 104, 123,
+	118, 187, 163, 100, 133, 217, 140, 147, 194, 257,
+	177, 200, 107, 243, 218, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 95, 144, 0, 192, 121,
+	0, 0, 0, 109, 210, 0, 124, 239, 237, 253,
+	245, 0, 0, 114, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 96, 102, 108, 113,
+	117, 120, 126, 129, 132, 134, 135, 136, 139, 149,
+	152, 153, 154, 155, 165, 166, 167, 169, 172, 173,
+	174, 175, 176, 179, 181, 182, 183, 184, 185, 186,
+	193, 196, 202, 203, 204, 205, 206, 207, 208, 212,
+	213, 214, 215, 221, 224, 230, 231, 242, 249, 252,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 0, 0, 143, 0, 145, 0, 0,
+	219, 159, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 362, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 353, 354, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 576, 320, 341, 340, 343, 344, 345,
-	346, 0, 0, 101, 342, 347, 348, 349, 0, 0,
-	0, 0, 334, 0, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 331, 332, 0, 0, 0, 0,
-	375, 0, 333, 0, 0, 328, 329, 330, 335, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 265, 0, 0, 373, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 363, 374, 369,
-	370, 367, 368, 366, 365, 364, 376, 355, 356, 357,
-	358, 360, 0, 371, 372, 359, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	362, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	353, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 320, 341, 340, 343, 344, 345, 346,
-	0, 0, 101, 342, 347, 348, 349, 0, 0, 0,
-	0, 334, 0, 361, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 332, 0, 0, 0, 0, 375,
-	0, 333, 0, 0, 328, 329, 330, 335, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 265, 0, 0, 373, 0, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 0,
-	0, 0, 105, 0, 193, 172, 231, 0, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 0, 0, 212,
-	234, 249, 99, 0, 219, 243, 244, 0, 0, 100,
-	118, 113, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 363, 374, 369, 370,
-	367, 368, 366, 365, 364, 376, 355, 356, 357, 358,
-	360, 0, 371, 372, 359, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 0, 0, 0,
-	0, 0, 111, 0, 0, 0, 0, 0, 137, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	0, 0, 621, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 598, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 600, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 595, 594, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 596, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 74, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 76, 77, 0, 73, 0,
-	0, 0, 78, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 75, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 929, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 931, 0, 0, 0, 0, 0, 0, 101, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 24,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	24, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 929, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 931, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 927, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 80,
-	0, 0, 824, 0, 0, 825, 0, 0, 101, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
-	712, 0, 0, 0, 137, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	711, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 0, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 111, 0, 0, 0,
-	0, 0, 137, 0, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 931, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 165, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 600, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 265, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 0, 78, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 80, 81, 0, 77, 0, 0,
+	0, 82, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 0, 0, 0, 110, 0,
+	199, 178, 241, 0, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 0, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 0, 0, 220, 244, 259,
+	103, 0, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 0, 79, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 95, 144, 0, 192, 121, 0,
+	0, 0, 109, 210, 0, 124, 239, 237, 253, 245,
+	0, 0, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 57,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
+	116, 0, 0, 0, 0, 0, 143, 0, 145, 0,
+	0, 219, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 62, 0, 0,
+	84, 0, 0, 0, 0, 0, 0, 0, 0, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 275, 0,
+	0, 0, 0, 190, 0, 223, 128, 142, 101, 87,
+	97, 0, 127, 168, 197, 201, 0, 0, 0, 110,
+	0, 199, 178, 241, 0, 180, 198, 146, 229, 191,
+	240, 250, 251, 226, 248, 256, 216, 90, 225, 238,
+	106, 209, 211, 0, 92, 235, 222, 157, 137, 138,
+	91, 0, 195, 115, 122, 112, 170, 232, 233, 111,
+	258, 98, 247, 94, 99, 246, 164, 228, 236, 158,
+	151, 93, 234, 156, 150, 141, 119, 130, 188, 148,
+	189, 131, 161, 160, 162, 0, 0, 0, 220, 244,
+	259, 103, 0, 227, 254, 255, 0, 0, 104, 123,
+	118, 187, 163, 100, 133, 217, 140, 147, 194, 257,
+	177, 200, 107, 243, 218, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 95, 144, 58, 192, 121,
+	0, 0, 0, 109, 210, 0, 124, 239, 237, 253,
+	245, 0, 0, 114, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 96, 102, 108, 113,
+	117, 120, 126, 129, 132, 134, 135, 136, 139, 149,
+	152, 153, 154, 155, 165, 166, 167, 169, 172, 173,
+	174, 175, 176, 179, 181, 182, 183, 184, 185, 186,
+	193, 196, 202, 203, 204, 205, 206, 207, 208, 212,
+	213, 214, 215, 221, 224, 230, 231, 242, 249, 252,
+	57, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 116, 0, 0, 0, 0, 0, 143, 0, 145,
+	0, 0, 219, 159, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 273, 0, 0, 0, 0, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 275,
+	0, 0, 0, 0, 190, 0, 223, 128, 142, 101,
+	87, 97, 0, 127, 168, 197, 201, 0, 0, 0,
+	110, 0, 199, 178, 241, 0, 180, 198, 146, 229,
+	191, 240, 250, 251, 226, 248, 256, 216, 90, 225,
+	238, 106, 209, 211, 0, 92, 235, 222, 157, 137,
+	138, 91, 0, 195, 115, 122, 112, 170, 232, 233,
+	111, 258, 98, 247, 94, 99, 246, 164, 228, 236,
+	158, 151, 93, 234, 156, 150, 141, 119, 130, 188,
+	148, 189, 131, 161, 160, 162, 0, 0, 0, 220,
+	244, 259, 103, 0, 227, 254, 255, 0, 0, 104,
+	123, 118, 187, 163, 100, 133, 217, 140, 147, 194,
+	257, 177, 200, 107, 243, 218, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 95, 144, 58, 192,
+	121, 0, 0, 0, 109, 210, 0, 124, 239, 237,
+	253, 245, 0, 0, 114, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 89, 96, 102, 108,
+	113, 117, 120, 126, 129, 132, 134, 135, 136, 139,
+	149, 152, 153, 154, 155, 165, 166, 167, 169, 172,
+	173, 174, 175, 176, 179, 181, 182, 183, 184, 185,
+	186, 193, 196, 202, 203, 204, 205, 206, 207, 208,
+	212, 213, 214, 215, 221, 224, 230, 231, 242, 249,
+	252, 171, 0, 0, 0, 966, 0, 0, 0, 0,
+	116, 0, 0, 0, 0, 0, 143, 0, 145, 0,
+	0, 219, 159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	273, 0, 968, 0, 0, 0, 0, 0, 0, 105,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 0, 0, 275, 0,
+	0, 0, 0, 190, 0, 223, 128, 142, 101, 87,
+	97, 0, 127, 168, 197, 201, 0, 0, 0, 110,
+	0, 199, 178, 241, 0, 180, 198, 146, 229, 191,
+	240, 250, 251, 226, 248, 256, 216, 90, 225, 238,
+	106, 209, 211, 0, 92, 235, 222, 157, 137, 138,
+	91, 0, 195, 115, 122, 112, 170, 232, 233, 111,
+	258, 98, 247, 94, 99, 246, 164, 228, 236, 158,
+	151, 93, 234, 156, 150, 141, 119, 130, 188, 148,
+	189, 131, 161, 160, 162, 0, 0, 0, 220, 244,
+	259, 103, 0, 227, 254, 255, 0, 0, 104, 123,
+	118, 187, 163, 100, 133, 217, 140, 147, 194, 257,
+	177, 200, 107, 243, 218, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 95, 144, 0, 192, 121,
+	0, 0, 0, 109, 210, 0, 124, 239, 237, 253,
+	245, 0, 0, 114, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 89, 96, 102, 108, 113,
+	117, 120, 126, 129, 132, 134, 135, 136, 139, 149,
+	152, 153, 154, 155, 165, 166, 167, 169, 172, 173,
+	174, 175, 176, 179, 181, 182, 183, 184, 185, 186,
+	193, 196, 202, 203, 204, 205, 206, 207, 208, 212,
+	213, 214, 215, 221, 224, 230, 231, 242, 249, 252,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 0, 0, 143, 0, 145, 0, 0,
+	219, 159, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 682, 111, 0, 0, 0, 0, 0,
-	137, 0, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 265, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 379, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 260, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
-	0, 0, 0, 0, 137, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 0, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 111, 0, 0, 0,
-	0, 0, 137, 0, 139, 0, 0, 211, 153, 0,
+	0, 0, 1090, 0, 0, 1091, 0, 0, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 0, 0, 275, 0, 0,
+	0, 0, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 0, 0, 0, 110, 0,
+	199, 178, 241, 0, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 0, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 0, 0, 220, 244, 259,
+	103, 0, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 95, 144, 0, 192, 121, 0,
+	0, 0, 109, 210, 0, 124, 239, 237, 253, 245,
+	0, 0, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 171,
+	0, 0, 0, 966, 0, 0, 0, 0, 116, 0,
+	0, 0, 0, 0, 143, 0, 145, 0, 0, 219,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	968, 0, 0, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 320, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242,
+	0, 0, 125, 0, 0, 0, 275, 0, 0, 0,
+	0, 190, 0, 223, 128, 142, 101, 87, 97, 0,
+	127, 168, 197, 201, 0, 0, 0, 110, 0, 199,
+	178, 241, 0, 964, 198, 146, 229, 191, 240, 250,
+	251, 226, 248, 256, 216, 90, 225, 238, 106, 209,
+	211, 0, 92, 235, 222, 157, 137, 138, 91, 0,
+	195, 115, 122, 112, 170, 232, 233, 111, 258, 98,
+	247, 94, 99, 246, 164, 228, 236, 158, 151, 93,
+	234, 156, 150, 141, 119, 130, 188, 148, 189, 131,
+	161, 160, 162, 0, 0, 0, 220, 244, 259, 103,
+	0, 227, 254, 255, 0, 0, 104, 123, 118, 187,
+	163, 100, 133, 217, 140, 147, 194, 257, 177, 200,
+	107, 243, 218, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 86, 95, 144, 0, 192, 121, 0, 0,
+	0, 109, 210, 0, 124, 239, 237, 253, 245, 0,
+	0, 114, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 89, 96, 102, 108, 113, 117, 120,
+	126, 129, 132, 134, 135, 136, 139, 149, 152, 153,
+	154, 155, 165, 166, 167, 169, 172, 173, 174, 175,
+	176, 179, 181, 182, 183, 184, 185, 186, 193, 196,
+	202, 203, 204, 205, 206, 207, 208, 212, 213, 214,
+	215, 221, 224, 230, 231, 242, 249, 252, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 116, 0, 743,
+	0, 0, 0, 143, 0, 145, 0, 0, 219, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 84, 0, 742,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 0, 0, 275, 0, 0, 0, 0,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 0, 0, 0, 110, 0, 199, 178,
+	241, 0, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	0, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 0, 0, 220, 244, 259, 103, 0,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 95, 144, 0, 192, 121, 0, 0, 0,
+	109, 210, 0, 124, 239, 237, 253, 245, 0, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 171, 0, 0,
+	0, 0, 0, 0, 0, 0, 116, 0, 0, 0,
+	0, 0, 143, 0, 145, 0, 0, 219, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 0, 273, 0, 0, 0,
+	0, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 0, 0, 275, 0, 0, 0, 0, 190,
+	0, 223, 128, 142, 101, 87, 97, 0, 127, 168,
+	197, 201, 0, 0, 0, 110, 0, 199, 178, 241,
+	0, 180, 198, 146, 229, 191, 240, 250, 251, 226,
+	248, 256, 216, 90, 225, 238, 106, 209, 211, 0,
+	92, 235, 222, 157, 137, 138, 91, 0, 195, 115,
+	122, 112, 170, 232, 233, 111, 258, 98, 247, 94,
+	99, 246, 164, 228, 236, 158, 151, 93, 234, 156,
+	150, 141, 119, 130, 188, 148, 189, 131, 161, 160,
+	162, 0, 0, 0, 220, 244, 259, 103, 0, 227,
+	254, 255, 0, 0, 104, 123, 118, 187, 163, 100,
+	133, 217, 140, 147, 194, 257, 177, 200, 107, 243,
+	218, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 95, 144, 0, 192, 121, 0, 0, 0, 109,
+	210, 0, 124, 239, 237, 253, 245, 0, 0, 114,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 89, 96, 102, 108, 113, 117, 120, 126, 129,
+	132, 134, 135, 136, 139, 149, 152, 153, 154, 155,
+	165, 166, 167, 169, 172, 173, 174, 175, 176, 179,
+	181, 182, 183, 184, 185, 186, 193, 196, 202, 203,
+	204, 205, 206, 207, 208, 212, 213, 214, 215, 221,
+	224, 230, 231, 242, 249, 252, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 116, 0, 0, 0, 0,
+	0, 143, 0, 145, 0, 0, 219, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 273, 0, 968, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 0, 0, 275, 0, 0, 0, 0, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 0, 0, 0, 110, 0, 199, 178, 241, 0,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 0, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 99,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 0, 0, 220, 244, 259, 103, 0, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 163, 100, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	95, 144, 0, 192, 121, 0, 0, 0, 109, 210,
+	0, 124, 239, 237, 253, 245, 0, 0, 114, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 116, 0, 0, 0, 0, 0,
+	143, 0, 145, 0, 0, 219, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 84, 0, 629, 0, 0, 0,
+	0, 0, 0, 105, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	0, 0, 275, 0, 0, 0, 0, 190, 0, 223,
+	128, 142, 101, 87, 97, 0, 127, 168, 197, 201,
+	0, 0, 0, 110, 0, 199, 178, 241, 0, 180,
+	198, 146, 229, 191, 240, 250, 251, 226, 248, 256,
+	216, 90, 225, 238, 106, 209, 211, 0, 92, 235,
+	222, 157, 137, 138, 91, 0, 195, 115, 122, 112,
+	170, 232, 233, 111, 258, 98, 247, 94, 99, 246,
+	164, 228, 236, 158, 151, 93, 234, 156, 150, 141,
+	119, 130, 188, 148, 189, 131, 161, 160, 162, 0,
+	0, 0, 220, 244, 259, 103, 0, 227, 254, 255,
+	0, 0, 104, 123, 118, 187, 163, 100, 133, 217,
+	140, 147, 194, 257, 177, 200, 107, 243, 218, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 95,
+	144, 0, 192, 121, 0, 0, 0, 109, 210, 0,
+	124, 239, 237, 253, 245, 0, 0, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 89,
+	96, 102, 108, 113, 117, 120, 126, 129, 132, 134,
+	135, 136, 139, 149, 152, 153, 154, 155, 165, 166,
+	167, 169, 172, 173, 174, 175, 176, 179, 181, 182,
+	183, 184, 185, 186, 193, 196, 202, 203, 204, 205,
+	206, 207, 208, 212, 213, 214, 215, 221, 224, 230,
+	231, 242, 249, 252, 171, 0, 0, 0, 0, 0,
+	0, 0, 713, 116, 0, 0, 0, 0, 0, 143,
+	0, 145, 0, 0, 219, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 0,
+	0, 275, 0, 0, 0, 0, 190, 0, 223, 128,
+	142, 101, 87, 97, 0, 127, 168, 197, 201, 0,
+	0, 0, 110, 0, 199, 178, 241, 0, 180, 198,
+	146, 229, 191, 240, 250, 251, 226, 248, 256, 216,
+	90, 225, 238, 106, 209, 211, 0, 92, 235, 222,
+	157, 137, 138, 91, 0, 195, 115, 122, 112, 170,
+	232, 233, 111, 258, 98, 247, 94, 99, 246, 164,
+	228, 236, 158, 151, 93, 234, 156, 150, 141, 119,
+	130, 188, 148, 189, 131, 161, 160, 162, 0, 0,
+	0, 220, 244, 259, 103, 0, 227, 254, 255, 0,
+	0, 104, 123, 118, 187, 163, 100, 133, 217, 140,
+	147, 194, 257, 177, 200, 107, 243, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 95, 144,
+	0, 192, 121, 0, 0, 0, 109, 210, 0, 124,
+	239, 237, 253, 245, 0, 0, 114, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 89, 96,
+	102, 108, 113, 117, 120, 126, 129, 132, 134, 135,
+	136, 139, 149, 152, 153, 154, 155, 165, 166, 167,
+	169, 172, 173, 174, 175, 176, 179, 181, 182, 183,
+	184, 185, 186, 193, 196, 202, 203, 204, 205, 206,
+	207, 208, 212, 213, 214, 215, 221, 224, 230, 231,
+	242, 249, 252, 517, 0, 0, 0, 0, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 0, 0, 143, 0, 145, 0, 0,
+	219, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 0, 0, 275, 0, 0,
+	0, 0, 190, 0, 223, 128, 142, 101, 87, 97,
+	0, 127, 168, 197, 201, 0, 0, 0, 110, 0,
+	199, 178, 241, 0, 180, 198, 146, 229, 191, 240,
+	250, 251, 226, 248, 256, 216, 90, 225, 238, 106,
+	209, 211, 0, 92, 235, 222, 157, 137, 138, 91,
+	0, 195, 115, 122, 112, 170, 232, 233, 111, 258,
+	98, 247, 94, 99, 246, 164, 228, 236, 158, 151,
+	93, 234, 156, 150, 141, 119, 130, 188, 148, 189,
+	131, 161, 160, 162, 0, 0, 0, 220, 244, 259,
+	103, 0, 227, 254, 255, 0, 0, 104, 123, 118,
+	187, 163, 100, 133, 217, 140, 147, 194, 257, 177,
+	200, 107, 243, 218, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 95, 144, 0, 192, 121, 0,
+	0, 0, 109, 210, 0, 124, 239, 237, 253, 245,
+	0, 0, 114, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 89, 96, 102, 108, 113, 117,
+	120, 126, 129, 132, 134, 135, 136, 139, 149, 152,
+	153, 154, 155, 165, 166, 167, 169, 172, 173, 174,
+	175, 176, 179, 181, 182, 183, 184, 185, 186, 193,
+	196, 202, 203, 204, 205, 206, 207, 208, 212, 213,
+	214, 215, 221, 224, 230, 231, 242, 249, 252, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 116, 0,
+	0, 0, 0, 0, 143, 0, 145, 0, 0, 219,
+	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	0, 0, 0, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 0, 0, 275, 0, 0, 0,
+	0, 190, 0, 223, 128, 142, 101, 87, 97, 0,
+	127, 168, 197, 201, 0, 0, 0, 110, 0, 199,
+	178, 241, 0, 180, 198, 146, 229, 191, 240, 250,
+	251, 226, 248, 256, 216, 90, 225, 238, 106, 209,
+	211, 0, 92, 235, 222, 157, 137, 138, 91, 0,
+	195, 115, 122, 112, 170, 232, 233, 111, 258, 98,
+	247, 94, 99, 246, 164, 228, 236, 158, 151, 93,
+	234, 156, 150, 141, 119, 130, 188, 148, 189, 131,
+	161, 160, 162, 0, 0, 0, 220, 244, 259, 103,
+	0, 227, 254, 255, 0, 0, 104, 123, 118, 187,
+	163, 100, 133, 217, 140, 147, 194, 257, 177, 200,
+	107, 243, 218, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 86, 95, 144, 0, 192, 121, 0, 0,
+	0, 109, 210, 445, 124, 239, 237, 253, 245, 0,
+	0, 114, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 89, 96, 102, 108, 113, 117, 120,
+	126, 129, 132, 134, 135, 136, 139, 149, 152, 153,
+	154, 155, 165, 166, 167, 169, 172, 173, 174, 175,
+	176, 179, 181, 182, 183, 184, 185, 186, 193, 196,
+	202, 203, 204, 205, 206, 207, 208, 212, 213, 214,
+	215, 221, 224, 230, 231, 242, 249, 252, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 116, 0, 0,
+	0, 0, 0, 143, 0, 145, 0, 0, 219, 159,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 270, 0, 275, 0, 0, 0, 0,
+	190, 0, 223, 128, 142, 101, 87, 97, 0, 127,
+	168, 197, 201, 0, 0, 0, 110, 0, 199, 178,
+	241, 0, 180, 198, 146, 229, 191, 240, 250, 251,
+	226, 248, 256, 216, 90, 225, 238, 106, 209, 211,
+	0, 92, 235, 222, 157, 137, 138, 91, 0, 195,
+	115, 122, 112, 170, 232, 233, 111, 258, 98, 247,
+	94, 99, 246, 164, 228, 236, 158, 151, 93, 234,
+	156, 150, 141, 119, 130, 188, 148, 189, 131, 161,
+	160, 162, 0, 0, 0, 220, 244, 259, 103, 0,
+	227, 254, 255, 0, 0, 104, 123, 118, 187, 163,
+	100, 133, 217, 140, 147, 194, 257, 177, 200, 107,
+	243, 218, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 95, 144, 0, 192, 121, 0, 0, 0,
+	109, 210, 0, 124, 239, 237, 253, 245, 0, 0,
+	114, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 89, 96, 102, 108, 113, 117, 120, 126,
+	129, 132, 134, 135, 136, 139, 149, 152, 153, 154,
+	155, 165, 166, 167, 169, 172, 173, 174, 175, 176,
+	179, 181, 182, 183, 184, 185, 186, 193, 196, 202,
+	203, 204, 205, 206, 207, 208, 212, 213, 214, 215,
+	221, 224, 230, 231, 242, 249, 252, 171, 0, 0,
+	0, 0, 0, 0, 0, 0, 116, 0, 0, 0,
+	0, 0, 143, 0, 145, 0, 0, 219, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 0, 0, 275, 0, 0, 0, 0, 190,
+	0, 223, 128, 142, 101, 87, 97, 0, 127, 168,
+	197, 201, 0, 0, 0, 110, 0, 199, 178, 241,
+	0, 180, 198, 146, 229, 191, 240, 250, 251, 226,
+	248, 256, 216, 90, 225, 238, 106, 209, 211, 0,
+	92, 235, 222, 157, 137, 138, 91, 0, 195, 115,
+	122, 112, 170, 232, 233, 111, 258, 98, 247, 94,
+	99, 246, 164, 228, 236, 158, 151, 93, 234, 156,
+	150, 141, 119, 130, 188, 148, 189, 131, 161, 160,
+	162, 0, 0, 0, 220, 244, 259, 103, 0, 227,
+	254, 255, 0, 0, 104, 123, 118, 187, 163, 100,
+	133, 217, 140, 147, 194, 257, 177, 200, 107, 243,
+	218, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 95, 144, 0, 192, 121, 0, 0, 0, 109,
+	210, 0, 124, 239, 237, 253, 245, 0, 0, 114,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 89, 96, 102, 108, 113, 117, 120, 126, 129,
+	132, 134, 135, 136, 139, 149, 152, 153, 154, 155,
+	165, 166, 167, 169, 172, 173, 174, 175, 176, 179,
+	181, 182, 183, 184, 185, 186, 193, 196, 202, 203,
+	204, 205, 206, 207, 208, 212, 213, 214, 215, 221,
+	224, 230, 231, 242, 249, 252, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 116, 0, 0, 0, 0,
+	0, 143, 0, 145, 0, 0, 219, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 0, 0, 275, 0, 0, 0, 0, 190, 0,
+	223, 128, 142, 101, 87, 97, 0, 127, 168, 197,
+	201, 0, 0, 0, 110, 0, 199, 178, 241, 0,
+	180, 198, 146, 229, 191, 240, 250, 251, 226, 248,
+	256, 216, 90, 225, 238, 106, 209, 211, 0, 92,
+	235, 222, 157, 137, 138, 91, 0, 195, 115, 122,
+	112, 170, 232, 233, 111, 258, 98, 247, 94, 99,
+	246, 164, 228, 236, 158, 151, 93, 234, 156, 150,
+	141, 119, 130, 188, 148, 189, 131, 161, 160, 162,
+	0, 0, 0, 220, 244, 259, 103, 0, 227, 254,
+	255, 0, 0, 104, 123, 118, 187, 163, 100, 133,
+	217, 140, 147, 194, 257, 177, 200, 107, 243, 218,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	95, 144, 0, 192, 121, 0, 0, 0, 109, 210,
+	0, 124, 239, 237, 253, 245, 0, 0, 114, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	89, 96, 102, 108, 113, 117, 120, 126, 129, 132,
+	134, 135, 136, 139, 149, 152, 153, 154, 155, 165,
+	166, 167, 169, 172, 173, 174, 175, 176, 179, 181,
+	182, 183, 184, 185, 186, 193, 196, 202, 203, 204,
+	205, 206, 207, 208, 212, 213, 214, 215, 221, 224,
+	230, 231, 242, 249, 252, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 116, 0, 0, 0, 0, 0,
+	143, 0, 145, 0, 0, 219, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 457, 0, 0, 0, 0, 0,
+	0, 0, 0, 105, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	0, 0, 275, 0, 0, 0, 0, 190, 0, 223,
+	128, 142, 101, 87, 97, 0, 127, 168, 197, 201,
+	0, 0, 0, 110, 0, 199, 178, 241, 0, 180,
+	198, 146, 229, 191, 240, 250, 251, 226, 248, 256,
+	216, 90, 225, 238, 106, 209, 211, 0, 92, 235,
+	222, 157, 137, 138, 91, 0, 195, 115, 122, 112,
+	170, 232, 233, 111, 258, 98, 247, 94, 99, 246,
+	164, 228, 236, 158, 151, 93, 234, 156, 150, 141,
+	119, 130, 188, 148, 189, 131, 161, 160, 162, 0,
+	0, 0, 220, 244, 259, 103, 0, 227, 254, 255,
+	0, 0, 104, 123, 118, 187, 163, 100, 133, 217,
+	140, 147, 194, 257, 177, 200, 107, 243, 218, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 95,
+	144, 0, 192, 121, 0, 0, 0, 109, 210, 0,
+	124, 239, 237, 253, 245, 0, 0, 114, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 89,
+	96, 102, 108, 113, 117, 120, 126, 129, 132, 134,
+	135, 136, 139, 149, 152, 153, 154, 155, 165, 166,
+	167, 169, 172, 173, 174, 175, 176, 179, 181, 182,
+	183, 184, 185, 186, 193, 196, 202, 203, 204, 205,
+	206, 207, 208, 212, 213, 214, 215, 221, 224, 230,
+	231, 242, 249, 252, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 116, 0, 0, 0, 0, 0, 143,
+	0, 145, 0, 0, 219, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 0,
+	0, 275, 0, 0, 0, 0, 190, 0, 223, 128,
+	142, 101, 87, 97, 0, 127, 168, 197, 201, 0,
+	0, 0, 110, 0, 199, 178, 241, 0, 180, 198,
+	146, 229, 191, 240, 250, 251, 226, 248, 256, 216,
+	90, 225, 238, 106, 209, 591, 0, 92, 235, 222,
+	157, 137, 138, 91, 0, 195, 115, 122, 112, 170,
+	232, 233, 111, 258, 98, 247, 94, 99, 246, 164,
+	228, 236, 158, 151, 93, 234, 156, 150, 141, 119,
+	130, 188, 148, 189, 131, 161, 160, 162, 0, 0,
+	0, 220, 244, 259, 103, 0, 227, 254, 255, 0,
+	0, 104, 123, 118, 187, 163, 100, 133, 217, 140,
+	147, 194, 257, 177, 200, 107, 243, 218, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 95, 144,
+	0, 192, 121, 0, 0, 0, 109, 210, 0, 124,
+	239, 237, 253, 245, 0, 0, 114, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 89, 96,
+	102, 108, 113, 117, 120, 126, 129, 132, 134, 135,
+	136, 139, 149, 152, 153, 154, 155, 165, 166, 167,
+	169, 172, 173, 174, 175, 176, 179, 181, 182, 183,
+	184, 185, 186, 193, 196, 202, 203, 204, 205, 206,
+	207, 208, 212, 213, 214, 215, 221, 224, 230, 231,
+	242, 249, 252,
 }
 var yyPact = [...]int{
 
-	1877, -1000, -267, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 940, 995, -1000, -1000, -1000, -1000, -1000, -1000,
-	255, 11283, 36, 110, -5, 15262, 109, 1522, 15920, -1000,
-	13, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -80, -91,
-	-1000, 687, -1000, -1000, -1000, -1000, -1000, 914, 938, 778,
-	907, 822, -1000, 7981, 77, 77, 14933, 6665, -1000, -1000,
-	212, 15920, 101, 15920, -147, 80, 80, 80, -1000, -1000,
+	175, -1000, -271, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 758, -1000, -1000, -1000,
+	-1000, -1000, 358, 12492, 84, 184, 79, 16930, 176, 1534,
+	17608, -1000, 54, -1000, 148, 17269, 49, -1000, -1000, -1000,
+	-1000, -25, -46, 3862, -1000, 950, 984, -1000, 16591, -1000,
+	-1000, 112, -1000, -1000, -1000, -1000, 9441, -1000, 140, 140,
+	16252, 7734, -1000, -1000, 390, 17608, 180, 17608, -92, 136,
+	136, 136, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2561,21 +2789,13 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	107, 15920, 186, -1000, 15920, 75, 531, 75, 75, 75,
-	15920, -1000, 141, -1000, -1000, -1000, 15920, 525, 873, 3587,
-	55, 3587, -1000, 3587, 3587, -1000, 3587, 24, 3587, -83,
-	955, 25, -44, -1000, 3587, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 538, 885,
-	9309, 9309, 940, -1000, 687, -1000, -1000, -1000, 862, -1000,
-	-1000, 274, 972, -1000, 10954, 139, -1000, 9309, 1633, 634,
-	-1000, -1000, 634, -1000, -1000, 128, -1000, -1000, 10296, 10296,
-	10296, 10296, 10296, 10296, 10296, 10296, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	634, -1000, 8980, 634, 634, 634, 634, 634, 634, 634,
-	634, 9309, 634, 634, 634, 634, 634, 634, 634, 634,
-	634, 634, 634, 634, 634, 634, 634, 14597, 13610, 15920,
-	743, 702, -1000, -1000, 138, 689, 6323, -97, -1000, -1000,
-	-1000, 206, 13281, -1000, -1000, -1000, 870, -1000, -1000, -1000,
+	163, 17608, 274, -1000, 17608, 130, 607, 130, 130, 130,
+	17608, -1000, 251, -1000, -1000, -1000, 17608, 606, 878, 4214,
+	122, 4214, -1000, 4214, 4214, -1000, 4214, 62, 4214, -27,
+	960, 63, 35, -1000, 4214, -1000, -1000, -1000, -1000, -1000,
+	18286, -1000, 17269, -1000, -1000, -1000, -1000, -1000, -1000, 119,
+	5622, -1000, 342, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2587,294 +2807,323 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 619, 15920, -1000, 2291, -1000,
-	516, 3587, 92, 490, 244, 487, 15920, 15920, 3587, 31,
-	64, 60, 15920, 693, 90, 15920, 901, 785, 15920, 477,
-	473, -1000, 5981, -1000, 3587, 3587, -1000, -1000, -1000, 3587,
-	3587, 3587, 15920, 3587, 3587, -1000, -1000, -1000, -1000, 3587,
-	3587, -1000, 966, 262, -1000, -1000, -1000, -1000, 9309, 176,
-	-1000, 784, -1000, -1000, -1000, -1000, -1000, -1000, 988, 172,
-	446, 136, 692, -1000, 384, 914, 538, 822, 12952, 787,
-	-1000, -1000, 15920, -1000, 9309, 9309, 494, -1000, 14268, -1000,
-	-1000, 4613, 179, 10296, 346, 272, 10296, 10296, 10296, 10296,
-	10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296,
-	10296, 382, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	466, -1000, 687, 574, 574, 151, 151, 151, 151, 151,
-	151, 151, 10625, 7323, 538, 617, 322, 8980, 7981, 7981,
-	9309, 9309, 8639, 8310, 7981, 908, 215, 322, 16249, -1000,
-	-1000, 9967, -1000, -1000, -1000, -1000, -1000, 538, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15591, 15591, 7981, 7981, 7981,
-	7981, 46, 15920, -1000, 652, 961, -1000, -1000, -1000, 903,
-	12294, 12623, 46, 655, 13610, 15920, -1000, -1000, 13610, 15920,
-	4271, 5639, 689, -97, 657, -1000, -126, -103, 6994, 147,
-	-1000, -1000, -1000, -1000, 3245, 253, 558, 293, -58, -1000,
-	-1000, -1000, 737, -1000, 737, 737, 737, 737, -27, -27,
-	-27, -27, -1000, -1000, -1000, -1000, -1000, 757, 755, -1000,
-	737, 737, 737, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 942,
+	948, 737, 935, 822, 686, 17608, -1000, 699, 545, 968,
+	-1000, 12153, 247, -1000, 10458, 1783, 699, -1000, -1000, 699,
+	-1000, -1000, 215, -1000, -1000, 11475, 11475, 11475, 11475, 11475,
+	11475, 11475, 11475, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 699, -1000, 8424,
+	699, 699, 699, 699, 699, 699, 699, 699, 10458, 699,
+	699, 699, 699, 699, 699, 699, 699, 699, 699, 699,
+	699, 699, 699, 699, 458, 15906, 14889, 17608, 681, 665,
+	-1000, -1000, 246, 685, 7382, -68, -1000, -1000, -1000, 339,
+	14550, -1000, 877, -1000, 605, 17608, -1000, 2452, -1000, 601,
+	4214, 141, 600, 385, 599, 17608, 17608, 4214, 70, 125,
+	98, 17608, 687, 147, 17608, 922, 783, 17608, 597, 588,
+	-1000, 7030, -1000, 4214, 4214, -1000, 4214, 4214, 4214, 17608,
+	4214, 4214, -1000, -1000, -1000, -1000, 4214, 4214, -1000, 967,
+	371, -1000, -1000, -1000, -1000, 10458, 294, -1000, 780, -1000,
+	-1000, 17269, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	112, -1000, -105, 887, 10458, 10458, 950, -1000, 112, -1000,
+	-1000, -1000, 874, -1000, -1000, 17608, 686, 925, 17269, -1000,
+	-1000, 17608, -1000, 10458, 10458, 511, -1000, 15567, -1000, -1000,
+	5270, 297, 242, 11475, 481, 317, 11475, 11475, 11475, 11475,
+	11475, 11475, 11475, 11475, 11475, 11475, 11475, 11475, 11475, 11475,
+	11475, 500, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	577, -1000, 112, 726, 726, 259, 259, 259, 259, 259,
+	259, 259, 11814, 8763, 545, 587, 346, 8424, 9441, 9441,
+	10458, 10458, 10119, 9780, 9441, 936, 349, 346, 17947, -1000,
+	-1000, 11136, -1000, -1000, -1000, -1000, -1000, 545, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 17269, 17269, 9441, 9441, 9441,
+	9441, -1000, 104, 17608, -1000, 674, 800, -1000, -1000, -1000,
+	924, 13194, 14211, 104, 614, 14889, 17608, -1000, -1000, 14889,
+	17608, 4918, 6678, 685, -68, 638, -1000, -42, -60, 8073,
+	257, -1000, -1000, -1000, -1000, 3510, 304, 610, 402, -8,
+	-1000, -1000, -1000, 702, -1000, 702, 702, 702, 702, 24,
+	24, 24, 24, -1000, -1000, -1000, -1000, -1000, 738, 724,
+	-1000, 702, 702, 702, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 754, 754, 754, 740, 740, 759, -1000, 15920, 3587,
-	898, 3587, -1000, 76, -1000, 15920, 15920, 15920, 15920, 15920,
-	117, 15920, 15920, 688, -1000, 15920, 3587, -1000, -1000, -1000,
+	-1000, -1000, 714, 714, 714, 706, 706, 762, -1000, 17608,
+	4214, 906, 4214, -1000, 197, -1000, 17608, 17608, 17608, 17608,
+	17608, 192, 17608, 17608, 683, -1000, 17608, 4214, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15920, 313, 15920, 15920, 322, -1000, 425, 15920,
-	-1000, 835, 9309, 9309, 5297, 9309, -1000, -1000, -1000, 885,
-	-1000, 908, 945, -1000, 860, 847, 7981, -1000, -1000, 179,
-	227, -1000, -1000, 329, -1000, -1000, -1000, -1000, 135, 634,
-	-1000, 2130, -1000, -1000, -1000, -1000, 346, 10296, 10296, 10296,
-	409, 2130, 2088, 789, 722, 151, 269, 269, 155, 155,
-	155, 155, 155, 393, 393, -1000, -1000, -1000, 538, -1000,
-	-1000, -1000, 538, 7981, 668, -1000, -1000, 9309, -1000, 538,
-	611, 611, 514, 459, 238, 960, 611, 229, 959, 611,
-	611, 7981, 254, -1000, 9309, 538, -1000, 134, -1000, 633,
-	664, 661, 611, 538, 611, 611, 684, 634, -1000, 16249,
-	13610, 13610, 13610, 13610, 13610, -1000, 814, 813, -1000, 804,
-	798, 805, 15920, -1000, 615, 12294, 163, 634, -1000, 13939,
-	-1000, -1000, 954, 13610, 632, -1000, 632, -1000, 133, -1000,
-	-1000, 657, -97, -125, -1000, -1000, -1000, -1000, 322, -1000,
-	402, 656, 2903, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	748, 463, -1000, 889, 199, 203, 458, 882, -1000, -1000,
-	-1000, 876, -1000, 260, -105, -1000, -1000, 391, -27, -27,
-	-1000, -1000, 147, 869, 147, 147, 147, 424, 424, -1000,
-	-1000, -1000, -1000, 363, -1000, -1000, -1000, 341, -1000, 783,
-	15591, 3587, -1000, -1000, -1000, -1000, 347, 347, 198, -1000,
+	-1000, -1000, -1000, 17608, 378, 17608, 17608, 346, -1000, 523,
+	17608, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 979, 287,
+	455, 682, -1000, 405, 942, 545, 822, 13872, 795, -1000,
+	-1000, 699, 555, -1000, -1000, 297, 377, -1000, -1000, 414,
+	-1000, -1000, -1000, -1000, 235, 699, -1000, 6326, 1929, -1000,
+	-1000, -1000, -1000, 481, 11475, 11475, 11475, 404, 1929, 2395,
+	962, 837, 259, 354, 354, 227, 227, 227, 227, 227,
+	673, 673, -1000, -1000, -1000, 545, -1000, -1000, -1000, 545,
+	9441, 680, -1000, -1000, 10458, -1000, 545, 583, 583, 460,
+	449, 327, 966, 583, 324, 965, 583, 583, 9441, 434,
+	-1000, 10458, 545, -1000, 224, -1000, 1642, 660, 653, 583,
+	545, 583, 583, 156, 699, -1000, 17947, 14889, 14889, 14889,
+	14889, 14889, -1000, 815, 805, -1000, 814, 808, 854, 17608,
+	-1000, 585, 13194, 219, 699, -1000, 15228, -1000, -1000, 958,
+	14889, 669, -1000, 669, -1000, 221, -1000, -1000, 638, -68,
+	-63, -1000, -1000, -1000, -1000, 346, -1000, 504, 634, 3158,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 711, 540, -1000,
+	895, 253, 248, 538, 892, -1000, -1000, -1000, 880, -1000,
+	425, -21, -1000, -1000, 490, 24, 24, -1000, -1000, 257,
+	875, 257, 257, 257, 517, 517, -1000, -1000, -1000, -1000,
+	483, -1000, -1000, -1000, 482, -1000, 779, 17269, 4214, -1000,
+	-1000, -1000, -1000, 357, 357, 299, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 101, 743, -1000,
+	-1000, -1000, -1000, 55, 69, 146, -1000, 4214, -1000, 371,
+	-1000, 515, 10458, -1000, -1000, -1000, -1000, -1000, 856, 10458,
+	10458, 10458, -1000, -1000, -1000, 887, -1000, 936, 947, -1000,
+	869, 867, 9441, -1000, -1000, -1000, 17269, -1000, -1000, -1000,
+	4566, 9441, 218, -1000, 404, 1929, 2310, -1000, 11475, 11475,
+	-1000, -157, 583, 9441, 346, -1000, -1000, -1000, 229, 500,
+	229, 11475, 11475, -1000, 11475, 11475, -1000, -103, 664, 370,
+	-1000, 10458, 308, -1000, 6326, -1000, 11475, 11475, -1000, -1000,
+	-1000, -1000, 778, 17947, 699, -1000, 12843, 17269, 670, -1000,
+	334, 800, 753, 777, 840, -1000, -1000, -1000, -1000, 798,
+	-1000, 797, -1000, -1000, -1000, -1000, -1000, 178, 174, 173,
+	17269, -1000, 950, 10458, 669, -1000, -1000, 275, -1000, -1000,
+	-75, -52, -1000, -1000, -1000, 3510, -1000, 3510, 17269, 120,
+	-1000, 538, 538, -1000, -1000, -1000, 708, 772, 11475, -1000,
+	-1000, -1000, 575, 257, 257, -1000, 284, -1000, -1000, -1000,
+	581, -1000, 574, 633, 570, 17608, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	45, 576, -1000, -1000, -1000, -1000, -1, 30, 83, -1000,
-	3587, -1000, 262, -1000, 416, 9309, -1000, -1000, -1000, -1000,
-	827, 322, 322, 131, -1000, -1000, 15920, -1000, -1000, -1000,
-	-1000, 669, -1000, -1000, -1000, 3929, 7981, -1000, 409, 2130,
-	2055, -1000, 10296, 10296, -1000, -1000, 611, 7981, 322, -1000,
-	-1000, -1000, 98, 382, 98, 10296, 10296, -1000, 10296, 10296,
-	-1000, -165, 691, 210, -1000, 9309, 317, -1000, 5297, -1000,
-	10296, 10296, -1000, -1000, -1000, -1000, 782, 16249, 634, -1000,
-	11953, 15591, 643, -1000, 204, 961, 747, 776, 604, -1000,
-	-1000, -1000, -1000, 812, -1000, 796, -1000, -1000, -1000, -1000,
-	-1000, 100, 97, 94, 15591, -1000, 940, 9309, 632, -1000,
-	-1000, 164, -1000, -1000, -130, -108, -1000, -1000, -1000, 3245,
-	-1000, 3245, 15591, 61, -1000, 458, 458, -1000, -1000, -1000,
-	741, 761, 10296, -1000, -1000, -1000, 554, 147, 147, -1000,
-	200, -1000, -1000, -1000, 583, -1000, 571, 642, 545, 15920,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15920, -1000, -1000,
-	-1000, -1000, -1000, 15591, -173, 457, 15591, 15591, 15591, 15920,
-	-1000, 313, -1000, 322, -1000, 4955, -1000, 954, 13610, -1000,
-	-1000, 538, -1000, 10296, 2130, 2130, -1000, -1000, 538, 737,
-	737, -1000, 737, 740, -1000, 737, 3, 737, 2, 538,
-	538, 2040, 1999, 1869, 1843, 634, -160, -1000, 322, 9309,
-	-1000, 1705, 1118, -1000, 892, 553, 596, -1000, -1000, 7652,
-	538, 541, 127, 536, -1000, 940, 16249, 9309, -1000, -1000,
-	9309, 739, -1000, 9309, -1000, -1000, -1000, 634, 634, 634,
-	536, 914, 322, -1000, -1000, -1000, -1000, 2903, -1000, 529,
-	-1000, 737, -1000, -1000, -1000, 15591, -51, 981, 2130, -1000,
-	-1000, -1000, -1000, -1000, -27, 415, -27, 326, -1000, 305,
-	3587, -1000, -1000, -1000, -1000, 894, -1000, 4955, -1000, -1000,
-	731, 744, -1000, -1000, -1000, 952, 631, -1000, 2130, -1000,
-	-1000, 118, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	10296, 10296, 10296, 10296, 10296, 538, 410, 322, 10296, 10296,
-	881, -1000, 634, -1000, -1000, 711, 15591, 15591, -1000, 15591,
-	914, -1000, 322, 322, 15591, 322, 15591, 15591, 15591, 11612,
-	-1000, 149, 15591, -1000, 523, -1000, 191, -1000, -75, 147,
-	-1000, 147, 546, 542, -1000, 634, 626, -1000, 194, 15591,
-	15920, 943, 916, -1000, -1000, 633, 633, 633, 633, 51,
-	-1000, -1000, 633, 633, 979, -1000, 634, -1000, 687, 123,
-	-1000, -1000, -1000, 521, 503, 503, 503, 163, 149, -1000,
-	441, 189, 404, -1000, 58, 15591, 271, 880, -1000, 879,
-	-1000, -1000, -1000, -1000, -1000, 43, 4955, 3245, 485, -1000,
-	-1000, 9309, 9309, -1000, -1000, -1000, -1000, 538, 44, -177,
-	-1000, -1000, 16249, 596, 538, 15591, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 298, -1000, -1000, 15920, -1000, -1000, 395,
-	-1000, -1000, 482, -1000, 15591, -1000, -1000, 576, 322, 575,
-	-1000, 826, -171, -181, 562, -1000, -1000, -1000, 704, -1000,
-	-1000, 43, 843, -173, -1000, 825, -1000, 15591, -1000, 37,
-	-1000, -175, 432, 35, -178, 749, 634, -182, 690, -1000,
-	984, 9638, -1000, -1000, 977, 177, 177, 633, 538, -1000,
-	-1000, -1000, 65, 345, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 17608, -1000, -1000, -1000, -1000, -1000, 17269,
+	-134, 530, 17269, 17269, 17269, 17608, -1000, 378, -1000, 346,
+	852, 346, 346, -1000, -1000, 17608, -1000, -1000, -1000, -1000,
+	661, -1000, -1000, -1000, 545, 5974, -1000, 11475, 1929, 1929,
+	-1000, 699, -1000, -1000, 545, 702, 702, -1000, 702, 706,
+	-1000, 702, 41, 702, 40, 545, 545, 2202, 2039, 2001,
+	1841, 699, -101, -1000, 346, 10458, -1000, 1798, 1733, -1000,
+	897, 613, 629, -1000, -1000, 9102, 545, 568, 198, 565,
+	-1000, 950, 17947, 10458, -1000, -1000, 10458, 705, -1000, 10458,
+	-1000, -1000, -1000, 699, 699, 699, 565, 942, 346, -1000,
+	-1000, -1000, -1000, 3158, -1000, 562, -1000, 702, -1000, -1000,
+	-1000, 17269, 1, 976, 1929, -1000, -1000, -1000, -1000, -1000,
+	24, 514, 24, 479, -1000, 474, 4214, -1000, -1000, -1000,
+	-1000, 902, -1000, 5974, -1000, -1000, 701, 745, -1000, -1000,
+	-1000, -1000, -1000, 958, 14889, -1000, 1929, 100, -1000, -1000,
+	162, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 11475,
+	11475, 11475, 11475, 11475, 545, 507, 346, 11475, 11475, 885,
+	-1000, 699, -1000, -1000, 114, 17269, 17269, -1000, 17269, 942,
+	-1000, 346, 346, 17269, 346, 17269, 17269, 17269, 13533, -1000,
+	222, 17269, -1000, 559, 272, -1000, -95, 257, -1000, 257,
+	571, 549, -1000, 699, 632, -1000, 322, 17269, 17608, 956,
+	630, 950, 946, -1000, -1000, 1642, 1642, 1642, 1642, 139,
+	-1000, -1000, 1642, 1642, 975, -1000, 699, -1000, 112, 196,
+	-1000, -1000, -1000, 557, 555, 555, 555, 219, 222, -1000,
+	526, 313, 501, -1000, 116, 454, 883, -1000, 876, -1000,
+	-1000, -1000, -1000, -1000, 99, 5974, 3510, 552, -1000, 954,
+	945, -167, 10458, -1000, -1000, -1000, -1000, 545, 93, -141,
+	-1000, -1000, 17947, 629, 545, 17269, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 470, -1000, -1000, 17608, -1000, 497, -1000,
+	-1000, 548, -1000, 17269, -1000, -1000, 743, -1000, 10458, 10458,
+	545, 46, -1000, -1000, 622, -1000, 848, -132, -149, 625,
+	-1000, -1000, -1000, 700, -1000, -1000, 99, 866, -134, 346,
+	622, -1000, -1000, 78, -218, -171, -219, -1000, 11475, -1000,
+	827, -1000, 17269, -1000, 95, -1000, 406, -1000, -1000, -1000,
+	-1000, -1000, 11814, -138, 544, 89, 78, -1000, -144, 767,
+	699, -1000, -155, 764, -1000, 964, 10797, -1000, -1000, 973,
+	220, 220, 1642, 545, -1000, -1000, -1000, 124, 456, -1000,
+	-1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1202, 55, 407, 1201, 1196, 1195, 1192, 1190, 1187,
-	1186, 1185, 1184, 1183, 1182, 1181, 1169, 1168, 1167, 1164,
-	1163, 1161, 1159, 1157, 1155, 1153, 96, 1151, 1150, 1149,
-	66, 1147, 81, 1138, 1137, 40, 918, 41, 45, 827,
-	1136, 26, 71, 46, 1135, 35, 1134, 1133, 88, 1131,
-	1130, 57, 1126, 1124, 92, 1122, 73, 1121, 11, 43,
-	1120, 1119, 1118, 1117, 70, 329, 1116, 1115, 16, 1114,
-	1108, 84, 1107, 50, 7, 14, 13, 22, 1106, 906,
-	25, 1105, 58, 1104, 1100, 1097, 1096, 27, 1095, 64,
-	1094, 18, 59, 1093, 9, 78, 33, 21, 5, 75,
-	63, 1089, 24, 60, 49, 1087, 1086, 460, 1085, 1084,
-	42, 1083, 1082, 28, 1081, 97, 388, 1080, 1079, 1077,
-	1076, 52, 0, 489, 137, 69, 1074, 1073, 1072, 1521,
-	38, 54, 17, 1071, 53, 20, 37, 1070, 1069, 34,
-	1068, 1067, 1061, 1060, 1058, 1057, 1055, 116, 1054, 1053,
-	1048, 19, 79, 1046, 1045, 51, 23, 1042, 1038, 1037,
-	47, 65, 1033, 1032, 48, 29, 1030, 1029, 1028, 1027,
-	1026, 31, 10, 1025, 12, 1024, 15, 1023, 30, 1021,
-	4, 1020, 8, 1019, 3, 1017, 6, 44, 1, 1016,
-	2, 1015, 1012, 61, 623, 76, 1008, 77,
+	0, 1286, 61, 926, 84, 1285, 1284, 1282, 96, 95,
+	89, 1280, 1279, 1277, 1273, 1272, 1271, 1270, 1268, 1261,
+	1258, 1255, 1253, 1252, 1251, 1250, 1249, 1247, 1242, 1239,
+	1238, 103, 1237, 1232, 1230, 87, 75, 1229, 58, 1228,
+	1227, 55, 143, 60, 45, 85, 1218, 69, 68, 63,
+	1214, 30, 1211, 1207, 80, 1206, 1195, 50, 1194, 1193,
+	1716, 1192, 73, 1191, 16, 56, 1190, 1184, 1183, 1181,
+	10, 230, 1179, 1178, 13, 1176, 1175, 88, 1174, 70,
+	9, 15, 18, 24, 1164, 373, 28, 1161, 57, 1151,
+	1131, 1121, 1114, 48, 1113, 36, 1112, 42, 1111, 1108,
+	1107, 8, 1106, 1105, 1104, 83, 79, 1102, 33, 1100,
+	7, 66, 27, 25, 6, 81, 67, 1098, 22, 64,
+	49, 1096, 1094, 519, 1093, 1091, 40, 1090, 1088, 26,
+	1087, 99, 472, 1085, 1083, 1082, 1081, 47, 0, 980,
+	119, 77, 1079, 1078, 1077, 1644, 52, 51, 17, 1076,
+	43, 180, 37, 1075, 1073, 35, 1071, 1067, 1053, 1051,
+	1050, 1049, 1048, 396, 1047, 1046, 1042, 20, 21, 1033,
+	1032, 65, 23, 1031, 1027, 1025, 44, 74, 1023, 1022,
+	53, 31, 1021, 1020, 1011, 1010, 1007, 29, 14, 1006,
+	19, 1003, 12, 1002, 32, 1001, 3, 999, 11, 996,
+	4, 995, 5, 39, 1, 994, 2, 993, 992, 632,
+	537, 82, 991, 144,
 }
 var yyR1 = [...]int{
 
-	0, 191, 192, 192, 1, 1, 1, 1, 1, 1,
+	0, 207, 208, 208, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
-	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 9, 195, 195, 48, 48, 49, 49, 95, 95,
-	10, 10, 10, 10, 100, 100, 104, 104, 104, 105,
-	105, 105, 105, 137, 137, 11, 11, 11, 11, 11,
-	11, 11, 186, 186, 185, 184, 184, 183, 183, 182,
-	17, 167, 169, 169, 168, 168, 168, 168, 161, 140,
-	140, 140, 140, 143, 143, 141, 141, 141, 141, 141,
-	141, 141, 141, 141, 142, 142, 142, 142, 142, 144,
-	144, 144, 144, 144, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 146,
-	146, 146, 146, 146, 146, 146, 146, 160, 160, 147,
-	147, 155, 155, 156, 156, 156, 153, 153, 154, 154,
-	157, 157, 157, 149, 149, 150, 150, 158, 158, 151,
-	151, 151, 152, 152, 152, 159, 159, 159, 159, 159,
-	148, 148, 162, 162, 177, 177, 176, 176, 176, 166,
-	166, 173, 173, 173, 173, 173, 164, 164, 165, 165,
-	175, 175, 174, 163, 163, 178, 178, 178, 178, 189,
-	190, 188, 188, 188, 188, 188, 170, 170, 170, 171,
-	171, 171, 172, 172, 172, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 181, 179, 179, 180, 180, 13, 18, 18,
-	14, 14, 14, 14, 14, 15, 15, 19, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 111, 111, 109, 109,
-	112, 112, 110, 110, 110, 113, 113, 113, 114, 114,
-	138, 138, 138, 21, 21, 23, 23, 24, 25, 22,
-	22, 22, 22, 22, 22, 22, 16, 196, 26, 27,
-	27, 28, 28, 28, 32, 32, 32, 30, 30, 31,
-	31, 37, 37, 36, 36, 38, 38, 38, 38, 126,
-	126, 126, 125, 125, 40, 40, 41, 41, 42, 42,
-	43, 43, 43, 43, 57, 57, 94, 94, 96, 96,
-	44, 44, 44, 44, 45, 45, 46, 46, 47, 47,
-	133, 133, 132, 132, 132, 131, 131, 50, 50, 50,
-	52, 51, 51, 51, 51, 53, 53, 55, 55, 54,
-	54, 56, 58, 58, 58, 58, 59, 59, 39, 39,
-	39, 39, 39, 39, 39, 108, 108, 61, 61, 60,
-	60, 60, 60, 60, 60, 60, 60, 60, 60, 72,
-	72, 72, 72, 72, 72, 62, 62, 62, 62, 62,
-	62, 62, 35, 35, 73, 73, 73, 79, 74, 74,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 69, 69, 69, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 197, 197, 71, 70, 70, 70,
-	70, 70, 70, 33, 33, 33, 33, 33, 136, 136,
-	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
-	139, 139, 139, 83, 83, 34, 34, 81, 81, 82,
-	84, 84, 80, 80, 80, 64, 64, 64, 64, 64,
-	64, 64, 64, 66, 66, 66, 85, 85, 86, 86,
-	87, 87, 88, 88, 89, 90, 90, 90, 91, 91,
-	91, 91, 92, 92, 92, 63, 63, 63, 63, 63,
-	63, 93, 93, 93, 93, 97, 97, 75, 75, 77,
-	77, 76, 78, 98, 98, 102, 99, 99, 103, 103,
-	103, 103, 101, 101, 101, 128, 128, 128, 106, 106,
-	115, 115, 116, 116, 107, 107, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 118, 118, 118, 119,
-	119, 120, 120, 120, 127, 127, 123, 123, 124, 124,
-	129, 129, 130, 130, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	193, 194, 134, 135, 135, 135,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 3,
+	3, 3, 104, 104, 105, 105, 106, 107, 107, 7,
+	4, 5, 5, 6, 6, 8, 8, 34, 34, 9,
+	10, 10, 10, 10, 211, 211, 54, 54, 55, 55,
+	111, 111, 11, 11, 11, 11, 116, 116, 120, 120,
+	120, 121, 121, 121, 121, 153, 153, 12, 12, 12,
+	12, 12, 12, 12, 202, 202, 201, 200, 200, 199,
+	199, 198, 18, 183, 185, 185, 184, 184, 184, 184,
+	177, 156, 156, 156, 156, 159, 159, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 158, 158, 158, 158,
+	158, 160, 160, 160, 160, 160, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 162, 162, 162, 162, 162, 162, 162, 162, 176,
+	176, 163, 163, 171, 171, 172, 172, 172, 169, 169,
+	170, 170, 173, 173, 173, 165, 165, 166, 166, 174,
+	174, 167, 167, 167, 168, 168, 168, 175, 175, 175,
+	175, 175, 164, 164, 178, 178, 193, 193, 192, 192,
+	192, 182, 182, 189, 189, 189, 189, 189, 180, 180,
+	181, 181, 191, 191, 190, 179, 179, 194, 194, 194,
+	194, 205, 206, 204, 204, 204, 204, 204, 186, 186,
+	186, 187, 187, 187, 188, 188, 188, 13, 13, 13,
+	13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
+	13, 13, 203, 203, 203, 203, 203, 203, 203, 203,
+	203, 203, 203, 203, 197, 195, 195, 196, 196, 14,
+	19, 19, 15, 15, 15, 15, 15, 16, 16, 20,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 127, 127,
+	125, 125, 128, 128, 126, 126, 126, 129, 129, 129,
+	130, 130, 154, 154, 154, 22, 22, 26, 26, 27,
+	28, 28, 28, 29, 30, 23, 23, 23, 23, 23,
+	23, 24, 24, 24, 24, 35, 35, 35, 35, 25,
+	25, 25, 25, 17, 212, 31, 32, 32, 33, 33,
+	33, 38, 38, 38, 36, 36, 37, 37, 43, 43,
+	42, 42, 44, 44, 44, 44, 142, 142, 142, 141,
+	141, 46, 46, 47, 47, 48, 48, 49, 49, 49,
+	49, 63, 63, 110, 110, 112, 112, 50, 50, 50,
+	50, 51, 51, 52, 52, 53, 53, 149, 149, 148,
+	148, 148, 147, 147, 56, 56, 56, 58, 57, 57,
+	57, 57, 59, 59, 61, 61, 60, 60, 62, 64,
+	64, 64, 64, 65, 65, 45, 45, 45, 45, 45,
+	45, 45, 124, 124, 67, 67, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 66, 78, 78, 78, 78,
+	78, 78, 68, 68, 68, 68, 68, 68, 68, 41,
+	41, 79, 79, 79, 85, 80, 80, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 75,
+	75, 75, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 213, 213, 77, 76, 76, 76, 76, 76, 76,
+	98, 98, 99, 99, 100, 100, 100, 102, 102, 101,
+	101, 101, 101, 101, 103, 103, 39, 39, 39, 39,
+	39, 152, 152, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 89, 89, 40, 40,
+	87, 87, 88, 90, 90, 86, 86, 86, 70, 70,
+	70, 70, 70, 70, 70, 70, 72, 72, 72, 91,
+	91, 92, 92, 93, 93, 94, 94, 95, 96, 96,
+	96, 97, 97, 97, 97, 108, 108, 108, 69, 69,
+	69, 69, 69, 69, 109, 109, 109, 109, 113, 113,
+	81, 81, 83, 83, 82, 84, 114, 114, 118, 115,
+	115, 119, 119, 119, 119, 117, 117, 117, 144, 144,
+	144, 122, 122, 131, 131, 132, 132, 123, 123, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 134,
+	134, 134, 135, 135, 136, 136, 136, 143, 143, 139,
+	139, 140, 140, 145, 145, 146, 146, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 209, 210, 150, 151, 151, 151,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 6, 7, 5, 10, 1,
-	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
-	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
-	3, 4, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 8, 4, 6,
-	5, 5, 0, 2, 1, 0, 2, 1, 3, 3,
-	4, 4, 2, 4, 1, 3, 3, 3, 8, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
-	0, 1, 2, 0, 2, 0, 3, 0, 1, 0,
-	3, 3, 0, 2, 2, 0, 2, 1, 2, 1,
-	0, 2, 5, 4, 1, 2, 2, 3, 2, 0,
-	1, 2, 3, 3, 2, 2, 1, 1, 0, 1,
-	1, 3, 2, 3, 1, 10, 11, 11, 12, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 3, 1,
-	2, 3, 1, 1, 1, 6, 7, 7, 7, 7,
-	4, 5, 7, 5, 5, 5, 12, 7, 5, 9,
+	1, 1, 1, 1, 1, 1, 0, 1, 2, 4,
+	6, 7, 2, 3, 1, 3, 4, 0, 3, 5,
+	10, 1, 3, 1, 3, 7, 8, 1, 1, 9,
+	8, 7, 6, 6, 1, 1, 1, 3, 1, 3,
+	0, 4, 3, 4, 5, 4, 1, 3, 3, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 2, 8,
+	4, 6, 5, 5, 0, 2, 1, 0, 2, 1,
+	3, 3, 4, 4, 2, 4, 1, 3, 3, 3,
+	8, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 1, 4, 4, 2, 2,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 6,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
+	0, 1, 0, 1, 2, 0, 2, 0, 3, 0,
+	1, 0, 3, 3, 0, 2, 2, 0, 2, 1,
+	2, 1, 0, 2, 5, 4, 1, 2, 2, 3,
+	2, 0, 1, 2, 3, 3, 2, 2, 1, 1,
+	0, 1, 1, 3, 2, 3, 1, 10, 11, 11,
+	12, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	3, 1, 2, 3, 1, 1, 1, 6, 7, 7,
+	7, 7, 4, 5, 7, 5, 5, 5, 12, 7,
+	5, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
+	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
+	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
+	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
+	2, 4, 4, 3, 3, 5, 2, 3, 1, 1,
+	0, 1, 1, 1, 0, 2, 2, 0, 2, 2,
+	0, 2, 0, 1, 1, 2, 1, 1, 2, 1,
+	1, 3, 4, 2, 3, 2, 2, 2, 2, 3,
+	3, 3, 4, 2, 3, 3, 3, 3, 3, 1,
+	1, 1, 1, 2, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 0, 1, 0, 1,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
+	1, 0, 2, 1, 3, 1, 1, 1, 3, 1,
+	3, 3, 7, 1, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 5, 5, 0, 2, 1, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 2, 3, 1, 1, 1, 1, 5,
+	5, 6, 4, 4, 6, 6, 6, 8, 8, 8,
+	8, 9, 7, 5, 4, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
+	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
+	0, 6, 0, 3, 0, 2, 5, 1, 1, 2,
+	2, 2, 2, 2, 1, 3, 0, 3, 4, 7,
+	3, 1, 1, 2, 3, 3, 1, 2, 2, 1,
+	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
+	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
+	1, 0, 2, 4, 4, 0, 2, 4, 2, 1,
+	3, 5, 4, 6, 1, 3, 3, 5, 0, 5,
+	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 3, 1, 2, 1, 1, 1,
+	1, 1, 1, 0, 2, 0, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 1, 0, 1, 1, 0, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 7, 1, 3, 8, 8, 3, 3, 5,
-	4, 6, 5, 4, 4, 3, 2, 3, 4, 4,
-	3, 4, 4, 4, 4, 4, 4, 3, 2, 3,
-	3, 2, 3, 4, 3, 7, 5, 4, 2, 4,
-	4, 3, 3, 5, 2, 3, 1, 1, 0, 1,
-	1, 1, 0, 2, 2, 0, 2, 2, 0, 2,
-	0, 1, 1, 2, 1, 1, 2, 1, 1, 2,
-	2, 2, 2, 2, 3, 3, 2, 0, 2, 0,
-	2, 1, 2, 2, 0, 1, 1, 0, 1, 0,
-	1, 0, 1, 1, 3, 1, 2, 3, 5, 0,
-	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
-	1, 3, 1, 3, 3, 7, 1, 3, 1, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 3, 0, 5, 5, 5, 0, 2, 1, 3,
-	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
-	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
-	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 3, 1, 3,
-	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
-	1, 1, 4, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 7, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
-	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
-	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
-	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
-	4, 4, 0, 2, 4, 2, 1, 3, 5, 4,
-	6, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
-	0, 2, 0, 3, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2904,317 +3153,335 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -191, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 115, 116, 118, 117, 150, 119, 143, 50, 164,
-	165, 167, 168, 25, 144, 145, 148, 149, 31, 32,
-	121, -193, 8, 249, 54, -192, 347, -87, 15, -28,
-	5, -26, -196, -26, -26, -26, -26, -26, -167, -169,
-	54, 90, -120, 125, 72, 241, 122, 123, 129, -123,
-	57, -122, 259, 136, 291, 292, 164, 175, 169, 196,
-	188, 260, 293, 137, 186, 189, 228, 135, 294, 216,
-	223, 66, 167, 237, 295, 146, 184, 180, 296, 268,
-	178, 27, 297, 225, 201, 298, 264, 179, 224, 121,
-	299, 139, 133, 300, 202, 206, 301, 229, 302, 303,
-	304, 173, 174, 305, 231, 200, 134, 33, 261, 35,
-	154, 232, 204, 306, 199, 195, 307, 308, 309, 310,
-	198, 172, 194, 39, 208, 207, 209, 227, 191, 311,
-	312, 313, 140, 314, 181, 18, 315, 316, 317, 318,
-	319, 235, 149, 320, 152, 321, 322, 323, 324, 325,
-	326, 226, 203, 205, 130, 156, 263, 327, 233, 177,
-	328, 141, 153, 148, 236, 142, 329, 330, 331, 332,
-	333, 334, 335, 168, 336, 337, 338, 339, 163, 230,
-	239, 38, 213, 340, 171, 132, 341, 165, 160, 218,
-	192, 155, 342, 343, 182, 183, 197, 170, 193, 166,
-	157, 150, 344, 238, 214, 265, 190, 187, 161, 345,
-	158, 159, 346, 219, 220, 162, 262, 234, 185, 215,
-	-107, 125, 220, 127, 123, 123, 124, 125, 241, 122,
-	123, -54, -129, 57, -122, 125, 123, 108, 189, 228,
-	115, 217, 225, 124, 33, 226, 156, -138, 123, -109,
-	216, 219, 220, 162, 57, 230, 229, 221, -129, 166,
-	-134, -134, -134, -134, -134, 218, 218, -134, -2, -91,
-	17, 16, -5, -3, -193, 6, 20, 21, -32, 40,
-	41, -27, -38, 99, -39, -129, -60, 74, -65, 29,
-	57, -122, 23, -64, -61, -80, -78, -79, 108, 109,
-	110, 97, 98, 105, 75, 111, -69, -67, -68, -70,
-	59, 58, 67, 60, 61, 62, 63, 68, 69, 70,
-	-123, -76, -193, 44, 45, 250, 251, 252, 253, 258,
-	254, 77, 34, 240, 248, 247, 246, 244, 245, 242,
-	243, 256, 257, 128, 241, 103, 249, -107, -107, 11,
-	-48, -49, -54, -56, -129, -99, -137, 166, -103, 230,
-	229, -124, -101, -123, -121, 228, 189, 227, 120, 266,
-	73, 22, 24, 211, 76, 108, 16, 77, 107, 250,
-	115, 48, 267, 242, 243, 240, 252, 253, 241, 217,
-	29, 10, 269, 25, 144, 21, 101, 117, 80, 81,
-	147, 23, 145, 70, 272, 19, 51, 11, 13, 273,
-	274, 14, 128, 127, 92, 124, 46, 8, 111, 26,
-	89, 42, 275, 28, 276, 277, 278, 279, 44, 90,
-	17, 244, 245, 31, 280, 258, 151, 103, 49, 36,
-	74, 281, 282, 68, 283, 71, 52, 72, 15, 47,
-	284, 285, 286, 287, 91, 118, 249, 45, 288, 122,
-	6, 255, 30, 143, 43, 289, 123, 79, 256, 257,
-	126, 69, 5, 129, 32, 9, 50, 53, 246, 247,
-	248, 34, 78, 12, 290, -168, 90, -161, 57, -54,
-	124, -54, 249, -116, 128, -116, -116, 123, -54, 115,
-	117, 120, 52, -18, -54, -115, 128, 57, -115, -115,
-	-115, -54, 112, -54, 57, 30, -135, -193, -124, 241,
-	57, 156, 123, 157, 125, -135, -135, -135, -135, 160,
-	161, -135, -112, -111, 223, 224, 218, 222, 12, 161,
-	218, 159, -135, -134, -134, -194, 56, -92, 19, 31,
-	-39, -129, -88, -89, -39, -87, -2, -26, 36, -30,
-	21, 65, 11, -126, 73, 72, 89, -125, 22, -123,
-	59, 112, -39, -62, 92, 74, 90, 91, 76, 94,
+	-1000, -207, -1, -2, -7, -8, -9, -10, -11, -12,
+	-13, -14, -15, -16, -20, -21, -22, -26, -27, -28,
+	-29, -30, -23, -24, -17, -3, -104, 7, -34, 9,
+	10, 30, -18, 115, 116, 118, 117, 150, 119, 143,
+	50, 164, 165, 167, 168, 169, 170, 25, 144, 148,
+	149, 31, 32, 145, 121, -4, -5, 6, 264, 8,
+	251, -209, 54, -208, 357, -3, -31, -212, -31, -31,
+	-31, -31, -183, -185, 54, 90, -136, 125, 72, 243,
+	122, 123, 129, -139, 57, -138, 261, 136, 301, 302,
+	164, 177, 171, 198, 190, 262, 303, 137, 188, 191,
+	230, 135, 304, 218, 225, 66, 167, 239, 305, 270,
+	146, 186, 182, 306, 280, 180, 27, 307, 227, 203,
+	308, 266, 181, 226, 273, 121, 309, 139, 133, 310,
+	204, 208, 311, 231, 312, 313, 314, 175, 176, 315,
+	233, 202, 134, 33, 263, 35, 154, 234, 206, 316,
+	201, 197, 317, 318, 319, 320, 200, 174, 196, 39,
+	210, 209, 211, 229, 193, 321, 322, 323, 140, 324,
+	183, 18, 325, 326, 327, 328, 329, 237, 149, 330,
+	152, 331, 332, 333, 334, 335, 336, 228, 205, 207,
+	130, 156, 265, 337, 235, 179, 338, 141, 153, 148,
+	238, 142, 339, 340, 341, 342, 343, 344, 345, 168,
+	271, 169, 346, 347, 348, 349, 163, 232, 241, 38,
+	215, 350, 173, 132, 351, 165, 160, 220, 194, 155,
+	352, 353, 184, 185, 199, 172, 195, 275, 166, 274,
+	157, 150, 354, 240, 216, 277, 192, 189, 161, 355,
+	158, 159, 356, 276, 221, 222, 162, 236, 187, 217,
+	-123, 125, 222, 127, 123, 123, 124, 125, 243, 122,
+	123, -60, -145, 57, -138, 125, 123, 108, 191, 230,
+	115, 219, 227, 124, 33, 228, 156, -154, 123, -125,
+	218, 221, 222, 162, 57, 232, 231, 223, -145, 166,
+	126, -139, 169, -150, -150, -150, -150, 220, 220, -35,
+	119, -151, 273, -209, -140, -139, -137, 120, 278, 73,
+	22, 24, 213, 76, 108, 16, 77, 107, 252, 115,
+	48, 279, 244, 245, 242, 254, 255, 243, 219, 29,
+	10, 281, 25, 144, 21, 101, 117, 80, 81, 147,
+	23, 145, 70, 284, 19, 51, 11, 13, 285, 286,
+	14, 128, 127, 92, 124, 46, 8, 111, 26, 89,
+	42, 287, 28, 288, 289, 290, 291, 44, 90, 17,
+	246, 247, 31, 292, 260, 151, 103, 49, 36, 74,
+	293, 294, 68, 295, 71, 52, 72, 15, 47, 267,
+	296, 269, 297, 272, 91, 170, 118, 251, 45, 268,
+	298, 122, 6, 257, 30, 143, 43, 299, 123, 79,
+	258, 259, 126, 69, 5, 129, 32, 9, 50, 53,
+	248, 249, 250, 34, 78, 12, 300, 264, -150, -93,
+	15, -33, 5, -31, -105, 272, -106, -145, -2, -44,
+	99, -45, -145, -66, 74, -71, 29, 57, -138, 23,
+	-70, -67, -86, -84, -85, 108, 109, 110, 97, 98,
+	105, 75, 111, -75, -73, -74, -76, 59, 58, 67,
+	60, 61, 62, 63, 68, 69, 70, -139, -82, -209,
+	44, 45, 252, 253, 254, 255, 260, 256, 77, 34,
+	242, 250, 249, 248, 246, 247, 244, 245, 258, 259,
+	128, 243, 103, 251, -32, -123, -123, 11, -54, -55,
+	-60, -62, -145, -115, -153, 166, -119, 232, 231, -140,
+	-117, 230, 191, 229, -184, 90, -177, 57, -60, 124,
+	-60, 251, -132, 128, -132, -132, 123, -60, 115, 117,
+	120, 52, -19, -60, -131, 128, 57, -131, -131, -131,
+	-60, 112, -60, 57, 30, -151, 243, 57, 156, 123,
+	157, 125, -151, -151, -151, -151, 160, 161, -151, -128,
+	-127, 225, 226, 220, 224, 12, 161, 220, 159, -151,
+	-139, 169, -139, -150, -150, -25, -2, -8, -9, -10,
+	-35, -151, 82, -97, 17, 16, -6, -4, -209, 6,
+	20, 21, -38, 40, 41, 55, -105, -107, -209, -210,
+	56, 11, -142, 73, 72, 89, -141, 22, -139, 59,
+	112, -45, -145, -68, 92, 74, 90, 91, 76, 94,
 	93, 104, 97, 98, 99, 100, 101, 102, 103, 95,
-	96, 107, 82, 83, 84, 85, 86, 87, 88, -108,
-	-193, -79, -193, 113, 114, -65, -65, -65, -65, -65,
-	-65, -65, -65, -193, -2, -74, -39, -193, -193, -193,
-	-193, -193, -193, -193, -193, -193, -83, -39, -193, -197,
-	-71, -193, -197, -71, -197, -71, -197, -193, -197, -71,
-	-197, -71, -197, -197, -71, -193, -193, -193, -193, -193,
-	-193, -55, 26, -54, -41, -42, -43, -44, -57, -79,
-	-193, -54, -54, -48, -195, 55, 11, 53, -195, 55,
-	112, 55, -99, 166, -100, -104, 231, 233, 82, -128,
-	-123, 59, 29, 30, 56, 55, -54, -140, -143, -145,
-	-144, -146, -141, -142, 186, 187, 108, 190, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 30, 146,
-	182, 183, 184, 185, 202, 203, 204, 205, 206, 207,
-	208, 209, 169, 188, 260, 170, 171, 172, 173, 174,
-	175, 177, 178, 179, 180, 181, 57, -135, 125, 57,
-	74, 57, -54, -54, -135, 158, 158, 123, 123, 163,
-	-54, 55, 126, -48, 23, 52, -54, 57, 57, -130,
-	-129, -121, -135, -135, -135, -135, -135, -54, -135, -135,
-	-135, -135, 11, -110, 11, 92, -39, -114, 90, 52,
-	9, 92, 55, 18, 112, 55, -90, 24, 25, -91,
-	-194, -32, -66, -123, 60, 63, -31, 43, -54, -39,
-	-39, -72, 68, 74, 69, 70, -125, 99, -130, -124,
-	-121, -65, -73, -76, -79, 64, 92, 90, 91, 76,
-	-65, -65, -65, -65, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -65, -136, 57, 59, 57, -64,
-	-64, -123, -37, 21, -36, -38, -194, 55, -194, -2,
-	-36, -36, -39, -39, -80, 59, -36, -80, 59, -36,
-	-36, -30, -81, -82, 78, -80, -123, -129, -194, -65,
-	-123, -123, -36, -37, -36, -36, -95, 152, -54, 30,
-	55, -50, -52, -51, -53, 42, 46, 48, 43, 44,
-	45, 49, -133, 22, -41, -193, -132, 152, -131, 22,
-	-129, 59, -95, 53, -41, -54, -41, -56, -129, 99,
-	-103, -100, 55, 232, 234, 235, 52, 71, -39, -152,
-	107, -170, -171, -172, -124, 59, 60, -161, -162, -163,
-	-173, 138, -178, 130, 132, 129, -164, 139, 124, 28,
-	56, -157, 68, 74, -153, 214, -147, 54, -147, -147,
-	-147, -147, -151, 189, -151, -151, -151, 54, 54, -147,
-	-147, -147, -155, 54, -155, -155, -156, 54, -156, -127,
-	53, -54, -135, 23, -135, -117, 120, 117, 118, -181,
-	116, 211, 189, 66, 29, 15, 250, 152, 265, 57,
-	153, -54, -54, -54, -54, -54, 120, 117, -54, -54,
-	-54, -135, -54, -113, 90, 12, -129, -129, 59, -54,
-	38, -39, -39, -130, -89, -92, -106, 19, 11, 34,
-	34, -36, 68, 69, 70, 112, -193, -73, -65, -65,
-	-65, -35, 147, 73, -194, -194, -36, 55, -39, -194,
-	-194, -194, 55, 53, 22, 11, 11, -194, 11, 11,
-	-194, -194, -36, -84, -82, 80, -39, -194, 112, -194,
-	55, 55, -194, -194, -194, -194, -63, 30, 34, -2,
-	-193, -193, -98, -102, -80, -42, -43, -43, -42, -43,
-	42, 42, 42, 47, 42, 47, 42, -51, -129, -194,
-	-58, 50, 127, 51, -193, -131, -59, 12, -41, -59,
-	-59, 112, -104, -105, 236, 233, 239, 57, 59, 55,
-	-172, 82, 54, 57, 28, -164, -164, -165, 57, -165,
-	28, -149, 29, 68, -154, 215, 60, -151, -151, -152,
-	30, -152, -152, -152, -160, 59, -160, 60, 60, 52,
-	-123, -135, -134, -187, 135, 131, 138, 139, 133, 57,
-	124, 28, 130, 132, 152, 129, -187, -118, -119, 126,
-	22, 124, 28, 152, -186, 53, 158, 211, 158, 126,
-	-135, -110, 59, -39, 39, 112, -54, -40, 11, 99,
-	-124, -37, -35, 73, -65, -65, -194, -38, -139, 108,
-	186, 146, 184, 180, 200, 191, 213, 182, 214, -136,
-	-139, -65, -65, -65, -65, 259, -87, 81, -39, 79,
-	-124, -65, -65, -97, 52, -98, -75, -77, -76, -193,
-	-2, -93, -123, -96, -123, -59, 55, 82, -46, -45,
-	52, 53, -47, 52, -45, 42, 42, 124, 124, 124,
-	-96, -87, -39, -59, 233, 237, 238, -171, -172, -175,
-	-174, -123, -178, -165, -165, 54, -150, 52, -65, 56,
-	-152, -152, 57, 108, 56, 55, 56, 55, 56, 55,
-	-54, -134, -134, -54, -134, -123, -184, 262, -185, 57,
-	-123, -123, -123, -54, -113, -59, -41, -194, -65, -194,
-	-147, -147, -147, -156, -147, 174, -147, 174, -194, -194,
-	19, 19, 19, 19, -193, -34, 255, -39, 55, 55,
-	27, -97, 55, -194, -194, -194, 55, 112, -194, 55,
-	-87, -102, -39, -39, 54, -39, -193, -193, -193, -194,
-	-91, 56, 55, -147, -94, -123, -158, 211, 9, -151,
-	59, -151, 60, 60, -135, 26, -183, -182, -124, 54,
-	53, -85, 13, -151, 57, -65, -65, -65, -65, -65,
-	-194, 59, -65, -65, 28, -77, 34, -2, -193, -123,
-	-123, -123, -91, -94, -94, -94, -94, -132, -177, -176,
-	53, 134, 66, -174, 56, 55, -159, 130, 28, 129,
-	-68, -152, -152, 56, 56, -193, 55, 82, -94, -54,
-	-86, 14, 16, -194, -194, -194, -194, -33, 92, 262,
-	-194, -194, 9, -75, -2, 112, 56, -194, -194, -194,
-	-58, -176, 57, -166, 82, 59, 141, -123, -148, 66,
-	28, 28, -179, -180, 152, -182, -172, 56, -39, -74,
-	-194, 260, 49, 263, -98, -194, -123, 60, -54, 59,
-	-194, 55, -123, -186, 39, 261, 264, 54, -180, 34,
-	-184, 39, -94, 154, 262, 56, 155, 263, -189, -190,
-	52, -193, 264, -190, 52, 10, 9, -65, 151, -188,
-	142, 137, 140, 30, -188, -194, -194, 136, 29, 68,
+	96, 107, 82, 83, 84, 85, 86, 87, 88, -124,
+	-209, -85, -209, 113, 114, -71, -71, -71, -71, -71,
+	-71, -71, -71, -209, -2, -80, -45, -209, -209, -209,
+	-209, -209, -209, -209, -209, -209, -89, -45, -209, -213,
+	-77, -209, -213, -77, -213, -77, -213, -209, -213, -77,
+	-213, -77, -213, -213, -77, -209, -209, -209, -209, -209,
+	-209, 65, -61, 26, -60, -47, -48, -49, -50, -63,
+	-85, -209, -60, -60, -54, -211, 55, 11, 53, -211,
+	55, 112, 55, -115, 166, -116, -120, 233, 235, 82,
+	-144, -139, 59, 29, 30, 56, 55, -60, -156, -159,
+	-161, -160, -162, -157, -158, 188, 189, 108, 192, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 30,
+	146, 184, 185, 186, 187, 204, 205, 206, 207, 208,
+	209, 210, 211, 171, 190, 262, 172, 173, 174, 175,
+	176, 177, 179, 180, 181, 182, 183, 57, -151, 125,
+	57, 74, 57, -60, -60, -151, 158, 158, 123, 123,
+	163, -60, 55, 126, -54, 23, 52, -60, 57, 57,
+	-146, -145, -137, -151, -151, -151, -151, -151, -60, -151,
+	-151, -151, -151, 11, -126, 11, 92, -45, -130, 90,
+	52, -139, -2, 202, 274, 275, 276, -108, 19, 31,
+	-45, -94, -95, -45, -93, -2, -31, 36, -36, 21,
+	-106, 22, -110, -139, -60, -45, -45, -78, 68, 74,
+	69, 70, -141, 99, -146, -140, -137, 112, -71, -79,
+	-82, -85, 64, 92, 90, 91, 76, -71, -71, -71,
+	-71, -71, -71, -71, -71, -71, -71, -71, -71, -71,
+	-71, -71, -152, 57, 59, 57, -70, -70, -139, -43,
+	21, -42, -44, -210, 55, -210, -2, -42, -42, -45,
+	-45, -86, 59, -42, -86, 59, -42, -42, -36, -87,
+	-88, 78, -86, -139, -145, -210, -71, -139, -139, -42,
+	-43, -42, -42, -111, 152, -60, 30, 55, -56, -58,
+	-57, -59, 42, 46, 48, 43, 44, 45, 49, -149,
+	22, -47, -209, -148, 152, -147, 22, -145, 59, -111,
+	53, -47, -60, -47, -62, -145, 99, -119, -116, 55,
+	234, 236, 237, 52, 71, -45, -168, 107, -186, -187,
+	-188, -140, 59, 60, -177, -178, -179, -189, 138, -194,
+	130, 132, 129, -180, 139, 124, 28, 56, -173, 68,
+	74, -169, 216, -163, 54, -163, -163, -163, -163, -167,
+	191, -167, -167, -167, 54, 54, -163, -163, -163, -171,
+	54, -171, -171, -172, 54, -172, -143, 53, -60, -151,
+	23, -151, -133, 120, 117, 118, -197, 116, 213, 191,
+	66, 29, 15, 252, 152, 277, 57, 153, -60, -60,
+	-60, -60, -60, 120, 117, -60, -60, -60, -151, -60,
+	-129, 90, 12, -145, -145, 59, -60, 9, 92, 55,
+	18, 55, -96, 24, 25, -97, -210, -38, -72, -139,
+	60, 63, -37, 43, -85, -210, 55, 68, 69, 70,
+	112, -209, -146, -79, -71, -71, -71, -41, 147, 73,
+	-210, -210, -42, 55, -45, -210, -210, -210, 55, 53,
+	22, 11, 11, -210, 11, 11, -210, -210, -42, -90,
+	-88, 80, -45, -210, 112, -210, 55, 55, -210, -210,
+	-210, -210, -69, 30, 34, -2, -209, -209, -114, -118,
+	-86, -48, -49, -49, -48, -49, 42, 42, 42, 47,
+	42, 47, 42, -57, -145, -210, -64, 50, 127, 51,
+	-209, -147, -65, 12, -47, -65, -65, 112, -120, -121,
+	238, 235, 241, 57, 59, 55, -188, 82, 54, 57,
+	28, -180, -180, -181, 57, -181, 28, -165, 29, 68,
+	-170, 217, 60, -167, -167, -168, 30, -168, -168, -168,
+	-176, 59, -176, 60, 60, 52, -139, -151, -150, -203,
+	135, 131, 138, 139, 133, 57, 124, 28, 130, 132,
+	152, 129, -203, -134, -135, 126, 22, 124, 28, 152,
+	-202, 53, 158, 213, 158, 126, -151, -126, 59, -45,
+	38, -45, -45, -95, -108, -122, 19, 11, 34, 34,
+	-42, -139, 99, -140, -43, 112, -41, 73, -71, -71,
+	-98, 267, -210, -44, -155, 108, 188, 146, 186, 182,
+	202, 193, 215, 184, 216, -152, -155, -71, -71, -71,
+	-71, 261, -93, 81, -45, 79, -140, -71, -71, -113,
+	52, -114, -81, -83, -82, -209, -2, -109, -139, -112,
+	-139, -65, 55, 82, -52, -51, 52, 53, -53, 52,
+	-51, 42, 42, 124, 124, 124, -112, -93, -45, -65,
+	235, 239, 240, -187, -188, -191, -190, -139, -194, -181,
+	-181, 54, -166, 52, -71, 56, -168, -168, 57, 108,
+	56, 55, 56, 55, 56, 55, -60, -150, -150, -60,
+	-150, -139, -200, 264, -201, 57, -139, -139, -139, -60,
+	-129, 39, -60, -46, 11, -210, -71, -209, -210, -163,
+	-163, -163, -172, -163, 176, -163, 176, -210, -210, 19,
+	19, 19, 19, -209, -40, 257, -45, 55, 55, 27,
+	-113, 55, -210, -210, -210, 55, 112, -210, 55, -93,
+	-118, -45, -45, 54, -45, -209, -209, -209, -210, -97,
+	56, 55, -163, -110, -174, 213, 9, -167, 59, -167,
+	60, 60, -151, 26, -199, -198, -140, 54, 53, -65,
+	-47, -99, 152, -167, 57, -71, -71, -71, -71, -71,
+	-210, 59, -71, -71, 28, -83, 34, -2, -209, -139,
+	-139, -139, -97, -110, -110, -110, -110, -148, -193, -192,
+	53, 134, 66, -190, 56, -175, 130, 28, 129, -74,
+	-168, -168, 56, 56, -209, 55, 82, -110, -60, -91,
+	13, -93, 16, -210, -210, -210, -210, -39, 92, 264,
+	-210, -210, 9, -81, -2, 112, 56, -210, -210, -210,
+	-64, -192, 57, -182, 82, 59, 141, -164, 66, 28,
+	28, -195, -196, 152, -198, -188, 56, -92, 14, 16,
+	-100, -102, 268, 269, -80, -210, 262, 49, 265, -114,
+	-210, -139, 60, -60, 59, -210, 55, -139, -202, -45,
+	-80, -210, -101, 76, 354, 270, -103, -70, 111, 39,
+	263, 266, 54, -196, 34, -200, -101, 334, 309, 271,
+	334, 309, -71, 39, -110, 154, 73, -139, 264, 56,
+	155, -101, 265, -205, -206, 52, -209, 266, -206, 52,
+	10, 9, -71, 151, -204, 142, 137, 140, 30, -204,
+	-210, -210, 136, 29, 68,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	26, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 560, 0, 317, 317, 317, 317, 317, 317,
-	0, 631, 614, 0, 0, 0, 0, -2, 304, 305,
-	0, 307, 308, 932, 932, 932, 932, 932, 0, 0,
-	932, 0, 35, 36, 930, 1, 3, 568, 0, 0,
-	321, 324, 319, 0, 614, 614, 0, 0, 65, 66,
-	0, 0, 0, 919, 0, 612, 612, 612, 632, 633,
-	636, 637, 761, 762, 763, 764, 765, 766, 767, 768,
-	769, 770, 771, 772, 773, 774, 775, 776, 777, 778,
-	779, 780, 781, 782, 783, 784, 785, 786, 787, 788,
-	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
-	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
-	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
-	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
-	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
-	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
-	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
-	859, 860, 861, 862, 863, 864, 865, 866, 867, 868,
-	869, 870, 871, 872, 873, 874, 875, 876, 877, 878,
-	879, 880, 881, 882, 883, 884, 885, 886, 887, 888,
-	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
-	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
-	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
-	920, 921, 922, 923, 924, 925, 926, 927, 928, 929,
-	0, 0, 0, 615, 0, 610, 0, 610, 610, 610,
-	0, 256, 389, 640, 641, 919, 0, 0, 0, 933,
-	0, 933, 268, 933, 933, 271, 933, 0, 933, 0,
-	278, 0, 0, 284, 933, 301, 302, 289, 303, 306,
-	309, 310, 311, 312, 313, 932, 932, 316, 29, 572,
-	0, 0, 560, 31, 0, 317, 322, 323, 327, 325,
-	326, 318, 0, 335, 339, 0, 398, 0, 403, 405,
-	-2, -2, 0, 440, 441, 442, 443, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 468, 469, 470, 471,
-	545, 546, 547, 548, 549, 550, 551, 552, 407, 408,
-	542, 592, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 533, 0, 504, 504, 504, 504, 504, 504, 504,
-	504, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 44, 46, 389, 50, 0, 908, 596, -2,
-	-2, 0, 0, 638, 639, -2, 774, -2, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 677, 678, 679, 680, 681, 682, 683, 684, 685,
-	686, 687, 688, 689, 690, 691, 692, 693, 694, 695,
-	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
-	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
-	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
-	756, 757, 758, 759, 760, 0, 0, 84, 0, 82,
-	0, 933, 0, 0, 0, 0, 0, 0, 933, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
-	0, 255, 0, 257, 933, 933, 260, 934, 935, 933,
-	933, 933, 0, 933, 933, 267, 269, 270, 272, 933,
-	933, 274, 0, 292, 290, 291, 286, 287, 0, 298,
-	281, 282, 285, 314, 315, 30, 931, 24, 0, 0,
-	569, 0, 561, 562, 565, 568, 29, 324, 0, 329,
-	328, 320, 0, 336, 0, 0, 0, 340, 0, 342,
-	343, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 425, 426, 427, 428, 429, 430, 431, 404,
-	0, 418, 0, 0, 0, 460, 461, 462, 463, 464,
-	465, 466, 0, 331, 29, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 534, 0, 488,
-	496, 0, 489, 497, 490, 498, 491, 0, 492, 499,
-	493, 500, 494, 495, 501, 0, 0, 0, 331, 0,
-	0, 48, 0, 388, 0, 346, 348, 349, 350, -2,
-	0, 372, -2, 0, 0, 0, 42, 43, 0, 0,
-	0, 0, 51, 908, 53, 54, 0, 0, 0, 162,
-	605, 606, 607, 603, 206, 0, 0, 150, 146, 90,
-	91, 92, 139, 94, 139, 139, 139, 139, 159, 159,
-	159, 159, 122, 123, 124, 125, 126, 0, 0, 109,
-	139, 139, 139, 113, 129, 130, 131, 132, 133, 134,
-	135, 136, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 141, 141, 141, 143, 143, 634, 68, 0, 933,
-	0, 933, 80, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 250, 611, 0, 933, 253, 254, 390,
-	642, 643, 258, 259, 261, 262, 263, 264, 265, 266,
-	273, 277, 0, 295, 0, 0, 279, 280, 0, 0,
-	573, 0, 0, 0, 0, 0, 564, 566, 567, 572,
-	32, 327, 0, 553, 0, 0, 0, 330, 27, 399,
-	400, 402, 419, 0, 421, 423, 341, 337, 0, 543,
-	-2, 409, 410, 434, 435, 436, 0, 0, 0, 0,
-	432, 414, 0, 445, 446, 447, 448, 449, 450, 451,
-	452, 453, 454, 455, 456, 459, 518, 519, 0, 457,
-	458, 467, 0, 0, 332, 333, 437, 0, 591, 29,
-	0, 0, 0, 0, 442, 545, 0, 442, 545, 0,
-	0, 0, 540, 537, 0, 0, 542, 0, 505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 387, 0,
-	0, 0, 0, 0, 0, 377, 0, 0, 380, 0,
-	0, 0, 0, 371, 0, 0, 392, 853, 373, 0,
-	375, 376, 396, 0, 396, 45, 396, 47, 0, 391,
-	597, 52, 0, 0, 57, 58, 598, 599, 600, 601,
-	0, 81, 207, 209, 212, 213, 214, 85, 86, 87,
-	0, 0, 194, 0, 0, 188, 188, 0, 186, 187,
-	83, 153, 151, 0, 148, 147, 93, 0, 159, 159,
-	116, 117, 162, 0, 162, 162, 162, 0, 0, 110,
-	111, 112, 104, 0, 105, 106, 107, 0, 108, 0,
-	0, 933, 70, 613, 71, 932, 0, 0, 626, 221,
-	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
-	0, 72, 223, 225, 224, 228, 0, 0, 0, 248,
-	933, 252, 292, 276, 0, 0, 293, 294, 299, 283,
-	0, 570, 571, 0, 563, 25, 0, 608, 609, 554,
-	555, 344, 420, 422, 424, 0, 331, 411, 432, 415,
-	0, 412, 0, 0, 406, 472, 0, 0, 439, -2,
-	475, 476, 0, 0, 0, 0, 0, 511, 0, 0,
-	512, 0, 560, 0, 538, 0, 0, 487, 0, 506,
-	0, 0, 507, 508, 509, 510, 585, 0, 0, -2,
-	0, 0, 396, 593, 0, 347, 366, 368, 0, 363,
-	378, 379, 381, 0, 383, 0, 385, 386, 351, 353,
-	354, 0, 0, 0, 0, 374, 560, 0, 396, 40,
-	41, 0, 55, 56, 0, 0, 62, 163, 164, 0,
-	210, 0, 0, 0, 181, 188, 188, 184, 189, 185,
-	0, 155, 0, 152, 89, 149, 0, 162, 162, 118,
-	0, 119, 120, 121, 0, 137, 0, 0, 0, 0,
-	635, 69, 215, 932, 230, 231, 232, 233, 234, 235,
-	236, 237, 238, 239, 240, 241, 932, 0, 932, 627,
-	628, 629, 630, 0, 75, 0, 0, 0, 0, 0,
-	251, 295, 296, 297, 574, 0, 26, 396, 0, 338,
-	544, 0, 413, 0, 433, 416, 473, 334, 0, 139,
-	139, 523, 139, 143, 526, 139, 528, 139, 531, 0,
-	0, 0, 0, 0, 0, 0, 535, 486, 541, 0,
-	543, 0, 0, 33, 0, 585, 575, 587, 589, 0,
-	29, 0, 581, 0, 358, 560, 0, 0, 360, 367,
-	0, 0, 361, 0, 362, 382, 384, 0, 0, 0,
-	0, 568, 397, 39, 59, 60, 61, 208, 211, 0,
-	190, 139, 193, 182, 183, 0, 157, 0, 154, 140,
-	114, 115, 160, 161, 159, 0, 159, 0, 144, 0,
-	933, 216, 217, 218, 219, 0, 222, 0, 73, 74,
-	0, 0, 227, 249, 275, 556, 345, 474, 417, 477,
-	520, 159, 524, 525, 527, 529, 530, 532, 479, 478,
-	0, 0, 0, 0, 0, 0, 0, 539, 0, 0,
-	0, 34, 0, 590, -2, 0, 0, 0, 49, 0,
-	568, 594, 595, 364, 0, 369, 0, 0, 0, 372,
-	38, 173, 0, 192, 0, 356, 165, 158, 0, 162,
-	138, 162, 0, 0, 67, 0, 76, 77, 0, 0,
-	0, 558, 0, 521, 522, 0, 0, 0, 0, 513,
-	485, 536, 0, 0, 0, 588, 0, -2, 0, 583,
-	582, 359, 37, 0, 0, 0, 0, 392, 172, 174,
-	0, 179, 0, 191, 0, 0, 170, 0, 167, 169,
-	156, 127, 128, 142, 145, 0, 0, 0, 0, 229,
-	28, 0, 0, 480, 482, 481, 483, 0, 0, 0,
-	502, 503, 0, 578, 29, 0, 365, 393, 394, 395,
-	355, 175, 176, 0, 180, 178, 0, 357, 88, 0,
-	166, 168, 0, 243, 0, 78, 79, 72, 559, 557,
-	484, 0, 0, 0, 586, -2, 584, 177, 0, 171,
-	242, 0, 0, 75, 514, 0, 517, 0, 244, 0,
-	226, 515, 0, 0, 0, 195, 0, 0, 196, 197,
-	0, 0, 516, 198, 0, 0, 0, 0, 0, 199,
-	201, 202, 0, 0, 200, 245, 246, 203, 204, 205,
+	21, 22, 23, 24, 25, -2, 0, 344, 344, 344,
+	344, 344, 0, 674, 657, 0, 0, 0, 0, -2,
+	316, 317, 0, 319, 320, 0, 0, 985, 985, 985,
+	985, 0, 0, 986, 985, 603, 0, 344, 0, 47,
+	48, 0, 983, 1, 3, -2, 0, 346, 657, 657,
+	0, 0, 77, 78, 0, 0, 0, 972, 0, 655,
+	655, 655, 675, 676, 679, 680, 808, 809, 810, 811,
+	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
+	822, 823, 824, 825, 826, 827, 828, 829, 830, 831,
+	832, 833, 834, 835, 836, 837, 838, 839, 840, 841,
+	842, 843, 844, 845, 846, 847, 848, 849, 850, 851,
+	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
+	862, 863, 864, 865, 866, 867, 868, 869, 870, 871,
+	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
+	882, 883, 884, 885, 886, 887, 888, 889, 890, 891,
+	892, 893, 894, 895, 896, 897, 898, 899, 900, 901,
+	902, 903, 904, 905, 906, 907, 908, 909, 910, 911,
+	912, 913, 914, 915, 916, 917, 918, 919, 920, 921,
+	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 934, 935, 936, 937, 938, 939, 940, 941,
+	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
+	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
+	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	973, 974, 975, 976, 977, 978, 979, 980, 981, 982,
+	0, 0, 0, 658, 0, 653, 0, 653, 653, 653,
+	0, 268, 416, 683, 684, 972, 0, 0, 0, 986,
+	0, 986, 280, 986, 986, 283, 986, 0, 986, 0,
+	290, 0, 0, 296, 986, 313, 314, 301, 315, 318,
+	0, 323, 0, 325, 326, 327, 328, 985, 985, 0,
+	986, 333, 846, 987, 988, 681, 682, 687, 688, 689,
+	690, 691, 692, 693, 694, 695, 696, 697, 698, 699,
+	700, 701, 702, 703, 704, 705, 706, 707, 708, 709,
+	710, 711, 712, 713, 714, 715, 716, 717, 718, 719,
+	720, 721, 722, 723, 724, 725, 726, 727, 728, 729,
+	730, 731, 732, 733, 734, 735, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 746, 747, 748, 749,
+	750, 751, 752, 753, 754, 755, 756, 757, 758, 759,
+	760, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 771, 772, 773, 774, 775, 776, 777, 778, 779,
+	780, 781, 782, 783, 784, 785, 786, 787, 788, 789,
+	790, 791, 792, 793, 794, 795, 796, 797, 798, 799,
+	800, 801, 802, 803, 804, 805, 806, 807, 343, 611,
+	0, 0, 348, 351, 32, 0, 34, 37, 0, 0,
+	362, 366, 0, 425, 0, 430, 432, -2, -2, 0,
+	467, 468, 469, 470, 471, 0, 0, 0, 0, 0,
+	0, 0, 0, 495, 496, 497, 498, 588, 589, 590,
+	591, 592, 593, 594, 595, 434, 435, 585, 635, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 576, 0,
+	531, 531, 531, 531, 531, 531, 531, 531, 0, 0,
+	0, 0, 0, 0, 345, 0, 0, 0, 0, 0,
+	56, 58, 416, 62, 0, 960, 639, -2, -2, 0,
+	0, -2, 821, -2, 0, 0, 96, 0, 94, 0,
+	986, 0, 0, 0, 0, 0, 0, 986, 0, 0,
+	0, 0, 259, 0, 0, 0, 0, 0, 0, 0,
+	267, 0, 269, 986, 986, 272, 986, 986, 986, 0,
+	986, 986, 279, 281, 282, 284, 986, 986, 286, 0,
+	304, 302, 303, 298, 299, 0, 310, 293, 294, 297,
+	321, 933, 324, 329, 330, 331, 339, 340, 341, 342,
+	0, 334, 0, 615, 0, 0, 603, 43, 0, 344,
+	349, 350, 354, 352, 353, 0, 33, 0, 0, 42,
+	984, 0, 363, 0, 0, 0, 367, 0, 369, 370,
+	0, 428, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 453, 454, 455, 456, 457, 458, 431,
+	0, 445, 0, 0, 0, 487, 488, 489, 490, 491,
+	492, 493, 0, 358, 0, 0, 465, 0, 0, 0,
+	0, 0, 0, 0, 0, 354, 0, 577, 0, 515,
+	523, 0, 516, 524, 517, 525, 518, 0, 519, 526,
+	520, 527, 521, 522, 528, 0, 0, 0, 358, 0,
+	0, 347, 60, 0, 415, 0, 373, 375, 376, 377,
+	-2, 0, 399, -2, 0, 0, 0, 54, 55, 0,
+	0, 0, 0, 63, 960, 65, 66, 0, 0, 0,
+	174, 648, 649, 650, 646, 218, 0, 0, 162, 158,
+	102, 103, 104, 151, 106, 151, 151, 151, 151, 171,
+	171, 171, 171, 134, 135, 136, 137, 138, 0, 0,
+	121, 151, 151, 151, 125, 141, 142, 143, 144, 145,
+	146, 147, 148, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 153, 153, 153, 155, 155, 677, 80, 0,
+	986, 0, 986, 92, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 654, 0, 986, 265, 266,
+	417, 685, 686, 270, 271, 273, 274, 275, 276, 277,
+	278, 285, 289, 0, 307, 0, 0, 291, 292, 0,
+	0, 322, 332, 335, 336, 337, 338, 29, 0, 0,
+	612, 604, 605, 608, 611, 0, 351, 0, 356, 355,
+	35, 0, 0, 383, 39, 426, 427, 429, 446, 0,
+	448, 450, 368, 364, 0, 586, -2, 0, 436, 437,
+	461, 462, 463, 0, 0, 0, 0, 459, 441, 0,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 483, 486, 561, 562, 0, 484, 485, 494, 0,
+	0, 359, 360, 464, 0, 634, 0, 0, 0, 0,
+	0, 469, 588, 0, 469, 588, 0, 0, 0, 583,
+	580, 0, 0, 585, 0, 532, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 414, 0, 0, 0, 0,
+	0, 0, 404, 0, 0, 407, 0, 0, 0, 0,
+	398, 0, 0, 419, 902, 400, 0, 402, 403, 423,
+	0, 423, 57, 423, 59, 0, 418, 640, 64, 0,
+	0, 69, 70, 641, 642, 643, 644, 0, 93, 219,
+	221, 224, 225, 226, 97, 98, 99, 0, 0, 206,
+	0, 0, 200, 200, 0, 198, 199, 95, 165, 163,
+	0, 160, 159, 105, 0, 171, 171, 128, 129, 174,
+	0, 174, 174, 174, 0, 0, 122, 123, 124, 116,
+	0, 117, 118, 119, 0, 120, 0, 0, 986, 82,
+	656, 83, 985, 0, 0, 669, 233, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 0, 84, 235,
+	237, 236, 240, 0, 0, 0, 260, 986, 264, 304,
+	288, 0, 0, 305, 306, 311, 295, 616, 0, 0,
+	0, 0, 607, 609, 610, 615, 44, 354, 0, 596,
+	0, 0, 0, 357, 36, 38, 0, 447, 449, 451,
+	0, 358, 0, 438, 459, 442, 0, 439, 0, 0,
+	433, 540, 0, 0, 466, -2, 502, 503, 0, 0,
+	0, 0, 0, 538, 0, 0, 539, 0, 603, 0,
+	581, 0, 0, 514, 0, 533, 0, 0, 534, 535,
+	536, 537, 628, 0, 0, 619, 0, 0, 423, 636,
+	0, 374, 393, 395, 0, 390, 405, 406, 408, 0,
+	410, 0, 412, 413, 378, 380, 381, 0, 0, 0,
+	0, 401, 603, 0, 423, 52, 53, 0, 67, 68,
+	0, 0, 74, 175, 176, 0, 222, 0, 0, 0,
+	193, 200, 200, 196, 201, 197, 0, 167, 0, 164,
+	101, 161, 0, 174, 174, 130, 0, 131, 132, 133,
+	0, 149, 0, 0, 0, 0, 678, 81, 227, 985,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 985, 0, 985, 670, 671, 672, 673, 0,
+	87, 0, 0, 0, 0, 0, 263, 307, 308, 309,
+	0, 613, 614, 606, 30, 0, 651, 652, 597, 598,
+	371, 384, 365, 587, 0, 0, 440, 0, 460, 443,
+	499, 0, 500, 361, 0, 151, 151, 566, 151, 155,
+	569, 151, 571, 151, 574, 0, 0, 0, 0, 0,
+	0, 0, 578, 513, 584, 0, 586, 0, 0, 45,
+	0, 628, 618, 630, 632, 0, 0, 0, 624, 0,
+	385, 603, 0, 0, 387, 394, 0, 0, 388, 0,
+	389, 409, 411, 0, 0, 0, 0, 611, 424, 51,
+	71, 72, 73, 220, 223, 0, 202, 151, 205, 194,
+	195, 0, 169, 0, 166, 152, 126, 127, 172, 173,
+	171, 0, 171, 0, 156, 0, 986, 228, 229, 230,
+	231, 0, 234, 0, 85, 86, 0, 0, 239, 261,
+	287, 617, 31, 423, 0, 501, 444, 542, 504, 563,
+	171, 567, 568, 570, 572, 573, 575, 506, 505, 0,
+	0, 0, 0, 0, 0, 0, 582, 0, 0, 0,
+	46, 0, 633, -2, 0, 0, 0, 61, 0, 611,
+	637, 638, 391, 0, 396, 0, 0, 0, 399, 50,
+	185, 0, 204, 0, 177, 170, 0, 174, 150, 174,
+	0, 0, 79, 0, 88, 89, 0, 0, 0, 599,
+	372, 603, 0, 564, 565, 0, 0, 0, 0, 556,
+	512, 579, 0, 0, 0, 631, 0, 622, 0, 626,
+	625, 386, 49, 0, 0, 0, 0, 419, 184, 186,
+	0, 191, 0, 203, 0, 182, 0, 179, 181, 168,
+	139, 140, 154, 157, 0, 0, 0, 0, 241, 601,
+	0, 544, 0, 507, 509, 508, 510, 0, 0, 0,
+	529, 530, 0, 621, 0, 0, 392, 420, 421, 422,
+	382, 187, 188, 0, 192, 190, 0, 100, 0, 178,
+	180, 0, 255, 0, 90, 91, 84, 40, 0, 0,
+	0, 0, 547, 548, 543, 511, 0, 0, 0, 629,
+	-2, 627, 189, 0, 183, 254, 0, 0, 87, 602,
+	600, 541, 545, 0, 0, 0, 0, 554, 0, 557,
+	0, 560, 0, 256, 0, 238, 0, 549, 550, 551,
+	552, 553, 0, 558, 0, 0, 0, 555, 0, 207,
+	0, 546, 0, 208, 209, 0, 0, 559, 210, 0,
+	0, 0, 0, 0, 211, 213, 214, 0, 0, 212,
+	257, 258, 215, 216, 217,
 }
 var yyTok1 = [...]int{
 
@@ -3223,7 +3490,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 75, 3, 3, 3, 102, 94, 3,
 	54, 56, 99, 97, 55, 98, 112, 100, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 347,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 357,
 	83, 82, 84, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3276,7 +3543,9 @@ var yyTok3 = [...]int{
 	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
-	57670, 345, 57671, 346, 0,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3618,35 +3887,48 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:323
+//line sql.y:348
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:328
+//line sql.y:353
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:329
+//line sql.y:354
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:333
+//line sql.y:358
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:355
+//line sql.y:383
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 27:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:389
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 28:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:393
+		{
+			yyDollar[2].selStmt.SetWith(yyDollar[1].with)
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 29:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:361
+//line sql.y:401
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3654,57 +3936,99 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 25:
+	case 30:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:369
+//line sql.y:409
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 26:
+	case 31:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:373
+//line sql.y:413
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 27:
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:419
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:423
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:429
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:433
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:439
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 37:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:444
+		{
+			yyVAL.columns = nil
+		}
+	case 38:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:448
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 39:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:379
+//line sql.y:454
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 28:
+	case 40:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:386
+//line sql.y:461
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 29:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:392
+//line sql.y:467
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:396
+//line sql.y:471
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:477
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 32:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:406
+//line sql.y:481
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 33:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:413
+//line sql.y:488
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3716,9 +4040,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 34:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:425
+//line sql.y:500
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3728,334 +4052,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 35:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:437
+//line sql.y:512
 		{
 			yyVAL.str = InsertStr
 		}
-	case 36:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:516
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 37:
+	case 49:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:447
+//line sql.y:522
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 38:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:453
+//line sql.y:528
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 39:
+	case 51:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:457
+//line sql.y:532
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 40:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:461
+//line sql.y:536
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 41:
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:465
+//line sql.y:540
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 42:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:470
+//line sql.y:545
 		{
 		}
-	case 43:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:546
 		{
 		}
-	case 44:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:475
+//line sql.y:550
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 45:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:479
+//line sql.y:554
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 46:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:560
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 47:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:489
+//line sql.y:564
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 48:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:494
+//line sql.y:569
 		{
 			yyVAL.partitions = nil
 		}
-	case 49:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:498
+//line sql.y:573
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 50:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:504
+//line sql.y:579
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 51:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:508
+//line sql.y:583
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 52:
+	case 64:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:512
+//line sql.y:587
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 53:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:516
+//line sql.y:591
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 54:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:522
+//line sql.y:597
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 55:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:526
+//line sql.y:601
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 56:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:532
+//line sql.y:607
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 57:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:536
+//line sql.y:611
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 58:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:540
+//line sql.y:615
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 59:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:546
+//line sql.y:621
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 60:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:550
+//line sql.y:625
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 61:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:554
+//line sql.y:629
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 62:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:558
+//line sql.y:633
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 63:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:564
+//line sql.y:639
 		{
 			yyVAL.str = SessionStr
 		}
-	case 64:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:568
+//line sql.y:643
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 65:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:574
+//line sql.y:649
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 66:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:579
+//line sql.y:654
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 67:
+	case 79:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:585
+//line sql.y:660
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 68:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:590
+//line sql.y:665
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 69:
+	case 81:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:594
+//line sql.y:669
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 70:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:598
+//line sql.y:673
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 71:
+	case 83:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:602
+//line sql.y:677
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 72:
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:607
+//line sql.y:682
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 73:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:611
+//line sql.y:686
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 74:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:617
+//line sql.y:692
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 75:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:622
+//line sql.y:697
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 76:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:627
+//line sql.y:702
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 77:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:633
+//line sql.y:708
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 78:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:713
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 79:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:644
+//line sql.y:719
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 80:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:650
+//line sql.y:725
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 81:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:657
+//line sql.y:732
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 82:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:664
+//line sql.y:739
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 83:
+	case 95:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:668
+//line sql.y:743
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 84:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:674
+//line sql.y:749
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:679
+//line sql.y:754
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:683
+//line sql.y:758
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:687
+//line sql.y:762
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 88:
+	case 100:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:693
+//line sql.y:768
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4065,814 +4389,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 89:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:779
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 93:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:715
+//line sql.y:790
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 94:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:720
+//line sql.y:795
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 95:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:726
+//line sql.y:801
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:730
+//line sql.y:805
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:734
+//line sql.y:809
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:738
+//line sql.y:813
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:742
+//line sql.y:817
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:746
+//line sql.y:821
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:750
+//line sql.y:825
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:754
+//line sql.y:829
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:758
+//line sql.y:833
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:764
+//line sql.y:839
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:770
+//line sql.y:845
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:776
+//line sql.y:851
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 107:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:782
+//line sql.y:857
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:788
+//line sql.y:863
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:796
+//line sql.y:871
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 110:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:800
+//line sql.y:875
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 111:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:804
+//line sql.y:879
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 112:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:883
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 113:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:812
+//line sql.y:887
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 114:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:818
+//line sql.y:893
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 115:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:822
+//line sql.y:897
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 116:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:826
+//line sql.y:901
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:830
+//line sql.y:905
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:834
+//line sql.y:909
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:913
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 120:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:842
+//line sql.y:917
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 121:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:921
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:850
+//line sql.y:925
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:854
+//line sql.y:929
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:858
+//line sql.y:933
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 125:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:862
+//line sql.y:937
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:866
+//line sql.y:941
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:870
+//line sql.y:945
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 128:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:875
+//line sql.y:950
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 129:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:881
+//line sql.y:956
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:885
+//line sql.y:960
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:889
+//line sql.y:964
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:893
+//line sql.y:968
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:897
+//line sql.y:972
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:976
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:980
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:909
+//line sql.y:984
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:915
+//line sql.y:990
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 138:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:920
+//line sql.y:995
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 139:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:925
+//line sql.y:1000
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 140:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:929
+//line sql.y:1004
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 141:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:934
+//line sql.y:1009
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 142:
+	case 154:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:938
+//line sql.y:1013
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:946
+//line sql.y:1021
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 144:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:950
+//line sql.y:1025
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 145:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:956
+//line sql.y:1031
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:964
+//line sql.y:1039
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 147:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:968
+//line sql.y:1043
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 148:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:973
+//line sql.y:1048
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:977
+//line sql.y:1052
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:983
+//line sql.y:1058
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 151:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:987
+//line sql.y:1062
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:991
+//line sql.y:1066
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:996
+//line sql.y:1071
 		{
 			yyVAL.optVal = nil
 		}
-	case 154:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1000
+//line sql.y:1075
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 155:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1005
+//line sql.y:1080
 		{
 			yyVAL.optVal = nil
 		}
-	case 156:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1009
+//line sql.y:1084
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 157:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1014
+//line sql.y:1089
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 158:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1018
+//line sql.y:1093
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 159:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1023
+//line sql.y:1098
 		{
 			yyVAL.str = ""
 		}
-	case 160:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1027
+//line sql.y:1102
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 161:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1031
+//line sql.y:1106
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 162:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1036
+//line sql.y:1111
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1040
+//line sql.y:1115
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 164:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1044
+//line sql.y:1119
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 165:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1124
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 166:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1053
+//line sql.y:1128
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 167:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1057
+//line sql.y:1132
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 168:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1061
+//line sql.y:1136
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 169:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1065
+//line sql.y:1140
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 170:
+	case 182:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1070
+//line sql.y:1145
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 171:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1074
+//line sql.y:1149
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 172:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1080
+//line sql.y:1155
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 173:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1084
+//line sql.y:1159
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 174:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1090
+//line sql.y:1165
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 175:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1094
+//line sql.y:1169
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 176:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1100
+//line sql.y:1175
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 177:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1104
+//line sql.y:1179
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 178:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1109
+//line sql.y:1184
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 179:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1115
+//line sql.y:1190
 		{
 			yyVAL.str = ""
 		}
-	case 180:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1119
+//line sql.y:1194
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 181:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1125
+//line sql.y:1200
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 182:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1129
+//line sql.y:1204
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 183:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1133
+//line sql.y:1208
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 184:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1137
+//line sql.y:1212
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 185:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1141
+//line sql.y:1216
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 186:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1147
+//line sql.y:1222
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 187:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1151
+//line sql.y:1226
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 188:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1156
+//line sql.y:1231
 		{
 			yyVAL.str = ""
 		}
-	case 189:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1235
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:1241
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 191:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1170
+//line sql.y:1245
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 192:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1176
+//line sql.y:1251
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 193:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1257
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 194:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1261
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 195:
+	case 207:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1193
+//line sql.y:1268
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 196:
+	case 208:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1197
+//line sql.y:1272
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 197:
+	case 209:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1201
+//line sql.y:1276
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 198:
+	case 210:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1205
+//line sql.y:1280
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 199:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1211
+//line sql.y:1286
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 200:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1217
+//line sql.y:1292
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 201:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1223
+//line sql.y:1298
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 202:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1227
+//line sql.y:1302
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 203:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1231
+//line sql.y:1306
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 204:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1235
+//line sql.y:1310
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 205:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1239
+//line sql.y:1314
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 206:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1319
 		{
 			yyVAL.str = ""
 		}
-	case 207:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1323
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 208:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1252
+//line sql.y:1327
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 209:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1260
+//line sql.y:1335
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 210:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1339
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 211:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1268
+//line sql.y:1343
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 212:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1274
+//line sql.y:1349
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 213:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1278
+//line sql.y:1353
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 214:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1282
+//line sql.y:1357
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 215:
+	case 227:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1288
+//line sql.y:1363
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 216:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1292
+//line sql.y:1367
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 217:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1296
+//line sql.y:1371
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 218:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1300
+//line sql.y:1375
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 219:
+	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1305
+//line sql.y:1380
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 220:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1310
+//line sql.y:1385
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 221:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1314
+//line sql.y:1389
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 222:
+	case 234:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1318
+//line sql.y:1393
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4884,9 +5208,9 @@ yydefault:
 				},
 			}
 		}
-	case 223:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1330
+//line sql.y:1405
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4896,21 +5220,21 @@ yydefault:
 				},
 			}
 		}
-	case 224:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1340
+//line sql.y:1415
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 225:
+	case 237:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1344
+//line sql.y:1419
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 226:
+	case 238:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1348
+//line sql.y:1423
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4923,9 +5247,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 227:
+	case 239:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1361
+//line sql.y:1436
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4935,15 +5259,15 @@ yydefault:
 				},
 			}
 		}
-	case 228:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1371
+//line sql.y:1446
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 229:
+	case 241:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1375
+//line sql.y:1450
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -4954,59 +5278,59 @@ yydefault:
 				},
 			}
 		}
-	case 242:
+	case 254:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1402
+//line sql.y:1477
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 243:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1408
+//line sql.y:1483
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 244:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1487
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 245:
+	case 257:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1418
+//line sql.y:1493
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 246:
+	case 258:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1422
+//line sql.y:1497
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 247:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1503
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 248:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1434
+//line sql.y:1509
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 249:
+	case 261:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1438
+//line sql.y:1513
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 250:
+	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1446
+//line sql.y:1521
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5014,16 +5338,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 251:
+	case 263:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1454
+//line sql.y:1529
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 252:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1459
+//line sql.y:1534
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5031,148 +5355,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 253:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1467
+//line sql.y:1542
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 254:
+	case 266:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1471
+//line sql.y:1546
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 255:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1477
+//line sql.y:1552
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 256:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1481
+//line sql.y:1556
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 257:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1486
+//line sql.y:1561
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1492
+//line sql.y:1567
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 259:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1497
+//line sql.y:1572
 		{
 			yyVAL.statement = &Show{Type: CharsetStr}
 		}
-	case 260:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1501
+//line sql.y:1576
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 261:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1505
+//line sql.y:1580
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 262:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1510
+//line sql.y:1585
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1514
+//line sql.y:1589
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1518
+//line sql.y:1593
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 265:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1522
+//line sql.y:1597
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 266:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1526
+//line sql.y:1601
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1530
+//line sql.y:1605
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 268:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1534
+//line sql.y:1609
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1613
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1542
+//line sql.y:1617
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1546
+//line sql.y:1621
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1625
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1554
+//line sql.y:1629
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 274:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1558
+//line sql.y:1633
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 287:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1562
+//line sql.y:1637
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 276:
+	case 288:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1567
+//line sql.y:1642
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5182,645 +5506,717 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 277:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1577
+//line sql.y:1652
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 278:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1581
+//line sql.y:1656
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 279:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1585
+//line sql.y:1660
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 280:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1591
+//line sql.y:1666
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 281:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1596
+//line sql.y:1671
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1600
+//line sql.y:1675
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 283:
+	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1604
+//line sql.y:1679
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 284:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1608
+//line sql.y:1683
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1622
+//line sql.y:1697
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1628
+//line sql.y:1703
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 287:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1632
+//line sql.y:1707
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 288:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1638
+//line sql.y:1713
 		{
 			yyVAL.str = ""
 		}
-	case 289:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1642
+//line sql.y:1717
 		{
 			yyVAL.str = "full "
 		}
-	case 290:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1648
+//line sql.y:1723
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 291:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1652
+//line sql.y:1727
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1658
+//line sql.y:1733
 		{
 			yyVAL.str = ""
 		}
-	case 293:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1662
+//line sql.y:1737
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 294:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1666
+//line sql.y:1741
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1672
+//line sql.y:1747
 		{
 			yyVAL.showFilter = nil
 		}
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1676
+//line sql.y:1751
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1680
+//line sql.y:1755
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1686
+//line sql.y:1761
 		{
 			yyVAL.showFilter = nil
 		}
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1690
+//line sql.y:1765
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1696
+//line sql.y:1771
 		{
 			yyVAL.str = ""
 		}
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1700
+//line sql.y:1775
 		{
 			yyVAL.str = SessionStr
 		}
-	case 302:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1704
+//line sql.y:1779
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 303:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1710
+//line sql.y:1785
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 304:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1714
+//line sql.y:1789
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 305:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1720
+//line sql.y:1795
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 306:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1724
+//line sql.y:1799
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 307:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1730
+//line sql.y:1805
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 308:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1736
+//line sql.y:1811
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 309:
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1815
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[3].colIdent}
+		}
+	case 322:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1819
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[4].colIdent}
+		}
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1742
+//line sql.y:1825
 		{
-			yyVAL.statement = &OtherRead{}
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 310:
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1831
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1746
+//line sql.y:1837
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 311:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1750
+//line sql.y:1841
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 312:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1754
+//line sql.y:1845
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 313:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1758
+//line sql.y:1849
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 314:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:1853
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 315:
+	case 330:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:1857
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1772
+	case 331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1865
 		{
-			yyVAL.statement = &DDL{Action: FlushStr}
+			yyVAL.statement = &Explain{Type: yyDollar[2].str, Statement: yyDollar[3].statement}
 		}
-	case 317:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1776
+	case 332:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1869
 		{
-			setAllowComments(yylex, true)
+			yyVAL.statement = &Explain{Type: yyDollar[3].str, Analyze: true, Statement: yyDollar[4].selStmt}
 		}
-	case 318:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1780
+//line sql.y:1873
 		{
-			yyVAL.bytes2 = yyDollar[2].bytes2
-			setAllowComments(yylex, false)
+			yyVAL.statement = &OtherRead{}
 		}
-	case 319:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1786
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1877
 		{
-			yyVAL.bytes2 = nil
+			yyVAL.statement = &OtherRead{}
 		}
-	case 320:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1790
+	case 335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1883
 		{
-			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+			yyVAL.str = ExplainJSONStr
 		}
-	case 321:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1796
+	case 336:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1887
 		{
-			yyVAL.str = UnionStr
+			yyVAL.str = ExplainTreeStr
 		}
-	case 322:
+	case 337:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1891
+		{
+			yyVAL.str = ExplainTraditionalStr
+		}
+	case 338:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1895
+		{
+			yyVAL.str = ExplainVitessStr
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1901
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1910
+		{
+			yyVAL.statement = &DDL{Action: FlushStr}
+		}
+	case 344:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1914
+		{
+			setAllowComments(yylex, true)
+		}
+	case 345:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1918
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 346:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1924
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 347:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1928
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 348:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1934
+		{
+			yyVAL.str = UnionStr
+		}
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1800
+//line sql.y:1938
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 323:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1804
+//line sql.y:1942
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 324:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1809
+//line sql.y:1947
 		{
 			yyVAL.str = ""
 		}
-	case 325:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1951
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 326:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:1955
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 327:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1822
+//line sql.y:1960
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1826
+//line sql.y:1964
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 329:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1831
+//line sql.y:1969
 		{
 			yyVAL.str = ""
 		}
-	case 330:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1973
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 331:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1840
+//line sql.y:1978
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 332:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1844
+//line sql.y:1982
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 333:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1988
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 334:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1854
+//line sql.y:1992
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 335:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1860
+//line sql.y:1998
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 336:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1864
+//line sql.y:2002
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 337:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1868
+//line sql.y:2006
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 338:
+	case 365:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1872
+//line sql.y:2010
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 339:
+	case 366:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1877
+//line sql.y:2015
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 340:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1881
+//line sql.y:2019
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 341:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1885
+//line sql.y:2023
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 343:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1892
+//line sql.y:2030
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 344:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1897
+//line sql.y:2035
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 345:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:2039
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 346:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:2045
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 347:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1911
+//line sql.y:2049
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 350:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1921
+//line sql.y:2059
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 351:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1925
+//line sql.y:2063
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 352:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1929
+//line sql.y:2067
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 353:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1935
+//line sql.y:2073
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 354:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1941
+//line sql.y:2079
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 355:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1945
+//line sql.y:2083
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 356:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1951
+//line sql.y:2089
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 357:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1955
+//line sql.y:2093
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 358:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1961
+//line sql.y:2099
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 359:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1965
+//line sql.y:2103
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 360:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1978
+//line sql.y:2116
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 361:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1982
+//line sql.y:2120
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 362:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1986
+//line sql.y:2124
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 363:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2128
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 364:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1996
+//line sql.y:2134
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 365:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1998
+//line sql.y:2136
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 366:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2002
+//line sql.y:2140
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 367:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2004
+//line sql.y:2142
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 368:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2008
+//line sql.y:2146
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 369:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2010
+//line sql.y:2148
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 370:
+	case 397:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2013
+//line sql.y:2151
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 371:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2153
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 372:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2018
+//line sql.y:2156
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 373:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2022
+//line sql.y:2160
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 374:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2026
+//line sql.y:2164
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 376:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:2171
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 377:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2039
+//line sql.y:2177
 		{
 			yyVAL.str = JoinStr
 		}
-	case 378:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2043
+//line sql.y:2181
 		{
 			yyVAL.str = JoinStr
 		}
-	case 379:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2047
+//line sql.y:2185
 		{
 			yyVAL.str = JoinStr
 		}
-	case 380:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2053
+//line sql.y:2191
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 381:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2059
+//line sql.y:2197
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 382:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2063
+//line sql.y:2201
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 383:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2067
+//line sql.y:2205
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 384:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2071
+//line sql.y:2209
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 385:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2077
+//line sql.y:2215
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 386:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2081
+//line sql.y:2219
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5828,465 +6224,465 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 387:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2091
+//line sql.y:2229
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 388:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2233
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 389:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2101
+//line sql.y:2239
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 390:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2105
+//line sql.y:2243
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 391:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2111
+//line sql.y:2249
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 392:
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2116
+//line sql.y:2254
 		{
 			yyVAL.indexHints = nil
 		}
-	case 393:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2120
+//line sql.y:2258
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 394:
+	case 421:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2124
+//line sql.y:2262
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 395:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2128
+//line sql.y:2266
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 396:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2133
+//line sql.y:2271
 		{
 			yyVAL.expr = nil
 		}
-	case 397:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2137
+//line sql.y:2275
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 398:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2143
+//line sql.y:2281
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 399:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2147
+//line sql.y:2285
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 400:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2151
+//line sql.y:2289
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 401:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2155
+//line sql.y:2293
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 402:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2159
+//line sql.y:2297
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 403:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2163
+//line sql.y:2301
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 404:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2167
+//line sql.y:2305
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 405:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2173
+//line sql.y:2311
 		{
 			yyVAL.str = ""
 		}
-	case 406:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2177
+//line sql.y:2315
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 407:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2183
+//line sql.y:2321
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 408:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2187
+//line sql.y:2325
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 409:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2193
+//line sql.y:2331
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 410:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2197
+//line sql.y:2335
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 411:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2201
+//line sql.y:2339
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 412:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2205
+//line sql.y:2343
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 413:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2209
+//line sql.y:2347
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 414:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2213
+//line sql.y:2351
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2217
+//line sql.y:2355
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 416:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2221
+//line sql.y:2359
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 417:
+	case 444:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2225
+//line sql.y:2363
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 418:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2229
+//line sql.y:2367
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 419:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2235
+//line sql.y:2373
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 420:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2239
+//line sql.y:2377
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 421:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2243
+//line sql.y:2381
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 422:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2247
+//line sql.y:2385
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 423:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2251
+//line sql.y:2389
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 424:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2255
+//line sql.y:2393
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 425:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2261
+//line sql.y:2399
 		{
 			yyVAL.str = EqualStr
 		}
-	case 426:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2265
+//line sql.y:2403
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 427:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2269
+//line sql.y:2407
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 428:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2273
+//line sql.y:2411
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 429:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2277
+//line sql.y:2415
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 430:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2281
+//line sql.y:2419
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 431:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2285
+//line sql.y:2423
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 432:
+	case 459:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2290
+//line sql.y:2428
 		{
 			yyVAL.expr = nil
 		}
-	case 433:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2294
+//line sql.y:2432
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 434:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2300
+//line sql.y:2438
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 435:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2304
+//line sql.y:2442
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 436:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2308
+//line sql.y:2446
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 437:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2314
+//line sql.y:2452
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 438:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2320
+//line sql.y:2458
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 439:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2324
+//line sql.y:2462
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 440:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2330
+//line sql.y:2468
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 441:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2472
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 442:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2338
+//line sql.y:2476
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 443:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2342
+//line sql.y:2480
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 444:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2346
+//line sql.y:2484
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 445:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2350
+//line sql.y:2488
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 446:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2354
+//line sql.y:2492
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 447:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2358
+//line sql.y:2496
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 448:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2362
+//line sql.y:2500
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 449:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2366
+//line sql.y:2504
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 450:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2370
+//line sql.y:2508
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 451:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2374
+//line sql.y:2512
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2378
+//line sql.y:2516
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2382
+//line sql.y:2520
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2386
+//line sql.y:2524
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 455:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2390
+//line sql.y:2528
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 456:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2394
+//line sql.y:2532
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 457:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2398
+//line sql.y:2536
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 458:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2402
+//line sql.y:2540
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 459:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2406
+//line sql.y:2544
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 460:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2410
+//line sql.y:2548
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 461:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2414
+//line sql.y:2552
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 462:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2418
+//line sql.y:2556
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 463:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2422
+//line sql.y:2560
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6294,9 +6690,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 464:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2430
+//line sql.y:2568
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6310,21 +6706,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 465:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2444
+//line sql.y:2582
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 466:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2448
+//line sql.y:2586
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 467:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2452
+//line sql.y:2590
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6332,485 +6728,581 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 472:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2470
+	case 499:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2608
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 473:
+	case 500:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2474
+//line sql.y:2612
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 474:
+	case 501:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2478
+//line sql.y:2616
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 475:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2488
+//line sql.y:2626
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 476:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2492
+//line sql.y:2630
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 477:
+	case 504:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2496
+//line sql.y:2634
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 478:
+	case 505:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2500
+//line sql.y:2638
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 479:
+	case 506:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2504
+//line sql.y:2642
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 480:
+	case 507:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2508
+//line sql.y:2646
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 481:
+	case 508:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2512
+//line sql.y:2650
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 482:
+	case 509:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2516
+//line sql.y:2654
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 483:
+	case 510:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2520
+//line sql.y:2658
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 484:
+	case 511:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2524
+//line sql.y:2662
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 485:
+	case 512:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2528
+//line sql.y:2666
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 486:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2532
+//line sql.y:2670
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 487:
+	case 514:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2536
+//line sql.y:2674
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 488:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2546
+//line sql.y:2684
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 489:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2688
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 490:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2554
+//line sql.y:2692
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 491:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2559
+//line sql.y:2697
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 492:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2564
+//line sql.y:2702
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2569
+//line sql.y:2707
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2575
+//line sql.y:2713
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 495:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2580
+//line sql.y:2718
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 496:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2585
+//line sql.y:2723
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 497:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2589
+//line sql.y:2727
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 498:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2593
+//line sql.y:2731
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 499:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2598
+//line sql.y:2736
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 500:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2741
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 501:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2746
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 502:
+	case 529:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2612
+//line sql.y:2750
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 503:
+	case 530:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2616
+//line sql.y:2754
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 506:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2626
+//line sql.y:2764
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 507:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2636
+//line sql.y:2774
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 508:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2640
+//line sql.y:2778
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 509:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2644
+//line sql.y:2782
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 510:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2648
+//line sql.y:2786
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 511:
+	case 538:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2790
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2652
+//line sql.y:2794
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 512:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2656
+	case 540:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2799
+		{
+			yyVAL.overClause = nil
+		}
+	case 541:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2803
+		{
+			yyVAL.overClause = &OverClause{PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy, Frame: yyDollar[5].frameClause}
+		}
+	case 542:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2808
+		{
+			yyVAL.exprs = nil
+		}
+	case 543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2812
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2817
+		{
+			yyVAL.frameClause = nil
+		}
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2821
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 546:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2825
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2831
+		{
+			yyVAL.str = RowsStr
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2835
+		{
+			yyVAL.str = RangeStr
+		}
+	case 549:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2841
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 550:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2845
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 551:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2849
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 552:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2853
+		{
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 553:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2857
+		{
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2863
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2867
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 513:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2662
+//line sql.y:2873
 		{
 			yyVAL.str = ""
 		}
-	case 514:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2666
+//line sql.y:2877
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 515:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2670
+//line sql.y:2881
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 516:
+	case 559:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2674
+//line sql.y:2885
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 517:
+	case 560:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2678
+//line sql.y:2889
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 518:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2895
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 519:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2688
+//line sql.y:2899
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 520:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2694
+//line sql.y:2905
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 521:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2698
+//line sql.y:2909
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 522:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2702
+//line sql.y:2913
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 523:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2706
+//line sql.y:2917
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 524:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2710
+//line sql.y:2921
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 525:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2714
+//line sql.y:2925
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 526:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2720
+//line sql.y:2931
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 527:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2724
+//line sql.y:2935
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 528:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2728
+//line sql.y:2939
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 529:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2732
+//line sql.y:2943
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 530:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2736
+//line sql.y:2947
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 531:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2740
+//line sql.y:2951
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 532:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2744
+//line sql.y:2955
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 533:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2749
+//line sql.y:2960
 		{
 			yyVAL.expr = nil
 		}
-	case 534:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2753
+//line sql.y:2964
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 535:
+	case 578:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2758
+//line sql.y:2969
 		{
 			yyVAL.str = string("")
 		}
-	case 536:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2762
+//line sql.y:2973
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 537:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2768
+//line sql.y:2979
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 538:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2772
+//line sql.y:2983
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 539:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2778
+//line sql.y:2989
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 540:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2994
 		{
 			yyVAL.expr = nil
 		}
-	case 541:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2787
+//line sql.y:2998
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 542:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:3004
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 543:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2797
+//line sql.y:3008
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 544:
+	case 587:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2801
+//line sql.y:3012
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 545:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2807
+//line sql.y:3018
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 546:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2811
+//line sql.y:3022
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 547:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2815
+//line sql.y:3026
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 548:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2819
+//line sql.y:3030
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 549:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2823
+//line sql.y:3034
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 550:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2827
+//line sql.y:3038
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 551:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2831
+//line sql.y:3042
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 552:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2835
+//line sql.y:3046
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 553:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2841
+//line sql.y:3052
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6819,239 +7311,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 554:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:3061
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 555:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2854
+//line sql.y:3065
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 556:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:3070
 		{
 			yyVAL.exprs = nil
 		}
-	case 557:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2863
+//line sql.y:3074
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 558:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2868
+//line sql.y:3079
 		{
 			yyVAL.expr = nil
 		}
-	case 559:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2872
+//line sql.y:3083
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 560:
+	case 603:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2877
+//line sql.y:3088
 		{
 			yyVAL.orderBy = nil
 		}
-	case 561:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2881
+//line sql.y:3092
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 562:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:3098
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 563:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:3102
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 564:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2897
+//line sql.y:3108
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 565:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2902
+//line sql.y:3113
 		{
 			yyVAL.str = AscScr
 		}
-	case 566:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2906
+//line sql.y:3117
 		{
 			yyVAL.str = AscScr
 		}
-	case 567:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2910
+//line sql.y:3121
 		{
 			yyVAL.str = DescScr
 		}
-	case 568:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2915
+//line sql.y:3126
 		{
 			yyVAL.limit = nil
 		}
-	case 569:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2919
+//line sql.y:3130
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 570:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2923
+//line sql.y:3134
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 571:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2927
+//line sql.y:3138
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 572:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2932
+//line sql.y:3143
 		{
 			yyVAL.str = ""
 		}
-	case 573:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2936
+//line sql.y:3147
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 574:
+	case 617:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2940
+//line sql.y:3151
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 575:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2953
+//line sql.y:3164
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 576:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2957
+//line sql.y:3168
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 577:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2961
+//line sql.y:3172
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 578:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2966
+//line sql.y:3177
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 579:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2970
+//line sql.y:3181
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 580:
+	case 623:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2974
+//line sql.y:3185
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 581:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2981
+//line sql.y:3192
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 582:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2985
+//line sql.y:3196
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 583:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2989
+//line sql.y:3200
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 584:
+	case 627:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2993
+//line sql.y:3204
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 585:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2998
+//line sql.y:3209
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 586:
+	case 629:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3002
+//line sql.y:3213
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 587:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3008
+//line sql.y:3219
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 588:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3012
+//line sql.y:3223
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 589:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3018
+//line sql.y:3229
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 590:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3022
+//line sql.y:3233
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 591:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3028
+//line sql.y:3239
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 592:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3034
+//line sql.y:3245
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7059,312 +7551,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 593:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3044
+//line sql.y:3255
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 594:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3048
+//line sql.y:3259
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 595:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3054
+//line sql.y:3265
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 596:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3060
+//line sql.y:3271
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 597:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3064
+//line sql.y:3275
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 598:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3070
+//line sql.y:3281
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 599:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3074
+//line sql.y:3285
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 600:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3078
+//line sql.y:3289
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 601:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3082
+//line sql.y:3293
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 603:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3089
+//line sql.y:3300
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 605:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3096
+//line sql.y:3307
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 606:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3100
+//line sql.y:3311
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 607:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3104
+//line sql.y:3315
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 610:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3113
+//line sql.y:3324
 		{
 			yyVAL.byt = 0
 		}
-	case 611:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3115
+//line sql.y:3326
 		{
 			yyVAL.byt = 1
 		}
-	case 612:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3118
+//line sql.y:3329
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 613:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3120
+//line sql.y:3331
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 614:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3123
+//line sql.y:3334
 		{
 			yyVAL.str = ""
 		}
-	case 615:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3125
+//line sql.y:3336
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 616:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3129
+//line sql.y:3340
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 617:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3131
+//line sql.y:3342
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 618:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3133
+//line sql.y:3344
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3135
+//line sql.y:3346
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 620:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3137
+//line sql.y:3348
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 621:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3139
+//line sql.y:3350
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 622:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3141
+//line sql.y:3352
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 623:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3143
+//line sql.y:3354
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3145
+//line sql.y:3356
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3147
+//line sql.y:3358
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3150
+//line sql.y:3361
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3152
+//line sql.y:3363
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3365
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3369
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3160
+//line sql.y:3371
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3163
+//line sql.y:3374
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3165
+//line sql.y:3376
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3167
+//line sql.y:3378
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 677:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3170
+//line sql.y:3381
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 635:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3172
+//line sql.y:3383
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 636:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3176
+//line sql.y:3387
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 637:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3180
+//line sql.y:3391
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 639:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3187
+//line sql.y:3398
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 640:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3193
+//line sql.y:3404
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 641:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3197
+//line sql.y:3408
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 643:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3204
+//line sql.y:3415
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 930:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3516
+//line sql.y:3737
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 931:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:3746
 		{
 			decNesting(yylex)
 		}
-	case 932:
+	case 985:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3530
+//line sql.y:3751
 		{
 			skipToEnd(yylex)
 		}
-	case 933:
+	case 986:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3535
+//line sql.y:3756
 		{
 			skipToEnd(yylex)
 		}
-	case 934:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3539
+//line sql.y:3760
 		{
 			skipToEnd(yylex)
 		}
-	case 935:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3543
+//line sql.y:3764
 		{
 			skipToEnd(yylex)
 		}
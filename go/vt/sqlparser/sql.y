@@ -115,6 +115,12 @@ func skipToEnd(yylex interface{}) {
   vindexParams  []VindexParam
   showFilter    *ShowFilter
   optLike       *OptLike
+  overClause    *OverClause
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  with          *With
+  ctes          []*CommonTableExpr
+  cte           *CommonTableExpr
 }
 
 %token LEX_ERROR
@@ -167,7 +173,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SEQUENCE
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -200,9 +206,18 @@ func skipToEnd(yylex interface{}) {
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// Window functions
+%token <bytes> OVER ROWS RANGE CURRENT ROW
+
+// Common table expressions
+%token <bytes> RECURSIVE
+
+// Explain tokens
+%token <bytes> FORMAT TREE TRADITIONAL VITESS
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
+%token <bytes> NTH_VALUE NTILE OF PERCENT_RANK RANK ROW_NUMBER SYSTEM WINDOW
 %token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
@@ -210,14 +225,14 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement query_expression base_select union_lhs union_rhs
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
-%type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> analyze_statement show_statement use_statement other_statement explain_statement explainable_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
 %type <bytes2> comment_opt comment_list
-%type <str> union_op insert_or_replace
+%type <str> union_op insert_or_replace explain_format
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
 %type <expr> like_escape_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
@@ -255,6 +270,16 @@ func skipToEnd(yylex interface{}) {
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
+%type <overClause> over_clause_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <str> frame_unit
+%type <expr> frame_expr
+%type <with> with_clause
+%type <ctes> common_table_expr_list
+%type <cte> common_table_expr
+%type <columns> cte_column_list_opt
 %type <str> lock_opt
 %type <columns> ins_column_list column_list
 %type <partitions> opt_partition_clause partition_list
@@ -349,7 +374,10 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
 | other_statement
+| explain_statement
 | flush_statement
 | /*empty*/
 {
@@ -357,6 +385,18 @@ command:
 }
 
 select_statement:
+  query_expression
+  {
+    $$ = $1
+  }
+| with_clause query_expression
+  {
+    $2.SetWith($1)
+    $$ = $2
+  }
+
+// query_expression is a SELECT statement without a WITH clause.
+query_expression:
   base_select order_by_opt limit_opt lock_opt
   {
     sel := $1.(*Select)
@@ -374,6 +414,41 @@ select_statement:
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
   }
 
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE common_table_expr_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+common_table_expr_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id cte_column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
+  }
+
+cte_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb column_list closeb
+  {
+    $$ = $2
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -388,7 +463,7 @@ base_select:
   }
 
 union_lhs:
-  select_statement
+  query_expression
   {
     $$ = $1
   }
@@ -1736,6 +1811,26 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK TO sql_id
+  {
+    $$ = &SRollback{Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &SRollback{Name: $4}
+  }
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
 
 other_statement:
   DESC skip_to_end
@@ -1746,10 +1841,6 @@ other_statement:
   {
     $$ = &OtherRead{}
   }
-| EXPLAIN skip_to_end
-  {
-    $$ = &OtherRead{}
-  }
 | REPAIR skip_to_end
   {
     $$ = &OtherAdmin{}
@@ -1767,6 +1858,53 @@ other_statement:
     $$ = &OtherAdmin{}
   }
 
+// Only the EXPLAIN statements that have an explicit format are
+// fully parsed. The other ones are passed through as OtherRead.
+explain_statement:
+  EXPLAIN explain_format explainable_statement
+  {
+    $$ = &Explain{Type: $2, Statement: $3}
+  }
+| EXPLAIN ANALYZE explain_format select_statement
+  {
+    $$ = &Explain{Type: $3, Analyze: true, Statement: $4}
+  }
+| EXPLAIN ddl_skip_to_end
+  {
+    $$ = &OtherRead{}
+  }
+| EXPLAIN ANALYZE ddl_skip_to_end
+  {
+    $$ = &OtherRead{}
+  }
+
+explain_format:
+  FORMAT '=' JSON
+  {
+    $$ = ExplainJSONStr
+  }
+| FORMAT '=' TREE
+  {
+    $$ = ExplainTreeStr
+  }
+| FORMAT '=' TRADITIONAL
+  {
+    $$ = ExplainTraditionalStr
+  }
+| FORMAT '=' VITESS
+  {
+    $$ = ExplainVitessStr
+  }
+
+explainable_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| insert_statement
+| update_statement
+| delete_statement
+
 flush_statement:
   FLUSH skip_to_end
   {
@@ -2466,9 +2604,9 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -2657,6 +2795,79 @@ function_call_conflict:
     $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
   }
 
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER openb partition_by_opt order_by_opt frame_clause_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| frame_expr PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| frame_expr FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
+  }
+
+frame_expr:
+  value
+  {
+    $$ = $1
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 match_option:
 /*empty*/
   {
@@ -3299,12 +3510,15 @@ reserved_keyword:
 | OUTER
 | OVER
 | PERCENT_RANK
+| RANGE
 | RANK
 | RECURSIVE
 | REGEXP
+| RELEASE
 | RENAME
 | REPLACE
 | RIGHT
+| ROWS
 | ROW_NUMBER
 | SCHEMA
 | SELECT
@@ -3332,6 +3546,7 @@ reserved_keyword:
 | WHEN
 | WHERE
 | WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3364,6 +3579,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3378,6 +3594,7 @@ non_reserved_keyword:
 | EXPANSION
 | FLOAT_TYPE
 | FIELDS
+| FORMAT
 | FLUSH
 | FOLLOWING
 | FOREIGN
@@ -3463,6 +3680,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| SAVEPOINT
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_LOAD
@@ -3488,7 +3707,9 @@ non_reserved_keyword:
 | TINYBLOB
 | TINYINT
 | TINYTEXT
+| TRADITIONAL
 | TRANSACTION
+| TREE
 | TRIGGER
 | TRUNCATE
 | UNBOUNDED
@@ -3503,10 +3724,10 @@ non_reserved_keyword:
 | VINDEX
 | VINDEXES
 | VISIBLE
+| VITESS
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
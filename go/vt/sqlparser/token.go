@@ -133,6 +133,7 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -181,9 +182,11 @@ var keywords = map[string]int{
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
+	"format":              FORMAT,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
@@ -285,24 +288,27 @@ var keywords = map[string]int{
 	"order":               ORDER,
 	"out":                 UNUSED,
 	"outer":               OUTER,
+	"over":                OVER,
 	"outfile":             UNUSED,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
-	"release":             UNUSED,
+	"release":             RELEASE,
 	"rename":              RENAME,
 	"reorganize":          REORGANIZE,
 	"repair":              REPAIR,
@@ -317,6 +323,9 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
+	"savepoint":           SAVEPOINT,
 	"schema":              SCHEMA,
 	"second_microsecond":  UNUSED,
 	"select":              SELECT,
@@ -363,11 +372,14 @@ var keywords = map[string]int{
 	"tinyint":             TINYINT,
 	"tinytext":            TINYTEXT,
 	"to":                  TO,
+	"traditional":         TRADITIONAL,
 	"trailing":            UNUSED,
 	"transaction":         TRANSACTION,
+	"tree":                TREE,
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
@@ -391,6 +403,7 @@ var keywords = map[string]int{
 	"vindex":              VINDEX,
 	"vindexes":            VINDEXES,
 	"view":                VIEW,
+	"vitess":              VITESS,
 	"vitess_metadata":     VITESS_METADATA,
 	"vschema":             VSCHEMA,
 	"warnings":            WARNINGS,
@@ -328,6 +328,12 @@ var commands = []commandGroup{
 			{"MigrateWrites", commandMigrateWrites,
 				"[-filtered_replication_wait_time=30s] [-cancel] [-reverse_replication=false] -workflow=workflow <target keyspace>",
 				"Migrate write traffic for the specified workflow."},
+			{"CreateLookupVindex", commandCreateLookupVindex,
+				"<keyspace> <json_spec>",
+				"Adds a lookup vindex in write only mode and backfills its table using vreplication. The <json_spec> must contain the vindex and the owner table with the column vindex that uses it. The vindex is not used for routing until ExternalizeVindex is run, which must be done manually once the backfill streams have finished copying."},
+			{"ExternalizeVindex", commandExternalizeVindex,
+				"<keyspace>.<vindex>",
+				"Makes a lookup vindex created by CreateLookupVindex usable for routing, and deletes the backfill streams. It fails if the streams are still copying, or lag behind the owner table. In that case, run it again later."},
 			{"CancelResharding", commandCancelResharding,
 				"<keyspace/shard>",
 				"Permanently cancels a resharding in progress. All resharding related metadata will be deleted."},
@@ -1940,6 +1946,31 @@ func commandMigrateWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *
 	return nil
 }
 
+func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <json_spec> arguments are required for the CreateLookupVindex command")
+	}
+	keyspace := subFlags.Arg(0)
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
+		return err
+	}
+	return wr.CreateLookupVindex(ctx, keyspace, specs)
+}
+
+func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace>.<vindex> argument is required for the ExternalizeVindex command")
+	}
+	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
+}
+
 func commandCancelResharding(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
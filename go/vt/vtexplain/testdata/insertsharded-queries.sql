@@ -25,3 +25,5 @@ With the multi-shard autocommit option selected all inserts happen in one
 round trip so there is no race
 */
 insert /*vt+ MULTI_SHARD_AUTOCOMMIT=1 */ into music_extra (id, extra) values (1, 'a'), (2, 'b'), (3, 'c');
+
+insert into customer (id, country, name) values (1, 'US', 'alice'), (2, 'DE', 'bob') /* region_json */;
@@ -50,6 +50,13 @@ create table table_not_in_vschema (
 	primary key (id)
 ) Engine=InnoDB;
 
+create table customer (
+	id bigint,
+	country varchar(2),
+	name varchar(64),
+	primary key (id)
+) Engine=InnoDB;
+
 /*
  * This is not used by the tests themselves, but is used to verify
  * that the vtexplain schema parsing logic can properly skip past
@@ -195,6 +195,31 @@ func TestErrors(t *testing.T) {
 	}
 }
 
+func TestInitErrors(t *testing.T) {
+	vSchema := `{
+		"ks_sharded": {
+			"sharded": true,
+			"vindexes": {
+				"region_json": {
+					"type": "region_json",
+					"params": {
+						"region_map": "testdata/no-such-region-map.json",
+						"region_bytes": "1"
+					}
+				}
+			}
+		}
+	}`
+	err := Init(vSchema, "", defaultTestOpts())
+	want := "initVtgateExecutor: invalid vschema for keyspace ks_sharded: region_json vindex region_json: region_map testdata/no-such-region-map.json: open testdata/no-such-region-map.json: no such file or directory"
+	if err == nil || err.Error() != want {
+		t.Errorf("Init: %v, want %s", err, want)
+	}
+
+	// Restore the environment for the tests that follow.
+	initTest(ModeMulti, defaultTestOpts(), t)
+}
+
 func TestJSONOutput(t *testing.T) {
 	sql := "select 1 from user where id = 1"
 	explains, err := Run(sql)
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/gateway"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
@@ -99,6 +100,17 @@ func buildTopology(opts *Options, vschemaStr string, numShardsPerKeyspace int) e
 	if err != nil {
 		return err
 	}
+	// Report vschema errors, like misconfigured vindexes,
+	// instead of failing every query of the keyspace.
+	vschema, err := vindexes.BuildVSchema(&srvVSchema)
+	if err != nil {
+		return err
+	}
+	for ks, ksSchema := range vschema.Keyspaces {
+		if ksSchema.Error != nil {
+			return fmt.Errorf("invalid vschema for keyspace %s: %v", ks, ksSchema.Error)
+		}
+	}
 	explainTopo.Keyspaces = srvVSchema.Keyspaces
 
 	explainTopo.TabletConns = make(map[string]*explainTablet)
@@ -0,0 +1,147 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Concatenate)(nil)
+
+// Concatenate is a primitive that returns the rows of all its
+// sources, one after the other. It's used for a UNION ALL whose
+// parts cannot be sent to MySQL as a single query. The sources
+// are executed in order, and the fields of the result are the
+// fields of the first source.
+type Concatenate struct {
+	Sources []Primitive
+}
+
+// MarshalJSON serializes the Concatenate into a JSON representation.
+// It's used for testing and diagnostics.
+func (c *Concatenate) MarshalJSON() ([]byte, error) {
+	marshalConcatenate := struct {
+		Opcode  string
+		Sources []Primitive
+	}{
+		Opcode:  "Concatenate",
+		Sources: c.Sources,
+	}
+	return json.Marshal(marshalConcatenate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (c *Concatenate) RouteType() string {
+	return "Concatenate"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (c *Concatenate) GetKeyspaceName() string {
+	var ksNames []string
+	seen := make(map[string]bool)
+	for _, source := range c.Sources {
+		ksName := source.GetKeyspaceName()
+		if seen[ksName] {
+			continue
+		}
+		seen[ksName] = true
+		ksNames = append(ksNames, ksName)
+	}
+	return strings.Join(ksNames, "_")
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (c *Concatenate) GetTableName() string {
+	var tabNames []string
+	for _, source := range c.Sources {
+		tabNames = append(tabNames, source.GetTableName())
+	}
+	return strings.Join(tabNames, "_")
+}
+
+// Execute performs a non-streaming exec.
+func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for i, source := range c.Sources {
+		qr, err := source.Execute(vcursor, bindVars, wantfields)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Fields = qr.Fields
+		} else if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// Only the fields of the first source are sent.
+func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	for i, source := range c.Sources {
+		first := i == 0
+		err := source.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+			if qr.Fields != nil {
+				if first {
+					fields = qr.Fields
+				} else {
+					if err := checkColumnCount(fields, qr.Fields); err != nil {
+						return err
+					}
+					if len(qr.Rows) == 0 {
+						return nil
+					}
+					qr = &sqltypes.Result{Rows: qr.Rows}
+				}
+			}
+			return callback(qr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return c.Sources[0].GetFields(vcursor, bindVars)
+}
+
+// checkColumnCount returns an error if the sources of a union
+// return a different number of columns. The check is skipped
+// if the fields were not requested.
+func checkColumnCount(first, fields []*querypb.Field) error {
+	if first == nil || fields == nil || len(first) == len(fields) {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "The used SELECT statements have a different number of columns: %d, %d", len(first), len(fields))
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func newConcatenateSources() []*fakePrimitive {
+	return []*fakePrimitive{{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|name",
+				"int64|varchar",
+			),
+			"1|a",
+			"2|b",
+			"3|c",
+		)},
+	}, {
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|val",
+				"int64|varchar",
+			),
+			"3|c",
+			"4|d",
+		)},
+	}}
+}
+
+func TestConcatenateExecute(t *testing.T) {
+	sources := newConcatenateSources()
+	c := &Concatenate{Sources: []Primitive{sources[0], sources[1]}}
+	wantFields := sqltypes.MakeTestFields(
+		"id|name",
+		"int64|varchar",
+	)
+
+	result, err := c.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "1|a", "2|b", "3|c", "3|c", "4|d"), result)
+	sources[0].ExpectLog(t, []string{"Execute  true"})
+	sources[1].ExpectLog(t, []string{"Execute  true"})
+
+	for _, source := range sources {
+		source.rewind()
+	}
+	var results []*sqltypes.Result
+	err = c.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(t, err)
+	wantResults := sqltypes.MakeTestStreamingResults(wantFields, "1|a", "2|b", "---", "3|c", "---", "3|c", "4|d")
+	assert.Equal(t, wantResults, results)
+
+	sources[0].rewind()
+	result, err = c.GetFields(noopVCursor{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, wantFields, result.Fields)
+
+	// Error on the second source.
+	sources[0].rewind()
+	c.Sources[1] = &fakePrimitive{sendErr: errors.New("source fail")}
+	_, err = c.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "source fail")
+}
+
+func TestConcatenateColumnCount(t *testing.T) {
+	sources := newConcatenateSources()
+	sources[1].results[0] = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col",
+			"int32",
+		),
+		"3",
+	)
+	c := &Concatenate{Sources: []Primitive{sources[0], sources[1]}}
+	want := "The used SELECT statements have a different number of columns: 2, 1"
+
+	_, err := c.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, want)
+
+	for _, source := range sources {
+		source.rewind()
+	}
+	err = c.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+}
+
+func TestConcatenateMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 4
+	defer func() { testMaxMemoryRows = save }()
+
+	sources := newConcatenateSources()
+	c := &Concatenate{Sources: []Primitive{sources[0], sources[1]}}
+	_, err := c.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 4")
+}
+
+func TestConcatenateMarshalJSON(t *testing.T) {
+	c := &Concatenate{Sources: []Primitive{&fakePrimitive{}, &fakePrimitive{}}}
+	out, err := json.Marshal(c)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"Concatenate","Sources":[{},{}]}`, string(out))
+	assert.Equal(t, "fakeKs", c.GetKeyspaceName())
+	assert.Equal(t, "fakeTable_fakeTable", c.GetTableName())
+}
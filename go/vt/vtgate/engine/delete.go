@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -61,6 +62,14 @@ type Delete struct {
 	// OwnedVindexQuery is used for deleting lookup vindex entries.
 	OwnedVindexQuery string
 
+	// KsidVindex is used to compute the keyspace id of the rows
+	// returned by OwnedVindexQuery for a multi-shard delete. In that
+	// case, the first columns of the query are the ones of KsidVindex.
+	KsidVindex vindexes.Vindex
+
+	// Limit is set for a multi-shard delete with a LIMIT.
+	Limit *DMLLimit
+
 	// Option to override the standard behavior and allow a multi-shard delete
 	// to use single round trip autocommit.
 	MultiShardAutocommit bool
@@ -72,13 +81,16 @@ type Delete struct {
 // MarshalJSON serializes the Delete into a JSON representation.
 // It's used for testing and diagnostics.
 func (del *Delete) MarshalJSON() ([]byte, error) {
-	var tname, vindexName string
+	var tname, vindexName, ksidVindexName string
 	if del.Table != nil {
 		tname = del.Table.Name.String()
 	}
 	if del.Vindex != nil {
 		vindexName = del.Vindex.String()
 	}
+	if del.KsidVindex != nil {
+		ksidVindexName = del.KsidVindex.String()
+	}
 	marshalDelete := struct {
 		Opcode               DeleteOpcode
 		Keyspace             *vindexes.Keyspace   `json:",omitempty"`
@@ -87,6 +99,8 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		Values               []sqltypes.PlanValue `json:",omitempty"`
 		Table                string               `json:",omitempty"`
 		OwnedVindexQuery     string               `json:",omitempty"`
+		KsidVindex           string               `json:",omitempty"`
+		Limit                *DMLLimit            `json:",omitempty"`
 		MultiShardAutocommit bool                 `json:",omitempty"`
 		QueryTimeout         int                  `json:",omitempty"`
 	}{
@@ -97,6 +111,8 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		Values:               del.Values,
 		Table:                tname,
 		OwnedVindexQuery:     del.OwnedVindexQuery,
+		KsidVindex:           ksidVindexName,
+		Limit:                del.Limit,
 		MultiShardAutocommit: del.MultiShardAutocommit,
 		QueryTimeout:         del.QueryTimeout,
 	}
@@ -118,7 +134,10 @@ const (
 	// determine if lookup rows need to be deleted.
 	DeleteEqual
 	// DeleteScatter is for routing a scattered
-	// delete statement.
+	// delete statement. If the table has owned lookup
+	// vindexes, OwnedVindexQuery and KsidVindex are used
+	// to delete their entries first. If it has a LIMIT,
+	// Limit is used to find the rows to delete on each shard.
 	DeleteScatter
 	// DeleteByDestination is to route explicitly to a given
 	// target destination. Is used when the query explicitly sets a target destination:
@@ -247,20 +266,78 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	return nil
 }
 
+// restrictToKeys returns a copy of the delete whose queries only
+// read or change the rows with the primary keys bound as ListVarName.
+func (del *Delete) restrictToKeys(pk sqlparser.ColIdent) (*Delete, error) {
+	limited := *del
+	for _, query := range []*string{&limited.Query, &limited.OwnedVindexQuery} {
+		if *query == "" {
+			continue
+		}
+		var err error
+		if *query, err = restrictToKeys(*query, pk); err != nil {
+			return nil, err
+		}
+	}
+	return &limited, nil
+}
+
+// deleteVindexEntriesMultiShard deletes the lookup vindex entries
+// of the rows that a multi-shard delete is going to delete. The
+// keyspace id of every row is computed from its first columns, the
+// ones of the primary vindex.
+func (del *Delete) deleteVindexEntriesMultiShard(vcursor VCursor, bvs []map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+	result, err := execMultiShardSelect(vcursor, del.OwnedVindexQuery, bvs, rss)
+	if err != nil {
+		return err
+	}
+	ksidColumns := len(del.Table.ColumnVindexes[0].Columns)
+	for _, row := range result.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:ksidColumns])
+		if err != nil {
+			return err
+		}
+		colnum := ksidColumns
+		for _, colVindex := range del.Table.Owned {
+			ids := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for range colVindex.Columns {
+				ids = append(ids, row[colnum])
+				colnum++
+			}
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{ids}, ksid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]*querypb.BindVariable, dest key.Destination) (*sqltypes.Result, error) {
 	rss, _, err := vcursor.ResolveDestinations(del.Keyspace.Name, nil, []key.Destination{dest})
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteScatter")
 	}
-
-	queries := make([]*querypb.BoundQuery, len(rss))
-	sql := sqlannotation.AnnotateIfDML(del.Query, nil)
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{
-			Sql:           sql,
-			BindVariables: bindVars,
+	bvs := shardBindVars(bindVars, rss)
+	if del.Limit != nil {
+		var pk sqlparser.ColIdent
+		rss, bvs, pk, err = del.Limit.lockRows(vcursor, bindVars, rss)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execDeleteScatter")
+		}
+		if len(rss) == 0 {
+			return &sqltypes.Result{}, nil
+		}
+		if del, err = del.restrictToKeys(pk); err != nil {
+			return nil, vterrors.Wrap(err, "execDeleteScatter")
 		}
 	}
+	if del.KsidVindex != nil {
+		if err := del.deleteVindexEntriesMultiShard(vcursor, bvs, rss); err != nil {
+			return nil, vterrors.Wrap(err, "execDeleteScatter")
+		}
+	}
+
+	queries := getQueries(sqlannotation.AnnotateIfDML(del.Query, nil), bvs)
 	autocommit := (len(rss) == 1 || del.MultiShardAutocommit) && vcursor.AutocommitApproval()
 	res, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	return res, vterrors.Aggregate(errs)
@@ -252,6 +252,172 @@ func TestDeleteSharded(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteScatter: shard_error")
 }
 
+func TestDeleteScatterMultiColumnPrimaryVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		Opcode:           DeleteScatter,
+		Keyspace:         ks.Keyspace,
+		Query:            "dummy_delete",
+		Table:            ks.Tables["t2"],
+		OwnedVindexQuery: "dummy_subquery",
+		KsidVindex:       ks.Vindexes["region"],
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"region|id|c1",
+			"int64|int64|int64",
+		),
+		"1|2|3",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The keyspace id of the row is computed from its region and id.
+		`Execute delete from lkp3 where from = :from and toc = :toc from: type:INT64 value:"3" toc: type:VARBINARY value:"\001\006\347\352\"\316\222p\217"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+}
+
+func TestDeleteScatterOwnedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		Opcode:           DeleteScatter,
+		Keyspace:         ks.Keyspace,
+		Query:            "dummy_delete",
+		Table:            ks.Tables["t1"],
+		OwnedVindexQuery: "dummy_subquery",
+		KsidVindex:       ks.Vindexes["hash"],
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+		"2|7|8|9",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The subquery is sent to all shards to fetch the rows being deleted.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The keyspace id of each row is computed from its id.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"7" from2: type:INT64 value:"8" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// Finally, the actual delete is sent to all shards.
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+
+	// Failure case: the subquery fails.
+	vc = &loggingVCursor{
+		shards:         []string{"-20", "20-"},
+		multiShardErrs: []error{errors.New("result error -20")},
+	}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteScatter: result error -20")
+}
+
+func TestDeleteScatterLimit(t *testing.T) {
+	del := &Delete{
+		Opcode:   DeleteScatter,
+		Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+		Query:    "delete from t where val < 10 order by val desc limit :n",
+		Limit: &DMLLimit{
+			Query:   "dummy_select",
+			OrderBy: []OrderbyParams{{Col: 0, Desc: true}},
+			Count:   sqltypes.PlanValue{Key: "n"},
+		},
+	}
+
+	fields := sqltypes.MakeTestFields("val|id|val", "int64|int64|int64")
+	fields[1].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	results := func() []*sqltypes.Result {
+		return []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "5|50|5", "3|30|3", "1|10|1"),
+			sqltypes.MakeTestResult(fields, "4|40|4", "0|0|0"),
+		}
+	}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results(),
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(3)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: dummy_select {n: type:INT64 value:"3" } false false`,
+		`ExecuteMultiShard ks.20-: dummy_select {n: type:INT64 value:"3" } false false`,
+		// The rows 5, 4 and 3 come first: two of them are on -20.
+		`ExecuteMultiShard ` +
+			`ks.-20: delete from t where id in ::__vals/* vtgate:: filtered_replication_unfriendly */ {__vals: type:TUPLE values:<type:INT64 value:"50" > values:<type:INT64 value:"30" > n: type:INT64 value:"3" } ` +
+			`ks.20-: delete from t where id in ::__vals/* vtgate:: filtered_replication_unfriendly */ {__vals: type:TUPLE values:<type:INT64 value:"40" > n: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+
+	// The shards that have no rows to delete are skipped.
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results(),
+	}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(1)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: dummy_select {n: type:INT64 value:"1" } false false`,
+		`ExecuteMultiShard ks.20-: dummy_select {n: type:INT64 value:"1" } false false`,
+		`ExecuteMultiShard ks.-20: delete from t where id in ::__vals/* vtgate:: filtered_replication_unfriendly */ {__vals: type:TUPLE values:<type:INT64 value:"50" > n: type:INT64 value:"1" } true true`,
+	})
+
+	// Nothing is deleted if no row matches.
+	vc = &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(1)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: dummy_select {n: type:INT64 value:"1" } false false`,
+		`ExecuteMultiShard ks.20-: dummy_select {n: type:INT64 value:"1" } false false`,
+	})
+
+	// Failure case: the table has no primary key.
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("val|id|val", "int64|int64|int64"), "5|50|5")},
+	}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(1)}, false)
+	expectError(t, "Execute", err, `execDeleteScatter: unsupported: multi-shard DML with a limit on a table without a primary key`)
+
+	// Failure case: the limit is not a number.
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{"n": sqltypes.StringBindVariable("a")}, false)
+	expectError(t, "Execute", err, `execDeleteScatter: could not parse value: 'a'`)
+}
+
 func TestDeleteNoStream(t *testing.T) {
 	del := &Delete{}
 	err := del.StreamExecute(nil, nil, false, nil)
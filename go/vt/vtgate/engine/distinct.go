@@ -0,0 +1,208 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes the duplicate rows of its
+// input. It's used for a UNION whose parts cannot be sent to MySQL
+// as a single query. The rows are returned in the order in which
+// they were first seen. Text values are compared using the collation
+// of their field if vtgate models it exactly, and by their weight_string
+// otherwise. Other values are compared by their raw bytes. Since all
+// distinct rows have to be remembered, their number is limited by the
+// max memory rows of the vcursor.
+type Distinct struct {
+	// WeightStrings maps the text columns to the input columns
+	// that contain their weight_string. The weight_string columns
+	// are not compared themselves.
+	WeightStrings map[int]int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	Input Primitive
+}
+
+// MarshalJSON serializes the Distinct into a JSON representation.
+// It's used for testing and diagnostics.
+func (d *Distinct) MarshalJSON() ([]byte, error) {
+	marshalDistinct := struct {
+		Opcode              string
+		WeightStrings       map[int]int `json:",omitempty"`
+		TruncateColumnCount int         `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Distinct",
+		WeightStrings:       d.WeightStrings,
+		TruncateColumnCount: d.TruncateColumnCount,
+		Input:               d.Input,
+	}
+	return json.Marshal(marshalDistinct)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (d *Distinct) RouteType() string {
+	return d.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (d *Distinct) SetTruncateColumnCount(count int) {
+	d.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	qr, err := d.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	rows, err := d.dedup(seen, vcursor.MaxMemoryRows(), qr.Fields, qr.Rows)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields:       qr.Fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+		Extras:       qr.Extras,
+	}
+	return out.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The fields are only sent with the first result,
+	// but they're needed to compare the text values.
+	var fields []*querypb.Field
+	seen := make(map[string]bool)
+	return d.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			fields = qr.Fields
+		}
+		rows, err := d.dedup(seen, vcursor.MaxMemoryRows(), fields, qr.Rows)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 && qr.Fields == nil {
+			return nil
+		}
+		out := &sqltypes.Result{Fields: qr.Fields, Rows: rows}
+		return callback(out.Truncate(d.TruncateColumnCount))
+	})
+}
+
+// GetFields is a Primitive function.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := d.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(d.TruncateColumnCount), nil
+}
+
+// dedup returns the rows that are not in seen, and adds them to it.
+func (d *Distinct) dedup(seen map[string]bool, maxRows int, fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		key, err := d.key(fields, row)
+		if err != nil {
+			return nil, err
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if len(seen) > maxRows {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", maxRows)
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+// key returns the hash key of a row.
+func (d *Distinct) key(fields []*querypb.Field, row []sqltypes.Value) (string, error) {
+	var buf []byte
+	for col := range row {
+		if d.isWeightString(col) {
+			continue
+		}
+		var err error
+		if buf, err = appendCollatedValueKey(buf, fields, d.WeightStrings, row, col); err != nil {
+			return "", err
+		}
+	}
+	return string(buf), nil
+}
+
+// isWeightString returns true if col contains the weight_string
+// of another column.
+func (d *Distinct) isWeightString(col int) bool {
+	for _, weightCol := range d.WeightStrings {
+		if weightCol == col {
+			return true
+		}
+	}
+	return false
+}
+
+// appendCollatedValueKey appends the hash key of column col of row
+// to buf. Text values are replaced by their weight string. It's
+// computed by vtgate if their collation is modeled exactly, and
+// taken from the weight_string column of col otherwise. It's an
+// error if there's no such column, because vtgate would group
+// values differently than MySQL.
+func appendCollatedValueKey(buf []byte, fields []*querypb.Field, weightStrings map[int]int, row []sqltypes.Value, col int) ([]byte, error) {
+	v := row[col]
+	if !v.IsText() {
+		return appendValueKey(buf, v), nil
+	}
+	if col < len(fields) {
+		if coll, ok := evalengine.ExactCollationByID(fields[col].Charset); ok {
+			return appendValueKey(buf, sqltypes.MakeTrusted(v.Type(), coll.WeightString(v.Raw()))), nil
+		}
+	}
+	if weightCol, ok := weightStrings[col]; ok && weightCol < len(row) {
+		return appendValueKey(buf, row[weightCol]), nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cannot compare the text values of column %d without their weight_string", col)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|name|data|weight_string(name)",
+		"int64|varchar|varbinary|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|x|A",
+			"1|A |x|A",
+			"2|b|x|B",
+			"null|b|x|B",
+			"null|b|x|B",
+			"1|a|X|A",
+			"2|b|x|B",
+		)},
+	}
+	d := &Distinct{
+		WeightStrings:       map[int]int{1: 3},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+	wantFields := fields[:3]
+
+	// The collation of the name column is not known. So, its
+	// weight_string is compared instead. The varbinary column
+	// is compared as binary.
+	result, err := d.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "1|a|x", "2|b|x", "null|b|x", "1|a|X"), result)
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = d.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(t, err)
+	wantResults := sqltypes.MakeTestStreamingResults(wantFields, "1|a|x", "---", "2|b|x", "null|b|x", "---", "1|a|X")
+	assert.Equal(t, wantResults, results)
+
+	// A binary collation on the field is modeled exactly by vtgate,
+	// which makes the comparison case-sensitive.
+	fp.rewind()
+	fp.results[0].Fields[1].Charset = 63
+	result, err = d.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(result.Rows))
+
+	fp.rewind()
+	result, err = d.GetFields(noopVCursor{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, wantFields, result.Fields)
+
+	// Without a weight_string, the values of other collations
+	// cannot be compared.
+	fp.rewind()
+	fp.results[0].Fields[1].Charset = 33
+	d.WeightStrings = nil
+	_, err = d.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "unsupported: cannot compare the text values of column 1 without their weight_string")
+
+	d.Input = &fakePrimitive{sendErr: errors.New("input fail")}
+	_, err = d.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "input fail")
+}
+
+func TestDistinctMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+			"1",
+			"1",
+			"2",
+			"3",
+		)},
+	}
+	d := &Distinct{Input: fp}
+	_, err := d.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestDistinctMarshalJSON(t *testing.T) {
+	d := &Distinct{Input: &fakePrimitive{}}
+	out, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"Distinct","Input":{}}`, string(out))
+
+	d.WeightStrings = map[int]int{0: 1}
+	d.TruncateColumnCount = 1
+	out, err = json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"Distinct","WeightStrings":{"0":1},"TruncateColumnCount":1,"Input":{}}`, string(out))
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// DMLLimit contains the instructions to apply the LIMIT of
+// a multi-shard UPDATE or DELETE. The rows that match the
+// statement are locked and read from every shard, in the
+// transaction of the statement, and sorted to find the ones
+// to change. The queries of the statement are then restricted
+// to the primary keys of those rows, which are bound as
+// ListVarName for each shard.
+type DMLLimit struct {
+	// Query selects the ORDER BY columns of the rows that
+	// match the statement, followed by all their columns,
+	// with its ORDER BY and LIMIT, FOR UPDATE. The primary
+	// key of the table is found in the flags of the fields.
+	Query string
+
+	// OrderBy specifies how the rows of Query are sorted.
+	OrderBy []OrderbyParams `json:",omitempty"`
+
+	// Count is the row count of the LIMIT.
+	Count sqltypes.PlanValue
+}
+
+// lockRows locks the rows to change, and returns the shards that
+// have some, along with their bind variables and the primary key
+// column of the table. The shards are queried one at a time
+// because the rows of every shard must be told apart.
+func (l *DMLLimit) lockRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, sqlparser.ColIdent, error) {
+	value, err := l.Count.ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, sqlparser.ColIdent{}, err
+	}
+	count, err := sqltypes.ToInt64(value)
+	if err != nil {
+		return nil, nil, sqlparser.ColIdent{}, err
+	}
+
+	// The index of the shard of every row is appended to it.
+	var fields []*querypb.Field
+	var rows [][]sqltypes.Value
+	for i, rs := range rss {
+		queries := getQueries(l.Query, []map[string]*querypb.BindVariable{bindVars})
+		result, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, queries, false /* isDML */, false /* canAutocommit */)
+		if err := vterrors.Aggregate(errs); err != nil {
+			return nil, nil, sqlparser.ColIdent{}, err
+		}
+		if len(result.Rows) == 0 {
+			continue
+		}
+		fields = result.Fields
+		for _, row := range result.Rows {
+			rows = append(rows, append(row, sqltypes.NewInt64(int64(i))))
+		}
+	}
+	if len(rows) == 0 {
+		return nil, nil, sqlparser.ColIdent{}, nil
+	}
+	pkCol, err := l.primaryKeyColumn(fields)
+	if err != nil {
+		return nil, nil, sqlparser.ColIdent{}, err
+	}
+	sh := &sortHeap{
+		rows:    rows,
+		orderBy: l.OrderBy,
+	}
+	sort.Sort(sh)
+	if sh.err != nil {
+		return nil, nil, sqlparser.ColIdent{}, sh.err
+	}
+	if int64(len(rows)) > count {
+		rows = rows[:count]
+	}
+
+	keys := make([]*querypb.BindVariable, len(rss))
+	for _, row := range rows {
+		shard, err := sqltypes.ToInt64(row[len(row)-1])
+		if err != nil {
+			return nil, nil, sqlparser.ColIdent{}, err
+		}
+		if keys[shard] == nil {
+			keys[shard] = &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		}
+		keys[shard].Values = append(keys[shard].Values, sqltypes.ValueToProto(row[pkCol]))
+	}
+	var limitedRss []*srvtopo.ResolvedShard
+	var limitedBvs []map[string]*querypb.BindVariable
+	for i, rs := range rss {
+		if keys[i] == nil {
+			continue
+		}
+		bv := make(map[string]*querypb.BindVariable, len(bindVars)+1)
+		for k, v := range bindVars {
+			bv[k] = v
+		}
+		bv[ListVarName] = keys[i]
+		limitedRss = append(limitedRss, rs)
+		limitedBvs = append(limitedBvs, bv)
+	}
+	return limitedRss, limitedBvs, sqlparser.NewColIdent(fields[pkCol].Name), nil
+}
+
+// primaryKeyColumn returns the position of the primary key column in
+// the fields returned by Query. Only single-column primary keys are
+// supported.
+func (l *DMLLimit) primaryKeyColumn(fields []*querypb.Field) (int, error) {
+	pkCol := -1
+	for col := len(l.OrderBy); col < len(fields); col++ {
+		if fields[col].Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+			continue
+		}
+		if pkCol != -1 {
+			return 0, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-shard DML with a limit on a table with a multi-column primary key")
+		}
+		pkCol = col
+	}
+	if pkCol == -1 {
+		return 0, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-shard DML with a limit on a table without a primary key")
+	}
+	return pkCol, nil
+}
+
+// restrictToKeys rewrites a query of a multi-shard DML with a LIMIT
+// so that it only reads or changes the rows that have the primary
+// keys bound as ListVarName. Its ORDER BY and LIMIT are removed.
+func restrictToKeys(query string, pk sqlparser.ColIdent) (string, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	where := sqlparser.NewWhere(sqlparser.WhereStr, &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     &sqlparser.ColName{Name: pk},
+		Right:    sqlparser.ListArg("::" + ListVarName),
+	})
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.Where, stmt.OrderBy, stmt.Limit = where, nil, nil
+	case *sqlparser.Update:
+		stmt.Where, stmt.OrderBy, stmt.Limit = where, nil, nil
+	case *sqlparser.Delete:
+		stmt.Where, stmt.OrderBy, stmt.Limit = where, nil, nil
+	default:
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement in multi-shard DML: %s", query)
+	}
+	return sqlparser.String(stmt), nil
+}
+
+// shardBindVars returns the bind variables of every shard
+// for a statement that sends the same ones to all of them.
+func shardBindVars(bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) []map[string]*querypb.BindVariable {
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		bvs[i] = bindVars
+	}
+	return bvs
+}
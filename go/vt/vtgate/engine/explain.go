@@ -0,0 +1,262 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file has the functions that describe a plan for
+// EXPLAIN FORMAT=VITESS.
+
+var explainFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.Int64},
+	{Name: "parent", Type: sqltypes.Int64},
+	{Name: "operator", Type: sqltypes.VarChar},
+	{Name: "keyspace", Type: sqltypes.VarChar},
+	{Name: "table", Type: sqltypes.VarChar},
+	{Name: "details", Type: sqltypes.VarChar},
+}
+
+var analyzeFields = []*querypb.Field{
+	{Name: "executions", Type: sqltypes.Int64},
+	{Name: "rows", Type: sqltypes.Int64},
+	{Name: "time_us", Type: sqltypes.Int64},
+}
+
+// Explain returns the description of a plan. There is one row per
+// primitive. The primitives are listed in depth-first order, and
+// the parent column has the id of the primitive they are an input of.
+// The details column has the JSON representation of the primitive
+// without its inputs.
+func Explain(p Primitive) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{Fields: explainFields}
+	err := describe(p, func(p Primitive, row []sqltypes.Value) {
+		result.Rows = append(result.Rows, row)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// ExplainAnalyze executes the plan and returns its description
+// like Explain. For every primitive, it adds the number of times
+// it was executed, the number of rows it returned, and the time
+// spent in it and its inputs. The inputs of the primitives get
+// replaced. So, the plan must not be shared.
+func ExplainAnalyze(vcursor VCursor, p Primitive, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	root := instrument(p)
+	if _, err := root.Execute(vcursor, bindVars, true); err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: append(append([]*querypb.Field(nil), explainFields...), analyzeFields...)}
+	err := describe(root, func(p Primitive, row []sqltypes.Value) {
+		ap := p.(*analyzedPrimitive)
+		row = append(row,
+			sqltypes.NewInt64(int64(ap.executions)),
+			sqltypes.NewInt64(int64(ap.rows)),
+			sqltypes.NewInt64(ap.elapsed.Nanoseconds()/int64(time.Microsecond)),
+		)
+		result.Rows = append(result.Rows, row)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// describe walks the primitive tree and calls the visit function
+// with the description of every primitive.
+func describe(root Primitive, visit func(p Primitive, row []sqltypes.Value)) error {
+	id := 0
+	var walk func(p Primitive, parent int) error
+	walk = func(p Primitive, parent int) error {
+		id++
+		current := id
+		row, err := describePrimitive(p, current, parent)
+		if err != nil {
+			return err
+		}
+		visit(p, row)
+		for _, input := range inputs(p) {
+			if *input == nil {
+				continue
+			}
+			if err := walk(*input, current); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(root, 0)
+}
+
+func describePrimitive(p Primitive, id, parent int) ([]sqltypes.Value, error) {
+	if ap, ok := p.(*analyzedPrimitive); ok {
+		p = ap.Primitive
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var details map[string]interface{}
+	if err := json.Unmarshal(b, &details); err != nil {
+		return nil, err
+	}
+	operator, _ := details["Opcode"].(string)
+	delete(details, "Opcode")
+	delete(details, "Keyspace")
+	delete(details, "Table")
+	for k, v := range details {
+		if isPrimitiveJSON(v) {
+			delete(details, k)
+		}
+	}
+	b, err = jsonutil.MarshalNoEscape(details)
+	if err != nil {
+		return nil, err
+	}
+	parentValue := sqltypes.NULL
+	if parent != 0 {
+		parentValue = sqltypes.NewInt64(int64(parent))
+	}
+	return []sqltypes.Value{
+		sqltypes.NewInt64(int64(id)),
+		parentValue,
+		sqltypes.NewVarChar(operator),
+		sqltypes.NewVarChar(p.GetKeyspaceName()),
+		sqltypes.NewVarChar(p.GetTableName()),
+		// The encoder terminates the JSON with a newline.
+		sqltypes.NewVarChar(strings.TrimSuffix(string(b), "\n")),
+	}, nil
+}
+
+// isPrimitiveJSON returns true if the unmarshaled JSON value
+// is a primitive, or a list of primitives.
+func isPrimitiveJSON(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		_, ok := v["Opcode"]
+		return ok
+	case []interface{}:
+		return len(v) != 0 && isPrimitiveJSON(v[0])
+	}
+	return false
+}
+
+// inputs returns pointers to the inputs of the primitive.
+// This must be updated when a primitive with inputs is added.
+func inputs(p Primitive) []*Primitive {
+	switch p := p.(type) {
+	case *analyzedPrimitive:
+		return inputs(p.Primitive)
+	case *Concatenate:
+		var sources []*Primitive
+		for i := range p.Sources {
+			sources = append(sources, &p.Sources[i])
+		}
+		return sources
+	case *Distinct:
+		return []*Primitive{&p.Input}
+	case *Filter:
+		return []*Primitive{&p.Input}
+	case *HashAggregate:
+		return []*Primitive{&p.Input}
+	case *HashJoin:
+		return []*Primitive{&p.Left, &p.Right}
+	case *Insert:
+		return []*Primitive{&p.Input}
+	case *Join:
+		return []*Primitive{&p.Left, &p.Right}
+	case *Limit:
+		return []*Primitive{&p.Input}
+	case *MemorySort:
+		return []*Primitive{&p.Input}
+	case *OrderedAggregate:
+		return []*Primitive{&p.Input}
+	case *Projection:
+		return []*Primitive{&p.Input}
+	case *PulloutSubquery:
+		return []*Primitive{&p.Subquery, &p.Underlying}
+	case *SemiJoin:
+		return []*Primitive{&p.Left, &p.Right}
+	case *Subquery:
+		return []*Primitive{&p.Subquery}
+	case *Window:
+		return []*Primitive{&p.Input}
+	}
+	return nil
+}
+
+// instrument wraps the primitive and its inputs
+// into primitives that collect execution statistics.
+func instrument(p Primitive) *analyzedPrimitive {
+	for _, input := range inputs(p) {
+		if *input != nil {
+			*input = instrument(*input)
+		}
+	}
+	return &analyzedPrimitive{Primitive: p}
+}
+
+// analyzedPrimitive collects the execution statistics
+// of the primitive it wraps.
+type analyzedPrimitive struct {
+	Primitive
+	executions int
+	rows       int
+	elapsed    time.Duration
+}
+
+// MarshalJSON serializes the wrapped primitive.
+func (ap *analyzedPrimitive) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ap.Primitive)
+}
+
+// Execute satisfies the Primitive interface.
+func (ap *analyzedPrimitive) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	start := time.Now()
+	defer func() { ap.elapsed += time.Since(start) }()
+	ap.executions++
+	result, err := ap.Primitive.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	ap.rows += len(result.Rows)
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (ap *analyzedPrimitive) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	start := time.Now()
+	defer func() { ap.elapsed += time.Since(start) }()
+	ap.executions++
+	return ap.Primitive.StreamExecute(vcursor, bindVars, wantfields, func(result *sqltypes.Result) error {
+		ap.rows += len(result.Rows)
+		return callback(result)
+	})
+}
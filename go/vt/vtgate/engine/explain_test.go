@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestExplain(t *testing.T) {
+	route := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"select id from t where id < 10",
+		"select id from t where 1 != 1",
+	)
+	route.TableName = "t"
+	l := &Limit{
+		Count: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)},
+		Input: &Join{
+			Opcode: NormalJoin,
+			Left:   route,
+			Right:  NewSimpleRoute(SelectUnsharded, &vindexes.Keyspace{Name: "main"}),
+			Cols:   []int{-1},
+		},
+	}
+	result, err := Explain(l)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|parent|operator|keyspace|table|details",
+			"int64|int64|varchar|varchar|varchar|varchar",
+		),
+		`1|null|Limit|ks_main|t_|{"Count":1,"Offset":null}`,
+		`2|1|Join|ks_main|t_|{"Cols":[-1]}`,
+		`3|2|SelectScatter|ks|t|{"FieldQuery":"select id from t where 1 != 1","Query":"select id from t where id < 10"}`,
+		`4|2|SelectUnsharded|main||{}`,
+	)
+	expectResult(t, "Explain", result, wantResult)
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"a",
+					"int64",
+				),
+				"1",
+				"2",
+				"3",
+			),
+		},
+	}
+	l := &Limit{
+		Count: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)},
+		Input: input,
+	}
+	result, err := ExplainAnalyze(noopVCursor{}, l, map[string]*querypb.BindVariable{})
+	assert.NoError(t, err)
+
+	// The time is not deterministic. The fake primitive is not
+	// recognized as an input in the details of the limit.
+	for _, row := range result.Rows {
+		row[len(row)-1] = sqltypes.NewInt64(0)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|parent|operator|keyspace|table|details|executions|rows|time_us",
+			"int64|int64|varchar|varchar|varchar|varchar|int64|int64|int64",
+		),
+		`1|null|Limit|fakeKs|fakeTable|{"Count":1,"Input":{},"Offset":null}|1|1|0`,
+		`2|1||fakeKs|fakeTable|{}|1|3|0`,
+	)
+	expectResult(t, "ExplainAnalyze", result, wantResult)
+	// The inputs are instrumented.
+	_, ok := l.Input.(*analyzedPrimitive)
+	assert.True(t, ok)
+
+	_, err = ExplainAnalyze(noopVCursor{}, &fakePrimitive{sendErr: errors.New("input err")}, nil)
+	assert.EqualError(t, err, "input err")
+}
@@ -68,10 +68,22 @@ func (t noopVCursor) AutocommitApproval() bool {
 	panic("unimplemented")
 }
 
+func (t noopVCursor) InTransaction() bool {
+	return false
+}
+
+func (t noopVCursor) AfterTransaction(hook func()) {
+	hook()
+}
+
 func (t noopVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	panic("unimplemented")
 }
 
+func (t noopVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	panic("unimplemented")
+}
+
 func (t noopVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	panic("unimplemented")
 }
@@ -154,6 +166,11 @@ func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*qu
 	return f.nextResult()
 }
 
+func (f *loggingVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	f.log = append(f.log, fmt.Sprintf("ExecuteLock %s %v %s %s", query.Sql, printBindVars(query.BindVariables), rs.Target.Keyspace, rs.Target.Shard))
+	return f.nextResult()
+}
+
 func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	f.log = append(f.log, fmt.Sprintf("StreamExecuteMulti %s %s", query, printResolvedShardsBindVars(rss, bindVars)))
 	r, err := f.nextResult()
@@ -0,0 +1,141 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that returns the rows of its input for
+// which the predicate is true. It's used for the conditions that
+// cannot be pushed down to the shards, like a HAVING clause on
+// aggregates computed by vtgate.
+type Filter struct {
+	Predicate evalengine.Expr
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	// It's used if the input had to return extra columns
+	// for the predicate.
+	TruncateColumnCount int
+	Input               Primitive
+}
+
+// MarshalJSON serializes the Filter into a JSON representation.
+// It's used for testing and diagnostics.
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	marshalFilter := struct {
+		Opcode              string
+		Predicate           evalengine.Expr
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Filter",
+		Predicate:           f.Predicate,
+		TruncateColumnCount: f.TruncateColumnCount,
+		Input:               f.Input,
+	}
+	return json.Marshal(marshalFilter)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (f *Filter) SetTruncateColumnCount(count int) {
+	f.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	qr, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	qr, err = f.filter(qr, qr.Fields, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr.RowsAffected = uint64(len(qr.Rows))
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The fields are only sent with the first result,
+	// but they're needed to evaluate the predicate.
+	var fields []*querypb.Field
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			fields = qr.Fields
+		}
+		qr, err := f.filter(qr, fields, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(qr.Truncate(f.TruncateColumnCount))
+	})
+}
+
+// GetFields is a Primitive function.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+// filter returns a result with the rows that satisfy the predicate.
+// fields describe the columns of the rows.
+func (f *Filter) filter(in *sqltypes.Result, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	out := &sqltypes.Result{
+		Fields: in.Fields,
+		Extras: in.Extras,
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	for _, row := range in.Rows {
+		env.Row = row
+		val, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if evalengine.IsTrue(val) {
+			out.Rows = append(out.Rows, row)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|3",
+			"c|null",
+			"d|5",
+		)},
+	}
+
+	// count(*) > :x
+	f := &Filter{
+		Predicate: &evalengine.Comparison{
+			Op:    evalengine.CompareGreaterThan,
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewBindVariable("x"),
+		},
+		Input: fp,
+	}
+	bv := map[string]*querypb.BindVariable{"x": sqltypes.Int64BindVariable(2)}
+
+	result, err := f.Execute(noopVCursor{}, bv, true)
+	assert.NoError(t, err)
+	fp.ExpectLog(t, []string{`Execute x: type:INT64 value:"2"  true`})
+	assert.Equal(t, sqltypes.MakeTestResult(fields, "b|3", "d|5"), result)
+
+	// Streaming gets the results in batches of two rows.
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = f.StreamExecute(noopVCursor{}, bv, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(t, err)
+	wantResults := sqltypes.MakeTestStreamingResults(fields, "b|3", "---", "d|5")
+	assert.Equal(t, wantResults, results)
+
+	// A predicate that cannot be evaluated fails the query.
+	fp.rewind()
+	_, err = f.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "missing bind var x")
+
+	f.Input = &fakePrimitive{sendErr: errors.New("input fail")}
+	_, err = f.Execute(noopVCursor{}, bv, true)
+	assert.EqualError(t, err, "input fail")
+}
+
+func TestFilterTruncate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(a)",
+				"varchar|decimal",
+			),
+			"a|1",
+			"b|3",
+		)},
+	}
+
+	// The predicate uses a column that's not returned.
+	f := &Filter{
+		Predicate: &evalengine.Comparison{
+			Op:    evalengine.CompareLessThan,
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewLiteral(sqltypes.NewInt64(2)),
+		},
+		TruncateColumnCount: 1,
+		Input:               fp,
+	}
+	wantFields := sqltypes.MakeTestFields("col", "varchar")
+
+	result, err := f.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "a"), result)
+
+	fp.rewind()
+	result, err = f.GetFields(noopVCursor{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, wantFields, result.Fields)
+}
+
+func TestFilterMarshalJSON(t *testing.T) {
+	f := &Filter{
+		Predicate: &evalengine.IsNull{Expr: evalengine.NewColumn(1)},
+		Input:     &fakePrimitive{},
+	}
+	out, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"Filter","Predicate":"[COLUMN 1] is null","Input":{}}`, string(out))
+}
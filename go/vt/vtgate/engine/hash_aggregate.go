@@ -0,0 +1,363 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*HashAggregate)(nil)
+
+// HashAggregate is a primitive that aggregates the rows of the
+// underlying primitive without requiring them to be sorted. The
+// rows are grouped by the Keys in a hash table, and rows with
+// duplicate keys are aggregated using the Aggregate functions.
+// Since all groups have to be held in memory until the input is
+// exhausted, the number of groups is limited by the max memory rows
+// of the vcursor. HashAggregate is used when the underlying primitive
+// cannot deliver the rows in the order of the keys, like for
+// 'select count(*) from t group by a+1'. The groups are returned
+// in the order in which they were first seen.
+type HashAggregate struct {
+	// HasDistinct is true if one of the aggregates is distinct.
+	HasDistinct bool
+	// Aggregates specifies the aggregation parameters for each
+	// aggregation function: function opcode and input column number.
+	Aggregates []AggregateParams
+
+	// Keys specifies the input values that must be used for
+	// the aggregation key.
+	Keys []int
+
+	// WeightStrings maps the text columns of the keys and of the
+	// distinct aggregate to the input columns that contain their
+	// weight_string. They're used to compare the values whose
+	// collation is not modeled exactly by vtgate.
+	WeightStrings map[int]int
+
+	// PostExprs specifies the expressions that must be evaluated
+	// on every aggregated row before it's returned.
+	PostExprs []PostExprParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the HashAggregate into a JSON representation.
+// It's used for testing and diagnostics.
+func (ha *HashAggregate) MarshalJSON() ([]byte, error) {
+	marshalHashAggregate := struct {
+		Opcode              string
+		HasDistinct         bool `json:",omitempty"`
+		Aggregates          []AggregateParams
+		Keys                []int
+		WeightStrings       map[int]int      `json:",omitempty"`
+		PostExprs           []PostExprParams `json:",omitempty"`
+		TruncateColumnCount int              `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "HashAggregate",
+		HasDistinct:         ha.HasDistinct,
+		Aggregates:          ha.Aggregates,
+		Keys:                ha.Keys,
+		WeightStrings:       ha.WeightStrings,
+		PostExprs:           ha.PostExprs,
+		TruncateColumnCount: ha.TruncateColumnCount,
+		Input:               ha.Input,
+	}
+	return json.Marshal(marshalHashAggregate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (ha *HashAggregate) RouteType() string {
+	return ha.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (ha *HashAggregate) GetKeyspaceName() string {
+	return ha.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (ha *HashAggregate) GetTableName() string {
+	return ha.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (ha *HashAggregate) SetTruncateColumnCount(count int) {
+	ha.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (ha *HashAggregate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := ha.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	ht := ha.newHashTable(vcursor.MaxMemoryRows())
+	ht.setInputFields(result.Fields)
+	fields := convertFields(ha.HasDistinct, ha.Aggregates, result.Fields)
+	if err := ht.add(fields, result.Rows); err != nil {
+		return nil, err
+	}
+	rows, err := ht.result(fields, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields:       convertPostExprFields(ha.PostExprs, fields, bindVars),
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+		Extras:       result.Extras,
+	}
+	return out.Truncate(ha.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (ha *HashAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(ha.TruncateColumnCount))
+	}
+
+	ht := ha.newHashTable(vcursor.MaxMemoryRows())
+	err := ha.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			ht.setInputFields(qr.Fields)
+			fields = convertFields(ha.HasDistinct, ha.Aggregates, qr.Fields)
+			if err := cb(&sqltypes.Result{Fields: convertPostExprFields(ha.PostExprs, fields, bindVars)}); err != nil {
+				return err
+			}
+		}
+		return ht.add(fields, qr.Rows)
+	})
+	if err != nil {
+		return err
+	}
+
+	rows, err := ht.result(fields, bindVars)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// GetFields is a Primitive function.
+func (ha *HashAggregate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ha.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: convertPostExprFields(ha.PostExprs, convertFields(ha.HasDistinct, ha.Aggregates, qr.Fields), bindVars)}
+	return qr.Truncate(ha.TruncateColumnCount), nil
+}
+
+func (ha *HashAggregate) newHashTable(maxRows int) *hashTable {
+	return &hashTable{
+		ha:      ha,
+		maxRows: maxRows,
+		index:   make(map[string]int),
+	}
+}
+
+// hashTable holds the groups of a HashAggregate while
+// the input is being consumed.
+type hashTable struct {
+	ha *HashAggregate
+	// maxRows is the maximum number of values that can be held.
+	// Both the groups and the values seen by the distinct
+	// aggregate count towards the limit.
+	maxRows int
+	// numRows is the number of values currently held.
+	numRows int
+	// index maps the key of a group to its position in groups.
+	index  map[string]int
+	groups []*hashGroup
+	// inputFields are the fields of the input, whose collations
+	// are used to compare text values.
+	inputFields []*querypb.Field
+}
+
+// setInputFields remembers the fields of the input. They must be
+// copied before convertFields replaces the distinct aggregate ones.
+func (ht *hashTable) setInputFields(fields []*querypb.Field) {
+	ht.inputFields = append([]*querypb.Field(nil), fields...)
+}
+
+// hashGroup is the aggregated row of a group. distincts is the set
+// of values seen by the distinct aggregate.
+type hashGroup struct {
+	row       []sqltypes.Value
+	distincts map[string]bool
+}
+
+// add aggregates the rows into the hash table.
+func (ht *hashTable) add(fields []*querypb.Field, rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		key, err := ht.groupKey(row)
+		if err != nil {
+			return err
+		}
+		i, ok := ht.index[key]
+		if !ok {
+			newRow, curDistinct := convertRow(ht.ha.HasDistinct, ht.ha.Aggregates, row)
+			if !ht.ha.HasDistinct {
+				// merge updates the row in place. So, it must not
+				// be shared with the input.
+				newRow = sqltypes.CopyRow(row)
+			}
+			group := &hashGroup{row: newRow}
+			if ht.ha.HasDistinct {
+				group.distincts = make(map[string]bool)
+				if !curDistinct.IsNull() {
+					distinctKey, err := ht.valueKey(row, ht.distinctCol())
+					if err != nil {
+						return err
+					}
+					group.distincts[distinctKey] = true
+					ht.numRows++
+				}
+			}
+			ht.index[key] = len(ht.groups)
+			ht.groups = append(ht.groups, group)
+			ht.numRows++
+			if ht.numRows > ht.maxRows {
+				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", ht.maxRows)
+			}
+			continue
+		}
+		if err := ht.merge(fields, ht.groups[i], row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge aggregates the row into the group. Unlike OrderedAggregate,
+// the values of the distinct aggregate can arrive in any order. So,
+// all of them are remembered.
+func (ht *hashTable) merge(fields []*querypb.Field, group *hashGroup, row []sqltypes.Value) error {
+	for _, aggr := range ht.ha.Aggregates {
+		if aggr.isDistinct() {
+			if row[aggr.Col].IsNull() {
+				continue
+			}
+			key, err := ht.valueKey(row, aggr.Col)
+			if err != nil {
+				return err
+			}
+			if group.distincts[key] {
+				continue
+			}
+			group.distincts[key] = true
+			ht.numRows++
+			if ht.numRows > ht.maxRows {
+				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", ht.maxRows)
+			}
+		}
+		var err error
+		group.row[aggr.Col], err = aggregate(fields, aggr, group.row[aggr.Col], row[aggr.Col])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// result returns the aggregated rows, with the PostExprs evaluated.
+func (ht *hashTable) result(fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	rows := make([][]sqltypes.Value, 0, len(ht.groups))
+	for _, group := range ht.groups {
+		rows = append(rows, group.row)
+	}
+	if len(rows) == 0 && len(ht.ha.Keys) == 0 {
+		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
+		// different aggregation functions
+		row, err := createEmptyRow(ht.ha.Aggregates, len(fields))
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		var err error
+		if rows[i], err = evalPostExprs(ht.ha.PostExprs, fields, bindVars, row); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// distinctCol returns the column of the distinct aggregate.
+func (ht *hashTable) distinctCol() int {
+	for _, aggr := range ht.ha.Aggregates {
+		if aggr.isDistinct() {
+			return aggr.Col
+		}
+	}
+	return -1
+}
+
+// groupKey returns the hash key of the group the row belongs to.
+func (ht *hashTable) groupKey(row []sqltypes.Value) (string, error) {
+	var buf []byte
+	for _, key := range ht.ha.Keys {
+		var err error
+		if buf, err = appendCollatedValueKey(buf, ht.inputFields, ht.ha.WeightStrings, row, key); err != nil {
+			return "", err
+		}
+	}
+	return string(buf), nil
+}
+
+// valueKey returns the hash key of the value of column col.
+func (ht *hashTable) valueKey(row []sqltypes.Value, col int) (string, error) {
+	buf, err := appendCollatedValueKey(nil, ht.inputFields, ht.ha.WeightStrings, row, col)
+	return string(buf), err
+}
+
+// appendValueKey appends an encoding of v to buf that's unambiguous
+// when several values are concatenated. NULLs are distinct from all
+// other values, including the empty string. The values of a column
+// are expected to have the same type, so they're compared by their raw
+// bytes. Text values must be replaced by their weight strings, which
+// appendCollatedValueKey does.
+func appendValueKey(buf []byte, v sqltypes.Value) []byte {
+	if v.IsNull() {
+		return append(buf, 0)
+	}
+	buf = append(buf, 1)
+	var lenbuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenbuf[:], uint64(len(v.Raw())))
+	buf = append(buf, lenbuf[:n]...)
+	return append(buf, v.Raw()...)
+}
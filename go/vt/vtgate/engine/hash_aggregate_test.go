@@ -0,0 +1,423 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestHashAggregateExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)|max(id)",
+		"varbinary|decimal|int64",
+	)
+	input := sqltypes.MakeTestResult(
+		fields,
+		"b|2|5",
+		"a|1|1",
+		"null|1|7",
+		"b|3|2",
+		"a|1|3",
+		"null|2|4",
+	)
+	fp := &fakePrimitive{results: []*sqltypes.Result{input}}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}, {
+			Opcode: AggregateMax,
+			Col:    2,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"b|5|5",
+		"a|2|3",
+		"null|3|7",
+	)
+	assert.Equal(wantResult, result)
+
+	// The input rows must not be modified.
+	assert.Equal("2", input.Rows[0][1].ToString(), "input")
+}
+
+func TestHashAggregateStreamExecute(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)|weight_string(col)",
+		"varchar|decimal|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"c|3|C",
+			"a|1|A",
+			"b|2|B",
+			"A|1|A",
+			"C|4|C",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys:                []int{2},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	var results []*sqltypes.Result
+	err := ha.StreamExecute(noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"col|count(*)",
+			"varchar|decimal",
+		),
+		"c|7",
+		"a|2",
+		"b|2",
+	)
+	assert.Equal(wantResults, results)
+}
+
+func TestHashAggregateCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"concat(col, '')|count(distinct name)|weight_string(concat(col, ''))|weight_string(name)",
+		"varchar|varchar|varbinary|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|Bob|A|BOB",
+			"A |bob|A|BOB",
+			"b|bob|B|BOB",
+			"a|alice|A|ALICE",
+		)},
+	}
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct name)",
+		}},
+		Keys:                []int{0},
+		WeightStrings:       map[int]int{0: 2, 1: 3},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	// The collations of the fields are not known. So, the keys
+	// and the distinct values are compared by their weight_string.
+	result, err := ha.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	wantFields := sqltypes.MakeTestFields(
+		"concat(col, '')|count(distinct name)",
+		"varchar|int64",
+	)
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "a|2", "b|1"), result)
+
+	// A binary collation on the fields is modeled exactly by vtgate,
+	// which makes the comparisons case-sensitive.
+	fp.rewind()
+	fp.results[0].Fields = sqltypes.MakeTestFields(
+		"concat(col, '')|count(distinct name)|weight_string(concat(col, ''))|weight_string(name)",
+		"varchar|varchar|varbinary|varbinary",
+	)
+	fp.results[0].Fields[0].Charset = 63
+	fp.results[0].Fields[1].Charset = 63
+	var results []*sqltypes.Result
+	err = ha.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "a|2", "A |1", "b|1").Rows, results[1].Rows)
+
+	// Without a weight_string, the values of other collations
+	// cannot be compared.
+	fp.rewind()
+	fp.results[0].Fields[1].Charset = 45
+	ha.WeightStrings = map[int]int{0: 2}
+	_, err = ha.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "unsupported: cannot compare the text values of column 1 without their weight_string")
+}
+
+func TestHashAggregateCountDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|count(*)",
+				"varbinary|decimal|int64",
+			),
+			"a|1|1",
+			"b|null|1",
+			"a|2|1",
+			"b|1|1",
+			"a|1|2",
+			"b|null|1",
+			"c|null|1",
+			"a|3|1",
+			"b|1|1",
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct col2)",
+		}, {
+			Opcode: AggregateCount,
+			Col:    2,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|count(distinct col2)|count(*)",
+			"varbinary|int64|int64",
+		),
+		"a|3|5",
+		"b|1|4",
+		"c|0|1",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateSumDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"varbinary|int64",
+			),
+			"a|2",
+			"a|3",
+			"a|2",
+			"b|null",
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSumDistinct,
+			Col:    1,
+			Alias:  "sum(distinct col2)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|sum(distinct col2)",
+			"varbinary|decimal",
+		),
+		"a|5",
+		"b|null",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateNoInput(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"count(*)|sum(col)",
+		"int64|decimal",
+	)
+	fp := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields)}}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    0,
+		}, {
+			Opcode: AggregateSum,
+			Col:    1,
+		}},
+		PostExprs: []PostExprParams{{
+			Col:   1,
+			Expr:  &evalengine.Arithmetic{Op: evalengine.ArithmeticDivide, Left: evalengine.NewColumn(1), Right: evalengine.NewColumn(0)},
+			Alias: "avg(col)",
+		}},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*)|avg(col)",
+			"int64|float64",
+		),
+		"0|null",
+	)
+	assert.Equal(wantResult, result)
+
+	// With keys, no rows are returned.
+	fp.rewind()
+	ha.Keys = []int{0}
+	var results []*sqltypes.Result
+	err = ha.StreamExecute(noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal([]*sqltypes.Result{{Fields: wantResult.Fields}}, results)
+}
+
+func TestHashAggregateGetFields(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|col2|weight_string(col)",
+				"varchar|int64|varbinary",
+			),
+		)},
+	}
+
+	ha := &HashAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct col2)",
+		}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	got, err := ha.GetFields(nil, nil)
+	assert.NoError(err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct col2)",
+			"varchar|int64",
+		),
+	)
+	assert.Equal(wantResult, got)
+}
+
+func TestHashAggregateMemoryLimit(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"int64|int64",
+	)
+	var rows []string
+	for i := 0; i < testMaxMemoryRows+1; i++ {
+		rows = append(rows, sqltypes.NewInt64(int64(i)).ToString()+"|1")
+	}
+	fp := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)}}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	want := "in-memory row count exceeded allowed limit of 100"
+	_, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	fp.rewind()
+	err = ha.StreamExecute(noopVCursor{}, nil, false, func(_ *sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, want)
+
+	// The same number of rows with a single key fits.
+	fp.rewind()
+	ha.Keys = nil
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(0), sqltypes.NewInt64(101)}}, result.Rows)
+}
+
+func TestHashAggregateInputFail(t *testing.T) {
+	fp := &fakePrimitive{sendErr: errors.New("input fail")}
+
+	ha := &HashAggregate{Input: fp}
+
+	want := "input fail"
+	if _, err := ha.Execute(noopVCursor{}, nil, false); err == nil || err.Error() != want {
+		t.Errorf("ha.Execute(): %v, want %s", err, want)
+	}
+
+	fp.rewind()
+	if err := ha.StreamExecute(noopVCursor{}, nil, false, func(_ *sqltypes.Result) error { return nil }); err == nil || err.Error() != want {
+		t.Errorf("ha.StreamExecute(): %v, want %s", err, want)
+	}
+
+	fp.rewind()
+	if _, err := ha.GetFields(noopVCursor{}, nil); err == nil || err.Error() != want {
+		t.Errorf("ha.GetFields(): %v, want %s", err, want)
+	}
+}
+
+func TestHashAggregateMarshalJSON(t *testing.T) {
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    1,
+		}},
+		Keys:                []int{2},
+		TruncateColumnCount: 2,
+		Input:               &fakePrimitive{},
+	}
+	out, err := json.Marshal(ha)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"HashAggregate","Aggregates":[{"Opcode":"sum","Col":1}],"Keys":[2],"TruncateColumnCount":2,"Input":{}}`, string(out))
+}
@@ -0,0 +1,600 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin is a join primitive that executes each side only once.
+// The rows of the LHS are loaded into a hash table indexed by
+// LHSKeys. The rows of the RHS are then matched against it using
+// RHSKeys. Unlike Join, the RHS cannot depend on values of the LHS.
+// The rows are returned in the order of the RHS. For left joins,
+// the LHS rows that have no match are returned at the end.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the HashJoin. They can be any primitive.
+	Left, Right Primitive
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It follows the same convention
+	// as Join.Cols.
+	Cols []int
+
+	// LHSKeys and RHSKeys are the columns of the left and right
+	// results that must be equal for two rows to be joined.
+	// NULL values never match. The values are compared like
+	// MySQL does, using the collations of the fields for text.
+	LHSKeys, RHSKeys []int
+
+	// LHSWeightStrings and RHSWeightStrings map the text keys to the
+	// columns of the left and right results that contain their
+	// weight_string. They're used to compare the values whose
+	// collation is not modeled exactly by vtgate.
+	LHSWeightStrings, RHSWeightStrings map[int]int
+
+	// MaxRows is the maximum number of LHS rows that can be held
+	// in memory. If 0, the max memory rows of the vcursor is used.
+	MaxRows int
+
+	// ExprCols, Filter and PostExprs are evaluated on
+	// the joined rows. See Join for their usage.
+	ExprCols  []int
+	Filter    evalengine.Expr
+	PostExprs []PostExprParams
+}
+
+// MarshalJSON serializes the HashJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (hj *HashJoin) MarshalJSON() ([]byte, error) {
+	marshalHashJoin := struct {
+		Opcode           string
+		Left             Primitive `json:",omitempty"`
+		Right            Primitive `json:",omitempty"`
+		Cols             []int     `json:",omitempty"`
+		LHSKeys          []int
+		RHSKeys          []int
+		LHSWeightStrings map[int]int      `json:",omitempty"`
+		RHSWeightStrings map[int]int      `json:",omitempty"`
+		MaxRows          int              `json:",omitempty"`
+		ExprCols         []int            `json:",omitempty"`
+		Filter           evalengine.Expr  `json:",omitempty"`
+		PostExprs        []PostExprParams `json:",omitempty"`
+	}{
+		Opcode:           "Hash" + hj.Opcode.String(),
+		Left:             hj.Left,
+		Right:            hj.Right,
+		Cols:             hj.Cols,
+		LHSKeys:          hj.LHSKeys,
+		RHSKeys:          hj.RHSKeys,
+		LHSWeightStrings: hj.LHSWeightStrings,
+		RHSWeightStrings: hj.RHSWeightStrings,
+		MaxRows:          hj.MaxRows,
+		ExprCols:         hj.ExprCols,
+		Filter:           hj.Filter,
+		PostExprs:        hj.PostExprs,
+	}
+	return json.Marshal(marshalHashJoin)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	lt, err := hj.buildTable(vcursor, bindVars, lresult.Fields, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	if len(lresult.Rows) == 0 {
+		// There's nothing to match. There's no need to execute the RHS.
+		if wantfields {
+			rresult, err := hj.Right.GetFields(vcursor, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			result.Fields = lt.joiner.joinFields(lresult.Fields, rresult.Fields, bindVars)
+		}
+		return result, nil
+	}
+
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if wantfields {
+		result.Fields = lt.joiner.joinFields(lresult.Fields, rresult.Fields, bindVars)
+	}
+	lt.rfields = rresult.Fields
+	if result.Rows, err = lt.probe(rresult.Rows); err != nil {
+		return nil, err
+	}
+	if hj.Opcode == LeftJoin {
+		rows, err := lt.unmatched()
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, rows...)
+	}
+	if len(result.Rows) > vcursor.MaxMemoryRows() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The LHS has to be fully loaded before the RHS can be probed.
+	var lfields []*querypb.Field
+	var lrows [][]sqltypes.Value
+	err := hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if len(lresult.Fields) != 0 {
+			lfields = lresult.Fields
+		}
+		lrows = append(lrows, lresult.Rows...)
+		if len(lrows) > hj.maxRows(vcursor) {
+			return hj.limitExceeded(vcursor)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	lt, err := hj.buildTable(vcursor, bindVars, lfields, lrows)
+	if err != nil {
+		return err
+	}
+
+	if len(lrows) == 0 {
+		if !wantfields {
+			return nil
+		}
+		rresult, err := hj.Right.GetFields(vcursor, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(&sqltypes.Result{Fields: lt.joiner.joinFields(lfields, rresult.Fields, bindVars)})
+	}
+
+	err = hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if len(rresult.Fields) != 0 {
+			result.Fields = lt.joiner.joinFields(lfields, rresult.Fields, bindVars)
+			lt.rfields = rresult.Fields
+		}
+		var err error
+		if result.Rows, err = lt.probe(rresult.Rows); err != nil {
+			return err
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+	if err != nil {
+		return err
+	}
+	if hj.Opcode == LeftJoin {
+		rows, err := lt.unmatched()
+		if err != nil {
+			return err
+		}
+		if len(rows) != 0 {
+			return callback(&sqltypes.Result{Rows: rows})
+		}
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: hj.joiner().joinFields(lresult.Fields, rresult.Fields, bindVars)}, nil
+}
+
+func (hj *HashJoin) maxRows(vcursor VCursor) int {
+	if hj.MaxRows != 0 {
+		return hj.MaxRows
+	}
+	return vcursor.MaxMemoryRows()
+}
+
+func (hj *HashJoin) limitExceeded(vcursor VCursor) error {
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "hash join: row count of the left side exceeded allowed limit of %d", hj.maxRows(vcursor))
+}
+
+// joiner returns a Join that builds the joined rows of the HashJoin.
+func (hj *HashJoin) joiner() *Join {
+	return &Join{
+		Opcode:    hj.Opcode,
+		Cols:      hj.Cols,
+		ExprCols:  hj.ExprCols,
+		Filter:    hj.Filter,
+		PostExprs: hj.PostExprs,
+	}
+}
+
+// buildTable loads the LHS rows into a hash table.
+func (hj *HashJoin) buildTable(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lfields []*querypb.Field, lrows [][]sqltypes.Value) (*joinTable, error) {
+	if len(lrows) > hj.maxRows(vcursor) {
+		return nil, hj.limitExceeded(vcursor)
+	}
+	lt := &joinTable{
+		hj:       hj,
+		joiner:   hj.joiner(),
+		bindVars: bindVars,
+		lfields:  lfields,
+		rows:     lrows,
+		matched:  make([]bool, len(lrows)),
+		indexes:  make(map[string]map[string][]int),
+		ltypes:   make([]querypb.Type, len(hj.LHSKeys)),
+	}
+	// The type of an LHS key is the type of its field or,
+	// if the fields are unknown, of its first non-NULL value.
+	for i, col := range hj.LHSKeys {
+		if col < len(lfields) {
+			lt.ltypes[i] = lfields[col].Type
+			continue
+		}
+		lt.ltypes[i] = sqltypes.Null
+		for _, lrow := range lrows {
+			if !lrow[col].IsNull() {
+				lt.ltypes[i] = lrow[col].Type()
+				break
+			}
+		}
+	}
+	return lt, nil
+}
+
+// joinTable is the hash table built from the LHS rows.
+type joinTable struct {
+	hj *HashJoin
+	// joiner and bindVars are used to build the joined rows.
+	joiner   *Join
+	bindVars map[string]*querypb.BindVariable
+	// lfields and rfields are the fields of the LHS and RHS,
+	// if known. Their collations are used to compare text keys.
+	lfields, rfields []*querypb.Field
+
+	rows [][]sqltypes.Value
+	// matched tracks the LHS rows that were joined
+	// with at least one RHS row.
+	matched []bool
+	// ltypes are the types of the LHS keys.
+	ltypes []querypb.Type
+	// indexes maps the modes of the keys to an index that maps
+	// a join key to the LHS rows that have it. The modes depend
+	// on the types of the RHS keys, which are only known when
+	// the RHS rows arrive. So, the indexes are built lazily.
+	indexes map[string]map[string][]int
+}
+
+// The join key modes define how the values of the LHS and RHS keys
+// are compared, following the rules of MySQL: values are compared as
+// decimals if both are integers or decimals, as floating-point numbers
+// if any other of them is a number, as strings using the collation
+// of their fields if both are text, and by their bytes otherwise.
+const (
+	joinKeyDecimal = 'd'
+	joinKeyFloat   = 'f'
+	joinKeyText    = 't'
+	joinKeyBinary  = 'b'
+)
+
+// probe returns the joined rows for the RHS rows.
+func (lt *joinTable) probe(rrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	modes := make([]byte, len(lt.hj.RHSKeys))
+	for _, rrow := range rrows {
+		if !lt.keyModes(rrow, modes) {
+			continue
+		}
+		key, ok, err := lt.joinKey(rrow, lt.hj.RHSKeys, lt.hj.RHSWeightStrings, modes)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		index, err := lt.index(modes)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range index[key] {
+			lt.matched[i] = true
+			row, err := lt.joiner.joinRow(lt.rows[i], rrow, lt.bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if row != nil {
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// keyModes sets the modes of the keys for the RHS row. It returns
+// false if any of the RHS keys is NULL, because NULL is never equal
+// to anything.
+func (lt *joinTable) keyModes(rrow []sqltypes.Value, modes []byte) bool {
+	for i, col := range lt.hj.RHSKeys {
+		rval := rrow[col]
+		ltype := lt.ltypes[i]
+		switch {
+		case rval.IsNull():
+			return false
+		case isExactNumber(ltype) && isExactNumber(rval.Type()):
+			modes[i] = joinKeyDecimal
+		case isNumber(ltype) || isNumber(rval.Type()):
+			modes[i] = joinKeyFloat
+		case sqltypes.IsText(ltype) && rval.IsText():
+			modes[i] = joinKeyText
+		default:
+			modes[i] = joinKeyBinary
+		}
+	}
+	return true
+}
+
+// index returns the index of the LHS rows for the key modes,
+// and builds it if needed.
+func (lt *joinTable) index(modes []byte) (map[string][]int, error) {
+	if index, ok := lt.indexes[string(modes)]; ok {
+		return index, nil
+	}
+	index := make(map[string][]int)
+	for i, lrow := range lt.rows {
+		key, ok, err := lt.joinKey(lrow, lt.hj.LHSKeys, lt.hj.LHSWeightStrings, modes)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		index[key] = append(index[key], i)
+	}
+	lt.indexes[string(modes)] = index
+	return index, nil
+}
+
+// unmatched returns the LHS rows that didn't match
+// any RHS row, joined with a NULL row.
+func (lt *joinTable) unmatched() ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for i, lrow := range lt.rows {
+		if lt.matched[i] {
+			continue
+		}
+		row, err := lt.joiner.joinRow(lrow, nil, lt.bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if row != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// joinKey returns the hash key for the values of the row at
+// the specified columns, using the modes of the keys. It returns
+// false if any of the values is NULL, because NULL is never equal
+// to anything. weightStrings are the weight_string columns of the
+// side of the row.
+func (lt *joinTable) joinKey(row []sqltypes.Value, cols []int, weightStrings map[int]int, modes []byte) (string, bool, error) {
+	var buf []byte
+	var lenbuf [binary.MaxVarintLen64]byte
+	for i, col := range cols {
+		if row[col].IsNull() {
+			return "", false, nil
+		}
+		var val string
+		switch modes[i] {
+		case joinKeyDecimal:
+			var ok bool
+			if val, ok = normalizeDecimal(row[col]); !ok {
+				return "", false, nil
+			}
+		case joinKeyFloat:
+			var ok bool
+			if val, ok = normalizeJoinValue(row[col]); !ok {
+				return "", false, nil
+			}
+		case joinKeyText:
+			coll, err := lt.collation(i)
+			if err != nil {
+				return "", false, err
+			}
+			if coll != nil {
+				val = string(coll.WeightString(row[col].ToBytes()))
+				break
+			}
+			weightCol, ok := weightStrings[col]
+			if !ok || weightCol >= len(row) {
+				return "", false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cannot compare the text values of join key %d without their weight_string", i)
+			}
+			val = row[weightCol].ToString()
+		default:
+			val = row[col].ToString()
+		}
+		n := binary.PutUvarint(lenbuf[:], uint64(len(val)))
+		buf = append(buf, lenbuf[:n]...)
+		buf = append(buf, val...)
+	}
+	return string(buf), true, nil
+}
+
+// collation returns the collation of the i-th text keys if vtgate
+// models it exactly, and nil if their weight_string must be compared
+// instead. The collation is the one of the LHS and RHS fields. If
+// they differ, the one that compares bytes wins, like the _bin
+// collations do in MySQL. Other mixes of collations are not supported.
+func (lt *joinTable) collation(i int) (*evalengine.Collation, error) {
+	var lid, rid uint32
+	if col := lt.hj.LHSKeys[i]; col < len(lt.lfields) {
+		lid = lt.lfields[col].Charset
+	}
+	if col := lt.hj.RHSKeys[i]; col < len(lt.rfields) {
+		rid = lt.rfields[col].Charset
+	}
+	// A Charset of 0 means that the collation is not known.
+	if lid == 0 || lid == rid {
+		lid = rid
+	} else if rid == 0 {
+		rid = lid
+	}
+	lcoll, lexact := evalengine.ExactCollationByID(lid)
+	rcoll, rexact := evalengine.ExactCollationByID(rid)
+	switch {
+	case lexact:
+		return lcoll, nil
+	case rexact:
+		return rcoll, nil
+	case lid != rid:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cannot compare the text values of join key %d: their collations %d and %d differ", i, lid, rid)
+	}
+	return nil, nil
+}
+
+// normalizeDecimal returns a representation of an integer or decimal
+// value that's the same for numbers that are equal, irrespective of
+// their type: the sign of zero, the leading zeros and the trailing
+// zeros of the fraction are removed. Unlike a conversion to float, it
+// doesn't lose precision. It returns false if the value cannot be
+// parsed.
+func normalizeDecimal(v sqltypes.Value) (string, bool) {
+	str := v.ToString()
+	if !decimalNumber.MatchString(str) {
+		return "", false
+	}
+	neg := false
+	switch str[0] {
+	case '-':
+		neg = true
+		str = str[1:]
+	case '+':
+		str = str[1:]
+	}
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if fracPart = strings.TrimRight(fracPart, "0"); fracPart != "" {
+		intPart += "." + fracPart
+	}
+	if neg && intPart != "0" {
+		return "-" + intPart, true
+	}
+	return intPart, true
+}
+
+var decimalNumber = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// normalizeJoinValue returns a representation of the value as a
+// floating-point number that's the same for numbers that are equal,
+// irrespective of their type. It's used when a number is compared with
+// a float or a string. Other values are converted to numbers, like
+// MySQL does when it compares them with numbers. It returns false if
+// a numeric value cannot be parsed.
+func normalizeJoinValue(v sqltypes.Value) (string, bool) {
+	if isNumber(v.Type()) {
+		fval, err := sqltypes.ToFloat64(v)
+		if err != nil {
+			return "", false
+		}
+		return normalizeFloat(fval), true
+	}
+	// Like MySQL, use the longest prefix that's a number,
+	// or 0 if there's none.
+	str := numberPrefix.FindString(strings.TrimLeft(v.ToString(), " \t\n"))
+	if fval, err := strconv.ParseFloat(str, 64); err == nil {
+		return normalizeFloat(fval), true
+	}
+	return "0", true
+}
+
+var numberPrefix = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?`)
+
+func normalizeFloat(fval float64) string {
+	if fval == math.Trunc(fval) && math.Abs(fval) < math.MaxInt64 {
+		return strconv.FormatInt(int64(fval), 10)
+	}
+	return strconv.FormatFloat(fval, 'g', -1, 64)
+}
+
+// isNumber returns true if values of the type are compared as numbers.
+func isNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// isExactNumber returns true if values of the type are compared
+// as decimals with the values of the other exact types.
+func isExactNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || typ == sqltypes.Decimal
+}
@@ -0,0 +1,606 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newHashJoinInputs() (leftPrim, rightPrim *fakePrimitive) {
+	leftPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+				"null|d|dd",
+				"3|e|ee",
+			),
+		},
+	}
+	rightPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"decimal|varchar|varchar",
+				),
+				"3|f|ff",
+				"1|g|gg",
+				"null|h|hh",
+				"4|i|ii",
+				"3.0|j|jj",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	// Normal join
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col2|col4|col5",
+		"int64|varchar|decimal|varchar",
+	)
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"3|c|3|f",
+		"3|e|3|f",
+		"1|a|1|g",
+		"3|c|3.0|j",
+		"3|e|3.0|j",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = hj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"3|c|3|f",
+		"3|e|3|f",
+		"1|a|1|g",
+		"3|c|3.0|j",
+		"3|e|3.0|j",
+		"2|b|null|null",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinExecuteMultipleKeys(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0, 2},
+		RHSKeys: []int{0, 2},
+	}
+	leftPrim.results[0].Rows[2][2] = sqltypes.NewVarChar("jj")
+	leftPrim.results[0].Fields[2].Charset = 46
+	rightPrim.results[0].Fields[2].Charset = 46
+	r, err := hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{
+		Rows:         [][]sqltypes.Value{{sqltypes.NewVarChar("c"), sqltypes.NewVarChar("j")}},
+		RowsAffected: 1,
+	})
+}
+
+func TestHashJoinExecuteNoResult(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"int64|varchar|varchar",
+				),
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the fields of the RHS must be fetched.
+	rightPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+	))
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+	))
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+
+	// Normal join
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col2|col4|col5",
+		"int64|varchar|decimal|varchar",
+	)
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"3|c|3|f",
+		"3|e|3|f",
+		"1|a|1|g",
+		"3|c|3.0|j",
+		"3|e|3.0|j",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = wrapStreamExecute(hj, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.StreamExecute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"3|c|3|f",
+		"3|e|3|f",
+		"1|a|1|g",
+		"3|c|3.0|j",
+		"3|e|3.0|j",
+		"2|b|null|null",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinMaxRows(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+		MaxRows: 4,
+	}
+	want := "hash join: row count of the left side exceeded allowed limit of 4"
+	_, err := hj.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+
+	leftPrim.rewind()
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	assert.EqualError(t, err, want)
+	// The RHS must not be executed.
+	rightPrim.ExpectLog(t, nil)
+
+	// Without MaxRows, the limit is the max memory rows of the vcursor.
+	leftPrim.rewind()
+	hj.MaxRows = 0
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = 100 }()
+	_, err = hj.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "hash join: row count of the left side exceeded allowed limit of 3")
+}
+
+func TestHashJoinErrors(t *testing.T) {
+	// Error on left
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   &fakePrimitive{sendErr: errors.New("left err")},
+		Right:  &fakePrimitive{},
+	}
+	_, err := hj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "left err")
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "left err")
+	_, err = hj.GetFields(noopVCursor{}, nil)
+	assert.EqualError(t, err, "left err")
+
+	// Error on right
+	leftPrim, _ := newHashJoinInputs()
+	hj = &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   &fakePrimitive{sendErr: errors.New("right err")},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	_, err = hj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "right err")
+	leftPrim.rewind()
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "right err")
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinInputs()
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 1, 2},
+	}
+	r, err := hj.GetFields(noopVCursor{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.GetFields", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|decimal|varchar",
+		),
+	})
+}
+
+func TestHashJoinMarshalJSON(t *testing.T) {
+	hj := &HashJoin{
+		Opcode:  LeftJoin,
+		Left:    &fakePrimitive{},
+		Right:   &fakePrimitive{},
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{1},
+	}
+	out, err := json.Marshal(hj)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"HashLeftJoin","Left":{},"Right":{},"Cols":[-1,1],"LHSKeys":[0],"RHSKeys":[1]}`, string(out))
+}
+
+func TestHashJoinCollation(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"name|num|weight_string(name)",
+					"varchar|varchar|varbinary",
+				),
+				"Bob|1.0|BOB",
+				"alice|2|ALICE",
+				"carol|x|CAROL",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"name|num|weight_string(name)",
+					"varchar|int64|varbinary",
+				),
+				"bob|1|BOB",
+				"ALICE |3|ALICE",
+				"carol|0|CAROL",
+			),
+		},
+	}
+
+	// The collations of the fields are not known. So, the text
+	// keys are compared by their weight_string.
+	hj := &HashJoin{
+		Opcode:           NormalJoin,
+		Left:             leftPrim,
+		Right:            rightPrim,
+		Cols:             []int{-1, 1},
+		LHSKeys:          []int{0},
+		RHSKeys:          []int{0},
+		LHSWeightStrings: map[int]int{0: 2},
+		RHSWeightStrings: map[int]int{0: 2},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name|name", "varchar|varchar"),
+		"Bob|bob",
+		"alice|ALICE ",
+		"carol|carol",
+	))
+
+	// A binary collation is modeled exactly by vtgate. It wins
+	// over the collation of the other side.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	leftPrim.results[0].Fields[0].Charset = 63
+	rightPrim.results[0].Fields[0].Charset = 33
+	r, err = hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Rows) != 1 || r.Rows[0][0].ToString() != "carol" {
+		t.Errorf("hj.Execute(binary): %v, want carol", r.Rows)
+	}
+
+	// Other collations cannot be mixed.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	leftPrim.results[0].Fields[0].Charset = 45
+	_, err = hj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "unsupported: cannot compare the text values of join key 0: their collations 45 and 33 differ")
+
+	// The weight_string is required for the collations that
+	// are not modeled exactly.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	leftPrim.results[0].Fields[0].Charset = 33
+	hj.RHSWeightStrings = nil
+	_, err = hj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "unsupported: cannot compare the text values of join key 0 without their weight_string")
+
+	// Text is compared with numbers as a number.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Cols = []int{-2, 2}
+	hj.LHSKeys = []int{1}
+	hj.RHSKeys = []int{1}
+	r, err = hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("num|num", "varchar|int64"),
+		"1.0|1",
+		"x|0",
+	))
+}
+
+func TestHashJoinDecimal(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("d", "decimal"),
+				"0.1000000000000000055511151231257827",
+				"-0.00",
+				"10.50",
+				"9007199254740993",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("d", "decimal"),
+				"0.1",
+				"0",
+				"10.5",
+				"9007199254740992",
+			),
+		},
+	}
+
+	// Decimals are compared exactly, not as floats.
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("d|d", "decimal|decimal"),
+		"-0.00|0",
+		"10.50|10.5",
+	))
+
+	// Against a float, they're compared as floats.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	rightPrim.results[0].Fields[0].Type = sqltypes.Float64
+	for _, row := range rightPrim.results[0].Rows {
+		row[0] = sqltypes.MakeTrusted(sqltypes.Float64, row[0].Raw())
+	}
+	r, err = hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 4, len(r.Rows))
+}
+
+func TestNormalizeDecimal(t *testing.T) {
+	tcases := []struct {
+		in  sqltypes.Value
+		out string
+		ok  bool
+	}{{
+		in:  sqltypes.NewInt64(-1),
+		out: "-1",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewUint64(18446744073709551615),
+		out: "18446744073709551615",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.00")),
+		out: "10",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-007.250")),
+		out: "-7.25",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-0.000")),
+		out: "0",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte(".5")),
+		out: "0.5",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567890.12345678901234567890")),
+		out: "12345678901234567890.1234567890123456789",
+		ok:  true,
+	}, {
+		in: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("abc")),
+	}}
+	for _, tcase := range tcases {
+		out, ok := normalizeDecimal(tcase.in)
+		assert.Equal(t, tcase.out, out, tcase.in.String())
+		assert.Equal(t, tcase.ok, ok, tcase.in.String())
+	}
+}
+
+func TestNormalizeJoinValue(t *testing.T) {
+	tcases := []struct {
+		in  sqltypes.Value
+		out string
+		ok  bool
+	}{{
+		in:  sqltypes.NewInt64(-1),
+		out: "-1",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewUint64(1),
+		out: "1",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewUint64(18446744073709551615),
+		out: "1.8446744073709552e+19",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewFloat64(2),
+		out: "2",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewFloat64(2.5),
+		out: "2.5",
+		ok:  true,
+	}, {
+		in:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.00")),
+		out: "10",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewVarChar("10.00"),
+		out: "10",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewVarChar(" 18446744073709551615"),
+		out: "1.8446744073709552e+19",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewVarChar("1.5e1abc"),
+		out: "15",
+		ok:  true,
+	}, {
+		in:  sqltypes.NewVarChar("abc"),
+		out: "0",
+		ok:  true,
+	}}
+	for _, tcase := range tcases {
+		out, ok := normalizeJoinValue(tcase.in)
+		assert.Equal(t, tcase.out, out, tcase.in.String())
+		assert.Equal(t, tcase.ok, ok, tcase.in.String())
+	}
+}
@@ -69,6 +69,17 @@ type Insert struct {
 	Mid    []string
 	Suffix string
 
+	// Input is set for an INSERT ... SELECT into a sharded table.
+	// The rows it returns are inserted in batches, and Mid and the
+	// values of VindexValues and Generate are built for each batch.
+	Input Primitive
+
+	// VindexValueOffset specifies the offsets of the vindex columns in
+	// the rows of Input, using the same structure as VindexValues:
+	// VindexValueOffset[i][j] is the offset of the j'th column of the
+	// i'th colvindex.
+	VindexValueOffset [][]int
+
 	// Option to override the standard behavior and allow a multi-shard insert
 	// to use single round trip autocommit.
 	//
@@ -80,6 +91,19 @@ type Insert struct {
 
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
+
+	// OwnedVindexQuery is used for InsertShardedReplace and InsertShardedUpsert.
+	// It selects the existing rows that have the primary vindex values of
+	// the inserted rows, which are passed as a list. It returns the columns
+	// of the owned vindexes followed by all the columns of the table. The
+	// primary key of the table is found through the flags of those fields.
+	OwnedVindexQuery string
+
+	// UpsertValues contains the values of the owned vindex columns that are
+	// changed by the ON DUPLICATE KEY UPDATE clause of an InsertShardedUpsert.
+	// They are keyed by the lower case name of the column, and every value
+	// is a list with one value per row.
+	UpsertValues map[string]sqltypes.PlanValue
 }
 
 // NewQueryInsert creates an Insert with a query string.
@@ -122,16 +146,20 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 	}
 	marshalInsert := struct {
 		Opcode               InsertOpcode
-		Keyspace             *vindexes.Keyspace   `json:",omitempty"`
-		Query                string               `json:",omitempty"`
-		Values               []sqltypes.PlanValue `json:",omitempty"`
-		Table                string               `json:",omitempty"`
-		Generate             *Generate            `json:",omitempty"`
-		Prefix               string               `json:",omitempty"`
-		Mid                  []string             `json:",omitempty"`
-		Suffix               string               `json:",omitempty"`
-		MultiShardAutocommit bool                 `json:",omitempty"`
-		QueryTimeout         int                  `json:",omitempty"`
+		Keyspace             *vindexes.Keyspace            `json:",omitempty"`
+		Query                string                        `json:",omitempty"`
+		Values               []sqltypes.PlanValue          `json:",omitempty"`
+		Table                string                        `json:",omitempty"`
+		Generate             *Generate                     `json:",omitempty"`
+		Prefix               string                        `json:",omitempty"`
+		Mid                  []string                      `json:",omitempty"`
+		Suffix               string                        `json:",omitempty"`
+		Input                Primitive                     `json:",omitempty"`
+		VindexValueOffset    [][]int                       `json:",omitempty"`
+		MultiShardAutocommit bool                          `json:",omitempty"`
+		QueryTimeout         int                           `json:",omitempty"`
+		OwnedVindexQuery     string                        `json:",omitempty"`
+		UpsertValues         map[string]sqltypes.PlanValue `json:",omitempty"`
 	}{
 		Opcode:               ins.Opcode,
 		Keyspace:             ins.Keyspace,
@@ -142,8 +170,12 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Prefix:               ins.Prefix,
 		Mid:                  ins.Mid,
 		Suffix:               ins.Suffix,
+		Input:                ins.Input,
+		VindexValueOffset:    ins.VindexValueOffset,
 		MultiShardAutocommit: ins.MultiShardAutocommit,
 		QueryTimeout:         ins.QueryTimeout,
+		OwnedVindexQuery:     ins.OwnedVindexQuery,
+		UpsertValues:         ins.UpsertValues,
 	}
 	return jsonutil.MarshalNoEscape(marshalInsert)
 }
@@ -158,6 +190,10 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is the offset of the column in the rows of
+	// Insert.Input. It's used instead of Values for an
+	// INSERT ... SELECT.
+	Offset int `json:",omitempty"`
 }
 
 // InsertOpcode is a number representing the opcode
@@ -176,12 +212,23 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertShardedReplace is for REPLACE into a sharded table.
+	// The owned vindex entries of the rows that get replaced
+	// are deleted before the new ones are created.
+	InsertShardedReplace
+	// InsertShardedUpsert is for INSERT...ON DUPLICATE KEY
+	// constructs that change owned vindex columns. The owned
+	// vindex entries of the rows that get updated are moved
+	// to their new values.
+	InsertShardedUpsert
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertShardedReplace: "InsertShardedReplace",
+	InsertShardedUpsert:  "InsertShardedUpsert",
 }
 
 // MarshalJSON serializes the InsertOpcode as a JSON string.
@@ -218,7 +265,10 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	switch ins.Opcode {
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
-	case InsertSharded, InsertShardedIgnore:
+	case InsertSharded, InsertShardedIgnore, InsertShardedReplace, InsertShardedUpsert:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -286,6 +336,129 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// insertSelectBatchSize is the number of rows of an INSERT ... SELECT
+// that are inserted together.
+var insertSelectBatchSize = 500
+
+// execInsertSelect streams the rows of the Input and inserts them
+// in batches. The batches are never autocommitted, because the
+// statement would not be atomic otherwise.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	var rows [][]sqltypes.Value
+	flush := func() error {
+		qr, err := ins.insertRows(vcursor, bindVars, rows)
+		if err != nil {
+			return err
+		}
+		result.RowsAffected += qr.RowsAffected
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+		rows = nil
+		return nil
+	}
+	err := ins.Input.StreamExecute(vcursor, bindVars, false, func(qr *sqltypes.Result) error {
+		rows = append(rows, qr.Rows...)
+		if len(rows) < insertSelectBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if len(rows) != 0 {
+		if err := flush(); err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+	}
+	return result, nil
+}
+
+// insertRows inserts a batch of rows returned by the Input. It builds
+// an Insert for the batch that is executed like a regular sharded insert.
+// Vindex and auto-inc columns are bound using the same names as for
+// a regular insert. The other columns are bound as :__c<row>_<col>.
+func (ins *Insert) insertRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	batch := *ins
+	batch.Input = nil
+	batch.VindexValueOffset = nil
+	bv := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+
+	// colVars maps the offsets of the vindex and auto-inc
+	// columns to the prefix of their bind var names.
+	colVars := make(map[int]string)
+	if ins.Generate != nil {
+		colVars[ins.Generate.Offset] = SeqVarName
+		generate := *ins.Generate
+		generate.Values = sqltypes.PlanValue{Values: make([]sqltypes.PlanValue, len(rows))}
+		for rowNum, row := range rows {
+			generate.Values.Values[rowNum] = sqltypes.PlanValue{Value: row[ins.Generate.Offset]}
+		}
+		batch.Generate = &generate
+	}
+	batch.VindexValues = make([]sqltypes.PlanValue, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		batch.VindexValues[vIdx].Values = make([]sqltypes.PlanValue, len(offsets))
+		for colIdx, offset := range offsets {
+			values := make([]sqltypes.PlanValue, len(rows))
+			for rowNum, row := range rows {
+				if ins.Generate != nil && offset == ins.Generate.Offset {
+					// The value is only known after processGenerate.
+					values[rowNum] = sqltypes.PlanValue{Key: SeqVarName + strconv.Itoa(rowNum)}
+					continue
+				}
+				values[rowNum] = sqltypes.PlanValue{Value: row[offset]}
+			}
+			batch.VindexValues[vIdx].Values[colIdx].Values = values
+			colVars[offset] = "_" + ins.Table.ColumnVindexes[vIdx].Columns[colIdx].CompliantName()
+		}
+	}
+
+	batch.Mid = make([]string, len(rows))
+	buf := &strings.Builder{}
+	for rowNum, row := range rows {
+		buf.Reset()
+		buf.WriteByte('(')
+		for colNum, val := range row {
+			if colNum != 0 {
+				buf.WriteString(", ")
+			}
+			name, ok := colVars[colNum]
+			if ok {
+				name += strconv.Itoa(rowNum)
+			} else {
+				name = "__c" + strconv.Itoa(rowNum) + "_" + strconv.Itoa(colNum)
+				bv[name] = sqltypes.ValueBindVariable(val)
+			}
+			buf.WriteString(":" + name)
+		}
+		buf.WriteByte(')')
+		batch.Mid[rowNum] = buf.String()
+	}
+
+	insertID, err := batch.processGenerate(vcursor, bv)
+	if err != nil {
+		return nil, err
+	}
+	rss, queries, err := batch.getInsertShardedRoute(vcursor, bv)
+	if err != nil {
+		return nil, err
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, false /* canAutocommit */)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -349,6 +522,8 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 // For unowned vindexes with no input values, it reverse maps.
 // For unowned vindexes with values, it validates.
 // If it's an IGNORE or ON DUPLICATE key insert, it drops unroutable rows.
+// If it's a REPLACE or an upsert that changes owned vindex columns, it
+// also deletes the owned vindex entries of the existing rows.
 func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 	// vindexRowsValues builds the values of all vindex columns.
 	// the 3-d structure indexes are colVindex, row, col. Note that
@@ -396,6 +571,14 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
 
+	var existingRows [][]sqltypes.Value
+	if ins.OwnedVindexQuery != "" {
+		existingRows, err = ins.selectExistingRows(vcursor, vindexRowsValues, keyspaceIDs)
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		}
+	}
+
 	for vIdx := 1; vIdx < len(vindexRowsValues); vIdx++ {
 		colVindex := ins.Table.ColumnVindexes[vIdx]
 		var err error
@@ -407,6 +590,10 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 				// For InsertShardedIgnore, the work is substantially different.
 				// So, we use a separate function.
 				err = ins.processOwnedIgnore(vcursor, vindexRowsValues[vIdx], colVindex, bindVars, keyspaceIDs)
+			case InsertShardedReplace:
+				err = ins.processOwnedReplace(vcursor, vindexRowsValues[vIdx], colVindex, bindVars, keyspaceIDs, existingRows)
+			case InsertShardedUpsert:
+				err = ins.processOwnedUpsert(vcursor, vindexRowsValues[vIdx], colVindex, bindVars, keyspaceIDs, existingRows)
 			default:
 				err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected opcode: %v", ins.Opcode)
 			}
@@ -465,18 +652,30 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 }
 
 // processPrimary maps the primary vindex values to the keyspace ids.
-func (ins *Insert) processPrimary(vcursor VCursor, vindexKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable) ([][]byte, error) {
-	var flattenedVindexKeys []sqltypes.Value
-	// TODO: @rafael - this will change once vindex Primary keys also support multicolumns
-	for _, val := range vindexKeys {
-		flattenedVindexKeys = append(flattenedVindexKeys, val...)
+func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable) ([][]byte, error) {
+	var destinations []key.Destination
+	var err error
+	if mc, ok := colVindex.Vindex.(vindexes.MultiColumn); ok {
+		destinations, err = mc.MapMulti(vcursor, vindexColumnsKeys)
+	} else {
+		flattenedVindexKeys := make([]sqltypes.Value, 0, len(vindexColumnsKeys))
+		for _, rowColumnKeys := range vindexColumnsKeys {
+			flattenedVindexKeys = append(flattenedVindexKeys, rowColumnKeys[0])
+		}
+		destinations, err = colVindex.Vindex.Map(vcursor, flattenedVindexKeys)
 	}
-
-	destinations, err := colVindex.Vindex.Map(vcursor, flattenedVindexKeys)
 	if err != nil {
 		return nil, err
 	}
 
+	// vindexKey returns the value of a single column
+	// vindex as is, to keep the errors readable.
+	vindexKey := func(rowNum int) interface{} {
+		if len(vindexColumnsKeys[rowNum]) == 1 {
+			return vindexColumnsKeys[rowNum][0]
+		}
+		return vindexColumnsKeys[rowNum]
+	}
 	keyspaceIDs := make([][]byte, len(destinations))
 	for i, destination := range destinations {
 		switch d := destination.(type) {
@@ -486,20 +685,20 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexKeys [][]sqltypes.Value
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
 			if ins.Opcode != InsertShardedIgnore {
-				return nil, fmt.Errorf("could not map %v to a keyspace id", flattenedVindexKeys[i])
+				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexKey(i))
 			}
 		default:
-			return nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", flattenedVindexKeys[i], destination)
+			return nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", vindexKey(i), destination)
 		}
 	}
 
-	for rowNum, vindexKey := range flattenedVindexKeys {
+	for rowNum, rowColumnKeys := range vindexColumnsKeys {
 		if keyspaceIDs[rowNum] == nil {
 			// InsertShardedIgnore: skip the row.
 			continue
 		}
-		for _, col := range colVindex.Columns {
-			bv[insertVarName(col, rowNum)] = sqltypes.ValueBindVariable(vindexKey)
+		for colIdx, col := range colVindex.Columns {
+			bv[insertVarName(col, rowNum)] = sqltypes.ValueBindVariable(rowColumnKeys[colIdx])
 		}
 	}
 	return keyspaceIDs, nil
@@ -564,6 +763,231 @@ func (ins *Insert) processOwnedIgnore(vcursor VCursor, vindexColumnsKeys [][]sql
 	return nil
 }
 
+// selectExistingRows executes the OwnedVindexQuery on the shards of the
+// rows. It returns, for every row, the existing row that has the same
+// primary key, or nil if there is none. MySQL also replaces or updates
+// the rows that conflict on a secondary unique key, which cannot be found
+// this way. So, tables that have one are rejected before any vindex entry
+// is changed.
+func (ins *Insert) selectExistingRows(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value, ksids [][]byte) ([][]sqltypes.Value, error) {
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for i, ksid := range ksids {
+		if ksid != nil {
+			indexes = append(indexes, &querypb.Value{
+				Value: strconv.AppendInt(nil, int64(i), 10),
+			})
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		}
+	}
+	existingRows := make([][]sqltypes.Value, len(ksids))
+	if len(destinations) == 0 {
+		return existingRows, nil
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
+			values.Values = append(values.Values, sqltypes.ValueToProto(vindexRowsValues[0][index][0]))
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           ins.OwnedVindexQuery,
+			BindVariables: map[string]*querypb.BindVariable{ListVarName: values},
+		}
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, false /* isDML */, false /* canAutocommit */)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+	if err := ins.checkUniqueKeys(result.Fields); err != nil {
+		return nil, err
+	}
+	if len(result.Rows) == 0 {
+		return existingRows, nil
+	}
+	pkCols, pkVindexCols, err := ins.primaryKeyColumns(result.Fields)
+	if err != nil {
+		return nil, err
+	}
+	rowsByKey := make(map[string][]sqltypes.Value, len(result.Rows))
+	for _, row := range result.Rows {
+		var key []byte
+		for _, col := range pkCols {
+			key = appendValueKey(key, row[col])
+		}
+		rowsByKey[string(key)] = row
+	}
+	for rowNum := range ksids {
+		if ksids[rowNum] == nil {
+			continue
+		}
+		var key []byte
+		for _, col := range pkVindexCols {
+			key = appendValueKey(key, vindexRowsValues[col[0]][rowNum][col[1]])
+		}
+		existingRows[rowNum] = rowsByKey[string(key)]
+	}
+	return existingRows, nil
+}
+
+// checkUniqueKeys returns an error if a column of the table, as returned
+// by the OwnedVindexQuery, is part of a unique key other than the primary key.
+// MySQL only flags the columns of single column unique keys.
+func (ins *Insert) checkUniqueKeys(fields []*querypb.Field) error {
+	for col := ins.ownedColumnCount(); col < len(fields); col++ {
+		field := fields[col]
+		if field.Flags&uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG) != 0 && field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unique key on column %s of table %s is not its primary key", field.Name, ins.Table.Name)
+		}
+	}
+	return nil
+}
+
+// primaryKeyColumns returns the positions of the primary key columns in
+// the fields returned by the OwnedVindexQuery, and the vindex and column
+// indexes of the same columns in the vindex values of the inserted rows.
+// The existing row that an inserted row conflicts with can only be found
+// if its primary key is made of vindex columns, and includes the primary
+// vindex column. Otherwise, the rows that share the primary vindex value
+// are not guaranteed to be the replaced ones.
+func (ins *Insert) primaryKeyColumns(fields []*querypb.Field) ([]int, [][2]int, error) {
+	var pkCols []int
+	var pkVindexCols [][2]int
+	hasPrimary := false
+	for col := ins.ownedColumnCount(); col < len(fields); col++ {
+		field := fields[col]
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+			continue
+		}
+		vindexCol, ok := ins.vindexColumn(field.Name)
+		if !ok {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: primary key column %s of table %s is not a vindex column", field.Name, ins.Table.Name)
+		}
+		if vindexCol == [2]int{0, 0} {
+			hasPrimary = true
+		}
+		pkCols = append(pkCols, col)
+		pkVindexCols = append(pkVindexCols, vindexCol)
+	}
+	if !hasPrimary {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: primary key of table %s does not include the primary vindex column", ins.Table.Name)
+	}
+	return pkCols, pkVindexCols, nil
+}
+
+// vindexColumn returns the vindex and column indexes of the column in
+// the ColumnVindexes of the table.
+func (ins *Insert) vindexColumn(name string) ([2]int, bool) {
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		for cIdx, col := range colVindex.Columns {
+			if col.EqualString(name) {
+				return [2]int{vIdx, cIdx}, true
+			}
+		}
+	}
+	return [2]int{}, false
+}
+
+// ownedColumnCount returns the number of columns of the owned vindexes,
+// which come first in the rows returned by the OwnedVindexQuery.
+func (ins *Insert) ownedColumnCount() int {
+	count := 0
+	for _, owned := range ins.Table.Owned {
+		count += len(owned.Columns)
+	}
+	return count
+}
+
+// ownedColumns returns the values of the columns of an owned vindex
+// in a row returned by the OwnedVindexQuery.
+func (ins *Insert) ownedColumns(row []sqltypes.Value, colVindex *vindexes.ColumnVindex) []sqltypes.Value {
+	colnum := 0
+	for _, owned := range ins.Table.Owned {
+		if owned == colVindex {
+			break
+		}
+		colnum += len(owned.Columns)
+	}
+	return row[colnum : colnum+len(colVindex.Columns)]
+}
+
+// processOwnedReplace deletes the vindex entries of the rows replaced by
+// an InsertShardedReplace, and creates the entries of the new rows.
+func (ins *Insert) processOwnedReplace(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable, ksids [][]byte, existingRows [][]sqltypes.Value) error {
+	lookup := colVindex.Vindex.(vindexes.Lookup)
+	for rowNum, row := range existingRows {
+		if row == nil {
+			continue
+		}
+		if err := lookup.Delete(vcursor, [][]sqltypes.Value{ins.ownedColumns(row, colVindex)}, ksids[rowNum]); err != nil {
+			return err
+		}
+	}
+	return ins.processOwned(vcursor, vindexColumnsKeys, colVindex, bv, ksids)
+}
+
+// processOwnedUpsert creates the vindex entries of the rows inserted by an
+// InsertShardedUpsert. For the rows that get updated instead, it moves the
+// entries of the vindex to the values of the ON DUPLICATE KEY UPDATE clause.
+func (ins *Insert) processOwnedUpsert(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable, ksids [][]byte, existingRows [][]sqltypes.Value) error {
+	changed := false
+	upsertValues := make([][]sqltypes.Value, len(colVindex.Columns))
+	for colIdx, col := range colVindex.Columns {
+		pv, ok := ins.UpsertValues[col.Lowered()]
+		if !ok {
+			continue
+		}
+		values, err := pv.ResolveList(bv)
+		if err != nil {
+			return err
+		}
+		upsertValues[colIdx] = values
+		changed = true
+	}
+
+	lookup := colVindex.Vindex.(vindexes.Lookup)
+	var createKeys [][]sqltypes.Value
+	var createKsids [][]byte
+	for rowNum, rowColumnKeys := range vindexColumnsKeys {
+		for colIdx, vindexKey := range rowColumnKeys {
+			col := colVindex.Columns[colIdx]
+			bv[insertVarName(col, rowNum)] = sqltypes.ValueBindVariable(vindexKey)
+		}
+		row := existingRows[rowNum]
+		if row == nil {
+			createKeys = append(createKeys, rowColumnKeys)
+			createKsids = append(createKsids, ksids[rowNum])
+			continue
+		}
+		if !changed {
+			continue
+		}
+		oldKeys := ins.ownedColumns(row, colVindex)
+		newKeys := make([]sqltypes.Value, len(oldKeys))
+		for colIdx, oldKey := range oldKeys {
+			if upsertValues[colIdx] != nil {
+				newKeys[colIdx] = upsertValues[colIdx][rowNum]
+			} else {
+				newKeys[colIdx] = oldKey
+			}
+		}
+		if err := lookup.Delete(vcursor, [][]sqltypes.Value{oldKeys}, ksids[rowNum]); err != nil {
+			return err
+		}
+		createKeys = append(createKeys, newKeys)
+		createKsids = append(createKsids, ksids[rowNum])
+	}
+	if createKeys == nil {
+		return nil
+	}
+	return lookup.Create(vcursor, createKeys, createKsids, false /* ignoreMode */)
+}
+
 // processUnowned either reverse maps or validates the values for an unowned column.
 func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable, ksids [][]byte) error {
 	var reverseIndexes []int
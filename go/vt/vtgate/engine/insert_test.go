@@ -1482,3 +1482,546 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelect(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	save := insertSelectBatchSize
+	insertSelectBatchSize = 2
+	defer func() { insertSelectBatchSize = save }()
+
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	ins.VindexValueOffset = [][]int{{1}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"name|id",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|3",
+			),
+		},
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			{RowsAffected: 2},
+			{RowsAffected: 1},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The rows are inserted in two batches. They're never autocommitted.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__c0_0, :_id0) suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{__c0_0: type:VARCHAR value:"a" __c1_0: type:VARCHAR value:"b" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix (:__c1_0, :_id1) suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{__c0_0: type:VARCHAR value:"a" __c1_0: type:VARCHAR value:"b" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__c0_0, :_id0) suffix /* vtgate:: keyspace_id:4eb190c9a2fa169c */ ` +
+			`{__c0_0: type:VARCHAR value:"c" _id0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
+
+func TestInsertSelectGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// The auto-inc column is also the primary vindex column.
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.VindexValueOffset = [][]int{{1}}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 1,
+	}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"name|id",
+					"varchar|int64",
+				),
+				"a|null",
+				"b|5",
+			),
+		},
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"1",
+			),
+			{RowsAffected: 2},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(70bb023c810ca87a)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__c0_0, :_id0) /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{__c0_0: type:VARCHAR value:"a" __c1_0: type:VARCHAR value:"b" __seq0: type:INT64 value:"1" __seq1: type:INT64 value:"5" _id0: type:INT64 value:"1" _id1: type:INT64 value:"5" } ` +
+			`sharded.-20: prefix (:__c1_0, :_id1) /* vtgate:: keyspace_id:70bb023c810ca87a */ ` +
+			`{__c0_0: type:VARCHAR value:"a" __c1_0: type:VARCHAR value:"b" __seq0: type:INT64 value:"1" __seq1: type:INT64 value:"5" _id0: type:INT64 value:"1" _id1: type:INT64 value:"5" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2, InsertID: 1})
+}
+
+func TestInsertSelectInputError(t *testing.T) {
+	ins := NewSimpleInsert(InsertSharded, &vindexes.Table{}, &vindexes.Keyspace{Name: "sharded", Sharded: true})
+	ins.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err := ins.Execute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSelect: input err")
+}
+
+func TestInsertShardedReplace(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				// rows for c1
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(4),
+				}, {
+					Value: sqltypes.NewInt64(5),
+				}},
+			}, {
+				// rows for c2
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(7),
+				}, {
+					Value: sqltypes.NewInt64(8),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+
+	// Only the row with id 1 exists. id is the primary key.
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3|id|c1|c2|c3",
+		"int64|int64|int64|int64|int64|int64|int64",
+	)
+	fields[3].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		fields,
+		"14|17|20|1|14|17|20",
+	)}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-", "-20"},
+		results:      results,
+	}
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"1" > } ` +
+			`sharded.-20: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"2" > } ` +
+			`false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"14" from2: type:INT64 value:"17" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0), (:from11, :from21, :toc1) ` +
+			`from10: type:INT64 value:"4" from11: type:INT64 value:"5" ` +
+			`from20: type:INT64 value:"7" from21: type:INT64 value:"8" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"20" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1) ` +
+			`from0: type:INT64 value:"10" from1: type:INT64 value:"11" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c10: type:INT64 value:"4" _c11: type:INT64 value:"5" ` +
+			`_c20: type:INT64 value:"7" _c21: type:INT64 value:"8" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c10: type:INT64 value:"4" _c11: type:INT64 value:"5" ` +
+			`_c20: type:INT64 value:"7" _c21: type:INT64 value:"8" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+}
+
+func TestInsertShardedReplaceSharedVindexValue(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				// rows for c1
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(4),
+				}},
+			}, {
+				// rows for c2
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(7),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+
+	// Two rows have id 1. The primary key is (id, c3). So,
+	// only the second one is replaced.
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3|id|c1|c2|c3",
+		"int64|int64|int64|int64|int64|int64|int64",
+	)
+	fields[3].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	fields[6].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		fields,
+		"14|17|20|1|14|17|20",
+		"15|18|10|1|15|18|10",
+	)}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "20-"},
+		results:      results,
+	}
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"1" > } ` +
+			`false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"15" from2: type:INT64 value:"18" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) ` +
+			`from10: type:INT64 value:"4" from20: type:INT64 value:"7" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"10" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"10" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c10: type:INT64 value:"4" _c20: type:INT64 value:"7" ` +
+			`_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } ` +
+			`true true`,
+	})
+
+	// The primary key must be made of vindex columns.
+	fields = sqltypes.MakeTestFields(
+		"c1|c2|c3|id|other",
+		"int64|int64|int64|int64|int64",
+	)
+	fields[3].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	fields[4].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	vc = &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"14|17|20|1|2",
+		)},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: unsupported: primary key column other of table t1 is not a vindex column")
+}
+
+func TestInsertShardedReplaceSecondaryUniqueKey(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				// rows for c1
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(4),
+				}},
+			}, {
+				// rows for c2
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(7),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+
+	// No row has id 2, but c3 has a unique key of its own. MySQL
+	// would replace the row that has the same c3, which cannot
+	// be found. So, the vindexes must not be changed.
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3|id|c1|c2|c3",
+		"int64|int64|int64|int64|int64|int64|int64",
+	)
+	fields[3].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	fields[6].Flags = uint32(querypb.MySqlFlag_UNIQUE_KEY_FLAG)
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20"},
+		results:      []*sqltypes.Result{sqltypes.MakeTestResult(fields)},
+	}
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: unsupported: unique key on column c3 of table t1 is not its primary key")
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"2" > } ` +
+			`false false`,
+	})
+}
+
+func TestInsertShardedUpsert(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertShardedUpsert,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				// rows for c1
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(4),
+				}, {
+					Value: sqltypes.NewInt64(5),
+				}},
+			}, {
+				// rows for c2
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(7),
+				}, {
+					Value: sqltypes.NewInt64(8),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+	// on duplicate key update c2 = :c2
+	ins.UpsertValues = map[string]sqltypes.PlanValue{
+		"c2": {Values: []sqltypes.PlanValue{{Key: "c2"}, {Key: "c2"}}},
+	}
+
+	// Only the row with id 1 exists. id is the primary key.
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|c3|id|c1|c2|c3",
+		"int64|int64|int64|int64|int64|int64|int64",
+	)
+	fields[3].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		fields,
+		"14|17|20|1|14|17|20",
+	)}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-", "-20"},
+		results:      results,
+	}
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{"c2": sqltypes.Int64BindVariable(27)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"1" > } ` +
+			`sharded.-20: dummy_select {__vals: type:TUPLE values:<type:INT64 value:"2" > } ` +
+			`false false`,
+		// The existing row moves from (14, 17) to (14, 27).
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"14" from2: type:INT64 value:"17" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0), (:from11, :from21, :toc1) ` +
+			`from10: type:INT64 value:"14" from11: type:INT64 value:"5" ` +
+			`from20: type:INT64 value:"27" from21: type:INT64 value:"8" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// c3 doesn't change: only the new row gets an entry.
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"11" ` +
+			`toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c10: type:INT64 value:"4" _c11: type:INT64 value:"5" ` +
+			`_c20: type:INT64 value:"7" _c21: type:INT64 value:"8" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" c2: type:INT64 value:"27" } ` +
+			`sharded.-20: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c10: type:INT64 value:"4" _c11: type:INT64 value:"5" ` +
+			`_c20: type:INT64 value:"7" _c21: type:INT64 value:"8" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" c2: type:INT64 value:"27" } ` +
+			`true false`,
+	})
+}
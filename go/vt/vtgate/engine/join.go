@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -46,6 +47,24 @@ type Join struct {
 	// be built from the LHS result before invoking
 	// the RHS subqquery.
 	Vars map[string]int `json:",omitempty"`
+
+	// Filter and PostExprs are evaluated by vtgate on the joined
+	// rows. They're used for the parts of a query that reference
+	// the RHS of a left join, and that cannot be pushed into the
+	// RHS, because they must also be applied to the LHS rows that
+	// have no match.
+	//
+	// ExprCols defines the columns these expressions operate on,
+	// using the same convention as Cols. A column at offset i
+	// in the expressions is the value of ExprCols[i].
+	ExprCols []int `json:",omitempty"`
+
+	// Filter discards the joined rows for which it's not true.
+	Filter evalengine.Expr `json:",omitempty"`
+
+	// PostExprs specify the values of the columns
+	// for which Cols is 0.
+	PostExprs []PostExprParams `json:",omitempty"`
 }
 
 // Execute performs a non-streaming exec.
@@ -64,7 +83,7 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 		if err != nil {
 			return nil, err
 		}
-		result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
+		result.Fields = jn.joinFields(lresult.Fields, rresult.Fields, bindVars)
 		return result, nil
 	}
 	for _, lrow := range lresult.Rows {
@@ -77,16 +96,22 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 		}
 		if wantfields {
 			wantfields = false
-			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
+			result.Fields = jn.joinFields(lresult.Fields, rresult.Fields, bindVars)
 		}
-		for _, rrow := range rresult.Rows {
-			result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+		rrows := rresult.Rows
+		if jn.Opcode == LeftJoin && len(rrows) == 0 {
+			rrows = [][]sqltypes.Value{nil}
 		}
-		if jn.Opcode == LeftJoin && len(rresult.Rows) == 0 {
-			result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
+		for _, rrow := range rrows {
+			row, err := jn.joinRow(lrow, rrow, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if row == nil {
+				continue
+			}
+			result.Rows = append(result.Rows, row)
 			result.RowsAffected++
-		} else {
-			result.RowsAffected += uint64(len(rresult.Rows))
 		}
 		if len(result.Rows) > vcursor.MaxMemoryRows() {
 			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
@@ -111,10 +136,16 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 					// will always be just the field info, which will cause the outer
 					// wantfields code path to be executed. But this may change in the future.
 					wantfields = false
-					result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
+					result.Fields = jn.joinFields(lresult.Fields, rresult.Fields, bindVars)
 				}
 				for _, rrow := range rresult.Rows {
-					result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+					row, err := jn.joinRow(lrow, rrow, bindVars)
+					if err != nil {
+						return err
+					}
+					if row != nil {
+						result.Rows = append(result.Rows, row)
+					}
 				}
 				if len(rresult.Rows) != 0 {
 					rowSent = true
@@ -125,13 +156,16 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 				return err
 			}
 			if jn.Opcode == LeftJoin && !rowSent {
-				result := &sqltypes.Result{}
-				result.Rows = [][]sqltypes.Value{joinRows(
-					lrow,
-					nil,
-					jn.Cols,
-				)}
-				return callback(result)
+				row, err := jn.joinRow(lrow, nil, bindVars)
+				if err != nil {
+					return err
+				}
+				if row == nil {
+					continue
+				}
+				if err := callback(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
+					return err
+				}
 			}
 		}
 		if wantfields {
@@ -144,7 +178,7 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 			if err != nil {
 				return err
 			}
-			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
+			result.Fields = jn.joinFields(lresult.Fields, rresult.Fields, bindVars)
 			return callback(result)
 		}
 		return nil
@@ -167,18 +201,64 @@ func (jn *Join) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVari
 	if err != nil {
 		return nil, err
 	}
-	result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
+	result.Fields = jn.joinFields(lresult.Fields, rresult.Fields, bindVars)
 	return result, nil
 }
 
+// joinFields returns the fields of the joined rows.
+func (jn *Join) joinFields(lfields, rfields []*querypb.Field, bindVars map[string]*querypb.BindVariable) []*querypb.Field {
+	fields := joinFields(lfields, rfields, jn.Cols)
+	if len(jn.PostExprs) == 0 {
+		return fields
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: joinFields(lfields, rfields, jn.ExprCols)}
+	for _, pe := range jn.PostExprs {
+		fields[pe.Col] = &querypb.Field{
+			Name: pe.Alias,
+			Type: pe.Expr.Type(env),
+		}
+	}
+	return fields
+}
+
+// joinRow returns the joined row for lrow and rrow, with the
+// PostExprs evaluated. It returns nil if the row is discarded by
+// the Filter. rrow is nil for the LHS rows of a left join that have
+// no match.
+func (jn *Join) joinRow(lrow, rrow []sqltypes.Value, bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	row := joinRows(lrow, rrow, jn.Cols)
+	if jn.Filter == nil && len(jn.PostExprs) == 0 {
+		return row, nil
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Row: joinRows(lrow, rrow, jn.ExprCols)}
+	if jn.Filter != nil {
+		val, err := jn.Filter.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if !evalengine.IsTrue(val) {
+			return nil, nil
+		}
+	}
+	for _, pe := range jn.PostExprs {
+		val, err := pe.Expr.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		row[pe.Col] = val
+	}
+	return row, nil
+}
+
 func joinFields(lfields, rfields []*querypb.Field, cols []int) []*querypb.Field {
 	fields := make([]*querypb.Field, len(cols))
 	for i, index := range cols {
-		if index < 0 {
+		switch {
+		case index < 0:
 			fields[i] = lfields[-index-1]
-			continue
+		case index > 0:
+			fields[i] = rfields[index-1]
 		}
-		fields[i] = rfields[index-1]
 	}
 	return fields
 }
@@ -190,8 +270,9 @@ func joinRows(lrow, rrow []sqltypes.Value, cols []int) []sqltypes.Value {
 			row[i] = lrow[-index-1]
 			continue
 		}
-		// rrow can be nil on left joins
-		if rrow != nil {
+		// rrow can be nil on left joins. An index of 0
+		// is a column computed by the PostExprs of a Join.
+		if rrow != nil && index > 0 {
 			row[i] = rrow[index-1]
 		}
 	}
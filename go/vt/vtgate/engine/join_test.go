@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -126,6 +127,131 @@ func TestJoinExecute(t *testing.T) {
 	))
 }
 
+func TestJoinFilterAndPostExprs(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4|col5|col6",
+		"int64|varchar|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4|d|dd",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"5|e|ee",
+				"6|f|ff",
+				"7|g|gg",
+			),
+		},
+	}
+
+	// select col1, col4, col4 is null from l left join r where col4 is null or col4 > 5
+	jn := &Join{
+		Opcode: LeftJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, 1, 0},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		ExprCols: []int{-1, 1},
+		Filter: &evalengine.Logical{
+			Op:    evalengine.LogicalOr,
+			Left:  &evalengine.IsNull{Expr: evalengine.NewColumn(1)},
+			Right: &evalengine.Comparison{Op: evalengine.CompareGreaterThan, Left: evalengine.NewColumn(1), Right: evalengine.NewLiteral(sqltypes.NewInt64(5))},
+		},
+		PostExprs: []PostExprParams{{
+			Col:   2,
+			Expr:  &evalengine.IsNull{Expr: evalengine.NewColumn(1)},
+			Alias: "col4 is null",
+		}},
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4|col4 is null",
+			"int64|int64|int64",
+		),
+		"1|null|1",
+		"3|6|0",
+		"3|7|0",
+	)
+	r, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.Execute", r, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	// The first right query of a stream is a GetFields.
+	rightPrim.results = append([]*sqltypes.Result{sqltypes.MakeTestResult(rightFields)}, rightPrim.results...)
+	r, err = wrapStreamExecute(jn, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.StreamExecute", r, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = jn.GetFields(noopVCursor{}, map[string]*querypb.BindVariable{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.GetFields", r, &sqltypes.Result{Fields: want.Fields})
+
+	// Same query as a hash join on col2 = col5. The keys
+	// use the binary collation, which vtgate compares exactly.
+	leftPrim.rewind()
+	leftPrim.results[0].Fields[1].Charset = 63
+	rightFields[1].Charset = 63
+	rightPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4|b|dd",
+				"5|c|ee",
+				"6|c|ff",
+				"7|c|gg",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      jn.Cols,
+		LHSKeys:   []int{1},
+		RHSKeys:   []int{1},
+		ExprCols:  jn.ExprCols,
+		Filter:    jn.Filter,
+		PostExprs: jn.PostExprs,
+	}
+	want.Rows = [][]sqltypes.Value{want.Rows[1], want.Rows[2], want.Rows[0]}
+	r, err = hj.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, want)
+}
+
 func TestJoinExecuteMaxMemoryRows(t *testing.T) {
 	save := testMaxMemoryRows
 	testMaxMemoryRows = 3
@@ -0,0 +1,130 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Lock)(nil)
+
+// Lock is a primitive that executes the MySQL advisory lock
+// functions, like GET_LOCK and RELEASE_LOCK. The locks of a
+// session must be taken on the same MySQL connection, so the
+// query is always sent to the same shard of the keyspace, on
+// the connection that the session reserves for its locks.
+type Lock struct {
+	// Keyspace specifies the keyspace to send the query to.
+	Keyspace *vindexes.Keyspace
+
+	// TargetDestination specifies the shard of the keyspace
+	// that holds the locks.
+	TargetDestination key.Destination
+
+	// Query specifies the query to be executed.
+	Query string
+
+	// FieldQuery specifies the query to be executed for a GetFieldInfo request.
+	FieldQuery string
+}
+
+// MarshalJSON serializes the Lock into a JSON representation.
+// It's used for testing and diagnostics.
+func (l *Lock) MarshalJSON() ([]byte, error) {
+	var destination string
+	if l.TargetDestination != nil {
+		destination = l.TargetDestination.String()
+	}
+	marshalLock := struct {
+		Opcode            string
+		Keyspace          *vindexes.Keyspace
+		TargetDestination string `json:",omitempty"`
+		Query             string
+		FieldQuery        string `json:",omitempty"`
+	}{
+		Opcode:            "Lock",
+		Keyspace:          l.Keyspace,
+		TargetDestination: destination,
+		Query:             l.Query,
+		FieldQuery:        l.FieldQuery,
+	}
+	return jsonutil.MarshalNoEscape(marshalLock)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (l *Lock) RouteType() string {
+	return "Lock"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (l *Lock) GetKeyspaceName() string {
+	return l.Keyspace.Name
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (l *Lock) GetTableName() string {
+	return "dual"
+}
+
+// Execute satisfies the Primitive interface.
+func (l *Lock) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rs, err := l.resolveShard(vcursor, l.TargetDestination)
+	if err != nil {
+		return nil, err
+	}
+	return vcursor.ExecuteLock(rs, &querypb.BoundQuery{
+		Sql:           l.Query,
+		BindVariables: bindVars,
+	})
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (l *Lock) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	qr, err := l.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(qr)
+}
+
+// GetFields satisfies the Primitive interface.
+func (l *Lock) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rs, err := l.resolveShard(vcursor, key.DestinationAnyShard{})
+	if err != nil {
+		return nil, err
+	}
+	return execShard(vcursor, l.FieldQuery, bindVars, rs, false /* isDML */, false /* canAutocommit */)
+}
+
+func (l *Lock) resolveShard(vcursor VCursor, destination key.Destination) (*srvtopo.ResolvedShard, error) {
+	rss, _, err := vcursor.ResolveDestinations(l.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) != 1 {
+		return nil, fmt.Errorf("lock query can be routed to only one shard: %v", destination)
+	}
+	return rss[0], nil
+}
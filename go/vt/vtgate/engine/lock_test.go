@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestLockExecute(t *testing.T) {
+	lock := &Lock{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		TargetDestination: key.DestinationKeyspaceID([]byte{0}),
+		Query:             "select get_lock(:name, 10) from dual",
+		FieldQuery:        "select get_lock(:name, 10) from dual where 1 != 1",
+	}
+	lockResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"get_lock(:name, 10)",
+			"int64",
+		),
+		"1",
+	)
+	bv := map[string]*querypb.BindVariable{
+		"name": sqltypes.StringBindVariable("lock name"),
+	}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{lockResult},
+	}
+	result, err := lock.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock select get_lock(:name, 10) from dual name: type:VARCHAR value:"lock name"  ks -20`,
+	})
+	expectResult(t, "lock.Execute", result, lockResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(lock, vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock select get_lock(:name, 10) from dual name: type:VARCHAR value:"lock name"  ks -20`,
+	})
+	expectResult(t, "lock.StreamExecute", result, lockResult)
+}
+
+func TestLockExecuteError(t *testing.T) {
+	lock := &Lock{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		TargetDestination: key.DestinationKeyspaceID([]byte{0}),
+		Query:             "select release_lock('lock name') from dual",
+	}
+
+	vc := &loggingVCursor{
+		shards:    []string{"-20", "20-"},
+		resultErr: errors.New("lock connection failed"),
+	}
+	_, err := lock.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "lock.Execute", err, "lock connection failed")
+
+	vc = &loggingVCursor{
+		shardErr: errors.New("shard error"),
+	}
+	_, err = lock.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "lock.Execute", err, "shard error")
+}
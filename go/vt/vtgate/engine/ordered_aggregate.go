@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
 
@@ -45,6 +46,12 @@ type OrderedAggregate struct {
 	// the aggregation key.
 	Keys []int
 
+	// PostExprs specifies the expressions that must be evaluated
+	// on every aggregated row before it's returned. They're used
+	// for select expressions that combine aggregates, like AVG(a),
+	// which is computed as SUM(a)/COUNT(a), or 1+COUNT(*).
+	PostExprs []PostExprParams `json:",omitempty"`
+
 	// TruncateColumnCount specifies the number of columns to return
 	// in the final result. Rest of the columns are truncated
 	// from the result received. If 0, no truncation happens.
@@ -63,6 +70,15 @@ type AggregateParams struct {
 	Alias string `json:",omitempty"`
 }
 
+// PostExprParams specify an expression that's evaluated after
+// aggregation. The result of Expr replaces the value of column Col,
+// which gets renamed to Alias.
+type PostExprParams struct {
+	Col   int
+	Expr  evalengine.Expr
+	Alias string
+}
+
 func (ap AggregateParams) isDistinct() bool {
 	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct
 }
@@ -153,8 +169,9 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if err != nil {
 		return nil, err
 	}
+	fields := convertFields(oa.HasDistinct, oa.Aggregates, result.Fields)
 	out := &sqltypes.Result{
-		Fields: oa.convertFields(result.Fields),
+		Fields: convertPostExprFields(oa.PostExprs, fields, bindVars),
 		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
 		Extras: result.Extras,
 	}
@@ -163,7 +180,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	var curDistinct sqltypes.Value
 	for _, row := range result.Rows {
 		if current == nil {
-			current, curDistinct = oa.convertRow(row)
+			current, curDistinct = convertRow(oa.HasDistinct, oa.Aggregates, row)
 			continue
 		}
 
@@ -173,20 +190,20 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
 		out.Rows = append(out.Rows, current)
-		current, curDistinct = oa.convertRow(row)
+		current, curDistinct = convertRow(oa.HasDistinct, oa.Aggregates, row)
 	}
 
 	if len(result.Rows) == 0 && len(oa.Keys) == 0 {
 		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
 		// different aggregation functions
-		row, err := oa.createEmptyRow()
+		row, err := createEmptyRow(oa.Aggregates, len(fields))
 		if err != nil {
 			return nil, err
 		}
@@ -196,6 +213,11 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if current != nil {
 		out.Rows = append(out.Rows, current)
 	}
+	for i, row := range out.Rows {
+		if out.Rows[i], err = evalPostExprs(oa.PostExprs, fields, bindVars, row); err != nil {
+			return nil, err
+		}
+	}
 	out.RowsAffected = uint64(len(out.Rows))
 	return out, nil
 }
@@ -209,18 +231,25 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
+	emit := func(row []sqltypes.Value) error {
+		row, err := evalPostExprs(oa.PostExprs, fields, bindVars, row)
+		if err != nil {
+			return err
+		}
+		return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
+	}
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
-			fields = oa.convertFields(qr.Fields)
-			if err := cb(&sqltypes.Result{Fields: fields}); err != nil {
+			fields = convertFields(oa.HasDistinct, oa.Aggregates, qr.Fields)
+			if err := cb(&sqltypes.Result{Fields: convertPostExprFields(oa.PostExprs, fields, bindVars)}); err != nil {
 				return err
 			}
 		}
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
 			if current == nil {
-				current, curDistinct = oa.convertRow(row)
+				current, curDistinct = convertRow(oa.HasDistinct, oa.Aggregates, row)
 				continue
 			}
 
@@ -236,10 +265,10 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := emit(current); err != nil {
 				return err
 			}
-			current, curDistinct = oa.convertRow(row)
+			current, curDistinct = convertRow(oa.HasDistinct, oa.Aggregates, row)
 		}
 		return nil
 	})
@@ -248,19 +277,20 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := emit(current); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
-	if !oa.HasDistinct {
+// convertFields renames and retypes the columns of the distinct aggregates.
+func convertFields(hasDistinct bool, aggregates []AggregateParams, fields []*querypb.Field) []*querypb.Field {
+	if !hasDistinct {
 		return fields
 	}
 
-	for _, aggr := range oa.Aggregates {
+	for _, aggr := range aggregates {
 		if !aggr.isDistinct() {
 			continue
 		}
@@ -272,12 +302,50 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
-	if !oa.HasDistinct {
+// convertPostExprFields returns a copy of the fields where the columns
+// computed by postExprs are renamed and retyped.
+func convertPostExprFields(postExprs []PostExprParams, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) []*querypb.Field {
+	if len(postExprs) == 0 || fields == nil {
+		return fields
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	newFields := append([]*querypb.Field(nil), fields...)
+	for _, pe := range postExprs {
+		newFields[pe.Col] = &querypb.Field{
+			Name: pe.Alias,
+			Type: pe.Expr.Type(env),
+		}
+	}
+	return newFields
+}
+
+// evalPostExprs returns the row with the postExprs evaluated. All
+// expressions see the aggregated values and not the results of
+// other postExprs.
+func evalPostExprs(postExprs []PostExprParams, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) ([]sqltypes.Value, error) {
+	if len(postExprs) == 0 {
+		return row, nil
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields, Row: row}
+	newRow := sqltypes.CopyRow(row)
+	for _, pe := range postExprs {
+		val, err := pe.Expr.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		newRow[pe.Col] = val
+	}
+	return newRow, nil
+}
+
+// convertRow converts the first row of a group into its aggregated form.
+// It also returns the value of the distinct aggregate, if any.
+func convertRow(hasDistinct bool, aggregates []AggregateParams, row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+	if !hasDistinct {
 		return row, sqltypes.NULL
 	}
 	newRow = append(newRow, row...)
-	for _, aggr := range oa.Aggregates {
+	for _, aggr := range aggregates {
 		switch aggr.Opcode {
 		case AggregateCountDistinct:
 			curDistinct = row[aggr.Col]
@@ -305,7 +373,7 @@ func (oa *OrderedAggregate) GetFields(vcursor VCursor, bindVars map[string]*quer
 	if err != nil {
 		return nil, err
 	}
-	qr = &sqltypes.Result{Fields: oa.convertFields(qr.Fields)}
+	qr = &sqltypes.Result{Fields: convertPostExprFields(oa.PostExprs, convertFields(oa.HasDistinct, oa.Aggregates, qr.Fields), bindVars)}
 	return qr.Truncate(oa.TruncateColumnCount), nil
 }
 
@@ -339,20 +407,7 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			curDistinct = row2[aggr.Col]
 		}
 		var err error
-		switch aggr.Opcode {
-		case AggregateCount, AggregateSum:
-			result[aggr.Col] = sqltypes.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
-		case AggregateMin:
-			result[aggr.Col], err = sqltypes.Min(row1[aggr.Col], row2[aggr.Col])
-		case AggregateMax:
-			result[aggr.Col], err = sqltypes.Max(row1[aggr.Col], row2[aggr.Col])
-		case AggregateCountDistinct:
-			result[aggr.Col] = sqltypes.NullsafeAdd(row1[aggr.Col], countOne, opcodeType[aggr.Opcode])
-		case AggregateSumDistinct:
-			result[aggr.Col] = sqltypes.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], opcodeType[aggr.Opcode])
-		default:
-			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
-		}
+		result[aggr.Col], err = aggregate(fields, aggr, row1[aggr.Col], row2[aggr.Col])
 		if err != nil {
 			return nil, sqltypes.NULL, err
 		}
@@ -360,15 +415,38 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 	return result, curDistinct, nil
 }
 
-// creates the empty row for the case when we are missing grouping keys and have empty input table
-func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
-	out := make([]sqltypes.Value, len(oa.Aggregates))
-	for i, aggr := range oa.Aggregates {
+// aggregate combines the two values of the column of aggr.
+func aggregate(fields []*querypb.Field, aggr AggregateParams, v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	switch aggr.Opcode {
+	case AggregateCount, AggregateSum:
+		return sqltypes.NullsafeAdd(v1, v2, fields[aggr.Col].Type), nil
+	case AggregateMin:
+		return sqltypes.Min(v1, v2)
+	case AggregateMax:
+		return sqltypes.Max(v1, v2)
+	case AggregateCountDistinct:
+		return sqltypes.NullsafeAdd(v1, countOne, opcodeType[aggr.Opcode]), nil
+	case AggregateSumDistinct:
+		return sqltypes.NullsafeAdd(v1, v2, opcodeType[aggr.Opcode]), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+}
+
+// creates the empty row for the case when we are missing grouping keys and have empty input table.
+// The row has at least numCols columns, and is wide enough to contain all the aggregates.
+func createEmptyRow(aggregates []AggregateParams, numCols int) ([]sqltypes.Value, error) {
+	for _, aggr := range aggregates {
+		if aggr.Col >= numCols {
+			numCols = aggr.Col + 1
+		}
+	}
+	out := make([]sqltypes.Value, numCols)
+	for _, aggr := range aggregates {
 		value, err := createEmptyValueFor(aggr.Opcode)
 		if err != nil {
 			return nil, err
 		}
-		out[i] = value
+		out[aggr.Col] = value
 	}
 	return out, nil
 }
@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
@@ -742,3 +744,158 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		})
 	}
 }
+
+func TestOrderedAggregatePostExprs(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|sum(a)|count(a)",
+		"varbinary|decimal|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|1",
+			"a|2|1",
+			"b|3|2",
+			"c|null|0",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    1,
+		}, {
+			Opcode: AggregateCount,
+			Col:    2,
+		}},
+		Keys: []int{0},
+		PostExprs: []PostExprParams{{
+			Col: 1,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.ArithmeticDivide,
+				Left:  evalengine.NewColumn(1),
+				Right: evalengine.NewColumn(2),
+			},
+			Alias: "avg(a)",
+		}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|avg(a)",
+			"varbinary|float64",
+		),
+		"a|1.5",
+		"b|1.5",
+		"c|null",
+	)
+
+	result, err := oa.Execute(nil, nil, false)
+	assert.NoError(err)
+	assert.Equal(wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, nil, nil, false)
+	assert.NoError(err)
+	assert.Equal(wantResult, result)
+
+	fp.rewind()
+	result, err = oa.GetFields(nil, nil)
+	assert.NoError(err)
+	assert.Equal(&sqltypes.Result{Fields: wantResult.Fields}, result)
+}
+
+func TestOrderedAggregatePostExprsNoInput(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)|sum(a)|count(a)",
+				"int64|decimal|int64",
+			),
+			// Empty input table
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    0,
+		}, {
+			Opcode: AggregateSum,
+			Col:    1,
+		}, {
+			Opcode: AggregateCount,
+			Col:    2,
+		}},
+		PostExprs: []PostExprParams{{
+			Col: 0,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.ArithmeticAdd,
+				Left:  evalengine.NewColumn(0),
+				Right: evalengine.NewBindVariable("a"),
+			},
+			Alias: "count(*) + :a",
+		}, {
+			Col: 1,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.ArithmeticDivide,
+				Left:  evalengine.NewColumn(1),
+				Right: evalengine.NewColumn(2),
+			},
+			Alias: "avg(a)",
+		}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)}, false)
+	assert.NoError(err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*) + :a|avg(a)",
+			"int64|float64",
+		),
+		"1|null",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestOrderedAggregatePostExprsFail(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|count(*)",
+				"varbinary|int64",
+			),
+			"a|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}},
+		Keys: []int{0},
+		PostExprs: []PostExprParams{{
+			Col: 1,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.ArithmeticAdd,
+				Left:  evalengine.NewColumn(1),
+				Right: evalengine.NewBindVariable("a"),
+			},
+		}},
+		Input: fp,
+	}
+
+	_, err := oa.Execute(nil, nil, false)
+	assert.EqualError(t, err, "missing bind var a")
+
+	fp.rewind()
+	_, err = wrapStreamExecute(oa, nil, nil, false)
+	assert.EqualError(t, err, "missing bind var a")
+}
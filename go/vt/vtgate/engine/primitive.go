@@ -37,6 +37,9 @@ const (
 	// This is used for sending different IN clause values
 	// to different shards.
 	ListVarName = "__vals"
+	// LastInsertIDName is a reserved bind var name for the
+	// value of LAST_INSERT_ID() in the session.
+	LastInsertIDName = "__lastInsertId"
 )
 
 // VCursor defines the interface the engine will use
@@ -57,12 +60,20 @@ type VCursor interface {
 	// V3 functions.
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	AutocommitApproval() bool
+	InTransaction() bool
+	// AfterTransaction calls hook once the transaction of the
+	// session is over, or right away if there is none.
+	AfterTransaction(hook func())
 
 	// Shard-level functions.
 	ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, isDML, canAutocommit bool) (*sqltypes.Result, []error)
 	ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error)
 	StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error
 
+	// ExecuteLock executes the query on the connection that
+	// the session reserves for its advisory locks on the shard.
+	ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
+
 	// Keyspace ID level functions.
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, isDML, autocommit bool) (*sqltypes.Result, error)
 
@@ -82,6 +93,9 @@ type Plan struct {
 	// Instructions contains the instructions needed to
 	// fulfil the query.
 	Instructions Primitive `json:",omitempty"`
+	// NeedsLastInsertID is true if the instructions need
+	// the LastInsertIDName bind variable.
+	NeedsLastInsertID bool `json:",omitempty"`
 	// Mutex to protect the stats
 	mu sync.Mutex
 	// Count of times this plan was executed
@@ -0,0 +1,151 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Projection)(nil)
+
+// Projection is a primitive that computes its columns from the rows
+// of its input. It's used for the select expressions that cannot
+// be pushed down to the shards, like expressions on the results
+// of a cross-shard subquery.
+type Projection struct {
+	// Cols are the names of the columns. The columns that are
+	// a plain reference to an input column keep the input field
+	// instead.
+	Cols  []string
+	Exprs []evalengine.Expr
+	Input Primitive
+}
+
+// MarshalJSON serializes the Projection into a JSON representation.
+// It's used for testing and diagnostics.
+func (p *Projection) MarshalJSON() ([]byte, error) {
+	marshalProjection := struct {
+		Opcode string
+		Cols   []string
+		Exprs  []evalengine.Expr
+		Input  Primitive
+	}{
+		Opcode: "Projection",
+		Cols:   p.Cols,
+		Exprs:  p.Exprs,
+		Input:  p.Input,
+	}
+	return json.Marshal(marshalProjection)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (p *Projection) RouteType() string {
+	return p.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (p *Projection) GetKeyspaceName() string {
+	return p.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (p *Projection) GetTableName() string {
+	return p.Input.GetTableName()
+}
+
+// Execute is a Primitive function.
+func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	qr, err := p.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return p.project(qr, qr.Fields, bindVars)
+}
+
+// StreamExecute is a Primitive function.
+func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The fields are only sent with the first result,
+	// but they're needed to evaluate the expressions.
+	var fields []*querypb.Field
+	return p.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			fields = qr.Fields
+		}
+		qr, err := p.project(qr, fields, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(qr)
+	})
+}
+
+// GetFields is a Primitive function.
+func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := p.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: p.projectFields(qr.Fields, bindVars)}, nil
+}
+
+// project computes the result columns for every row. fields
+// describe the columns of the input rows.
+func (p *Projection) project(in *sqltypes.Result, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	out := &sqltypes.Result{
+		Fields:       p.projectFields(in.Fields, bindVars),
+		RowsAffected: in.RowsAffected,
+		Extras:       in.Extras,
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	for _, row := range in.Rows {
+		env.Row = row
+		newRow := make([]sqltypes.Value, 0, len(p.Exprs))
+		for _, expr := range p.Exprs {
+			val, err := expr.Evaluate(env)
+			if err != nil {
+				return nil, err
+			}
+			newRow = append(newRow, val)
+		}
+		out.Rows = append(out.Rows, newRow)
+	}
+	return out, nil
+}
+
+func (p *Projection) projectFields(fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	newFields := make([]*querypb.Field, 0, len(p.Exprs))
+	for i, expr := range p.Exprs {
+		if col, ok := expr.(*evalengine.Column); ok {
+			newFields = append(newFields, fields[col.Offset])
+			continue
+		}
+		newFields = append(newFields, &querypb.Field{
+			Name: p.Cols[i],
+			Type: expr.Type(env),
+		})
+	}
+	return newFields
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestProjectionExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|col|name",
+				"int64|int64|varchar",
+			),
+			"1|2|a",
+			"2|null|b",
+			"3|4|c",
+		)},
+	}
+
+	p := &Projection{
+		Cols: []string{"name", "id + col", "upper(name)"},
+		Exprs: []evalengine.Expr{
+			evalengine.NewColumn(2),
+			&evalengine.Arithmetic{Op: evalengine.ArithmeticAdd, Left: evalengine.NewColumn(0), Right: evalengine.NewColumn(1)},
+			mustFunction(t, "upper", evalengine.NewColumn(2)),
+		},
+		Input: fp,
+	}
+	wantFields := sqltypes.MakeTestFields(
+		"name|id + col|upper(name)",
+		"varchar|int64|varchar",
+	)
+
+	result, err := p.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(wantFields, "a|3|A", "b|null|B", "c|7|C"), result)
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = p.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(t, err)
+	wantResults := sqltypes.MakeTestStreamingResults(wantFields, "a|3|A", "b|null|B", "---", "c|7|C")
+	assert.Equal(t, wantResults, results)
+
+	fp.rewind()
+	result, err = p.GetFields(noopVCursor{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &sqltypes.Result{Fields: wantFields}, result)
+
+	p.Input = &fakePrimitive{sendErr: errors.New("input fail")}
+	_, err = p.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "input fail")
+}
+
+func TestProjectionMarshalJSON(t *testing.T) {
+	p := &Projection{
+		Cols:  []string{"a", "-a"},
+		Exprs: []evalengine.Expr{evalengine.NewColumn(1), &evalengine.UnaryMinus{Expr: evalengine.NewColumn(1)}},
+		Input: &fakePrimitive{},
+	}
+	out, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"Projection","Cols":["a","-a"],"Exprs":["[COLUMN 1]","-[COLUMN 1]"],"Input":{}}`, string(out))
+}
+
+func mustFunction(t *testing.T, name string, args ...evalengine.Expr) evalengine.Expr {
+	t.Helper()
+	f, err := evalengine.NewFunction(name, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
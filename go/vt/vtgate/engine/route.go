@@ -378,6 +378,9 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if route.Values[0].IsList() {
+		return route.paramsSelectEqualMultiColumn(vcursor, bindVars)
+	}
 	key, err := route.Values[0].ResolveValue(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
@@ -393,6 +396,32 @@ func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*quer
 	return rss, multiBindVars, nil
 }
 
+// paramsSelectEqualMultiColumn routes through a MultiColumn vindex.
+// The value is the list of the values of all the vindex columns.
+func (route *Route) paramsSelectEqualMultiColumn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	mc, ok := route.Vindex.(vindexes.MultiColumn)
+	if !ok {
+		return nil, nil, fmt.Errorf("paramsSelectEqualMultiColumn: vindex %s is not a multi-column vindex", route.Vindex)
+	}
+	colValues, err := route.Values[0].ResolveList(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectEqualMultiColumn")
+	}
+	destinations, err := mc.MapMulti(vcursor, [][]sqltypes.Value{colValues})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectEqualMultiColumn")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectEqualMultiColumn")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	keys, err := route.Values[0].ResolveList(bindVars)
 	if err != nil {
@@ -487,6 +516,29 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vinde
 	return rss[0], ksid, nil
 }
 
+// resolveKeyspaceID returns the keyspace id of a row from the
+// values of its unique vindex columns. A MultiColumn vindex
+// receives all of them.
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKeys []sqltypes.Value) ([]byte, error) {
+	var (
+		destinations []key.Destination
+		err          error
+	)
+	if mc, ok := vindex.(vindexes.MultiColumn); ok {
+		destinations, err = mc.MapMulti(vcursor, [][]sqltypes.Value{vindexKeys})
+	} else {
+		destinations, err = vindex.Map(vcursor, vindexKeys[:1])
+	}
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("cannot map vindex to unique keyspace id: %v", destinations[0])
+	}
+	return ksid, nil
+}
+
 func execShard(vcursor VCursor, query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard, isDML, canAutocommit bool) (*sqltypes.Result, error) {
 	autocommit := canAutocommit && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, []*querypb.BoundQuery{
@@ -498,6 +550,14 @@ func execShard(vcursor VCursor, query string, bindVars map[string]*querypb.BindV
 	return result, vterrors.Aggregate(errs)
 }
 
+// execMultiShardSelect sends the same select to all the shards, with
+// the bind variables of each shard. It's used to fetch the rows a
+// multi-shard DML is going to change.
+func execMultiShardSelect(vcursor VCursor, query string, bvs []map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	result, errs := vcursor.ExecuteMultiShard(rss, getQueries(query, bvs), false /* isDML */, false /* canAutocommit */)
+	return result, vterrors.Aggregate(errs)
+}
+
 func getQueries(query string, bvs []map[string]*querypb.BindVariable) []*querypb.BoundQuery {
 	queries := make([]*querypb.BoundQuery, len(bvs))
 	for i, bv := range bvs {
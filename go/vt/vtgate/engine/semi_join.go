@@ -0,0 +1,426 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// SemiJoin returns the rows of the LHS for which a correlated
+// subquery, the RHS, has (or doesn't have) a match. The RHS is
+// executed with the values of Vars taken from the LHS row. It's
+// only executed once per distinct combination of those values,
+// and the result is reused for the other LHS rows that have it.
+// If BatchVar is set, the RHS is instead executed once for the
+// distinct values of up to semiJoinBatchSize LHS rows.
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the SemiJoin. They can be any primitive.
+	Left, Right Primitive
+
+	// Cols defines which columns from the left
+	// results should be used to build the
+	// return result.
+	Cols []int
+
+	// Vars defines the list of joinVars that need to
+	// be built from the LHS result before invoking
+	// the RHS subquery.
+	Vars map[string]int
+
+	// LHSKey is the column of the LHS results that's looked up
+	// in the first column of the RHS results. It's only used by
+	// SemiJoinIn and AntiJoinNotIn.
+	LHSKey int
+
+	// BatchVar is the list bind var that receives the distinct
+	// non-NULL values of the LHS column BatchKey of a batch of
+	// rows. The RHS must return the value each of its rows was
+	// matched with as its last column. The RHS rows are then
+	// assigned to the LHS rows locally. Vars is not used if
+	// BatchVar is set.
+	BatchVar string
+	BatchKey int
+}
+
+// semiJoinBatchSize is the maximum number of LHS values
+// sent to the RHS in a single execution.
+var semiJoinBatchSize = 100
+
+// MarshalJSON serializes the SemiJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (sj *SemiJoin) MarshalJSON() ([]byte, error) {
+	marshalSemiJoin := struct {
+		Opcode   SemiJoinOpcode
+		Left     Primitive      `json:",omitempty"`
+		Right    Primitive      `json:",omitempty"`
+		Cols     []int          `json:",omitempty"`
+		Vars     map[string]int `json:",omitempty"`
+		LHSKey   *int           `json:",omitempty"`
+		BatchVar string         `json:",omitempty"`
+		BatchKey *int           `json:",omitempty"`
+	}{
+		Opcode: sj.Opcode,
+		Left:   sj.Left,
+		Right:  sj.Right,
+		Cols:   sj.Cols,
+		Vars:   sj.Vars,
+	}
+	if sj.Opcode.hasKey() {
+		marshalSemiJoin.LHSKey = &sj.LHSKey
+	}
+	if sj.BatchVar != "" {
+		marshalSemiJoin.BatchVar = sj.BatchVar
+		marshalSemiJoin.BatchKey = &sj.BatchKey
+	}
+	return json.Marshal(marshalSemiJoin)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = projectFields(lresult.Fields, sj.Cols)
+	}
+	rows, err := sj.newMatcher(vcursor, bindVars).filter(lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	m := sj.newMatcher(vcursor, bindVars)
+	return sj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if lresult.Fields != nil {
+			result.Fields = projectFields(lresult.Fields, sj.Cols)
+		}
+		rows, err := m.filter(lresult.Rows)
+		if err != nil {
+			return err
+		}
+		result.Rows = rows
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: projectFields(lresult.Fields, sj.Cols)}, nil
+}
+
+// semiJoinMatcher filters the LHS rows of a SemiJoin. It keeps
+// the RHS results of the values it has already seen.
+type semiJoinMatcher struct {
+	sj       *SemiJoin
+	vcursor  VCursor
+	bindVars map[string]*querypb.BindVariable
+	varNames []string
+
+	maxRows    int
+	cachedRows int
+	cache      map[string][][]sqltypes.Value
+}
+
+func (sj *SemiJoin) newMatcher(vcursor VCursor, bindVars map[string]*querypb.BindVariable) *semiJoinMatcher {
+	varNames := make([]string, 0, len(sj.Vars))
+	for k := range sj.Vars {
+		varNames = append(varNames, k)
+	}
+	sort.Strings(varNames)
+	return &semiJoinMatcher{
+		sj:       sj,
+		vcursor:  vcursor,
+		bindVars: bindVars,
+		varNames: varNames,
+		maxRows:  vcursor.MaxMemoryRows(),
+		cache:    make(map[string][][]sqltypes.Value),
+	}
+}
+
+// filter returns the projected LHS rows that satisfy the SemiJoin.
+func (m *semiJoinMatcher) filter(lrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	if m.sj.BatchVar != "" {
+		if err := m.executeBatches(lrows); err != nil {
+			return nil, err
+		}
+	}
+	var rows [][]sqltypes.Value
+	for _, lrow := range lrows {
+		var rrows [][]sqltypes.Value
+		var err error
+		if m.sj.BatchVar != "" {
+			// The batches were executed. So, the rows are cached.
+			rrows = m.cache[valueKey(lrow[m.sj.BatchKey])]
+		} else if rrows, err = m.rhsRows(lrow); err != nil {
+			return nil, err
+		}
+		ok, err := m.sj.match(lrow, rrows)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, projectRow(lrow, m.sj.Cols))
+		}
+	}
+	return rows, nil
+}
+
+// rhsRows returns the rows of the RHS for the values of the LHS row.
+func (m *semiJoinMatcher) rhsRows(lrow []sqltypes.Value) ([][]sqltypes.Value, error) {
+	var buf []byte
+	for _, name := range m.varNames {
+		buf = appendValueKey(buf, lrow[m.sj.Vars[name]])
+	}
+	key := string(buf)
+	if rrows, ok := m.cache[key]; ok {
+		return rrows, nil
+	}
+
+	joinVars := make(map[string]*querypb.BindVariable, len(m.sj.Vars))
+	for k, col := range m.sj.Vars {
+		joinVars[k] = sqltypes.ValueBindVariable(lrow[col])
+	}
+	rresult, err := m.sj.Right.Execute(m.vcursor, combineVars(m.bindVars, joinVars), false)
+	if err != nil {
+		return nil, err
+	}
+	rrows := rresult.Rows
+	if !m.sj.Opcode.hasKey() && len(rrows) > 1 {
+		// Only the presence of rows matters.
+		rrows = rrows[:1]
+	}
+	m.cachedRows += len(rrows)
+	if m.cachedRows > m.maxRows {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", m.maxRows)
+	}
+	m.cache[key] = rrows
+	return rrows, nil
+}
+
+// executeBatches executes the RHS for the values of the LHS rows that
+// are not cached yet, semiJoinBatchSize values at a time, and caches
+// the RHS rows of each value. NULL values are skipped because they
+// never match.
+func (m *semiJoinMatcher) executeBatches(lrows [][]sqltypes.Value) error {
+	var batch []sqltypes.Value
+	inBatch := make(map[string]bool)
+	for i, lrow := range lrows {
+		val := lrow[m.sj.BatchKey]
+		key := valueKey(val)
+		if _, ok := m.cache[key]; !ok && !val.IsNull() && !inBatch[key] {
+			inBatch[key] = true
+			batch = append(batch, val)
+		}
+		if len(batch) == semiJoinBatchSize || (i == len(lrows)-1 && len(batch) != 0) {
+			if err := m.executeBatch(batch); err != nil {
+				return err
+			}
+			batch = nil
+			inBatch = make(map[string]bool)
+		}
+	}
+	return nil
+}
+
+// executeBatch executes the RHS for the batch of LHS values, and
+// assigns each RHS row to the values its last column is equal to.
+// The values are compared like MySQL did when it matched the rows.
+func (m *semiJoinMatcher) executeBatch(batch []sqltypes.Value) error {
+	tuple := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for _, val := range batch {
+		tuple.Values = append(tuple.Values, sqltypes.ValueToProto(val))
+	}
+	rresult, err := m.sj.Right.Execute(m.vcursor, combineVars(m.bindVars, map[string]*querypb.BindVariable{m.sj.BatchVar: tuple}), false)
+	if err != nil {
+		return err
+	}
+	cmp := &evalengine.Comparison{
+		Op:    evalengine.CompareEqual,
+		Left:  evalengine.NewColumn(0),
+		Right: evalengine.NewColumn(1),
+	}
+	for _, val := range batch {
+		key := valueKey(val)
+		var rrows [][]sqltypes.Value
+		for _, rrow := range rresult.Rows {
+			if len(rrow) == 0 {
+				return vterrors.New(vtrpcpb.Code_INTERNAL, "semi join: the batched subquery returned no column")
+			}
+			v, err := cmp.Evaluate(evalengine.ExpressionEnv{Row: []sqltypes.Value{val, rrow[len(rrow)-1]}})
+			if err != nil {
+				return err
+			}
+			if !evalengine.IsTrue(v) {
+				continue
+			}
+			rrows = append(rrows, rrow[:len(rrow)-1])
+			if !m.sj.Opcode.hasKey() {
+				// Only the presence of rows matters.
+				break
+			}
+		}
+		m.cachedRows += len(rrows)
+		if m.cachedRows > m.maxRows {
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", m.maxRows)
+		}
+		m.cache[key] = rrows
+	}
+	return nil
+}
+
+// valueKey returns the key of a single value in the cache.
+func valueKey(v sqltypes.Value) string {
+	return string(appendValueKey(nil, v))
+}
+
+// match returns true if the LHS row must be returned for the RHS rows.
+// IN and NOT IN follow the MySQL rules for NULL values: a NULL
+// comparison makes the row fail the condition.
+func (sj *SemiJoin) match(lrow []sqltypes.Value, rrows [][]sqltypes.Value) (bool, error) {
+	switch sj.Opcode {
+	case SemiJoinExists:
+		return len(rrows) != 0, nil
+	case AntiJoinNotExists:
+		return len(rrows) == 0, nil
+	}
+	cmp := &evalengine.Comparison{
+		Op:    evalengine.CompareEqual,
+		Left:  evalengine.NewColumn(0),
+		Right: evalengine.NewColumn(1),
+	}
+	hasNull := false
+	for _, rrow := range rrows {
+		if len(rrow) != 1 {
+			return false, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "subquery returned more than one column")
+		}
+		v, err := cmp.Evaluate(evalengine.ExpressionEnv{Row: []sqltypes.Value{lrow[sj.LHSKey], rrow[0]}})
+		if err != nil {
+			return false, err
+		}
+		if v.IsNull() {
+			hasNull = true
+			continue
+		}
+		if evalengine.IsTrue(v) {
+			return sj.Opcode == SemiJoinIn, nil
+		}
+	}
+	if sj.Opcode == SemiJoinIn {
+		return false, nil
+	}
+	return !hasNull, nil
+}
+
+func projectFields(fields []*querypb.Field, cols []int) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	projected := make([]*querypb.Field, len(cols))
+	for i, col := range cols {
+		projected[i] = fields[col]
+	}
+	return projected
+}
+
+func projectRow(row []sqltypes.Value, cols []int) []sqltypes.Value {
+	projected := make([]sqltypes.Value, len(cols))
+	for i, col := range cols {
+		projected[i] = row[col]
+	}
+	return projected
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	SemiJoinExists = SemiJoinOpcode(iota)
+	AntiJoinNotExists
+	SemiJoinIn
+	AntiJoinNotIn
+)
+
+var semiJoinName = map[SemiJoinOpcode]string{
+	SemiJoinExists:    "SemiJoinExists",
+	AntiJoinNotExists: "AntiJoinNotExists",
+	SemiJoinIn:        "SemiJoinIn",
+	AntiJoinNotIn:     "AntiJoinNotIn",
+}
+
+func (code SemiJoinOpcode) String() string {
+	return semiJoinName[code]
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// hasKey returns true if the opcode compares the LHSKey
+// with the values returned by the RHS.
+func (code SemiJoinOpcode) hasKey() bool {
+	return code == SemiJoinIn || code == AntiJoinNotIn
+}
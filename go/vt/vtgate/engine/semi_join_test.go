@@ -0,0 +1,354 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newSemiJoinLeft() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|name|uid",
+					"int64|varchar|int64",
+				),
+				"1|a|10",
+				"2|b|20",
+				"3|c|10",
+				"4|d|null",
+			),
+		},
+	}
+}
+
+func TestSemiJoinExists(t *testing.T) {
+	leftPrim := newSemiJoinLeft()
+	rfields := sqltypes.MakeTestFields("col", "int64")
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rfields, "1", "2"),
+			sqltypes.MakeTestResult(rfields),
+			sqltypes.MakeTestResult(rfields),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 1},
+		Vars:   map[string]int{"uid": 2},
+	}
+	r, err := sj.Execute(noopVCursor{}, bv, true)
+	assert.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	// The RHS is executed only once for uid 10.
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" uid: type:INT64 value:"10"  false`,
+		`Execute a: type:INT64 value:"10" uid: type:INT64 value:"20"  false`,
+		`Execute a: type:INT64 value:"10" uid:  false`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"id|name",
+		"int64|varchar",
+	)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a",
+		"3|c",
+	))
+
+	// Anti join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = AntiJoinNotExists
+	r, err = sj.Execute(noopVCursor{}, bv, true)
+	assert.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"2|b",
+		"4|d",
+	))
+}
+
+func TestSemiJoinIn(t *testing.T) {
+	leftPrim := newSemiJoinLeft()
+	rfields := sqltypes.MakeTestFields("col", "int64")
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rfields, "1", "3"),
+			sqltypes.MakeTestResult(rfields, "2", "null"),
+			sqltypes.MakeTestResult(rfields),
+		},
+	}
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinIn,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{1},
+		Vars:   map[string]int{"uid": 2},
+		LHSKey: 0,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	wantFields := sqltypes.MakeTestFields(
+		"name",
+		"varchar",
+	)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"a",
+		"b",
+		"c",
+	))
+
+	// NOT IN: a NULL returned by the RHS makes the comparison
+	// NULL, and an empty RHS always matches.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = AntiJoinNotIn
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"d",
+	))
+}
+
+func TestSemiJoinBatch(t *testing.T) {
+	save := semiJoinBatchSize
+	defer func() { semiJoinBatchSize = save }()
+
+	leftPrim := newSemiJoinLeft()
+	rfields := sqltypes.MakeTestFields("col|uid", "int64|int64")
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rfields, "1|10", "5|20", "3|10"),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinIn,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		Cols:     []int{0},
+		LHSKey:   0,
+		BatchVar: "__sj_vals",
+		BatchKey: 2,
+	}
+
+	// The RHS is executed once for the distinct non-NULL values,
+	// and its rows are matched with them locally.
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj_vals: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"3",
+	))
+
+	// The values are split in batches.
+	semiJoinBatchSize = 1
+	leftPrim.rewind()
+	rightPrim = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rfields, "1|10"),
+			sqltypes.MakeTestResult(rfields),
+		},
+	}
+	sj.Right = rightPrim
+	sj.Opcode = SemiJoinExists
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj_vals: type:TUPLE values:<type:INT64 value:"10" >  false`,
+		`Execute __sj_vals: type:TUPLE values:<type:INT64 value:"20" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"3",
+	))
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim := newSemiJoinLeft()
+	rfields := sqltypes.MakeTestFields("col", "int64")
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rfields),
+			sqltypes.MakeTestResult(rfields, "1"),
+			sqltypes.MakeTestResult(rfields, "1"),
+		},
+	}
+
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+		Vars:   map[string]int{"uid": 2},
+	}
+	r, err := wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute uid: type:INT64 value:"10"  false`,
+		`Execute uid: type:INT64 value:"20"  false`,
+		`Execute uid:  false`,
+	})
+	expectResult(t, "sj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"2",
+		"4",
+	))
+}
+
+func TestSemiJoinGetFields(t *testing.T) {
+	leftPrim := newSemiJoinLeft()
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  &fakePrimitive{},
+		Cols:   []int{2, 0},
+	}
+	r, err := sj.GetFields(noopVCursor{}, nil)
+	assert.NoError(t, err)
+	expectResult(t, "sj.GetFields", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"uid|id",
+			"int64|int64",
+		),
+	))
+}
+
+func TestSemiJoinErrors(t *testing.T) {
+	// Error on left side
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   &fakePrimitive{sendErr: errors.New("left err")},
+		Right:  &fakePrimitive{},
+	}
+	_, err := sj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "left err")
+	_, err = wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "left err")
+
+	// Error on right side
+	sj = &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   newSemiJoinLeft(),
+		Right:  &fakePrimitive{sendErr: errors.New("right err")},
+		Cols:   []int{0},
+		Vars:   map[string]int{"uid": 2},
+	}
+	_, err = sj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "right err")
+
+	// Too many columns for IN
+	sj = &SemiJoin{
+		Opcode: SemiJoinIn,
+		Left:   newSemiJoinLeft(),
+		Right: &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"col1|col2",
+						"int64|int64",
+					),
+					"1|2",
+				),
+			},
+		},
+		Cols: []int{0},
+		Vars: map[string]int{"uid": 2},
+	}
+	_, err = sj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "subquery returned more than one column")
+}
+
+func TestSemiJoinMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	rfields := sqltypes.MakeTestFields("col", "int64")
+	sj := &SemiJoin{
+		Opcode: SemiJoinIn,
+		Left:   newSemiJoinLeft(),
+		Right: &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(rfields, "1", "2"),
+				sqltypes.MakeTestResult(rfields, "3"),
+			},
+		},
+		Cols: []int{0},
+		Vars: map[string]int{"uid": 2},
+	}
+	_, err := sj.Execute(noopVCursor{}, nil, true)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestSemiJoinMarshalJSON(t *testing.T) {
+	sj := &SemiJoin{
+		Opcode: AntiJoinNotExists,
+		Left:   &fakePrimitive{},
+		Right:  &fakePrimitive{},
+		Cols:   []int{0},
+		Vars:   map[string]int{"uid": 2},
+	}
+	out, err := json.Marshal(sj)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"AntiJoinNotExists","Left":{},"Right":{},"Cols":[0],"Vars":{"uid":2}}`, string(out))
+
+	sj.Opcode = SemiJoinIn
+	out, err = json.Marshal(sj)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"SemiJoinIn","Left":{},"Right":{},"Cols":[0],"Vars":{"uid":2},"LHSKey":0}`, string(out))
+
+	sj.Vars = nil
+	sj.BatchVar = "__sj_vals"
+	sj.BatchKey = 2
+	out, err = json.Marshal(sj)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Opcode":"SemiJoinIn","Left":{},"Right":{},"Cols":[0],"LHSKey":0,"BatchVar":"__sj_vals","BatchKey":2}`, string(out))
+}
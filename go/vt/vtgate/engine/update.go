@@ -19,12 +19,14 @@ package engine
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/jsonutil"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -64,6 +66,23 @@ type Update struct {
 	// OwnedVindexQuery is used for updating changes in lookup vindexes.
 	OwnedVindexQuery string
 
+	// KsidVindex is used to compute the keyspace id of the rows
+	// returned by OwnedVindexQuery for a multi-shard update. In that
+	// case, the first columns of the query are the ones of KsidVindex.
+	KsidVindex vindexes.Vindex
+
+	// Limit is set for a multi-shard update with a LIMIT.
+	Limit *DMLLimit
+
+	// ChangedColumnValues contains the values of all the columns changed
+	// by an update of the primary vindex. Such an update moves the rows
+	// to the shard of their new keyspace id: they are selected with
+	// SelectRowsQuery, deleted with DeleteRowsQuery, and inserted back
+	// with their new values.
+	ChangedColumnValues map[string]sqltypes.PlanValue
+	SelectRowsQuery     string
+	DeleteRowsQuery     string
+
 	// Option to override the standard behavior and allow a multi-shard update
 	// to use single round trip autocommit.
 	MultiShardAutocommit bool
@@ -75,13 +94,16 @@ type Update struct {
 // MarshalJSON serializes the Update into a JSON representation.
 // It's used for testing and diagnostics.
 func (upd *Update) MarshalJSON() ([]byte, error) {
-	var tname, vindexName string
+	var tname, vindexName, ksidVindexName string
 	if upd.Table != nil {
 		tname = upd.Table.Name.String()
 	}
 	if upd.Vindex != nil {
 		vindexName = upd.Vindex.String()
 	}
+	if upd.KsidVindex != nil {
+		ksidVindexName = upd.KsidVindex.String()
+	}
 	marshalUpdate := struct {
 		Opcode               UpdateOpcode
 		Keyspace             *vindexes.Keyspace              `json:",omitempty"`
@@ -91,6 +113,11 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		ChangedVindexValues  map[string][]sqltypes.PlanValue `json:",omitempty"`
 		Table                string                          `json:",omitempty"`
 		OwnedVindexQuery     string                          `json:",omitempty"`
+		KsidVindex           string                          `json:",omitempty"`
+		Limit                *DMLLimit                       `json:",omitempty"`
+		ChangedColumnValues  map[string]sqltypes.PlanValue   `json:",omitempty"`
+		SelectRowsQuery      string                          `json:",omitempty"`
+		DeleteRowsQuery      string                          `json:",omitempty"`
 		MultiShardAutocommit bool                            `json:",omitempty"`
 		QueryTimeout         int                             `json:",omitempty"`
 	}{
@@ -102,6 +129,11 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		ChangedVindexValues:  upd.ChangedVindexValues,
 		Table:                tname,
 		OwnedVindexQuery:     upd.OwnedVindexQuery,
+		KsidVindex:           ksidVindexName,
+		Limit:                upd.Limit,
+		ChangedColumnValues:  upd.ChangedColumnValues,
+		SelectRowsQuery:      upd.SelectRowsQuery,
+		DeleteRowsQuery:      upd.DeleteRowsQuery,
 		MultiShardAutocommit: upd.MultiShardAutocommit,
 		QueryTimeout:         upd.QueryTimeout,
 	}
@@ -122,7 +154,11 @@ const (
 	// a single Value.
 	UpdateEqual
 	// UpdateScatter is for routing a scattered
-	// update statement.
+	// update statement. If it changes owned lookup
+	// vindexes, OwnedVindexQuery and KsidVindex are
+	// used to update their entries first. If it has a
+	// LIMIT, Limit is used to find the rows to update
+	// on each shard.
 	UpdateScatter
 	// UpdateByDestination is to route explicitly to a given
 	// target destination. Is used when the query explicitly sets a target destination:
@@ -217,6 +253,14 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if upd.SelectRowsQuery != "" {
+		rss := []*srvtopo.ResolvedShard{rs}
+		result, err := upd.relocateRows(vcursor, bindVars, shardBindVars(bindVars, rss), rss)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateEqual")
+		}
+		return result, nil
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, upd.OwnedVindexQuery, bindVars, rs, ksid); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateEqual")
@@ -243,12 +287,39 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, query string, bindVars m
 	if len(subQueryResult.Rows) > 1 {
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update changes multiple rows in the vindex")
 	}
-	colnum := 0
+	return upd.updateRowVindexEntries(vcursor, bindVars, subQueryResult.Rows[0], 0, ksid)
+}
+
+// updateVindexEntriesMultiShard performs the vindex updates of a
+// multi-shard update, one row at a time. The keyspace id of every
+// row is computed from its first columns, the ones of the primary
+// vindex.
+func (upd *Update) updateVindexEntriesMultiShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, bvs []map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+	subQueryResult, err := execMultiShardSelect(vcursor, upd.OwnedVindexQuery, bvs, rss)
+	if err != nil {
+		return err
+	}
+	ksidColumns := len(upd.Table.ColumnVindexes[0].Columns)
+	for _, row := range subQueryResult.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[:ksidColumns])
+		if err != nil {
+			return err
+		}
+		if err := upd.updateRowVindexEntries(vcursor, bindVars, row, ksidColumns, ksid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateRowVindexEntries updates the changing lookup vindexes of a row.
+// The values of the owned vindex columns start at colnum.
+func (upd *Update) updateRowVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, row []sqltypes.Value, colnum int, ksid []byte) error {
 	for _, colVindex := range upd.Table.Owned {
 		// Fetch the column values. colnum must keep incrementing.
 		fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
 		for range colVindex.Columns {
-			fromIds = append(fromIds, subQueryResult.Rows[0][colnum])
+			fromIds = append(fromIds, row[colnum])
 			colnum++
 		}
 
@@ -276,16 +347,182 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateByDestination")
 	}
-
-	queries := make([]*querypb.BoundQuery, len(rss))
-	sql := sqlannotation.AnnotateIfDML(upd.Query, nil)
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{
-			Sql:           sql,
-			BindVariables: bindVars,
+	bvs := shardBindVars(bindVars, rss)
+	if upd.Limit != nil {
+		var pk sqlparser.ColIdent
+		rss, bvs, pk, err = upd.Limit.lockRows(vcursor, bindVars, rss)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateByDestination")
+		}
+		if len(rss) == 0 {
+			return &sqltypes.Result{}, nil
+		}
+		if upd, err = upd.restrictToKeys(pk); err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateByDestination")
+		}
+	}
+	if upd.SelectRowsQuery != "" {
+		result, err := upd.relocateRows(vcursor, bindVars, bvs, rss)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateByDestination")
 		}
+		return result, nil
 	}
+	if upd.KsidVindex != nil && len(upd.ChangedVindexValues) != 0 {
+		if err := upd.updateVindexEntriesMultiShard(vcursor, bindVars, bvs, rss); err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateByDestination")
+		}
+	}
+
+	queries := getQueries(sqlannotation.AnnotateIfDML(upd.Query, nil), bvs)
 	autocommit := (len(rss) == 1 || upd.MultiShardAutocommit) && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	return result, vterrors.Aggregate(errs)
 }
+
+// restrictToKeys returns a copy of the update whose queries only
+// read or change the rows with the primary keys bound as ListVarName.
+func (upd *Update) restrictToKeys(pk sqlparser.ColIdent) (*Update, error) {
+	limited := *upd
+	for _, query := range []*string{&limited.Query, &limited.OwnedVindexQuery, &limited.SelectRowsQuery, &limited.DeleteRowsQuery} {
+		if *query == "" {
+			continue
+		}
+		var err error
+		if *query, err = restrictToKeys(*query, pk); err != nil {
+			return nil, err
+		}
+	}
+	return &limited, nil
+}
+
+// relocateRows performs an update that changes the primary vindex.
+// The rows are read from their shards, deleted, and inserted with
+// their new values in the shard of their new keyspace id. The entries
+// of the owned lookup vindexes are moved to the new keyspace id.
+// None of these statements can be autocommitted: they all belong to
+// the transaction of the session. If a row moves to another shard,
+// its transaction mode must allow multi-shard transactions.
+func (upd *Update) relocateRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, bvs []map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	result, err := execMultiShardSelect(vcursor, upd.SelectRowsQuery, bvs, rss)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	colnums := make(map[string]int, len(result.Fields))
+	for i, field := range result.Fields {
+		colnums[strings.ToLower(field.Name)] = i
+	}
+	findColumn := func(col string) (int, error) {
+		colnum, ok := colnums[strings.ToLower(col)]
+		if !ok {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown column '%s' in table '%s'", col, upd.Table.Name.String())
+		}
+		return colnum, nil
+	}
+	newValues := make(map[int]sqltypes.Value, len(upd.ChangedColumnValues))
+	for col, pv := range upd.ChangedColumnValues {
+		colnum, err := findColumn(col)
+		if err != nil {
+			return nil, err
+		}
+		if newValues[colnum], err = pv.ResolveValue(bindVars); err != nil {
+			return nil, err
+		}
+	}
+
+	primary := upd.Table.ColumnVindexes[0]
+	primaryColnum, err := findColumn(primary.Columns[0].String())
+	if err != nil {
+		return nil, err
+	}
+	newRS, newKsid, err := resolveSingleShard(vcursor, primary.Vindex, upd.Keyspace, newValues[primaryColnum])
+	if err != nil {
+		return nil, err
+	}
+	if len(newKsid) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not map %v to a keyspace id", newValues[primaryColnum])
+	}
+
+	newRows := make([][]sqltypes.Value, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, primary.Vindex, []sqltypes.Value{row[primaryColnum]})
+		if err != nil {
+			return nil, err
+		}
+		newRow := append([]sqltypes.Value(nil), row...)
+		for colnum, value := range newValues {
+			newRow[colnum] = value
+		}
+		for _, colVindex := range upd.Table.Owned {
+			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			toIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for _, col := range colVindex.Columns {
+				colnum, err := findColumn(col.String())
+				if err != nil {
+					return nil, err
+				}
+				fromIds = append(fromIds, row[colnum])
+				toIds = append(toIds, newRow[colnum])
+			}
+			lookup := colVindex.Vindex.(vindexes.Lookup)
+			if err := lookup.Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return nil, err
+			}
+			if err := lookup.Create(vcursor, [][]sqltypes.Value{toIds}, [][]byte{newKsid}, false /* ignoreMode */); err != nil {
+				return nil, err
+			}
+		}
+		newRows = append(newRows, newRow)
+	}
+
+	queries := getQueries(sqlannotation.AnnotateIfDML(upd.DeleteRowsQuery, nil), bvs)
+	_, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+
+	insert, insertVars := upd.generateRelocateInsert(result.Fields, newRows)
+	insert = sqlannotation.AddKeyspaceIDs(insert, [][]byte{newKsid}, "")
+	if _, err := execShard(vcursor, insert, insertVars, newRS, true /* isDML */, false /* canAutocommit */); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{RowsAffected: uint64(len(newRows))}, nil
+}
+
+// generateRelocateInsert returns the insert that writes back the
+// rows moved by relocateRows, and its bind variables.
+func (upd *Update) generateRelocateInsert(fields []*querypb.Field, rows [][]sqltypes.Value) (string, map[string]*querypb.BindVariable) {
+	cols := make([]sqlparser.ColIdent, 0, len(fields))
+	for _, field := range fields {
+		cols = append(cols, sqlparser.NewColIdent(field.Name))
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(rows)*len(cols))
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v(", upd.Table.Name)
+	for i, col := range cols {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", col)
+	}
+	buf.WriteString(") values ")
+	for rownum, row := range rows {
+		if rownum != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		for i, col := range cols {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			name := insertVarName(col, rownum)
+			bindVars[name] = sqltypes.ValueBindVariable(row[i])
+			buf.WriteString(":" + name)
+		}
+		buf.WriteString(")")
+	}
+	return buf.String(), bindVars
+}
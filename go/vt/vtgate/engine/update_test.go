@@ -274,6 +274,223 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 	expectError(t, "Execute", err, "execUpdateEqual: unsupported: update changes multiple rows in the vindex")
 }
 
+func TestUpdateScatterChangedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:   UpdateScatter,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		ChangedVindexValues: map[string][]sqltypes.PlanValue{
+			"onecol": {{
+				Value: sqltypes.NewInt64(3),
+			}},
+		},
+		Table:            ks.Tables["t1"],
+		OwnedVindexQuery: "dummy_subquery",
+		KsidVindex:       ks.Vindexes["hash"],
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+		"2|7|8|9",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The subquery is sent to all shards to fetch the changing rows.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The keyspace id of each row is computed from its id.
+		// twocol is not changing. 6 has to be replaced by 3 for id 1.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// 9 has to be replaced by 3 for id 2.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// Finally, the actual update is sent to all shards.
+		`ExecuteMultiShard sharded.-20: dummy_update {} sharded.20-: dummy_update {} true false`,
+	})
+
+	// Failure case: the subquery fails.
+	vc = &loggingVCursor{
+		shards:         []string{"-20", "20-"},
+		multiShardErrs: []error{errors.New("result error -20")},
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execUpdateByDestination: result error -20")
+}
+
+func TestUpdateScatterLimit(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:   UpdateScatter,
+		Keyspace: ks.Keyspace,
+		Query:    "update t1 set c3 = 3 where c2 = 5 order by id asc limit 1",
+		ChangedVindexValues: map[string][]sqltypes.PlanValue{
+			"onecol": {{
+				Value: sqltypes.NewInt64(3),
+			}},
+		},
+		Table:            ks.Tables["t1"],
+		OwnedVindexQuery: "select id, c1, c2, c3 from t1 where c2 = 5 order by id asc limit 1 for update",
+		KsidVindex:       ks.Vindexes["hash"],
+		Limit: &DMLLimit{
+			Query:   "dummy_select",
+			OrderBy: []OrderbyParams{{Col: 0}},
+			Count:   sqltypes.PlanValue{Value: sqltypes.NewInt64(1)},
+		},
+	}
+
+	fields := sqltypes.MakeTestFields("id|id|c1|c2|c3", "int64|int64|int64|int64|int64")
+	fields[1].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	results := []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "2|2|7|5|8"),
+		sqltypes.MakeTestResult(fields, "1|1|4|5|6"),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3",
+				"int64|int64|int64|int64",
+			),
+			"1|4|5|6",
+		),
+	}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} false false`,
+		`ExecuteMultiShard sharded.20-: dummy_select {} false false`,
+		// Only 20- has a row to update. The subquery is restricted like the update.
+		`ExecuteMultiShard sharded.20-: select id, c1, c2, c3 from t1 where id in ::__vals for update {__vals: type:TUPLE values:<type:INT64 value:"1" > } false false`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.20-: update t1 set c3 = 3 where id in ::__vals/* vtgate:: filtered_replication_unfriendly */ {__vals: type:TUPLE values:<type:INT64 value:"1" > } true true`,
+	})
+
+	// Failure case: the primary key has more than one column.
+	fields = sqltypes.MakeTestFields("id|id|c1|c2|c3", "int64|int64|int64|int64|int64")
+	fields[1].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	fields[2].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG)
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "2|2|7|5|8")},
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, `execUpdateByDestination: unsupported: multi-shard DML with a limit on a table with a multi-column primary key`)
+}
+
+func TestUpdateEqualRelocate(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:   UpdateEqual,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		Vindex:   ks.Vindexes["hash"],
+		Values:   []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+		ChangedVindexValues: map[string][]sqltypes.PlanValue{
+			"hash": {{
+				Value: sqltypes.NewInt64(2),
+			}},
+		},
+		Table: ks.Tables["t1"],
+		ChangedColumnValues: map[string]sqltypes.PlanValue{
+			"id": {Value: sqltypes.NewInt64(2)},
+			"c3": {Key: "c3"},
+		},
+		SelectRowsQuery: "dummy_select",
+		DeleteRowsQuery: "dummy_delete",
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3|other",
+			"int64|int64|int64|int64|varchar",
+		),
+		"1|4|5|6|a",
+		"1|7|8|9|b",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	bv := map[string]*querypb.BindVariable{
+		"c3": sqltypes.Int64BindVariable(3),
+	}
+	result, err := upd.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RowsAffected != 2 {
+		t.Errorf("RowsAffected: %d, want 2", result.RowsAffected)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		// The rows are fetched from their current shard.
+		`ExecuteMultiShard sharded.-20: dummy_select {c3: type:INT64 value:"3" } false false`,
+		// The new keyspace id is computed from the new id.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// The lookup entries of every row are moved to the new keyspace id.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"4" from20: type:INT64 value:"5" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"7" from2: type:INT64 value:"8" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"7" from20: type:INT64 value:"8" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// The rows are deleted from their current shard, and
+		// inserted in the new one. Nothing is autocommitted.
+		`ExecuteMultiShard sharded.-20: dummy_delete {c3: type:INT64 value:"3" } true false`,
+		`ExecuteMultiShard sharded.-20: insert into t1(id, c1, c2, c3, other) values (:_id0, :_c10, :_c20, :_c30, :_other0), (:_id1, :_c11, :_c21, :_c31, :_other1) /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c10: type:INT64 value:"4" _c11: type:INT64 value:"7" _c20: type:INT64 value:"5" _c21: type:INT64 value:"8" _c30: type:INT64 value:"3" _c31: type:INT64 value:"3" ` +
+			`_id0: type:INT64 value:"2" _id1: type:INT64 value:"2" _other0: type:VARCHAR value:"a" _other1: type:VARCHAR value:"b" } true false`,
+	})
+
+	// No rows to move.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err = upd.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RowsAffected != 0 {
+		t.Errorf("RowsAffected: %d, want 0", result.RowsAffected)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {c3: type:INT64 value:"3" } false false`,
+	})
+
+	// Failure case: unknown column.
+	upd.ChangedColumnValues["c4"] = sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err = upd.Execute(vc, bv, false)
+	expectError(t, "Execute", err, "execUpdateEqual: unknown column 'c4' in table 't1'")
+}
+
 func TestUpdateNoStream(t *testing.T) {
 	upd := &Update{}
 	err := upd.StreamExecute(nil, nil, false, nil)
@@ -307,6 +524,21 @@ func buildTestVSchema() *vindexes.VSchema {
 						},
 						Owner: "t1",
 					},
+					"region": {
+						Type: "region_experimental",
+						Params: map[string]string{
+							"region_bytes": "1",
+						},
+					},
+					"regionlkp": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp3",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t2",
+					},
 				},
 				Tables: map[string]*vschemapb.Table{
 					"t1": {
@@ -321,6 +553,15 @@ func buildTestVSchema() *vindexes.VSchema {
 							Columns: []string{"c3"},
 						}},
 					},
+					"t2": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "region",
+							Columns: []string{"region", "id"},
+						}, {
+							Name:    "regionlkp",
+							Columns: []string{"c1"},
+						}},
+					},
 				},
 			},
 		},
@@ -0,0 +1,575 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that computes window functions over the
+// rows of its input. The input must return the rows sorted by the
+// PartitionBy columns, and then by the OrderBy columns. Like in
+// OrderedAggregate, this is done by asking the scatter route to
+// order the results. The rows of a partition are buffered until
+// the next partition starts. Only the default frame is supported:
+// the frame of a row ends with its last peer, or with the end of
+// the partition if there's no OrderBy.
+type Window struct {
+	// Functions specifies the parameters for each window function.
+	Functions []WindowParams
+
+	// PartitionBy specifies the input columns that split
+	// the rows into partitions.
+	PartitionBy []int `json:",omitempty"`
+
+	// OrderBy specifies the input columns that order the rows of
+	// a partition. Rows that have the same values are peers.
+	OrderBy []int `json:",omitempty"`
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowParams specify the parameters of a window function. The
+// value of the function replaces the column Col of every row. For
+// the functions that have an argument, Col is also the column that
+// has its values.
+type WindowParams struct {
+	Opcode WindowOpcode
+	Col    int
+	// Offset is the N of NTILE(N) and NTH_VALUE(expr, N), or the
+	// offset of LAG and LEAD, which is 1 if not set.
+	Offset sqltypes.PlanValue
+	// Default is the value of LAG and LEAD if the offset is
+	// outside of the partition. It's NULL if not set.
+	Default sqltypes.PlanValue
+	Alias   string
+}
+
+// MarshalJSON serializes the WindowParams into a JSON representation.
+// It's used for testing and diagnostics.
+func (wp WindowParams) MarshalJSON() ([]byte, error) {
+	marshalParams := struct {
+		Opcode  WindowOpcode
+		Col     int
+		Offset  *sqltypes.PlanValue `json:",omitempty"`
+		Default *sqltypes.PlanValue `json:",omitempty"`
+		Alias   string
+	}{
+		Opcode: wp.Opcode,
+		Col:    wp.Col,
+		Alias:  wp.Alias,
+	}
+	if !wp.Offset.IsNull() {
+		marshalParams.Offset = &wp.Offset
+	}
+	if !wp.Default.IsNull() {
+		marshalParams.Default = &wp.Default
+	}
+	return json.Marshal(marshalParams)
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the list of supported window
+// functions to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"min":          WindowMin,
+	"max":          WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// HasArgument returns true if the window function is computed from
+// the values of an expression, which have to be supplied by the input.
+func (code WindowOpcode) HasArgument() bool {
+	switch code {
+	case WindowRowNumber, WindowRank, WindowDenseRank, WindowPercentRank, WindowCumeDist, WindowNtile:
+		return false
+	}
+	return true
+}
+
+// MarshalJSON serializes the Window into a JSON representation.
+// It's used for testing and diagnostics.
+func (w *Window) MarshalJSON() ([]byte, error) {
+	marshalWindow := struct {
+		Opcode              string
+		Functions           []WindowParams
+		PartitionBy         []int `json:",omitempty"`
+		OrderBy             []int `json:",omitempty"`
+		TruncateColumnCount int   `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Window",
+		Functions:           w.Functions,
+		PartitionBy:         w.PartitionBy,
+		OrderBy:             w.OrderBy,
+		TruncateColumnCount: w.TruncateColumnCount,
+		Input:               w.Input,
+	}
+	return json.Marshal(marshalWindow)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	wb, err := w.newBuffer(vcursor, bindVars, result.Fields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: w.convertFields(result.Fields),
+		Extras: result.Extras,
+	}
+	for _, row := range result.Rows {
+		rows, err := wb.add(row)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+	}
+	out.Rows = append(out.Rows, wb.flush()...)
+	out.RowsAffected = uint64(len(out.Rows))
+	return out.Truncate(w.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var wb *windowBuffer
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+	err := w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if wb == nil {
+			// The fields are always sent with the first result.
+			var err error
+			if wb, err = w.newBuffer(vcursor, bindVars, qr.Fields); err != nil {
+				return err
+			}
+		}
+		if len(qr.Fields) != 0 {
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		var rows [][]sqltypes.Value
+		for _, row := range qr.Rows {
+			flushed, err := wb.add(row)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, flushed...)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return cb(&sqltypes.Result{Rows: rows})
+	})
+	if err != nil {
+		return err
+	}
+	if wb == nil {
+		return nil
+	}
+	if rows := wb.flush(); len(rows) != 0 {
+		return cb(&sqltypes.Result{Rows: rows})
+	}
+	return nil
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// convertFields returns a copy of the fields where the columns
+// computed by the window functions are renamed and retyped.
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	newFields := append([]*querypb.Field(nil), fields...)
+	for _, wp := range w.Functions {
+		newFields[wp.Col] = &querypb.Field{
+			Name: wp.Alias,
+			Type: wp.resultType(fields[wp.Col].Type),
+		}
+	}
+	return newFields
+}
+
+// resultType returns the type of the values of the window
+// function, given the type of its argument.
+func (wp WindowParams) resultType(typ querypb.Type) querypb.Type {
+	switch wp.Opcode {
+	case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount:
+		return sqltypes.Int64
+	case WindowPercentRank, WindowCumeDist:
+		return sqltypes.Float64
+	case WindowSum:
+		if sqltypes.IsFloat(typ) {
+			return sqltypes.Float64
+		}
+		return sqltypes.Decimal
+	}
+	return typ
+}
+
+// windowBuffer accumulates the rows of the current partition.
+type windowBuffer struct {
+	w       *Window
+	fields  []*querypb.Field
+	maxRows int
+	// offsets and defaults are the resolved
+	// Offset and Default of each function.
+	offsets  []int64
+	defaults []sqltypes.Value
+	rows     [][]sqltypes.Value
+}
+
+func (w *Window) newBuffer(vcursor VCursor, bindVars map[string]*querypb.BindVariable, fields []*querypb.Field) (*windowBuffer, error) {
+	wb := &windowBuffer{
+		w:        w,
+		fields:   fields,
+		maxRows:  vcursor.MaxMemoryRows(),
+		offsets:  make([]int64, len(w.Functions)),
+		defaults: make([]sqltypes.Value, len(w.Functions)),
+	}
+	for i, wp := range w.Functions {
+		switch wp.Opcode {
+		case WindowLag, WindowLead:
+			wb.offsets[i] = 1
+			if !wp.Default.IsNull() {
+				v, err := wp.Default.ResolveValue(bindVars)
+				if err != nil {
+					return nil, err
+				}
+				wb.defaults[i] = v
+			}
+		case WindowNtile, WindowNthValue:
+		default:
+			continue
+		}
+		if wp.Offset.IsNull() {
+			continue
+		}
+		v, err := wp.Offset.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		offset, err := sqltypes.ToInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		if offset < 0 || (offset == 0 && wp.Opcode != WindowLag && wp.Opcode != WindowLead) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect arguments to %s", wp.Opcode)
+		}
+		wb.offsets[i] = offset
+	}
+	return wb, nil
+}
+
+// add adds the row to the buffer. If the row starts a new
+// partition, the rows of the previous one are returned with
+// the values of the window functions.
+func (wb *windowBuffer) add(row []sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	if len(wb.rows) != 0 {
+		equal, err := wb.equal(wb.w.PartitionBy, wb.rows[0], row)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			if out, err = wb.compute(); err != nil {
+				return nil, err
+			}
+			wb.rows = nil
+		}
+	}
+	wb.rows = append(wb.rows, row)
+	if len(wb.rows) > wb.maxRows {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", wb.maxRows)
+	}
+	return out, nil
+}
+
+// flush returns the rows of the last partition.
+func (wb *windowBuffer) flush() [][]sqltypes.Value {
+	// compute can only fail while the peers are compared, which
+	// has already succeeded for the rows of the buffer when they
+	// were added. So, the error can be ignored.
+	out, _ := wb.compute()
+	wb.rows = nil
+	return out
+}
+
+func (wb *windowBuffer) equal(cols []int, row1, row2 []sqltypes.Value) (bool, error) {
+	for _, col := range cols {
+		cmp, err := sqltypes.NullsafeCompare(row1[col], row2[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// compute returns the rows of the partition with the values
+// of the window functions.
+func (wb *windowBuffer) compute() ([][]sqltypes.Value, error) {
+	rows := wb.rows
+	n := len(rows)
+	if n == 0 {
+		return nil, nil
+	}
+
+	// lastPeer has the index of the last peer of each row, which
+	// is where its frame ends. group is the number of its peer group.
+	lastPeer := make([]int, n)
+	group := make([]int, n)
+	start := 0
+	for i := 1; i <= n; i++ {
+		if i < n {
+			equal, err := wb.equal(wb.w.OrderBy, rows[i-1], rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				group[i] = group[i-1]
+				continue
+			}
+			group[i] = group[i-1] + 1
+		}
+		for j := start; j < i; j++ {
+			lastPeer[j] = i - 1
+		}
+		start = i
+	}
+
+	out := make([][]sqltypes.Value, n)
+	for i, row := range rows {
+		out[i] = sqltypes.CopyRow(row)
+	}
+	for f, wp := range wb.w.Functions {
+		col := wp.Col
+		switch wp.Opcode {
+		case WindowRowNumber, WindowRank, WindowDenseRank, WindowPercentRank, WindowCumeDist:
+			firstPeer := 0
+			for i := range rows {
+				if i != 0 && group[i] != group[i-1] {
+					firstPeer = i
+				}
+				out[i][col] = rankValue(wp.Opcode, i, firstPeer, lastPeer[i], group[i], n)
+			}
+		case WindowNtile:
+			buckets := int(wb.offsets[f])
+			size, remainder := n/buckets, n%buckets
+			for i := range rows {
+				var bucket int
+				if i < remainder*(size+1) {
+					bucket = i / (size + 1)
+				} else {
+					bucket = remainder + (i-remainder*(size+1))/size
+				}
+				out[i][col] = sqltypes.NewInt64(int64(bucket + 1))
+			}
+		case WindowLag, WindowLead:
+			offset := int(wb.offsets[f])
+			if wp.Opcode == WindowLag {
+				offset = -offset
+			}
+			for i := range rows {
+				if j := i + offset; j >= 0 && j < n {
+					out[i][col] = rows[j][col]
+				} else {
+					out[i][col] = wb.defaults[f]
+				}
+			}
+		case WindowFirstValue:
+			for i := range rows {
+				out[i][col] = rows[0][col]
+			}
+		case WindowLastValue:
+			for i := range rows {
+				out[i][col] = rows[lastPeer[i]][col]
+			}
+		case WindowNthValue:
+			nth := int(wb.offsets[f]) - 1
+			for i := range rows {
+				if nth <= lastPeer[i] {
+					out[i][col] = rows[nth][col]
+				} else {
+					out[i][col] = sqltypes.NULL
+				}
+			}
+		case WindowCount, WindowSum, WindowMin, WindowMax:
+			if err := wb.aggregate(wp, rows, out, lastPeer); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// rankValue returns the value of a ranking function for the row i
+// of a partition of n rows.
+func rankValue(opcode WindowOpcode, i, firstPeer, lastPeer, group, n int) sqltypes.Value {
+	switch opcode {
+	case WindowRowNumber:
+		return sqltypes.NewInt64(int64(i + 1))
+	case WindowRank:
+		return sqltypes.NewInt64(int64(firstPeer + 1))
+	case WindowDenseRank:
+		return sqltypes.NewInt64(int64(group + 1))
+	case WindowPercentRank:
+		if n == 1 {
+			return sqltypes.NewFloat64(0)
+		}
+		return sqltypes.NewFloat64(float64(firstPeer) / float64(n-1))
+	}
+	// WindowCumeDist
+	return sqltypes.NewFloat64(float64(lastPeer+1) / float64(n))
+}
+
+// aggregate computes an aggregate window function. The frame of a
+// row grows with each peer group, so the value is accumulated.
+func (wb *windowBuffer) aggregate(wp WindowParams, rows, out [][]sqltypes.Value, lastPeer []int) error {
+	col := wp.Col
+	typ := wp.resultType(wb.fields[col].Type)
+	acc := sqltypes.NULL
+	if wp.Opcode == WindowCount {
+		acc = sqltypes.NewInt64(0)
+	}
+	for i := 0; i < len(rows); {
+		for j := i; j <= lastPeer[i]; j++ {
+			v := rows[j][col]
+			if v.IsNull() {
+				continue
+			}
+			var err error
+			switch wp.Opcode {
+			case WindowCount:
+				acc = sqltypes.NullsafeAdd(acc, countOne, sqltypes.Int64)
+			case WindowSum:
+				acc = sqltypes.NullsafeAdd(acc, v, typ)
+			case WindowMin:
+				acc, err = sqltypes.Min(acc, v)
+			case WindowMax:
+				acc, err = sqltypes.Max(acc, v)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for j := i; j <= lastPeer[i]; j++ {
+			out[j][col] = acc
+		}
+		i = lastPeer[i] + 1
+	}
+	return nil
+}
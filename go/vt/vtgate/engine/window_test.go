@@ -0,0 +1,328 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newWindowInput() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"part|ord|rn|rk|drk|s|lg|fv",
+					"varbinary|int64|int64|int64|int64|int64|int64|int64",
+				),
+				"a|1|1|1|1|10|10|10",
+				"a|2|1|1|1|20|20|20",
+				"a|2|1|1|1|30|30|30",
+				"a|3|1|1|1|null|null|null",
+				"b|1|1|1|1|5|5|5",
+			),
+		},
+	}
+}
+
+func newWindowPartitioned(input Primitive) *Window {
+	return &Window{
+		Functions: []WindowParams{{
+			Opcode: WindowRowNumber,
+			Col:    2,
+			Alias:  "row_number()",
+		}, {
+			Opcode: WindowRank,
+			Col:    3,
+			Alias:  "rank()",
+		}, {
+			Opcode: WindowDenseRank,
+			Col:    4,
+			Alias:  "dense_rank()",
+		}, {
+			Opcode: WindowSum,
+			Col:    5,
+			Alias:  "sum(val)",
+		}, {
+			Opcode: WindowLag,
+			Col:    6,
+			Alias:  "lag(val)",
+		}, {
+			Opcode: WindowFirstValue,
+			Col:    7,
+			Alias:  "first_value(val)",
+		}},
+		PartitionBy: []int{0},
+		OrderBy:     []int{1},
+		Input:       input,
+	}
+}
+
+func TestWindowExecute(t *testing.T) {
+	w := newWindowPartitioned(newWindowInput())
+	result, err := w.Execute(noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"part|ord|row_number()|rank()|dense_rank()|sum(val)|lag(val)|first_value(val)",
+			"varbinary|int64|int64|int64|int64|decimal|int64|int64",
+		),
+		"a|1|1|1|1|10|null|10",
+		"a|2|2|2|2|60|10|10",
+		"a|2|3|2|2|60|20|10",
+		"a|3|4|4|3|60|30|10",
+		"b|1|1|1|1|5|null|5",
+	)
+	expectResult(t, "w.Execute", result, wantResult)
+}
+
+func TestWindowExecuteTruncate(t *testing.T) {
+	w := newWindowPartitioned(newWindowInput())
+	w.TruncateColumnCount = 3
+	result, err := w.Execute(noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"part|ord|row_number()",
+			"varbinary|int64|int64",
+		),
+		"a|1|1",
+		"a|2|2",
+		"a|2|3",
+		"a|3|4",
+		"b|1|1",
+	)
+	expectResult(t, "w.Execute", result, wantResult)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	// The input sends two rows at a time. So, a partition
+	// spans multiple results.
+	input := newWindowInput()
+	w := newWindowPartitioned(input)
+	w.TruncateColumnCount = 3
+	result, err := wrapStreamExecute(w, noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"part|ord|row_number()",
+			"varbinary|int64|int64",
+		),
+		"a|1|1",
+		"a|2|2",
+		"a|2|3",
+		"a|3|4",
+		"b|1|1",
+	)
+	expectResult(t, "w.StreamExecute", result, wantResult)
+}
+
+func TestWindowGetFields(t *testing.T) {
+	w := newWindowPartitioned(newWindowInput())
+	result, err := w.GetFields(nil, nil)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"part|ord|row_number()|rank()|dense_rank()|sum(val)|lag(val)|first_value(val)",
+			"varbinary|int64|int64|int64|int64|decimal|int64|int64",
+		),
+	)
+	expectResult(t, "w.GetFields", result, wantResult)
+}
+
+func TestWindowFunctions(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"ord|nt|pr|cd|ld|nv|lv|c|mn|mx",
+					"int64|int64|int64|int64|int64|int64|int64|int64|int64|int64",
+				),
+				"1|1|1|1|3|3|3|3|3|3",
+				"2|1|1|1|1|1|1|1|1|1",
+				"2|1|1|1|4|4|4|4|4|4",
+				"3|1|1|1|null|null|null|null|null|null",
+				"4|1|1|1|2|2|2|2|2|2",
+			),
+		},
+	}
+	w := &Window{
+		Functions: []WindowParams{{
+			Opcode: WindowNtile,
+			Col:    1,
+			Offset: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)},
+			Alias:  "nt",
+		}, {
+			Opcode: WindowPercentRank,
+			Col:    2,
+			Alias:  "pr",
+		}, {
+			Opcode: WindowCumeDist,
+			Col:    3,
+			Alias:  "cd",
+		}, {
+			Opcode:  WindowLead,
+			Col:     4,
+			Offset:  sqltypes.PlanValue{Key: "offset"},
+			Default: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)},
+			Alias:   "ld",
+		}, {
+			Opcode: WindowNthValue,
+			Col:    5,
+			Offset: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)},
+			Alias:  "nv",
+		}, {
+			Opcode: WindowLastValue,
+			Col:    6,
+			Alias:  "lv",
+		}, {
+			Opcode: WindowCount,
+			Col:    7,
+			Alias:  "c",
+		}, {
+			Opcode: WindowMin,
+			Col:    8,
+			Alias:  "mn",
+		}, {
+			Opcode: WindowMax,
+			Col:    9,
+			Alias:  "mx",
+		}},
+		OrderBy: []int{0},
+		Input:   input,
+	}
+	bv := map[string]*querypb.BindVariable{
+		"offset": sqltypes.Int64BindVariable(2),
+	}
+	result, err := w.Execute(noopVCursor{}, bv, false)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"ord|nt|pr|cd|ld|nv|lv|c|mn|mx",
+			"int64|int64|float64|float64|int64|int64|int64|int64|int64|int64",
+		),
+		"1|1|0|0.2|4|null|3|1|3|3",
+		"2|1|0.25|0.6|null|1|4|3|1|4",
+		"2|1|0.25|0.6|2|1|4|3|1|4",
+		"3|2|0.75|0.8|0|1|null|3|1|4",
+		"4|2|1|1|0|1|2|4|1|4",
+	)
+	expectResult(t, "w.Execute", result, wantResult)
+}
+
+func TestWindowEmptyInput(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"part|ord|rn",
+					"varbinary|int64|int64",
+				),
+			),
+		},
+	}
+	w := &Window{
+		Functions: []WindowParams{{
+			Opcode: WindowRowNumber,
+			Col:    2,
+			Alias:  "row_number()",
+		}},
+		PartitionBy: []int{0},
+		OrderBy:     []int{1},
+		Input:       input,
+	}
+	result, err := w.Execute(noopVCursor{}, nil, false)
+	assert.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"part|ord|row_number()",
+			"varbinary|int64|int64",
+		),
+	)
+	expectResult(t, "w.Execute", result, wantResult)
+}
+
+func TestWindowErrors(t *testing.T) {
+	w := newWindowPartitioned(&fakePrimitive{sendErr: errors.New("input err")})
+	_, err := w.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "input err")
+	_, err = wrapStreamExecute(w, noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "input err")
+	_, err = w.GetFields(noopVCursor{}, nil)
+	assert.EqualError(t, err, "input err")
+
+	w = &Window{
+		Functions: []WindowParams{{
+			Opcode: WindowNtile,
+			Col:    2,
+			Offset: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)},
+			Alias:  "ntile(0)",
+		}},
+		Input: newWindowInput(),
+	}
+	_, err = w.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "incorrect arguments to ntile")
+}
+
+func TestWindowMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	// The partition of 'a' has more than 2 rows.
+	w := newWindowPartitioned(newWindowInput())
+	_, err := w.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestWindowMarshalJSON(t *testing.T) {
+	w := &Window{
+		Functions: []WindowParams{{
+			Opcode: WindowRowNumber,
+			Col:    1,
+			Alias:  "row_number()",
+		}, {
+			Opcode:  WindowLag,
+			Col:     2,
+			Offset:  sqltypes.PlanValue{Value: sqltypes.NewInt64(2)},
+			Default: sqltypes.PlanValue{Key: "vtg1"},
+			Alias:   "lag(a, 2, :vtg1)",
+		}},
+		PartitionBy: []int{0},
+		OrderBy:     []int{3},
+		Input:       &fakePrimitive{},
+	}
+	out, err := json.Marshal(w)
+	assert.NoError(t, err)
+	want := `{"Opcode":"Window",` +
+		`"Functions":[{"Opcode":"row_number","Col":1,"Alias":"row_number()"},` +
+		`{"Opcode":"lag","Col":2,"Offset":2,"Default":":vtg1","Alias":"lag(a, 2, :vtg1)"}],` +
+		`"PartitionBy":[0],"OrderBy":[3],"Input":{}}`
+	assert.Equal(t, want, string(out))
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Expr = (*Collate)(nil)
+
+// Collation defines how text values are compared. Only the properties
+// that matter for equality and ordering are modeled: case sensitivity,
+// and whether trailing spaces are significant. Accent-insensitive
+// collations are treated like their case-insensitive counterpart.
+type Collation struct {
+	ID   uint32
+	Name string
+	// CaseInsensitive is set for the _ci collations.
+	CaseInsensitive bool
+	// PadSpace is set if trailing spaces are ignored by comparisons.
+	// It's true for all collations except binary and the _0900 ones.
+	PadSpace bool
+	// Exact is set if Compare and WeightString give the same results
+	// as MySQL. It's only the case for the collations that compare the
+	// bytes of the strings. The others are approximated by upper-casing
+	// the strings, which doesn't account for accents, expansions or
+	// the MySQL case folding tables.
+	Exact bool
+}
+
+// These are the collations known to vtgate. Unknown collations
+// are treated as binary.
+var (
+	CollationBinary        = &Collation{ID: 63, Name: "binary", Exact: true}
+	CollationUtf8GeneralCI = &Collation{ID: 33, Name: "utf8_general_ci", CaseInsensitive: true, PadSpace: true}
+
+	collationsByID = map[uint32]*Collation{}
+	// collationsByName also contains the character sets,
+	// mapped to their default collation.
+	collationsByName = map[string]*Collation{}
+)
+
+func init() {
+	for _, coll := range []*Collation{
+		CollationBinary,
+		CollationUtf8GeneralCI,
+		{ID: 8, Name: "latin1_swedish_ci", CaseInsensitive: true, PadSpace: true},
+		{ID: 11, Name: "ascii_general_ci", CaseInsensitive: true, PadSpace: true},
+		{ID: 45, Name: "utf8mb4_general_ci", CaseInsensitive: true, PadSpace: true},
+		{ID: 46, Name: "utf8mb4_bin", PadSpace: true, Exact: true},
+		{ID: 47, Name: "latin1_bin", PadSpace: true, Exact: true},
+		{ID: 65, Name: "ascii_bin", PadSpace: true, Exact: true},
+		{ID: 83, Name: "utf8_bin", PadSpace: true, Exact: true},
+		{ID: 192, Name: "utf8_unicode_ci", CaseInsensitive: true, PadSpace: true},
+		{ID: 224, Name: "utf8mb4_unicode_ci", CaseInsensitive: true, PadSpace: true},
+		{ID: 255, Name: "utf8mb4_0900_ai_ci", CaseInsensitive: true},
+		{ID: 309, Name: "utf8mb4_0900_bin", Exact: true},
+	} {
+		collationsByID[coll.ID] = coll
+		collationsByName[coll.Name] = coll
+	}
+	collationsByName["latin1"] = collationsByID[8]
+	collationsByName["ascii"] = collationsByID[11]
+	collationsByName["utf8"] = collationsByID[33]
+	collationsByName["utf8mb4"] = collationsByID[45]
+}
+
+// CollationByID returns the collation for the MySQL collation id,
+// as found in the Charset of a field. Unknown ids return the binary
+// collation.
+func CollationByID(id uint32) *Collation {
+	if coll, ok := collationsByID[id]; ok {
+		return coll
+	}
+	return CollationBinary
+}
+
+// ExactCollationByID returns the collation for the MySQL collation
+// id if it's known and modeled exactly. Values of other collations
+// can only be compared by MySQL, for example through their
+// weight_string.
+func ExactCollationByID(id uint32) (*Collation, bool) {
+	coll, ok := collationsByID[id]
+	if !ok || !coll.Exact {
+		return nil, false
+	}
+	return coll, true
+}
+
+// CollationByName returns the collation for a collation
+// or a character set name.
+func CollationByName(name string) (*Collation, bool) {
+	coll, ok := collationsByName[strings.ToLower(name)]
+	return coll, ok
+}
+
+// Compare compares two strings according to the collation.
+func (coll *Collation) Compare(s1, s2 []byte) int {
+	if coll.PadSpace {
+		s1 = bytes.TrimRight(s1, " ")
+		s2 = bytes.TrimRight(s2, " ")
+	}
+	if !coll.CaseInsensitive {
+		return bytes.Compare(s1, s2)
+	}
+	for len(s1) > 0 && len(s2) > 0 {
+		r1, size1 := utf8.DecodeRune(s1)
+		r2, size2 := utf8.DecodeRune(s2)
+		if r1 != r2 {
+			f1, f2 := unicode.ToUpper(r1), unicode.ToUpper(r2)
+			if f1 != f2 {
+				if f1 < f2 {
+					return -1
+				}
+				return 1
+			}
+		}
+		s1, s2 = s1[size1:], s2[size2:]
+	}
+	switch {
+	case len(s1) > 0:
+		return 1
+	case len(s2) > 0:
+		return -1
+	}
+	return 0
+}
+
+// WeightString returns a key for s. The keys of two strings
+// are equal if Compare returns 0 for them. It can be used to
+// hash strings according to the collation.
+func (coll *Collation) WeightString(s []byte) []byte {
+	if coll.PadSpace {
+		s = bytes.TrimRight(s, " ")
+	}
+	if !coll.CaseInsensitive {
+		return s
+	}
+	key := make([]byte, 0, len(s))
+	for len(s) > 0 {
+		r, size := utf8.DecodeRune(s)
+		key = append(key, string(unicode.ToUpper(r))...)
+		s = s[size:]
+	}
+	return key
+}
+
+func (coll *Collation) String() string {
+	return coll.Name
+}
+
+// collationOf returns the collation of the result of an expression:
+// the explicit collation of a COLLATE clause, or the collation
+// of a column. It returns nil if the collation is not known.
+func collationOf(expr Expr, env ExpressionEnv) *Collation {
+	switch expr := expr.(type) {
+	case *Collate:
+		return expr.Collation
+	case *Column:
+		// A Charset of 0 means that the collation was not set.
+		if expr.Offset < len(env.Fields) && sqltypes.IsText(env.Fields[expr.Offset].Type) && env.Fields[expr.Offset].Charset != 0 {
+			return CollationByID(env.Fields[expr.Offset].Charset)
+		}
+	}
+	return nil
+}
+
+// comparisonCollation returns the collation used to compare the results
+// of two expressions, following a simplified version of the MySQL
+// coercibility rules: an explicit COLLATE wins over a column collation,
+// and the left side wins if both have the same coercibility. If no
+// collation is known, text values use the default utf8_general_ci,
+// and everything else is compared as binary.
+func comparisonCollation(left, right Expr, lval, rval sqltypes.Value, env ExpressionEnv) *Collation {
+	if coll, ok := left.(*Collate); ok {
+		return coll.Collation
+	}
+	if coll, ok := right.(*Collate); ok {
+		return coll.Collation
+	}
+	if coll := collationOf(left, env); coll != nil {
+		return coll
+	}
+	if coll := collationOf(right, env); coll != nil {
+		return coll
+	}
+	if lval.IsText() || rval.IsText() {
+		return CollationUtf8GeneralCI
+	}
+	return CollationBinary
+}
+
+// Collate sets the collation of an expression, like the
+// COLLATE clause of MySQL. The value is not modified.
+type Collate struct {
+	Expr      Expr
+	Collation *Collation
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Collate) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return c.Expr.Evaluate(env)
+}
+
+// Type satisfies the Expr interface.
+func (c *Collate) Type(env ExpressionEnv) querypb.Type {
+	return c.Expr.Type(env)
+}
+
+func (c *Collate) String() string {
+	return parenthesize(c.Expr) + " collate " + c.Collation.Name
+}
+
+// MarshalJSON serializes the Collate as a JSON string.
+// It's used for testing and diagnostics.
+func (c *Collate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestCollationCompare(t *testing.T) {
+	bin, _ := CollationByName("utf8mb4_bin")
+	ai, _ := CollationByName("utf8mb4_0900_ai_ci")
+	tcases := []struct {
+		coll   *Collation
+		s1, s2 string
+		out    int
+	}{
+		{coll: CollationBinary, s1: "abc", s2: "abc", out: 0},
+		{coll: CollationBinary, s1: "abc", s2: "ABC", out: 1},
+		{coll: CollationBinary, s1: "abc", s2: "abc ", out: -1},
+		{coll: CollationUtf8GeneralCI, s1: "abc", s2: "ABC", out: 0},
+		{coll: CollationUtf8GeneralCI, s1: "abc", s2: "ABC  ", out: 0},
+		{coll: CollationUtf8GeneralCI, s1: "abc", s2: "ABD", out: -1},
+		{coll: CollationUtf8GeneralCI, s1: "abcd", s2: "ABC", out: 1},
+		{coll: CollationUtf8GeneralCI, s1: "ñ", s2: "Ñ", out: 0},
+		{coll: bin, s1: "abc", s2: "ABC", out: 1},
+		{coll: bin, s1: "abc", s2: "abc ", out: 0},
+		{coll: ai, s1: "abc", s2: "ABC", out: 0},
+		{coll: ai, s1: "abc", s2: "ABC ", out: -1},
+	}
+	for _, tcase := range tcases {
+		got := tcase.coll.Compare([]byte(tcase.s1), []byte(tcase.s2))
+		assert.Equal(t, tcase.out, got, "%s: %q vs %q", tcase.coll, tcase.s1, tcase.s2)
+		// The weight strings must be equal iff the strings compare equal.
+		w1, w2 := tcase.coll.WeightString([]byte(tcase.s1)), tcase.coll.WeightString([]byte(tcase.s2))
+		assert.Equal(t, tcase.out == 0, string(w1) == string(w2), "%s: weight strings of %q vs %q", tcase.coll, tcase.s1, tcase.s2)
+	}
+}
+
+func TestCollationLookup(t *testing.T) {
+	assert.Equal(t, CollationUtf8GeneralCI, CollationByID(33))
+	assert.Equal(t, CollationBinary, CollationByID(1000))
+
+	coll, ok := CollationByName("UTF8MB4")
+	assert.True(t, ok)
+	assert.Equal(t, "utf8mb4_general_ci", coll.Name)
+
+	_, ok = CollationByName("unknown_ci")
+	assert.False(t, ok)
+
+	coll, ok = ExactCollationByID(46)
+	assert.True(t, ok)
+	assert.Equal(t, "utf8mb4_bin", coll.Name)
+	_, ok = ExactCollationByID(33)
+	assert.False(t, ok)
+	_, ok = ExactCollationByID(1000)
+	assert.False(t, ok)
+}
+
+func TestComparisonCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields("a|b|c", "varchar|varchar|varbinary")
+	// b uses utf8mb4_bin.
+	fields[1].Charset = 46
+	fields[0].Charset = 33
+	env := ExpressionEnv{
+		Fields: fields,
+		Row: []sqltypes.Value{
+			sqltypes.NewVarChar("abc"),
+			sqltypes.NewVarChar("abc"),
+			sqltypes.NewVarBinary("abc"),
+		},
+	}
+	upper := NewLiteral(sqltypes.NewVarBinary("ABC"))
+	bin, _ := CollationByName("utf8_bin")
+	tcases := []struct {
+		left, right Expr
+		out         sqltypes.Value
+	}{
+		// The collation of the column is used.
+		{left: NewColumn(0), right: upper, out: boolTrue},
+		{left: NewColumn(1), right: upper, out: boolFalse},
+		// An explicit collation wins.
+		{left: NewColumn(0), right: &Collate{Expr: upper, Collation: bin}, out: boolFalse},
+		// Binary values are compared as binary.
+		{left: NewColumn(2), right: upper, out: boolFalse},
+	}
+	for _, tcase := range tcases {
+		expr := &Comparison{Op: CompareEqual, Left: tcase.left, Right: tcase.right}
+		got, err := expr.Evaluate(env)
+		assert.NoError(t, err)
+		assert.Equal(t, tcase.out, got, expr.String())
+	}
+
+	// Without fields, text values use the default collation.
+	env.Fields = nil
+	got, err := (&Comparison{Op: CompareEqual, Left: NewColumn(1), Right: upper}).Evaluate(env)
+	assert.NoError(t, err)
+	assert.Equal(t, boolTrue, got)
+}
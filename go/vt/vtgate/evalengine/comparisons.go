@@ -0,0 +1,506 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	_ Expr = (*Comparison)(nil)
+	_ Expr = (*Logical)(nil)
+	_ Expr = (*Not)(nil)
+	_ Expr = (*IsNull)(nil)
+	_ Expr = (*IsBool)(nil)
+	_ Expr = (*In)(nil)
+	_ Expr = (*Like)(nil)
+)
+
+var (
+	boolTrue  = sqltypes.NewInt64(1)
+	boolFalse = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return boolTrue
+	}
+	return boolFalse
+}
+
+// IsTrue returns true if the value is true in a boolean context,
+// like a WHERE clause: it must not be NULL, and its numeric value
+// must not be 0.
+func IsTrue(v sqltypes.Value) bool {
+	if v.IsNull() {
+		return false
+	}
+	f, err := sqltypes.ToFloat64(v)
+	return err == nil && f != 0
+}
+
+// ComparisonOp is the operator of a Comparison expression.
+type ComparisonOp int
+
+// These are the supported comparison operators.
+const (
+	CompareEqual = ComparisonOp(iota)
+	CompareNotEqual
+	CompareLessThan
+	CompareLessEqual
+	CompareGreaterThan
+	CompareGreaterEqual
+	CompareNullSafeEqual
+)
+
+var comparisonOpStrings = map[ComparisonOp]string{
+	CompareEqual:         "=",
+	CompareNotEqual:      "!=",
+	CompareLessThan:      "<",
+	CompareLessEqual:     "<=",
+	CompareGreaterThan:   ">",
+	CompareGreaterEqual:  ">=",
+	CompareNullSafeEqual: "<=>",
+}
+
+func (op ComparisonOp) String() string {
+	return comparisonOpStrings[op]
+}
+
+// Comparison compares two values. The result is 1 if the comparison
+// is true, and 0 if it's false. If any of the operands is NULL, the
+// result is NULL, except for the null-safe equal operator. Text values
+// are compared using their collation.
+type Comparison struct {
+	Op          ComparisonOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Comparison) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := c.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rval, err := c.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Op == CompareNullSafeEqual {
+		if lval.IsNull() || rval.IsNull() {
+			return boolValue(lval.IsNull() && rval.IsNull()), nil
+		}
+	} else if lval.IsNull() || rval.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := compareValues(lval, rval, comparisonCollation(c.Left, c.Right, lval, rval, env))
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.Op {
+	case CompareEqual, CompareNullSafeEqual:
+		return boolValue(cmp == 0), nil
+	case CompareNotEqual:
+		return boolValue(cmp != 0), nil
+	case CompareLessThan:
+		return boolValue(cmp < 0), nil
+	case CompareLessEqual:
+		return boolValue(cmp <= 0), nil
+	case CompareGreaterThan:
+		return boolValue(cmp > 0), nil
+	case CompareGreaterEqual:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected comparison operator: %d", c.Op)
+}
+
+// Type satisfies the Expr interface.
+func (c *Comparison) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (c *Comparison) String() string {
+	return fmt.Sprintf("%s %s %s", parenthesize(c.Left), c.Op, parenthesize(c.Right))
+}
+
+// MarshalJSON serializes the Comparison as a JSON string.
+// It's used for testing and diagnostics.
+func (c *Comparison) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// compareValues compares two non-NULL values. If any of them is
+// a number, they're compared as numbers. Otherwise, they're compared
+// using the collation.
+func compareValues(v1, v2 sqltypes.Value, coll *Collation) (int, error) {
+	if isNumber(v1.Type()) || isNumber(v2.Type()) {
+		return sqltypes.NullsafeCompare(v1, v2)
+	}
+	return coll.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
+
+func isNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// LogicalOp is the operator of a Logical expression.
+type LogicalOp int
+
+// These are the supported logical operators.
+const (
+	LogicalAnd = LogicalOp(iota)
+	LogicalOr
+)
+
+func (op LogicalOp) String() string {
+	if op == LogicalAnd {
+		return "and"
+	}
+	return "or"
+}
+
+// Logical is an AND or OR of two expressions. It follows the three
+// valued logic of SQL: the result is NULL if it cannot be decided
+// because of NULL operands.
+type Logical struct {
+	Op          LogicalOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *Logical) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := l.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	// The right side is not evaluated if the left side decides the result.
+	switch {
+	case l.Op == LogicalAnd && !lval.IsNull() && !IsTrue(lval):
+		return boolFalse, nil
+	case l.Op == LogicalOr && IsTrue(lval):
+		return boolTrue, nil
+	}
+	rval, err := l.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case l.Op == LogicalAnd && !rval.IsNull() && !IsTrue(rval):
+		return boolFalse, nil
+	case l.Op == LogicalOr && IsTrue(rval):
+		return boolTrue, nil
+	case lval.IsNull() || rval.IsNull():
+		return sqltypes.NULL, nil
+	}
+	// For AND, both sides are true. For OR, both sides are false.
+	return boolValue(l.Op == LogicalAnd), nil
+}
+
+// Type satisfies the Expr interface.
+func (l *Logical) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (l *Logical) String() string {
+	return fmt.Sprintf("%s %s %s", parenthesize(l.Left), l.Op, parenthesize(l.Right))
+}
+
+// MarshalJSON serializes the Logical as a JSON string.
+// It's used for testing and diagnostics.
+func (l *Logical) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// Not negates an expression. The result is NULL if the expression is NULL.
+type Not struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (n *Not) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := n.Expr.Evaluate(env)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!IsTrue(val)), nil
+}
+
+// Type satisfies the Expr interface.
+func (n *Not) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (n *Not) String() string {
+	return "not " + parenthesize(n.Expr)
+}
+
+// MarshalJSON serializes the Not as a JSON string.
+// It's used for testing and diagnostics.
+func (n *Not) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
+
+// IsNull checks if an expression is NULL, or
+// not NULL if Negate is set. The result is never NULL.
+type IsNull struct {
+	Expr   Expr
+	Negate bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (i *IsNull) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := i.Expr.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue(val.IsNull() != i.Negate), nil
+}
+
+// Type satisfies the Expr interface.
+func (i *IsNull) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (i *IsNull) String() string {
+	if i.Negate {
+		return parenthesize(i.Expr) + " is not null"
+	}
+	return parenthesize(i.Expr) + " is null"
+}
+
+// MarshalJSON serializes the IsNull as a JSON string.
+// It's used for testing and diagnostics.
+func (i *IsNull) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// IsBool checks if an expression is true, or false if Want is
+// false. Negate inverts the result. The result is never NULL:
+// NULL is neither true nor false.
+type IsBool struct {
+	Expr   Expr
+	Want   bool
+	Negate bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (i *IsBool) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := i.Expr.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	is := !val.IsNull() && IsTrue(val) == i.Want
+	return boolValue(is != i.Negate), nil
+}
+
+// Type satisfies the Expr interface.
+func (i *IsBool) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (i *IsBool) String() string {
+	op := "is "
+	if i.Negate {
+		op += "not "
+	}
+	if i.Want {
+		return parenthesize(i.Expr) + " " + op + "true"
+	}
+	return parenthesize(i.Expr) + " " + op + "false"
+}
+
+// MarshalJSON serializes the IsBool as a JSON string.
+// It's used for testing and diagnostics.
+func (i *IsBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// In checks if an expression is equal to any value of a list,
+// or none of them if Negate is set. If the expression is NULL, the
+// result is NULL. If there's no match and the list contains a NULL,
+// the result is also NULL.
+type In struct {
+	Expr   Expr
+	List   []Expr
+	Negate bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (i *In) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := i.Expr.Evaluate(env)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	hasNull := false
+	for _, item := range i.List {
+		ival, err := item.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if ival.IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := compareValues(val, ival, comparisonCollation(i.Expr, item, val, ival, env))
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(!i.Negate), nil
+		}
+	}
+	if hasNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(i.Negate), nil
+}
+
+// Type satisfies the Expr interface.
+func (i *In) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (i *In) String() string {
+	items := make([]string, 0, len(i.List))
+	for _, item := range i.List {
+		items = append(items, item.String())
+	}
+	op := "in"
+	if i.Negate {
+		op = "not in"
+	}
+	return fmt.Sprintf("%s %s (%s)", parenthesize(i.Expr), op, strings.Join(items, ", "))
+}
+
+// MarshalJSON serializes the In as a JSON string.
+// It's used for testing and diagnostics.
+func (i *In) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// DefaultLikeEscape is the escape character of LIKE patterns
+// if none is specified.
+const DefaultLikeEscape = '\\'
+
+// Like matches an expression against a LIKE pattern, where '%' matches
+// any sequence of characters and '_' matches a single character. The
+// Escape character makes the next character of the pattern match
+// literally. If the collation is case insensitive, so is the match.
+// Unlike comparisons, trailing spaces are always significant.
+type Like struct {
+	Expr    Expr
+	Pattern Expr
+	Escape  rune
+	Negate  bool
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *Like) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := l.Expr.Evaluate(env)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	pattern, err := l.Pattern.Evaluate(env)
+	if err != nil || pattern.IsNull() {
+		return sqltypes.NULL, err
+	}
+	coll := comparisonCollation(l.Expr, l.Pattern, val, pattern, env)
+	match := matchLike([]rune(val.ToString()), []rune(pattern.ToString()), l.Escape, coll.CaseInsensitive)
+	return boolValue(match != l.Negate), nil
+}
+
+// Type satisfies the Expr interface.
+func (l *Like) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (l *Like) String() string {
+	op := "like"
+	if l.Negate {
+		op = "not like"
+	}
+	str := fmt.Sprintf("%s %s %s", parenthesize(l.Expr), op, parenthesize(l.Pattern))
+	if l.Escape != DefaultLikeEscape {
+		str += fmt.Sprintf(" escape '%c'", l.Escape)
+	}
+	return str
+}
+
+// MarshalJSON serializes the Like as a JSON string.
+// It's used for testing and diagnostics.
+func (l *Like) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// matchLike matches s against a LIKE pattern. When a match fails after
+// a '%', it backtracks to let the '%' consume one more character.
+func matchLike(s, pattern []rune, escape rune, foldCase bool) bool {
+	si, pi := 0, 0
+	// backSi and backPi are the positions to resume from after
+	// a failed match. backSi is 0 until a '%' was seen.
+	backSi, backPi := 0, 0
+	for si < len(s) || pi < len(pattern) {
+		if pi < len(pattern) {
+			c := pattern[pi]
+			switch {
+			case c == '%':
+				backSi, backPi = si+1, pi
+				pi++
+				continue
+			case c == '_':
+				if si < len(s) {
+					si++
+					pi++
+					continue
+				}
+			default:
+				next := pi + 1
+				if c == escape && next < len(pattern) {
+					c = pattern[next]
+					next++
+				}
+				if si < len(s) && runesEqual(s[si], c, foldCase) {
+					si++
+					pi = next
+					continue
+				}
+			}
+		}
+		if backSi > 0 && backSi <= len(s) {
+			si, pi = backSi, backPi
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func runesEqual(r1, r2 rune, foldCase bool) bool {
+	if r1 == r2 {
+		return true
+	}
+	return foldCase && unicode.ToUpper(r1) == unicode.ToUpper(r2)
+}
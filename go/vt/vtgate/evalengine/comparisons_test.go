@@ -0,0 +1,254 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestComparisonEvaluate(t *testing.T) {
+	env := ExpressionEnv{
+		Fields: sqltypes.MakeTestFields("a|b|c|d", "int64|decimal|varchar|varchar"),
+		Row: []sqltypes.Value{
+			sqltypes.NewInt64(2),
+			sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.0")),
+			sqltypes.NewVarChar("abc"),
+			sqltypes.NULL,
+		},
+	}
+	lit := func(v int64) Expr { return NewLiteral(sqltypes.NewInt64(v)) }
+	tcases := []struct {
+		expr Expr
+		out  sqltypes.Value
+		str  string
+	}{{
+		expr: &Comparison{Op: CompareEqual, Left: NewColumn(0), Right: NewColumn(1)},
+		out:  boolTrue,
+		str:  "[COLUMN 0] = [COLUMN 1]",
+	}, {
+		expr: &Comparison{Op: CompareNotEqual, Left: NewColumn(0), Right: lit(2)},
+		out:  boolFalse,
+		str:  "[COLUMN 0] != 2",
+	}, {
+		expr: &Comparison{Op: CompareLessThan, Left: NewColumn(0), Right: lit(3)},
+		out:  boolTrue,
+		str:  "[COLUMN 0] < 3",
+	}, {
+		expr: &Comparison{Op: CompareLessEqual, Left: NewColumn(1), Right: lit(1)},
+		out:  boolFalse,
+		str:  "[COLUMN 1] <= 1",
+	}, {
+		expr: &Comparison{Op: CompareGreaterThan, Left: NewColumn(2), Right: NewLiteral(sqltypes.NewVarBinary("abb"))},
+		out:  boolTrue,
+		str:  "[COLUMN 2] > 'abb'",
+	}, {
+		expr: &Comparison{Op: CompareGreaterEqual, Left: NewColumn(2), Right: NewLiteral(sqltypes.NewVarBinary("abd"))},
+		out:  boolFalse,
+		str:  "[COLUMN 2] >= 'abd'",
+	}, {
+		expr: &Comparison{Op: CompareEqual, Left: NewColumn(3), Right: NewColumn(3)},
+		out:  sqltypes.NULL,
+		str:  "[COLUMN 3] = [COLUMN 3]",
+	}, {
+		expr: &Comparison{Op: CompareNullSafeEqual, Left: NewColumn(3), Right: NewLiteral(sqltypes.NULL)},
+		out:  boolTrue,
+		str:  "[COLUMN 3] <=> null",
+	}, {
+		expr: &Comparison{Op: CompareNullSafeEqual, Left: NewColumn(3), Right: NewColumn(0)},
+		out:  boolFalse,
+		str:  "[COLUMN 3] <=> [COLUMN 0]",
+	}, {
+		expr: &IsNull{Expr: NewColumn(3)},
+		out:  boolTrue,
+		str:  "[COLUMN 3] is null",
+	}, {
+		expr: &IsNull{Expr: NewColumn(3), Negate: true},
+		out:  boolFalse,
+		str:  "[COLUMN 3] is not null",
+	}, {
+		expr: &IsNull{Expr: &Arithmetic{Op: ArithmeticAdd, Left: NewColumn(0), Right: NewColumn(3)}},
+		out:  boolTrue,
+		str:  "([COLUMN 0] + [COLUMN 3]) is null",
+	}, {
+		expr: &IsBool{Expr: NewColumn(0), Want: true},
+		out:  boolTrue,
+		str:  "[COLUMN 0] is true",
+	}, {
+		expr: &IsBool{Expr: NewColumn(3), Want: true, Negate: true},
+		out:  boolTrue,
+		str:  "[COLUMN 3] is not true",
+	}, {
+		expr: &IsBool{Expr: NewColumn(3), Want: false},
+		out:  boolFalse,
+		str:  "[COLUMN 3] is false",
+	}, {
+		expr: &In{Expr: NewColumn(0), List: []Expr{lit(1), NewColumn(1)}},
+		out:  boolTrue,
+		str:  "[COLUMN 0] in (1, [COLUMN 1])",
+	}, {
+		expr: &In{Expr: NewColumn(0), List: []Expr{lit(1), NewColumn(3)}},
+		out:  sqltypes.NULL,
+		str:  "[COLUMN 0] in (1, [COLUMN 3])",
+	}, {
+		expr: &In{Expr: NewColumn(0), List: []Expr{lit(1), lit(3)}, Negate: true},
+		out:  boolTrue,
+		str:  "[COLUMN 0] not in (1, 3)",
+	}, {
+		expr: &In{Expr: NewColumn(3), List: []Expr{lit(1)}},
+		out:  sqltypes.NULL,
+		str:  "[COLUMN 3] in (1)",
+	}, {
+		expr: &In{Expr: NewColumn(2), List: []Expr{NewLiteral(sqltypes.NewVarBinary("ABC"))}},
+		out:  boolTrue,
+		str:  "[COLUMN 2] in ('ABC')",
+	}, {
+		expr: &Like{Expr: NewColumn(2), Pattern: NewLiteral(sqltypes.NewVarBinary("A%")), Escape: DefaultLikeEscape},
+		out:  boolTrue,
+		str:  "[COLUMN 2] like 'A%'",
+	}, {
+		expr: &Like{Expr: NewColumn(2), Pattern: NewLiteral(sqltypes.NewVarBinary("a_c")), Escape: '|', Negate: true},
+		out:  boolFalse,
+		str:  "[COLUMN 2] not like 'a_c' escape '|'",
+	}, {
+		expr: &Like{Expr: NewColumn(3), Pattern: NewLiteral(sqltypes.NewVarBinary("%")), Escape: DefaultLikeEscape},
+		out:  sqltypes.NULL,
+		str:  "[COLUMN 3] like '%'",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.str, func(t *testing.T) {
+			got, err := tcase.expr.Evaluate(env)
+			assert.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+			assert.Equal(t, sqltypes.Int64, tcase.expr.Type(env))
+			assert.Equal(t, tcase.str, tcase.expr.String())
+		})
+	}
+}
+
+func TestLogicalEvaluate(t *testing.T) {
+	values := map[string]Expr{
+		"true":  NewLiteral(sqltypes.NewInt64(1)),
+		"false": NewLiteral(sqltypes.NewInt64(0)),
+		"null":  NewLiteral(sqltypes.NULL),
+	}
+	results := map[string]sqltypes.Value{
+		"true":  boolTrue,
+		"false": boolFalse,
+		"null":  sqltypes.NULL,
+	}
+	tcases := []struct {
+		left, right   string
+		and, or, notl string
+	}{
+		{left: "true", right: "true", and: "true", or: "true", notl: "false"},
+		{left: "true", right: "false", and: "false", or: "true", notl: "false"},
+		{left: "true", right: "null", and: "null", or: "true", notl: "false"},
+		{left: "false", right: "true", and: "false", or: "true", notl: "true"},
+		{left: "false", right: "false", and: "false", or: "false", notl: "true"},
+		{left: "false", right: "null", and: "false", or: "null", notl: "true"},
+		{left: "null", right: "true", and: "null", or: "true", notl: "null"},
+		{left: "null", right: "false", and: "false", or: "null", notl: "null"},
+		{left: "null", right: "null", and: "null", or: "null", notl: "null"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.left+" "+tcase.right, func(t *testing.T) {
+			left, right := values[tcase.left], values[tcase.right]
+
+			got, err := (&Logical{Op: LogicalAnd, Left: left, Right: right}).Evaluate(ExpressionEnv{})
+			assert.NoError(t, err)
+			assert.Equal(t, results[tcase.and], got, "and")
+
+			got, err = (&Logical{Op: LogicalOr, Left: left, Right: right}).Evaluate(ExpressionEnv{})
+			assert.NoError(t, err)
+			assert.Equal(t, results[tcase.or], got, "or")
+
+			got, err = (&Not{Expr: left}).Evaluate(ExpressionEnv{})
+			assert.NoError(t, err)
+			assert.Equal(t, results[tcase.notl], got, "not")
+		})
+	}
+}
+
+func TestLogicalShortCircuit(t *testing.T) {
+	// The right side would fail because the bind var is missing.
+	missing := NewBindVariable("missing")
+	got, err := (&Logical{Op: LogicalAnd, Left: NewLiteral(sqltypes.NewInt64(0)), Right: missing}).Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, boolFalse, got)
+
+	got, err = (&Logical{Op: LogicalOr, Left: NewLiteral(sqltypes.NewInt64(2)), Right: missing}).Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, boolTrue, got)
+
+	_, err = (&Logical{Op: LogicalOr, Left: NewLiteral(sqltypes.NewInt64(0)), Right: missing}).Evaluate(ExpressionEnv{})
+	assert.EqualError(t, err, "missing bind var missing")
+}
+
+func TestIsTrue(t *testing.T) {
+	tcases := []struct {
+		in  sqltypes.Value
+		out bool
+	}{
+		{in: sqltypes.NULL, out: false},
+		{in: sqltypes.NewInt64(0), out: false},
+		{in: sqltypes.NewInt64(-1), out: true},
+		{in: sqltypes.NewFloat64(0.5), out: true},
+		{in: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.00")), out: false},
+		{in: sqltypes.NewVarChar("1"), out: true},
+		{in: sqltypes.NewVarChar("abc"), out: false},
+	}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.out, IsTrue(tcase.in), tcase.in.String())
+	}
+}
+
+func TestMatchLike(t *testing.T) {
+	tcases := []struct {
+		s, pattern string
+		foldCase   bool
+		out        bool
+	}{
+		{s: "abc", pattern: "abc", out: true},
+		{s: "abc", pattern: "ABC", out: false},
+		{s: "abc", pattern: "ABC", foldCase: true, out: true},
+		{s: "abc", pattern: "a%", out: true},
+		{s: "abc", pattern: "%c", out: true},
+		{s: "abc", pattern: "%b%", out: true},
+		{s: "abc", pattern: "%d%", out: false},
+		{s: "abc", pattern: "a_c", out: true},
+		{s: "abc", pattern: "a_", out: false},
+		{s: "abc", pattern: "___", out: true},
+		{s: "", pattern: "%", out: true},
+		{s: "", pattern: "_", out: false},
+		{s: "aXbXc", pattern: "%X%c", out: true},
+		{s: "abcabd", pattern: "%abd", out: true},
+		{s: "a%c", pattern: `a\%c`, out: true},
+		{s: "abc", pattern: `a\%c`, out: false},
+		{s: "a_", pattern: `%\_`, out: true},
+		{s: "abc ", pattern: "abc", out: false},
+		{s: "ñandú", pattern: "_and_", out: true},
+		{s: "ÑANDÚ", pattern: "ñandú", foldCase: true, out: true},
+	}
+	for _, tcase := range tcases {
+		got := matchLike([]rune(tcase.s), []rune(tcase.pattern), DefaultLikeEscape, tcase.foldCase)
+		assert.Equal(t, tcase.out, got, "%q like %q", tcase.s, tcase.pattern)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ConvertFunc is called by Convert for every node before it's converted.
+// It's used by the caller to translate the nodes evalengine cannot know
+// about, like column references or aggregate functions. If it returns
+// a nil Expr and no error, the node is converted by Convert itself.
+type ConvertFunc func(node sqlparser.Expr) (Expr, error)
+
+var arithmeticOps = map[string]ArithmeticOp{
+	sqlparser.PlusStr:  ArithmeticAdd,
+	sqlparser.MinusStr: ArithmeticSubtract,
+	sqlparser.MultStr:  ArithmeticMultiply,
+	sqlparser.DivStr:   ArithmeticDivide,
+}
+
+var comparisonOps = map[string]ComparisonOp{
+	sqlparser.EqualStr:         CompareEqual,
+	sqlparser.NotEqualStr:      CompareNotEqual,
+	sqlparser.LessThanStr:      CompareLessThan,
+	sqlparser.LessEqualStr:     CompareLessEqual,
+	sqlparser.GreaterThanStr:   CompareGreaterThan,
+	sqlparser.GreaterEqualStr:  CompareGreaterEqual,
+	sqlparser.NullSafeEqualStr: CompareNullSafeEqual,
+}
+
+// Convert converts the AST expression into an Expr that can be
+// evaluated by vtgate. It returns an error if the expression
+// contains constructs that cannot be evaluated.
+func Convert(node sqlparser.Expr, f ConvertFunc) (Expr, error) {
+	if f != nil {
+		expr, err := f(node)
+		if err != nil {
+			return nil, err
+		}
+		if expr != nil {
+			return expr, nil
+		}
+	}
+
+	switch node := node.(type) {
+	case *sqlparser.SQLVal:
+		return convertSQLVal(node)
+	case *sqlparser.NullVal:
+		return NewLiteral(sqltypes.NULL), nil
+	case *sqlparser.ParenExpr:
+		return Convert(node.Expr, f)
+	case *sqlparser.BinaryExpr:
+		op, ok := arithmeticOps[node.Operator]
+		if !ok {
+			break
+		}
+		left, right, err := convertOperands(node.Left, node.Right, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Arithmetic{Op: op, Left: left, Right: right}, nil
+	case *sqlparser.UnaryExpr:
+		switch node.Operator {
+		case sqlparser.UPlusStr:
+			return Convert(node.Expr, f)
+		case sqlparser.UMinusStr:
+			expr, err := Convert(node.Expr, f)
+			if err != nil {
+				return nil, err
+			}
+			return &UnaryMinus{Expr: expr}, nil
+		}
+	case *sqlparser.ComparisonExpr:
+		switch node.Operator {
+		case sqlparser.InStr, sqlparser.NotInStr:
+			return convertIn(node, f)
+		case sqlparser.LikeStr, sqlparser.NotLikeStr:
+			return convertLike(node, f)
+		}
+		op, ok := comparisonOps[node.Operator]
+		if !ok {
+			break
+		}
+		left, right, err := convertOperands(node.Left, node.Right, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Comparison{Op: op, Left: left, Right: right}, nil
+	case *sqlparser.AndExpr:
+		left, right, err := convertOperands(node.Left, node.Right, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Logical{Op: LogicalAnd, Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertOperands(node.Left, node.Right, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Logical{Op: LogicalOr, Left: left, Right: right}, nil
+	case *sqlparser.NotExpr:
+		expr, err := Convert(node.Expr, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	case *sqlparser.IsExpr:
+		expr, err := Convert(node.Expr, f)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Operator {
+		case sqlparser.IsNullStr, sqlparser.IsNotNullStr:
+			return &IsNull{Expr: expr, Negate: node.Operator == sqlparser.IsNotNullStr}, nil
+		case sqlparser.IsTrueStr, sqlparser.IsNotTrueStr:
+			return &IsBool{Expr: expr, Want: true, Negate: node.Operator == sqlparser.IsNotTrueStr}, nil
+		case sqlparser.IsFalseStr, sqlparser.IsNotFalseStr:
+			return &IsBool{Expr: expr, Want: false, Negate: node.Operator == sqlparser.IsNotFalseStr}, nil
+		}
+	case *sqlparser.CaseExpr:
+		return convertCase(node, f)
+	case *sqlparser.FuncExpr:
+		return convertFunc(node, f)
+	case *sqlparser.CollateExpr:
+		coll, ok := CollationByName(node.Charset)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unknown collation: %s", node.Charset)
+		}
+		expr, err := Convert(node.Expr, f)
+		if err != nil {
+			return nil, err
+		}
+		return &Collate{Expr: expr, Collation: coll}, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+}
+
+func convertOperands(lnode, rnode sqlparser.Expr, f ConvertFunc) (left, right Expr, err error) {
+	left, err = Convert(lnode, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err = Convert(rnode, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func convertIn(node *sqlparser.ComparisonExpr, f ConvertFunc) (Expr, error) {
+	tuple, ok := node.Right.(sqlparser.ValTuple)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+	}
+	expr, err := Convert(node.Left, f)
+	if err != nil {
+		return nil, err
+	}
+	in := &In{Expr: expr, Negate: node.Operator == sqlparser.NotInStr}
+	for _, item := range tuple {
+		expr, err := Convert(item, f)
+		if err != nil {
+			return nil, err
+		}
+		in.List = append(in.List, expr)
+	}
+	return in, nil
+}
+
+func convertLike(node *sqlparser.ComparisonExpr, f ConvertFunc) (Expr, error) {
+	expr, pattern, err := convertOperands(node.Left, node.Right, f)
+	if err != nil {
+		return nil, err
+	}
+	like := &Like{Expr: expr, Pattern: pattern, Escape: DefaultLikeEscape, Negate: node.Operator == sqlparser.NotLikeStr}
+	if node.Escape != nil {
+		// Only a literal of a single character can be used as escape.
+		escape, ok := node.Escape.(*sqlparser.SQLVal)
+		if !ok || escape.Type != sqlparser.StrVal || len([]rune(string(escape.Val))) != 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+		}
+		like.Escape = []rune(string(escape.Val))[0]
+	}
+	return like, nil
+}
+
+func convertCase(node *sqlparser.CaseExpr, f ConvertFunc) (Expr, error) {
+	c := &Case{}
+	var err error
+	if node.Expr != nil {
+		if c.Expr, err = Convert(node.Expr, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, val, err := convertOperands(when.Cond, when.Val, f)
+		if err != nil {
+			return nil, err
+		}
+		c.Whens = append(c.Whens, When{Cond: cond, Val: val})
+	}
+	if node.Else != nil {
+		if c.Else, err = Convert(node.Else, f); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func convertFunc(node *sqlparser.FuncExpr, f ConvertFunc) (Expr, error) {
+	if node.Distinct || !node.Qualifier.IsEmpty() || !IsBuiltin(node.Name.Lowered()) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+	}
+	var args []Expr
+	for _, selectExpr := range node.Exprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+		}
+		arg, err := Convert(aliased.Expr, f)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return NewFunction(node.Name.Lowered(), args)
+}
+
+func convertSQLVal(node *sqlparser.SQLVal) (Expr, error) {
+	switch node.Type {
+	case sqlparser.ValArg:
+		return NewBindVariable(string(node.Val[1:])), nil
+	case sqlparser.IntVal:
+		val, err := sqltypes.NewIntegral(string(node.Val))
+		if err != nil {
+			return nil, err
+		}
+		return NewLiteral(val), nil
+	case sqlparser.FloatVal:
+		return NewLiteral(sqltypes.MakeTrusted(sqltypes.Float64, node.Val)), nil
+	case sqlparser.StrVal:
+		return NewLiteral(sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val)), nil
+	case sqlparser.HexVal:
+		val, err := node.HexDecode()
+		if err != nil {
+			return nil, err
+		}
+		return NewLiteral(sqltypes.MakeTrusted(sqltypes.VarBinary, val)), nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression cannot be evaluated in vtgate: %s", sqlparser.String(node))
+}
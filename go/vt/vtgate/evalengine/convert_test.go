@@ -0,0 +1,161 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestConvert(t *testing.T) {
+	// The convert func maps every column to its position in cols.
+	cols := []string{"a", "b"}
+	f := func(node sqlparser.Expr) (Expr, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return nil, nil
+		}
+		for i, name := range cols {
+			if col.Name.EqualString(name) {
+				return NewColumn(i), nil
+			}
+		}
+		return nil, nil
+	}
+
+	tcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "1",
+		out: "1",
+	}, {
+		in:  "1.5",
+		out: "1.5",
+	}, {
+		in:  "'abc'",
+		out: "'abc'",
+	}, {
+		in:  "null",
+		out: "null",
+	}, {
+		in:  ":x",
+		out: ":x",
+	}, {
+		in:  "a + 1",
+		out: "[COLUMN 0] + 1",
+	}, {
+		in:  "(a - b) * 2",
+		out: "([COLUMN 0] - [COLUMN 1]) * 2",
+	}, {
+		in:  "a / (b + 1)",
+		out: "[COLUMN 0] / ([COLUMN 1] + 1)",
+	}, {
+		in:  "a = b and (a > 1 or b <= 2)",
+		out: "([COLUMN 0] = [COLUMN 1]) and (([COLUMN 0] > 1) or ([COLUMN 1] <= 2))",
+	}, {
+		in:  "not a <=> null",
+		out: "not ([COLUMN 0] <=> null)",
+	}, {
+		in:  "a + b is not null",
+		out: "([COLUMN 0] + [COLUMN 1]) is not null",
+	}, {
+		in:  "a != b",
+		out: "[COLUMN 0] != [COLUMN 1]",
+	}, {
+		in:  "a like 'x%'",
+		out: "[COLUMN 0] like 'x%'",
+	}, {
+		in:  "a not like 'x|%' escape '|'",
+		out: "[COLUMN 0] not like 'x|%' escape '|'",
+	}, {
+		in:  "a like 'x%' escape b",
+		out: "unsupported: expression cannot be evaluated in vtgate: a like 'x%' escape b",
+	}, {
+		in:  "a is true",
+		out: "[COLUMN 0] is true",
+	}, {
+		in:  "a is not false",
+		out: "[COLUMN 0] is not false",
+	}, {
+		in:  "a in (1, b, null)",
+		out: "[COLUMN 0] in (1, [COLUMN 1], null)",
+	}, {
+		in:  "a not in (1, 2)",
+		out: "[COLUMN 0] not in (1, 2)",
+	}, {
+		in:  "-a + +b",
+		out: "(-[COLUMN 0]) + [COLUMN 1]",
+	}, {
+		in:  "case a when 1 then 'x' when 2 then 'y' else b end",
+		out: "case [COLUMN 0] when 1 then 'x' when 2 then 'y' else [COLUMN 1] end",
+	}, {
+		in:  "case when a > 1 then b end",
+		out: "case when ([COLUMN 0] > 1) then [COLUMN 1] end",
+	}, {
+		in:  "if(a, b, 0)",
+		out: "if([COLUMN 0], [COLUMN 1], 0)",
+	}, {
+		in:  "COALESCE(a, b, 'x')",
+		out: "coalesce([COLUMN 0], [COLUMN 1], 'x')",
+	}, {
+		in:  "concat(upper(a), lower(b))",
+		out: "concat(upper([COLUMN 0]), lower([COLUMN 1]))",
+	}, {
+		in:  "a collate utf8mb4_bin = b",
+		out: "([COLUMN 0] collate utf8mb4_bin) = [COLUMN 1]",
+	}, {
+		in:  "a collate unknown_ci",
+		out: "unsupported: unknown collation: unknown_ci",
+	}, {
+		in:  "ifnull(a)",
+		out: "incorrect parameter count in the call to native function 'ifnull'",
+	}, {
+		in:  "my_func(a)",
+		out: "unsupported: expression cannot be evaluated in vtgate: my_func(a)",
+	}, {
+		in:  "concat(distinct a)",
+		out: "unsupported: expression cannot be evaluated in vtgate: concat(distinct a)",
+	}, {
+		in:  "a in (select 1 from dual)",
+		out: "unsupported: expression cannot be evaluated in vtgate: a in (select 1 from dual)",
+	}, {
+		in:  "c + 1",
+		out: "unsupported: expression cannot be evaluated in vtgate: c",
+	}, {
+		in:  "a % 2",
+		out: "unsupported: expression cannot be evaluated in vtgate: a % 2",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			node := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Convert(node, f)
+			if err != nil {
+				assert.EqualError(t, err, tcase.out)
+				return
+			}
+			assert.Equal(t, tcase.out, expr.String())
+		})
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalengine evaluates SQL expressions inside vtgate. It's used
+// by the engine primitives that need to compute values from the rows
+// returned by the shards, like the final step of a scatter aggregation.
+package evalengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ExpressionEnv contains the environment an expression is evaluated in.
+// Row is the current row, Fields describe its columns, and BindVars are
+// the bind variables of the query.
+type ExpressionEnv struct {
+	BindVars map[string]*querypb.BindVariable
+	Fields   []*querypb.Field
+	Row      []sqltypes.Value
+}
+
+// Expr is an expression that can be evaluated by vtgate.
+type Expr interface {
+	// Evaluate computes the value of the expression for the environment.
+	Evaluate(env ExpressionEnv) (sqltypes.Value, error)
+	// Type returns the type of the result of the expression. It only
+	// relies on env.Fields and env.BindVars.
+	Type(env ExpressionEnv) querypb.Type
+	// String returns a human readable representation of the expression.
+	String() string
+}
+
+var (
+	_ Expr = (*Literal)(nil)
+	_ Expr = (*Column)(nil)
+	_ Expr = (*BindVariable)(nil)
+	_ Expr = (*Arithmetic)(nil)
+	_ Expr = (*UnaryMinus)(nil)
+)
+
+// Literal is a constant value.
+type Literal struct {
+	Val sqltypes.Value
+}
+
+// NewLiteral creates a Literal for the value.
+func NewLiteral(val sqltypes.Value) *Literal {
+	return &Literal{Val: val}
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *Literal) Evaluate(ExpressionEnv) (sqltypes.Value, error) {
+	return l.Val, nil
+}
+
+// Type satisfies the Expr interface.
+func (l *Literal) Type(ExpressionEnv) querypb.Type {
+	return l.Val.Type()
+}
+
+func (l *Literal) String() string {
+	if l.Val.IsNull() {
+		return "null"
+	}
+	b := &strings.Builder{}
+	l.Val.EncodeSQL(b)
+	return b.String()
+}
+
+// MarshalJSON serializes the Literal as a JSON string.
+// It's used for testing and diagnostics.
+func (l *Literal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// Column references a column of the current row by its offset.
+type Column struct {
+	Offset int
+}
+
+// NewColumn creates a Column for the offset.
+func NewColumn(offset int) *Column {
+	return &Column{Offset: offset}
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Column) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	if c.Offset >= len(env.Row) {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "column offset %d out of range for a row of %d columns", c.Offset, len(env.Row))
+	}
+	return env.Row[c.Offset], nil
+}
+
+// Type satisfies the Expr interface.
+func (c *Column) Type(env ExpressionEnv) querypb.Type {
+	if c.Offset >= len(env.Fields) {
+		return sqltypes.Null
+	}
+	return env.Fields[c.Offset].Type
+}
+
+func (c *Column) String() string {
+	return fmt.Sprintf("[COLUMN %d]", c.Offset)
+}
+
+// MarshalJSON serializes the Column as a JSON string.
+// It's used for testing and diagnostics.
+func (c *Column) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// BindVariable references a bind variable of the query.
+type BindVariable struct {
+	Key string
+}
+
+// NewBindVariable creates a BindVariable for the key.
+func NewBindVariable(key string) *BindVariable {
+	return &BindVariable{Key: key}
+}
+
+// Evaluate satisfies the Expr interface.
+func (b *BindVariable) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "missing bind var %s", b.Key)
+	}
+	return sqltypes.BindVariableToValue(bv)
+}
+
+// Type satisfies the Expr interface.
+func (b *BindVariable) Type(env ExpressionEnv) querypb.Type {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.Null
+	}
+	return bv.Type
+}
+
+func (b *BindVariable) String() string {
+	return ":" + b.Key
+}
+
+// MarshalJSON serializes the BindVariable as a JSON string.
+// It's used for testing and diagnostics.
+func (b *BindVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// ArithmeticOp is the operator of an Arithmetic expression.
+type ArithmeticOp int
+
+// These are the supported arithmetic operators.
+const (
+	ArithmeticAdd = ArithmeticOp(iota)
+	ArithmeticSubtract
+	ArithmeticMultiply
+	ArithmeticDivide
+)
+
+var arithmeticOpStrings = map[ArithmeticOp]string{
+	ArithmeticAdd:      "+",
+	ArithmeticSubtract: "-",
+	ArithmeticMultiply: "*",
+	ArithmeticDivide:   "/",
+}
+
+func (op ArithmeticOp) String() string {
+	return arithmeticOpStrings[op]
+}
+
+// Arithmetic is a binary arithmetic operation. If any of the
+// operands is NULL, the result is NULL.
+type Arithmetic struct {
+	Op          ArithmeticOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (a *Arithmetic) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lval, err := a.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rval, err := a.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result sqltypes.Value
+	switch a.Op {
+	case ArithmeticAdd:
+		result, err = sqltypes.Add(lval, rval)
+	case ArithmeticSubtract:
+		result, err = sqltypes.Subtract(lval, rval)
+	case ArithmeticMultiply:
+		result, err = sqltypes.Multiply(lval, rval)
+	case ArithmeticDivide:
+		result, err = sqltypes.Divide(lval, rval)
+	default:
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected arithmetic operator: %d", a.Op)
+	}
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	// The sqltypes functions pick the result type based on the values.
+	// Cast it to the type of the operands to keep it consistent with Type.
+	return sqltypes.Cast(result, a.resultType(lval.Type(), rval.Type()))
+}
+
+// Type satisfies the Expr interface.
+func (a *Arithmetic) Type(env ExpressionEnv) querypb.Type {
+	return a.resultType(a.Left.Type(env), a.Right.Type(env))
+}
+
+// resultType follows the promotion rules of the sqltypes arithmetic
+// functions: divisions, floats, decimals and strings produce a float,
+// unsigned values produce an unsigned result, and everything else is
+// a signed integer. Strings produce a float because, like in UnaryMinus,
+// they may not hold integers.
+func (a *Arithmetic) resultType(ltype, rtype querypb.Type) querypb.Type {
+	switch {
+	case a.Op == ArithmeticDivide:
+		return sqltypes.Float64
+	case !isIntegralOrNull(ltype) || !isIntegralOrNull(rtype):
+		return sqltypes.Float64
+	case sqltypes.IsUnsigned(ltype) || sqltypes.IsUnsigned(rtype):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+func (a *Arithmetic) String() string {
+	return fmt.Sprintf("%s %s %s", parenthesize(a.Left), a.Op, parenthesize(a.Right))
+}
+
+// MarshalJSON serializes the Arithmetic as a JSON string.
+// It's used for testing and diagnostics.
+func (a *Arithmetic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnaryMinus negates a number. If the operand is NULL, the result is NULL.
+type UnaryMinus struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (u *UnaryMinus) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := u.Expr.Evaluate(env)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	result, err := sqltypes.Subtract(sqltypes.NewInt64(0), val)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.Cast(result, u.resultType(val.Type()))
+}
+
+// Type satisfies the Expr interface.
+func (u *UnaryMinus) Type(env ExpressionEnv) querypb.Type {
+	return u.resultType(u.Expr.Type(env))
+}
+
+// resultType returns a signed integer for integers, and a float for
+// everything else, because strings are converted to floats.
+func (u *UnaryMinus) resultType(typ querypb.Type) querypb.Type {
+	if isIntegralOrNull(typ) {
+		return sqltypes.Int64
+	}
+	return sqltypes.Float64
+}
+
+// isIntegralOrNull returns true if the arithmetic functions of sqltypes
+// don't convert values of typ to floats.
+func isIntegralOrNull(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || typ == sqltypes.Null
+}
+
+func (u *UnaryMinus) String() string {
+	return "-" + parenthesize(u.Expr)
+}
+
+// MarshalJSON serializes the UnaryMinus as a JSON string.
+// It's used for testing and diagnostics.
+func (u *UnaryMinus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// parenthesize returns the string representation of the expression,
+// with parenthesis if it's a compound expression.
+func parenthesize(expr Expr) string {
+	switch expr.(type) {
+	case *Arithmetic, *UnaryMinus, *Comparison, *Logical, *Not, *IsNull, *IsBool, *In, *Like, *Collate:
+		return "(" + expr.String() + ")"
+	}
+	return expr.String()
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestArithmeticEvaluate(t *testing.T) {
+	env := ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(3),
+		},
+		Fields: sqltypes.MakeTestFields("sum|count", "decimal|int64"),
+		Row:    []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10")), sqltypes.NewInt64(4)},
+	}
+	tcases := []struct {
+		expr    Expr
+		out     sqltypes.Value
+		outType querypb.Type
+		str     string
+	}{{
+		expr:    &Arithmetic{Op: ArithmeticAdd, Left: NewLiteral(sqltypes.NewInt64(1)), Right: NewColumn(1)},
+		out:     sqltypes.NewInt64(5),
+		outType: sqltypes.Int64,
+		str:     "1 + [COLUMN 1]",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticSubtract, Left: NewColumn(1), Right: NewBindVariable("a")},
+		out:     sqltypes.NewInt64(1),
+		outType: sqltypes.Int64,
+		str:     "[COLUMN 1] - :a",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticMultiply, Left: NewColumn(0), Right: NewColumn(1)},
+		out:     sqltypes.NewFloat64(40),
+		outType: sqltypes.Float64,
+		str:     "[COLUMN 0] * [COLUMN 1]",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticDivide, Left: NewColumn(0), Right: NewColumn(1)},
+		out:     sqltypes.NewFloat64(2.5),
+		outType: sqltypes.Float64,
+		str:     "[COLUMN 0] / [COLUMN 1]",
+	}, {
+		expr: &Arithmetic{
+			Op:    ArithmeticAdd,
+			Left:  &Arithmetic{Op: ArithmeticDivide, Left: NewColumn(0), Right: NewColumn(1)},
+			Right: NewLiteral(sqltypes.NewInt64(1)),
+		},
+		out:     sqltypes.NewFloat64(3.5),
+		outType: sqltypes.Float64,
+		str:     "([COLUMN 0] / [COLUMN 1]) + 1",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticAdd, Left: NewLiteral(sqltypes.NULL), Right: NewColumn(1)},
+		out:     sqltypes.NULL,
+		outType: sqltypes.Int64,
+		str:     "null + [COLUMN 1]",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticDivide, Left: NewColumn(1), Right: NewLiteral(sqltypes.NewInt64(0))},
+		out:     sqltypes.NULL,
+		outType: sqltypes.Float64,
+		str:     "[COLUMN 1] / 0",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticMultiply, Left: NewBindVariable("a"), Right: NewLiteral(sqltypes.NewVarBinary("1.5"))},
+		out:     sqltypes.NewFloat64(4.5),
+		outType: sqltypes.Float64,
+		str:     ":a * '1.5'",
+	}, {
+		expr:    &Arithmetic{Op: ArithmeticAdd, Left: NewLiteral(sqltypes.NewVarChar("2")), Right: NewColumn(1)},
+		out:     sqltypes.NewFloat64(6),
+		outType: sqltypes.Float64,
+		str:     "'2' + [COLUMN 1]",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.str, func(t *testing.T) {
+			got, err := tcase.expr.Evaluate(env)
+			assert.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+			assert.Equal(t, tcase.outType, tcase.expr.Type(env))
+			assert.Equal(t, tcase.str, tcase.expr.String())
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	env := ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewInt64(1)}}
+
+	_, err := NewColumn(1).Evaluate(env)
+	assert.EqualError(t, err, "column offset 1 out of range for a row of 1 columns")
+
+	_, err = NewBindVariable("a").Evaluate(env)
+	assert.EqualError(t, err, "missing bind var a")
+}
+
+func TestExprMarshalJSON(t *testing.T) {
+	expr := &Arithmetic{Op: ArithmeticDivide, Left: NewColumn(0), Right: NewLiteral(sqltypes.NewVarBinary("a"))}
+	out, err := json.Marshal(struct{ Expr Expr }{Expr: expr})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Expr":"[COLUMN 0] / 'a'"}`, string(out))
+}
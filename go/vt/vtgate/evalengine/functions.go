@@ -0,0 +1,504 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	_ Expr = (*Case)(nil)
+	_ Expr = (*Function)(nil)
+)
+
+// When is a condition of a Case expression, and the value
+// returned if it matches.
+type When struct {
+	Cond Expr
+	Val  Expr
+}
+
+// Case is a CASE expression. If Expr is set, the conditions are
+// values compared to it. Otherwise, they're boolean expressions.
+// The value of the first matching condition is returned. If none
+// matches, the result is Else, or NULL if there's no Else.
+type Case struct {
+	Expr  Expr
+	Whens []When
+	Else  Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Case) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	var val sqltypes.Value
+	if c.Expr != nil {
+		var err error
+		if val, err = c.Expr.Evaluate(env); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	for _, when := range c.Whens {
+		cond, err := when.Cond.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		match := false
+		switch {
+		case c.Expr == nil:
+			match = IsTrue(cond)
+		case !val.IsNull() && !cond.IsNull():
+			cmp, err := compareValues(val, cond, comparisonCollation(c.Expr, when.Cond, val, cond, env))
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			match = cmp == 0
+		}
+		if match {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return sqltypes.NULL, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+// Type satisfies the Expr interface.
+func (c *Case) Type(env ExpressionEnv) querypb.Type {
+	types := make([]querypb.Type, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		types = append(types, when.Val.Type(env))
+	}
+	if c.Else != nil {
+		types = append(types, c.Else.Type(env))
+	}
+	return mergeTypes(types...)
+}
+
+func (c *Case) String() string {
+	b := &strings.Builder{}
+	b.WriteString("case")
+	if c.Expr != nil {
+		b.WriteString(" " + parenthesize(c.Expr))
+	}
+	for _, when := range c.Whens {
+		fmt.Fprintf(b, " when %s then %s", parenthesize(when.Cond), parenthesize(when.Val))
+	}
+	if c.Else != nil {
+		b.WriteString(" else " + parenthesize(c.Else))
+	}
+	b.WriteString(" end")
+	return b.String()
+}
+
+// MarshalJSON serializes the Case as a JSON string.
+// It's used for testing and diagnostics.
+func (c *Case) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// mergeTypes returns the type of an expression that can return
+// values of any of the types: the common type if they're all the same,
+// a number type if they're all numbers, a text type if any of them
+// is text, and a binary type otherwise. NULLs are ignored.
+func mergeTypes(types ...querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null || typ == result:
+		case result == sqltypes.Null:
+			result = typ
+		case isNumber(result) && isNumber(typ):
+			switch {
+			case sqltypes.IsFloat(result) || sqltypes.IsFloat(typ):
+				result = sqltypes.Float64
+			case result == sqltypes.Decimal || typ == sqltypes.Decimal:
+				result = sqltypes.Decimal
+			case sqltypes.IsUnsigned(result) && sqltypes.IsUnsigned(typ):
+				result = sqltypes.Uint64
+			default:
+				result = sqltypes.Int64
+			}
+		case sqltypes.IsText(result) || sqltypes.IsText(typ):
+			result = sqltypes.VarChar
+		default:
+			result = sqltypes.VarBinary
+		}
+	}
+	return result
+}
+
+// builtin is a function that can be evaluated by vtgate.
+type builtin struct {
+	// minArgs and maxArgs are the allowed number of arguments.
+	// maxArgs is -1 if there is no maximum.
+	minArgs, maxArgs int
+	// call evaluates the function. The arguments are not
+	// evaluated beforehand, so that the control flow functions
+	// only evaluate the ones they need.
+	call func(env ExpressionEnv, args []Expr) (sqltypes.Value, error)
+	// typ returns the type of the result.
+	typ func(env ExpressionEnv, args []Expr) querypb.Type
+}
+
+var builtins = map[string]*builtin{
+	"if":               {minArgs: 3, maxArgs: 3, call: callIf, typ: typeOfArgs(1)},
+	"ifnull":           {minArgs: 2, maxArgs: 2, call: callCoalesce, typ: typeOfArgs(0)},
+	"coalesce":         {minArgs: 1, maxArgs: -1, call: callCoalesce, typ: typeOfArgs(0)},
+	"nullif":           {minArgs: 2, maxArgs: 2, call: callNullif, typ: typeOfArgs(0, 1)},
+	"concat":           {minArgs: 1, maxArgs: -1, call: callConcat, typ: typeOfString},
+	"concat_ws":        {minArgs: 2, maxArgs: -1, call: callConcatWs, typ: typeOfString},
+	"length":           {minArgs: 1, maxArgs: 1, call: stringFunc(lengthOf), typ: typeOfInt64},
+	"octet_length":     {minArgs: 1, maxArgs: 1, call: stringFunc(lengthOf), typ: typeOfInt64},
+	"char_length":      {minArgs: 1, maxArgs: 1, call: stringFunc(charLengthOf), typ: typeOfInt64},
+	"character_length": {minArgs: 1, maxArgs: 1, call: stringFunc(charLengthOf), typ: typeOfInt64},
+	"lower":            {minArgs: 1, maxArgs: 1, call: stringFunc(toLower), typ: typeOfString},
+	"lcase":            {minArgs: 1, maxArgs: 1, call: stringFunc(toLower), typ: typeOfString},
+	"upper":            {minArgs: 1, maxArgs: 1, call: stringFunc(toUpper), typ: typeOfString},
+	"ucase":            {minArgs: 1, maxArgs: 1, call: stringFunc(toUpper), typ: typeOfString},
+	"trim":             {minArgs: 1, maxArgs: 1, call: stringFunc(trimSpaces), typ: typeOfString},
+	"ltrim":            {minArgs: 1, maxArgs: 1, call: stringFunc(trimLeftSpaces), typ: typeOfString},
+	"rtrim":            {minArgs: 1, maxArgs: 1, call: stringFunc(trimRightSpaces), typ: typeOfString},
+	"reverse":          {minArgs: 1, maxArgs: 1, call: stringFunc(reverse), typ: typeOfString},
+	"left":             {minArgs: 2, maxArgs: 2, call: callLeft, typ: typeOfString},
+	"right":            {minArgs: 2, maxArgs: 2, call: callRight, typ: typeOfString},
+	"replace":          {minArgs: 3, maxArgs: 3, call: callReplace, typ: typeOfString},
+}
+
+// IsBuiltin returns true if vtgate can evaluate the function.
+func IsBuiltin(name string) bool {
+	_, ok := builtins[strings.ToLower(name)]
+	return ok
+}
+
+// Function is a call to a builtin function.
+type Function struct {
+	Name string
+	Args []Expr
+	fn   *builtin
+}
+
+// NewFunction creates a Function for a call to the builtin function
+// with the arguments. It returns an error if the function doesn't exist
+// or the number of arguments is wrong.
+func NewFunction(name string, args []Expr) (*Function, error) {
+	name = strings.ToLower(name)
+	fn, ok := builtins[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: function cannot be evaluated in vtgate: %s", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &Function{Name: name, Args: args, fn: fn}, nil
+}
+
+// Evaluate satisfies the Expr interface.
+func (f *Function) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return f.fn.call(env, f.Args)
+}
+
+// Type satisfies the Expr interface.
+func (f *Function) Type(env ExpressionEnv) querypb.Type {
+	return f.fn.typ(env, f.Args)
+}
+
+func (f *Function) String() string {
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, arg.String())
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(args, ", "))
+}
+
+// MarshalJSON serializes the Function as a JSON string.
+// It's used for testing and diagnostics.
+func (f *Function) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// typeOfArgs returns a type function that merges the types of the
+// arguments from the first index on. If more indexes are specified,
+// only those arguments are used.
+func typeOfArgs(indexes ...int) func(ExpressionEnv, []Expr) querypb.Type {
+	return func(env ExpressionEnv, args []Expr) querypb.Type {
+		var types []querypb.Type
+		if len(indexes) == 1 {
+			for _, arg := range args[indexes[0]:] {
+				types = append(types, arg.Type(env))
+			}
+		} else {
+			for _, i := range indexes {
+				types = append(types, args[i].Type(env))
+			}
+		}
+		return mergeTypes(types...)
+	}
+}
+
+// typeOfString is the type of the string functions: text if
+// any of the arguments is text, and binary otherwise.
+func typeOfString(env ExpressionEnv, args []Expr) querypb.Type {
+	for _, arg := range args {
+		if sqltypes.IsText(arg.Type(env)) {
+			return sqltypes.VarChar
+		}
+	}
+	return sqltypes.VarBinary
+}
+
+func typeOfInt64(ExpressionEnv, []Expr) querypb.Type {
+	return sqltypes.Int64
+}
+
+// evaluateArgs evaluates all the arguments. hasNull is
+// set if any of the values is NULL.
+func evaluateArgs(env ExpressionEnv, args []Expr) (vals []sqltypes.Value, hasNull bool, err error) {
+	vals = make([]sqltypes.Value, 0, len(args))
+	for _, arg := range args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return nil, false, err
+		}
+		hasNull = hasNull || val.IsNull()
+		vals = append(vals, val)
+	}
+	return vals, hasNull, nil
+}
+
+// stringResult makes the result of a string function.
+// Its type follows typeOfString.
+func stringResult(vals []sqltypes.Value, result []byte) sqltypes.Value {
+	for _, val := range vals {
+		if val.IsText() {
+			return sqltypes.MakeTrusted(sqltypes.VarChar, result)
+		}
+	}
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, result)
+}
+
+func callIf(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	cond, err := args[0].Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if IsTrue(cond) {
+		return args[1].Evaluate(env)
+	}
+	return args[2].Evaluate(env)
+}
+
+// callCoalesce returns the first argument that is not NULL.
+// IFNULL is the same with two arguments.
+func callCoalesce(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	for _, arg := range args {
+		val, err := arg.Evaluate(env)
+		if err != nil || !val.IsNull() {
+			return val, err
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+func callNullif(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	vals, _, err := evaluateArgs(env, args)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if vals[0].IsNull() || vals[1].IsNull() {
+		return vals[0], nil
+	}
+	cmp, err := compareValues(vals[0], vals[1], comparisonCollation(args[0], args[1], vals[0], vals[1], env))
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if cmp == 0 {
+		return sqltypes.NULL, nil
+	}
+	return vals[0], nil
+}
+
+func callConcat(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	vals, hasNull, err := evaluateArgs(env, args)
+	if err != nil || hasNull {
+		return sqltypes.NULL, err
+	}
+	var result []byte
+	for _, val := range vals {
+		result = append(result, val.ToBytes()...)
+	}
+	return stringResult(vals, result), nil
+}
+
+// callConcatWs concatenates the arguments with the separator of
+// the first argument. Unlike CONCAT, NULL arguments are skipped.
+func callConcatWs(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	vals, _, err := evaluateArgs(env, args)
+	if err != nil || vals[0].IsNull() {
+		return sqltypes.NULL, err
+	}
+	var parts [][]byte
+	for _, val := range vals[1:] {
+		if !val.IsNull() {
+			parts = append(parts, val.ToBytes())
+		}
+	}
+	return stringResult(vals, bytes.Join(parts, vals[0].ToBytes())), nil
+}
+
+// stringFunc makes the call function of a string function
+// with one argument. The result is NULL if the argument is NULL.
+func stringFunc(f func(val sqltypes.Value) sqltypes.Value) func(ExpressionEnv, []Expr) (sqltypes.Value, error) {
+	return func(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+		val, err := args[0].Evaluate(env)
+		if err != nil || val.IsNull() {
+			return sqltypes.NULL, err
+		}
+		return f(val), nil
+	}
+}
+
+// lengthOf returns the length in bytes.
+func lengthOf(val sqltypes.Value) sqltypes.Value {
+	return sqltypes.NewInt64(int64(len(val.ToBytes())))
+}
+
+// charLengthOf returns the length in characters. Binary
+// strings have one character per byte.
+func charLengthOf(val sqltypes.Value) sqltypes.Value {
+	if !val.IsText() {
+		return lengthOf(val)
+	}
+	return sqltypes.NewInt64(int64(utf8.RuneCount(val.ToBytes())))
+}
+
+// toLower converts text to lower case. Like MySQL,
+// binary strings are not modified.
+func toLower(val sqltypes.Value) sqltypes.Value {
+	if !val.IsText() {
+		return stringResult(nil, val.ToBytes())
+	}
+	return stringResult([]sqltypes.Value{val}, bytes.ToLower(val.ToBytes()))
+}
+
+// toUpper converts text to upper case. Like MySQL,
+// binary strings are not modified.
+func toUpper(val sqltypes.Value) sqltypes.Value {
+	if !val.IsText() {
+		return stringResult(nil, val.ToBytes())
+	}
+	return stringResult([]sqltypes.Value{val}, bytes.ToUpper(val.ToBytes()))
+}
+
+func trimSpaces(val sqltypes.Value) sqltypes.Value {
+	return stringResult([]sqltypes.Value{val}, bytes.Trim(val.ToBytes(), " "))
+}
+
+func trimLeftSpaces(val sqltypes.Value) sqltypes.Value {
+	return stringResult([]sqltypes.Value{val}, bytes.TrimLeft(val.ToBytes(), " "))
+}
+
+func trimRightSpaces(val sqltypes.Value) sqltypes.Value {
+	return stringResult([]sqltypes.Value{val}, bytes.TrimRight(val.ToBytes(), " "))
+}
+
+// reverse reverses the characters of text,
+// and the bytes of binary strings.
+func reverse(val sqltypes.Value) sqltypes.Value {
+	if !val.IsText() {
+		b := val.ToBytes()
+		result := make([]byte, len(b))
+		for i, c := range b {
+			result[len(b)-1-i] = c
+		}
+		return stringResult([]sqltypes.Value{val}, result)
+	}
+	runes := []rune(val.ToString())
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return stringResult([]sqltypes.Value{val}, []byte(string(runes)))
+}
+
+func callLeft(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	return substring(env, args, func(runes []rune, n int) []rune { return runes[:n] })
+}
+
+func callRight(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	return substring(env, args, func(runes []rune, n int) []rune { return runes[len(runes)-n:] })
+}
+
+// substring evaluates the string and length arguments of LEFT and RIGHT,
+// and uses cut to extract the characters. n is capped to the length
+// of the string. Binary strings are processed as one character per byte.
+func substring(env ExpressionEnv, args []Expr, cut func(runes []rune, n int) []rune) (sqltypes.Value, error) {
+	vals, hasNull, err := evaluateArgs(env, args)
+	if err != nil || hasNull {
+		return sqltypes.NULL, err
+	}
+	n, err := sqltypes.ToInt64(vals[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var runes []rune
+	if vals[0].IsText() {
+		runes = []rune(vals[0].ToString())
+	} else {
+		for _, c := range vals[0].ToBytes() {
+			runes = append(runes, rune(c))
+		}
+	}
+	switch {
+	case n < 0:
+		n = 0
+	case n > int64(len(runes)):
+		n = int64(len(runes))
+	}
+	runes = cut(runes, int(n))
+	if vals[0].IsText() {
+		return stringResult(vals[:1], []byte(string(runes))), nil
+	}
+	result := make([]byte, 0, len(runes))
+	for _, r := range runes {
+		result = append(result, byte(r))
+	}
+	return stringResult(vals[:1], result), nil
+}
+
+// callReplace replaces all occurrences of the second argument in the
+// first one. Like MySQL, the search is case sensitive.
+func callReplace(env ExpressionEnv, args []Expr) (sqltypes.Value, error) {
+	vals, hasNull, err := evaluateArgs(env, args)
+	if err != nil || hasNull {
+		return sqltypes.NULL, err
+	}
+	from := vals[1].ToBytes()
+	if len(from) == 0 {
+		return vals[0], nil
+	}
+	return stringResult(vals[:1], bytes.Replace(vals[0].ToBytes(), from, vals[2].ToBytes(), -1)), nil
+}
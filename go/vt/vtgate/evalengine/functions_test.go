@@ -0,0 +1,266 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFunctionsEvaluate(t *testing.T) {
+	env := ExpressionEnv{
+		Fields: sqltypes.MakeTestFields("i|f|s|b|n", "int64|float64|varchar|varbinary|int64"),
+		Row: []sqltypes.Value{
+			sqltypes.NewInt64(3),
+			sqltypes.NewFloat64(1.5),
+			sqltypes.NewVarChar(" Ñandú "),
+			sqltypes.NewVarBinary("Abc"),
+			sqltypes.NULL,
+		},
+	}
+	// The convert func maps every column to its position in the fields.
+	f := func(node sqlparser.Expr) (Expr, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return nil, nil
+		}
+		for i, field := range env.Fields {
+			if col.Name.EqualString(field.Name) {
+				return NewColumn(i), nil
+			}
+		}
+		return nil, nil
+	}
+
+	tcases := []struct {
+		in  string
+		out sqltypes.Value
+		typ querypb.Type
+	}{{
+		in:  "-i",
+		out: sqltypes.NewInt64(-3),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "-f",
+		out: sqltypes.NewFloat64(-1.5),
+		typ: sqltypes.Float64,
+	}, {
+		in:  "-n",
+		out: sqltypes.NULL,
+		typ: sqltypes.Int64,
+	}, {
+		in:  "case when i > 5 then 'big' when i > 1 then 'medium' else 'small' end",
+		out: sqltypes.NewVarBinary("medium"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "case i when 1 then 'one' when 3 then 'three' end",
+		out: sqltypes.NewVarBinary("three"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "case n when 1 then 'one' end",
+		out: sqltypes.NULL,
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "case b when 'ABC' then 1 else f end",
+		out: sqltypes.NewFloat64(1.5),
+		typ: sqltypes.Float64,
+	}, {
+		in:  "case when n then 1 else i end",
+		out: sqltypes.NewInt64(3),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "if(i > 1, s, b)",
+		out: sqltypes.NewVarChar(" Ñandú "),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "if(n, 1, 2.5)",
+		out: sqltypes.NewFloat64(2.5),
+		typ: sqltypes.Float64,
+	}, {
+		in:  "ifnull(n, i)",
+		out: sqltypes.NewInt64(3),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "coalesce(n, null, f, i)",
+		out: sqltypes.NewFloat64(1.5),
+		typ: sqltypes.Float64,
+	}, {
+		in:  "coalesce(n, null)",
+		out: sqltypes.NULL,
+		typ: sqltypes.Int64,
+	}, {
+		in:  "nullif(i, 3)",
+		out: sqltypes.NULL,
+		typ: sqltypes.Int64,
+	}, {
+		in:  "nullif(i, 4)",
+		out: sqltypes.NewInt64(3),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "concat(b, '-', i)",
+		out: sqltypes.NewVarBinary("Abc-3"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "concat(s, b)",
+		out: sqltypes.NewVarChar(" Ñandú Abc"),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "concat(b, n)",
+		out: sqltypes.NULL,
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "concat_ws(',', b, n, i)",
+		out: sqltypes.NewVarBinary("Abc,3"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "length(s)",
+		out: sqltypes.NewInt64(9),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "char_length(s)",
+		out: sqltypes.NewInt64(7),
+		typ: sqltypes.Int64,
+	}, {
+		in:  "length(n)",
+		out: sqltypes.NULL,
+		typ: sqltypes.Int64,
+	}, {
+		in:  "upper(s)",
+		out: sqltypes.NewVarChar(" ÑANDÚ "),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "lower(s)",
+		out: sqltypes.NewVarChar(" ñandú "),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "upper(b)",
+		out: sqltypes.NewVarBinary("Abc"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "trim(s)",
+		out: sqltypes.NewVarChar("Ñandú"),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "ltrim(s)",
+		out: sqltypes.NewVarChar("Ñandú "),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "rtrim(s)",
+		out: sqltypes.NewVarChar(" Ñandú"),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "reverse(trim(s))",
+		out: sqltypes.NewVarChar("údnaÑ"),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "left(trim(s), 2)",
+		out: sqltypes.NewVarChar("Ña"),
+		typ: sqltypes.VarChar,
+	}, {
+		in:  "right(b, 10)",
+		out: sqltypes.NewVarBinary("Abc"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "replace(b, 'b', 'xy')",
+		out: sqltypes.NewVarBinary("Axyc"),
+		typ: sqltypes.VarBinary,
+	}, {
+		in:  "replace(b, 'B', 'xy')",
+		out: sqltypes.NewVarBinary("Abc"),
+		typ: sqltypes.VarBinary,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			node := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Convert(node, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := expr.Evaluate(env)
+			assert.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+			assert.Equal(t, tcase.typ, expr.Type(env))
+		})
+	}
+}
+
+func TestCaseShortCircuit(t *testing.T) {
+	// The missing bind var would fail if it was evaluated.
+	missing := NewBindVariable("missing")
+	c := &Case{
+		Whens: []When{{
+			Cond: NewLiteral(sqltypes.NewInt64(1)),
+			Val:  NewLiteral(sqltypes.NewInt64(2)),
+		}, {
+			Cond: missing,
+			Val:  missing,
+		}},
+		Else: missing,
+	}
+	got, err := c.Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(2), got)
+
+	f, err := NewFunction("coalesce", []Expr{NewLiteral(sqltypes.NewInt64(1)), missing})
+	assert.NoError(t, err)
+	got, err = f.Evaluate(ExpressionEnv{})
+	assert.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), got)
+}
+
+func TestNewFunction(t *testing.T) {
+	_, err := NewFunction("my_func", nil)
+	assert.EqualError(t, err, "unsupported: function cannot be evaluated in vtgate: my_func")
+
+	_, err = NewFunction("IF", []Expr{NewColumn(0)})
+	assert.EqualError(t, err, "incorrect parameter count in the call to native function 'if'")
+
+	f, err := NewFunction("CONCAT", []Expr{NewColumn(0), NewColumn(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, "concat([COLUMN 0], [COLUMN 1])", f.String())
+}
+
+func TestMergeTypes(t *testing.T) {
+	tcases := []struct {
+		in  []querypb.Type
+		out querypb.Type
+	}{
+		{in: nil, out: sqltypes.Null},
+		{in: []querypb.Type{sqltypes.Null, sqltypes.Int32}, out: sqltypes.Int32},
+		{in: []querypb.Type{sqltypes.Int32, sqltypes.Int64}, out: sqltypes.Int64},
+		{in: []querypb.Type{sqltypes.Uint32, sqltypes.Uint64}, out: sqltypes.Uint64},
+		{in: []querypb.Type{sqltypes.Uint64, sqltypes.Int64}, out: sqltypes.Int64},
+		{in: []querypb.Type{sqltypes.Int64, sqltypes.Decimal}, out: sqltypes.Decimal},
+		{in: []querypb.Type{sqltypes.Decimal, sqltypes.Float32}, out: sqltypes.Float64},
+		{in: []querypb.Type{sqltypes.Int64, sqltypes.VarChar}, out: sqltypes.VarChar},
+		{in: []querypb.Type{sqltypes.Int64, sqltypes.VarBinary}, out: sqltypes.VarBinary},
+		{in: []querypb.Type{sqltypes.VarBinary, sqltypes.Text}, out: sqltypes.VarChar},
+	}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.out, mergeTypes(tcase.in...), "%v", tcase.in)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -211,6 +212,11 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		if err != nil {
 			return nil, err
 		}
+		// Like in MySQL, LAST_INSERT_ID() is only changed by
+		// the statements that generate a value.
+		if qr.InsertID != 0 {
+			safeSession.LastInsertId = qr.InsertID
+		}
 
 		if mustCommit {
 			commitStart := time.Now()
@@ -228,6 +234,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtRollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, logStats)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return e.handleSavepoint(ctx, safeSession, sql, logStats)
 	case sqlparser.StmtSet:
 		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtShow:
@@ -300,6 +308,7 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		logStats.Error = err
 		return nil, err
 	}
+	setSessionBindVars(plan, safeSession, bindVars)
 
 	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
 
@@ -331,6 +340,15 @@ func (e *Executor) destinationExec(ctx context.Context, safeSession *SafeSession
 }
 
 func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
+	// A temporary table only exists on the connection that created it,
+	// so the session gets pinned to reserved connections.
+	if isCreateTemporaryTable(sql) {
+		if !*sysVarSetEnabled {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: temporary tables without reserved connections: %s", sql)
+		}
+		safeSession.SetTemporaryTables()
+	}
+
 	// Parse the statement to handle vindex operations
 	// If the statement failed to be properly parsed, fall through anyway
 	// to broadcast the ddl to all shards.
@@ -374,6 +392,14 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 	return result, err
 }
 
+// isCreateTemporaryTable returns true if the statement creates a
+// temporary table. The parser doesn't support them, so the check
+// is textual.
+func isCreateTemporaryTable(sql string) bool {
+	words := strings.Fields(strings.ToLower(sqlparser.StripLeadingComments(sql)))
+	return len(words) >= 2 && words[0] == "create" && words[1] == "temporary"
+}
+
 func (e *Executor) handleVSchemaDDL(ctx context.Context, safeSession *SafeSession, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, ddl *sqlparser.DDL, logStats *LogStats) error {
 	vschema := e.vm.GetCurrentSrvVschema()
 	if vschema == nil {
@@ -445,6 +471,63 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
+// handleSavepoint executes SAVEPOINT, ROLLBACK TO and RELEASE SAVEPOINT
+// on all the shards of the transaction, and keeps track of the savepoints
+// in the session. The shards that join the transaction later create the
+// savepoints before their first query, so that rolling back to a savepoint
+// undoes the same statements on all of them.
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		if !safeSession.InTransaction() {
+			// MySQL ignores savepoints outside of transactions.
+			return &sqltypes.Result{}, nil
+		}
+		if err := e.executeSavepoint(ctx, safeSession, stmt, logStats); err != nil {
+			return nil, err
+		}
+		safeSession.AddSavepoint(stmt.Name.String())
+	case *sqlparser.SRollback:
+		if !safeSession.HasSavepoint(stmt.Name.String()) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", stmt.Name.String())
+		}
+		if err := e.executeSavepoint(ctx, safeSession, stmt, logStats); err != nil {
+			// Some shards may have rolled back to the savepoint,
+			// and some not. The whole transaction is rolled back.
+			_ = e.txConn.Rollback(ctx, safeSession)
+			return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back due to partial rollback to savepoint: %v", err)
+		}
+		safeSession.RollbackToSavepoint(stmt.Name.String())
+	case *sqlparser.Release:
+		if !safeSession.HasSavepoint(stmt.Name.String()) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", stmt.Name.String())
+		}
+		if err := e.executeSavepoint(ctx, safeSession, stmt, logStats); err != nil {
+			return nil, err
+		}
+		safeSession.ReleaseSavepoint(stmt.Name.String())
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized statement: %s", sql)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func (e *Executor) executeSavepoint(ctx context.Context, safeSession *SafeSession, stmt sqlparser.Statement, logStats *LogStats) error {
+	logStats.ShardQueries = uint32(len(safeSession.PreSessions) + len(safeSession.ShardSessions) + len(safeSession.PostSessions))
+	e.updateQueryCounts("Savepoint", "", "", int64(logStats.ShardQueries))
+	return e.scatterConn.ExecuteSavepoint(ctx, safeSession, sqlparser.String(stmt))
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
@@ -461,6 +544,9 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 		return &sqltypes.Result{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 	}
 
+	// sysVars are the MySQL system variables that must be
+	// set on the reserved connections of the session.
+	sysVars := make(map[string]interface{})
 	for k, v := range vals {
 		switch k.Scope {
 		case sqlparser.GlobalStr:
@@ -629,6 +715,10 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for wait_timeout: %T", v)
 			}
 		case "sql_mode", "net_write_timeout", "net_read_timeout", "lc_messages", "collation_connection", "foreign_key_checks":
+			if *sysVarSetEnabled {
+				sysVars[k.Key] = v
+				continue
+			}
 			log.Warningf("Ignored inapplicable SET %v = %v", k, v)
 			warnings.Add("IgnoredSet", 1)
 		case "charset", "names":
@@ -643,12 +733,89 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, fmt.Errorf("unexpected value for charset/names: %v", val)
 			}
 		default:
+			if *sysVarSetEnabled && (k.Scope == sqlparser.SessionStr || k.Scope == sqlparser.ImplicitStr) {
+				sysVars[k.Key] = v
+				continue
+			}
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
 		}
 	}
+	if len(sysVars) != 0 {
+		if err := e.setSystemVariables(ctx, safeSession, sysVars); err != nil {
+			return nil, err
+		}
+	}
 	return &sqltypes.Result{}, nil
 }
 
+// setSystemVariables sets the new values of the system variables on
+// the connections of the session, and records them in the session once
+// they're set. The session gets pinned to reserved connections as long
+// as it has system variables that are not set to their default, or
+// temporary tables.
+func (e *Executor) setSystemVariables(ctx context.Context, safeSession *SafeSession, sysVars map[string]interface{}) error {
+	names := make([]string, 0, len(sysVars))
+	for name := range sysVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	vars := safeSession.GetSystemVariables()
+	exprs := make([]string, 0, len(names))
+	for _, name := range names {
+		if err := checkSystemVariableName(name); err != nil {
+			return err
+		}
+		var expr sqlparser.Expr
+		switch v := sysVars[name].(type) {
+		case nil:
+			expr = &sqlparser.NullVal{}
+		case int64:
+			expr = sqlparser.NewIntVal(strconv.AppendInt(nil, v, 10))
+		case string:
+			if v == "default" {
+				delete(vars, name)
+				exprs = append(exprs, formatSystemVariable(name, &sqlparser.Default{}))
+				continue
+			}
+			expr = sqlparser.NewStrVal([]byte(v))
+		default:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for %s: %T", name, v)
+		}
+		vars[name] = sqlparser.String(expr)
+		exprs = append(exprs, formatSystemVariable(name, expr))
+	}
+
+	inReservedConn := len(vars) != 0 || safeSession.HasTemporaryTables
+	if !inReservedConn && !safeSession.InTransaction() {
+		if err := e.txConn.Release(ctx, safeSession); err != nil {
+			return err
+		}
+	} else if err := e.scatterConn.SetSystemVariables(ctx, safeSession, "set "+strings.Join(exprs, ", "), inReservedConn); err != nil {
+		return err
+	}
+	safeSession.SetSystemVariables(vars)
+	return nil
+}
+
+// CloseSession releases the reserved connections of the session,
+// including the one that holds its advisory locks. Its transaction,
+// if any, is rolled back.
+func (e *Executor) CloseSession(ctx context.Context, safeSession *SafeSession) error {
+	if safeSession.InTransaction() {
+		if err := e.txConn.Rollback(ctx, safeSession); err != nil {
+			log.Warningf("Rollback failed while closing session: %v", err)
+		}
+	}
+	safeSession.Session.InReservedConn = false
+	safeSession.Session.HasTemporaryTables = false
+	lockErr := e.txConn.ReleaseLock(ctx, safeSession)
+	if err := e.txConn.Release(ctx, safeSession); err != nil {
+		return err
+	}
+	return lockErr
+}
+
 func (e *Executor) handleSetVitessMetadata(ctx context.Context, session *SafeSession, k sqlparser.SetKey, v interface{}) (*sqltypes.Result, error) {
 	//TODO(kalfonso): move to its own acl check and consolidate into an acl component that can handle multiple operations (vschema, metadata)
 	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx))
@@ -1087,6 +1254,15 @@ func (e *Executor) handleUse(ctx context.Context, safeSession *SafeSession, sql
 }
 
 func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
+	// EXPLAIN FORMAT=VITESS is answered by vtgate. The statements
+	// that can't be parsed are still sent to a shard.
+	query, comments := sqlparser.SplitMarginComments(sql)
+	if stmt, err := sqlparser.Parse(query); err == nil {
+		if explain, ok := stmt.(*sqlparser.Explain); ok && explain.Type == sqlparser.ExplainVitessStr {
+			return e.handleExplain(ctx, safeSession, explain, comments, bindVars, dest, destKeyspace, destTabletType, logStats)
+		}
+	}
+
 	if destKeyspace == "" {
 		return nil, errNoKeyspace
 	}
@@ -1117,6 +1293,45 @@ func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sq
 	return result, err
 }
 
+// handleExplain returns the description of the vtgate plan of the
+// statement. With ANALYZE, the plan is also executed, and the
+// description has the execution statistics of every primitive.
+func (e *Executor) handleExplain(ctx context.Context, safeSession *SafeSession, explain *sqlparser.Explain, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
+	if dest != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: explain format = vitess with a shard or range target: %s", safeSession.TargetString)
+	}
+	vcursor := newVCursorImpl(ctx, safeSession, destKeyspace, destTabletType, comments, e, logStats)
+	sql := sqlparser.String(explain.Statement)
+	if !explain.Analyze {
+		plan, err := e.getPlan(vcursor, sql, comments, bindVars, skipQueryPlanCache(safeSession), logStats)
+		if err != nil {
+			return nil, err
+		}
+		e.updateQueryCounts("Explain", "", "", 0)
+		return engine.Explain(plan.Instructions)
+	}
+
+	// The plan gets instrumented by the analysis. So, a new
+	// one is built instead of using the cached one.
+	if e.normalize {
+		sqlparser.Normalize(explain.Statement, bindVars, "vtg")
+		sql = sqlparser.String(explain.Statement)
+	}
+	logStats.SQL = comments.Leading + sql + comments.Trailing
+	logStats.BindVariables = bindVars
+	plan, err := planbuilder.BuildFromStmt(sql, explain.Statement, vcursor)
+	if err != nil {
+		return nil, err
+	}
+	setSessionBindVars(plan, safeSession, bindVars)
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	result, err := engine.ExplainAnalyze(vcursor, plan.Instructions, bindVars)
+	logStats.ExecuteTime = time.Since(execStart)
+	e.updateQueryCounts("Explain", "", "", int64(logStats.ShardQueries))
+	return result, err
+}
+
 func (e *Executor) handleComment(sql string) (*sqltypes.Result, error) {
 	_, _ = sqlparser.ExtractMysqlComment(sql)
 	// Not sure if this is a good idea.
@@ -1153,6 +1368,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		logStats.Error = err
 		return err
 	}
+	setSessionBindVars(plan, safeSession, bindVars)
 
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
@@ -1401,6 +1617,14 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	return plan, nil
 }
 
+// setSessionBindVars sets the bind variables that the plan
+// needs from the session.
+func setSessionBindVars(plan *engine.Plan, safeSession *SafeSession, bindVars map[string]*querypb.BindVariable) {
+	if plan.NeedsLastInsertID {
+		bindVars[engine.LastInsertIDName] = sqltypes.Uint64BindVariable(safeSession.LastInsertId)
+	}
+}
+
 // skipQueryPlanCache extracts SkipQueryPlanCache from session
 func skipQueryPlanCache(safeSession *SafeSession) bool {
 	if safeSession == nil || safeSession.Options == nil {
@@ -1566,6 +1790,7 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 		logStats.Error = err
 		return nil, err
 	}
+	setSessionBindVars(plan, safeSession, bindVars)
 
 	qr, err := plan.Instructions.GetFields(vcursor, bindVars)
 	logStats.ExecuteTime = time.Since(execStart)
@@ -649,6 +649,34 @@ func TestInsertSharded(t *testing.T) {
 	}
 }
 
+func TestInsertShardedMultiColumnPrimary(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+
+	_, err := executorExec(executor, "insert into user_region(region, id, name) values (64, 1, 'myname')", nil)
+	if err != nil {
+		t.Error(err)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "insert into user_region(region, id, name) values (:_region0, :_id0, 'myname') /* vtgate:: keyspace_id:40166b40b44aba4bd6 */",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_region0": sqltypes.Int64BindVariable(64),
+			"_id0":     sqltypes.Int64BindVariable(1),
+		},
+	}}
+	if !reflect.DeepEqual(sbc2.Queries, wantQueries) {
+		t.Errorf("sbc2.Queries:\n%+v, want\n%+v\n", sbc2.Queries, wantQueries)
+	}
+	if sbc1.Queries != nil {
+		t.Errorf("sbc1.Queries: %+v, want nil\n", sbc1.Queries)
+	}
+
+	_, err = executorExec(executor, "insert into user_region(region, id, name) values (256, 1, 'myname')", nil)
+	want := "execInsertSharded: getInsertShardedRoute: could not map [INT64(256) INT64(1)] to a keyspace id"
+	if err == nil || err.Error() != want {
+		t.Errorf("insert with invalid region: %v, want %s", err, want)
+	}
+}
+
 func TestInsertShardedKeyrange(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 
@@ -1059,6 +1087,52 @@ func TestInsertGeneratorSharded(t *testing.T) {
 	}
 }
 
+func TestInsertGeneratorShardedLastInsertID(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	sbclookup.SetResults([]*sqltypes.Result{{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+		}},
+		RowsAffected: 1,
+		InsertID:     1,
+	}})
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "insert into user(v, name) values (2, 'myname')", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.InsertID != 1 || session.LastInsertId != 1 {
+		t.Errorf("InsertID: %d, LastInsertId: %d, want 1", result.InsertID, session.LastInsertId)
+	}
+
+	// Statements that don't generate a value keep it.
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "update user set a = 2 where id = 3", nil); err != nil {
+		t.Fatal(err)
+	}
+	if session.LastInsertId != 1 {
+		t.Errorf("LastInsertId: %d, want 1", session.LastInsertId)
+	}
+
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = last_insert_id()", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select id from user where id = :__lastInsertId",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__lastInsertId": sqltypes.Uint64BindVariable(1),
+		},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v\n", sbc1.Queries, wantQueries)
+	}
+	if sbc2.Queries != nil {
+		t.Errorf("sbc2.Queries: %+v, want nil\n", sbc2.Queries)
+	}
+}
+
 func TestInsertAutoincSharded(t *testing.T) {
 	router, sbc, _, _ := createExecutorEnv()
 
@@ -94,9 +94,23 @@ var executorVSchema = `
 		},
 		"krcol_vdx": {
 			"type": "keyrange_lookuper"
+		},
+		"region_vdx": {
+			"type": "region_experimental",
+			"params": {
+				"region_bytes": "1"
+			}
 		}
 	},
 	"tables": {
+		"user_region": {
+			"column_vindexes": [
+				{
+					"columns": ["region", "id"],
+					"name": "region_vdx"
+				}
+			]
+		},
 		"user": {
 			"column_vindexes": [
 				{
@@ -49,6 +49,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExecutorResultsExceeded(t *testing.T) {
@@ -477,6 +478,369 @@ func TestExecutorSet(t *testing.T) {
 	}
 }
 
+func TestExecutorSetSystemVariables(t *testing.T) {
+	*sysVarSetEnabled = true
+	defer func() {
+		*sysVarSetEnabled = false
+	}()
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = '', @@session.sql_big_selects = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.InReservedConn())
+	assert.Equal(t, map[string]string{"sql_mode": "''", "sql_big_selects": "1"}, session.SystemVariables)
+	assert.Empty(t, session.ReservedSessions)
+
+	// The first query reserves a connection with the system variables.
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "set sql_big_selects = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "set sql_mode = ''",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+	require.Len(t, session.ReservedSessions, 1)
+	reservedID := session.ReservedSessions[0].ReservedId
+
+	// The next ones, and the transactions, use the reserved connection.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "update user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, reservedID, session.ShardSessions[0].TransactionId)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ReservedSessions, 1)
+	assert.EqualValues(t, 0, sbc1.ReleaseCount.Get())
+
+	// Changing a system variable changes it on the reserved connection.
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_big_selects = default", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sql_mode": "''"}, session.SystemVariables)
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "set sql_big_selects = default",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+
+	// The session keeps its variables if they can't be set.
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = 'ansi'", nil)
+	require.Error(t, err)
+	assert.Equal(t, map[string]string{"sql_mode": "''"}, session.SystemVariables)
+
+	// The connections are released once all the variables are reset.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = default", nil)
+	require.NoError(t, err)
+	assert.False(t, session.InReservedConn())
+	assert.Empty(t, session.SystemVariables)
+	assert.Empty(t, session.ReservedSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@global.sql_mode = ''", nil)
+	assert.EqualError(t, err, "unsupported in set: global")
+}
+
+func TestExecutorSetSystemVariablesInTransaction(t *testing.T) {
+	*sysVarSetEnabled = true
+	defer func() {
+		*sysVarSetEnabled = false
+	}()
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "update user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+	require.Len(t, session.ShardSessions, 1)
+	transactionID := session.ShardSessions[0].TransactionId
+
+	// The connection of the transaction gets reserved.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	require.Len(t, session.ReservedSessions, 1)
+	assert.Equal(t, transactionID, session.ReservedSessions[0].ReservedId)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+
+	// Resetting the variables in the transaction keeps the
+	// connection until the transaction ends.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = default", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ReservedSessions, 1)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "rollback", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.ReservedSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+}
+
+func TestExecutorSetSystemVariablesHostileName(t *testing.T) {
+	*sysVarSetEnabled = true
+	defer func() {
+		*sysVarSetEnabled = false
+	}()
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set `sql_mode = 1; drop table user; set a` = 1", nil)
+	assert.EqualError(t, err, `invalid system variable name: "sql_mode = 1; drop table user; set a"`)
+	assert.Empty(t, session.SystemVariables)
+	assert.EqualValues(t, 0, sbc1.ReserveCount.Get())
+
+	// The variables of a session sent by a client are checked
+	// before they are replayed on a new connection.
+	session = NewSafeSession(&vtgatepb.Session{
+		TargetString:    "@master",
+		Autocommit:      true,
+		InReservedConn:  true,
+		SystemVariables: map[string]string{"sql_mode": "''; drop table user"},
+	})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	assert.EqualError(t, err, "invalid value for system variable sql_mode: ''; drop table user")
+	session.SystemVariables = map[string]string{"sql_mode = 1; drop table user; set a": "1"}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	assert.EqualError(t, err, `invalid system variable name: "sql_mode = 1; drop table user; set a"`)
+	assert.EqualValues(t, 0, sbc1.ReserveCount.Get())
+}
+
+func TestExecutorCreateTemporaryTable(t *testing.T) {
+	*sysVarSetEnabled = true
+	defer func() {
+		*sysVarSetEnabled = false
+	}()
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor/-20@master", Autocommit: true})
+
+	// The table gets created on a reserved connection.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "/* comment */ CREATE TEMPORARY TABLE t (id bigint)", nil)
+	require.NoError(t, err)
+	assert.True(t, session.InReservedConn())
+	assert.True(t, session.HasTemporaryTables)
+	require.Len(t, session.ReservedSessions, 1)
+	reservedID := session.ReservedSessions[0].ReservedId
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+
+	// Resetting the system variables keeps the session pinned.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = default", nil)
+	require.NoError(t, err)
+	assert.True(t, session.InReservedConn())
+	require.Len(t, session.ReservedSessions, 1)
+	assert.Equal(t, reservedID, session.ReservedSessions[0].ReservedId)
+	assert.EqualValues(t, 0, sbc1.ReleaseCount.Get())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select * from t", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+
+	err = executor.CloseSession(context.Background(), session)
+	require.NoError(t, err)
+	assert.False(t, session.InReservedConn())
+	assert.False(t, session.HasTemporaryTables)
+	assert.Empty(t, session.ReservedSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+
+	// A temporary table created in a transaction reserves its connection.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor/-20@master"})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "insert into t1 values (1)", nil)
+	require.NoError(t, err)
+	require.Len(t, session.ShardSessions, 1)
+	transactionID := session.ShardSessions[0].TransactionId
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "create temporary table t (id bigint)", nil)
+	require.NoError(t, err)
+	require.Len(t, session.ReservedSessions, 1)
+	assert.Equal(t, transactionID, session.ReservedSessions[0].ReservedId)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ReservedSessions, 1)
+}
+
+func TestExecutorCreateTemporaryTableDisabled(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor/-20@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "create temporary table t (id bigint)", nil)
+	assert.EqualError(t, err, "unsupported: temporary tables without reserved connections: create temporary table t (id bigint)")
+	assert.False(t, session.InReservedConn())
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+}
+
+func TestExecutorCloseSession(t *testing.T) {
+	*sysVarSetEnabled = true
+	defer func() {
+		*sysVarSetEnabled = false
+	}()
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id in (1, 3)", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.ReservedSessions, 2)
+
+	err = executor.CloseSession(context.Background(), session)
+	require.NoError(t, err)
+	assert.Empty(t, session.ReservedSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.EqualValues(t, 1, sbc2.ReleaseCount.Get())
+}
+
+func TestExecutorLocks(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	// The first lock query reserves a connection on the first shard.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "select get_lock('lock name', 10) from TestExecutor.dual", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+	require.NotNil(t, session.LockSession)
+	assert.Equal(t, "-20", session.LockSession.Target.Shard)
+	reservedID := session.LockSession.ReservedId
+
+	stats := executor.txConn.locks.stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, "TestExecutor/-20@master", stats[0].Target)
+	assert.Equal(t, reservedID, stats[0].ReservedID)
+
+	// The next ones use the same connection.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select release_lock('lock name') from TestExecutor.dual", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.Equal(t, reservedID, session.LockSession.ReservedId)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select get_lock('lock name', 10) from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select release_lock('lock name') from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+
+	// Closing the session releases the connection, and its locks.
+	err = executor.CloseSession(context.Background(), session)
+	require.NoError(t, err)
+	assert.Nil(t, session.LockSession)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.Empty(t, executor.txConn.locks.stats())
+
+	// The session finds out when the tablet loses the connection.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select get_lock('lock name', 10) from TestExecutor.dual", nil)
+	require.NoError(t, err)
+	reservedID = session.LockSession.ReservedId
+	sbc1.LostReservedIDs = map[int64]bool{reservedID: true}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select is_free_lock('lock name') from TestExecutor.dual", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the connection holding the advisory locks of the session was lost, and its locks were released")
+	assert.Nil(t, session.LockSession)
+	assert.Empty(t, executor.txConn.locks.stats())
+	// The next lock query reserves a new connection.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select get_lock('lock name', 10) from TestExecutor.dual", nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, reservedID, session.LockSession.ReservedId)
+	assert.EqualValues(t, 3, sbc1.ReserveCount.Get())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select get_lock('lock name', 10) from user", nil)
+	assert.EqualError(t, err, "unsupported: lock functions in a query that doesn't select from dual")
+
+	// The connection is released if the first lock query fails on it.
+	err = executor.CloseSession(context.Background(), session)
+	require.NoError(t, err)
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	sbc1.LostReservedIDs = map[int64]bool{sbc1.TransactionID.Get() + 1: true}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select get_lock('lock name', 10) from TestExecutor.dual", nil)
+	require.Error(t, err)
+	assert.Nil(t, session.LockSession)
+	assert.EqualValues(t, 3, sbc1.ReleaseCount.Get())
+	assert.Empty(t, executor.txConn.locks.stats())
+}
+
+func TestExecutorSavepoints(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	// Savepoints are ignored outside of transactions.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "savepoint a", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Savepoints)
+	assert.Empty(t, sbc1.Queries)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "savepoint a", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, session.Savepoints)
+
+	// The second shard joins the transaction after the
+	// savepoint, which gets created on it first.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 3", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "savepoint b", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, session.Savepoints)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint b",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc2.Queries)
+	assert.EqualValues(t, 1, sbc2.BeginCount.Get())
+
+	// Rolling back to a savepoint keeps it, but forgets the later ones.
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "rollback to savepoint a", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, session.Savepoints)
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "rollback to a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+	assert.Equal(t, wantQueries, sbc2.Queries)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "rollback to b", nil)
+	assert.EqualError(t, err, "SAVEPOINT b does not exist")
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "release savepoint A", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Savepoints)
+
+	// The savepoints end with the transaction.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "savepoint c", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Savepoints)
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -939,8 +1303,9 @@ func TestExecutorShow(t *testing.T) {
 			buildVarCharRow("TestExecutor", "music_user_map", "lookup_hash_unique", "from=music_id; table=music_user_map; to=user_id", "music"),
 			buildVarCharRow("TestExecutor", "name_lastname_keyspace_id_map", "lookup", "from=name,lastname; table=name_lastname_keyspace_id_map; to=keyspace_id", "user2"),
 			buildVarCharRow("TestExecutor", "name_user_map", "lookup_hash", "from=name; table=name_user_map; to=user_id", "user"),
+			buildVarCharRow("TestExecutor", "region_vdx", "region_experimental", "region_bytes=1", ""),
 		},
-		RowsAffected: 10,
+		RowsAffected: 11,
 	}
 	if !reflect.DeepEqual(qr, wantqr) {
 		t.Errorf("show vschema vindexes:\n%+v, want\n%+v", qr, wantqr)
@@ -1252,6 +1617,75 @@ func TestExecutorOther(t *testing.T) {
 	}
 }
 
+func TestExecutorExplain(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	result, err := executorExec(executor, "explain format = vitess select id from user where id = 1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|parent|operator|keyspace|table|details",
+			"int64|int64|varchar|varchar|varchar|varchar",
+		),
+		`1|null|SelectEqualUnique|TestExecutor|user|{"FieldQuery":"select id from user where 1 != 1","Query":"select id from user where id = 1","Values":[1],"Vindex":"hash_index"}`,
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("explain:\n%+v, want\n%+v", result, wantResult)
+	}
+	gotCount := []int64{
+		sbc1.ExecCount.Get(),
+		sbc2.ExecCount.Get(),
+		sbclookup.ExecCount.Get(),
+	}
+	wantCount := []int64{0, 0, 0}
+	if !reflect.DeepEqual(gotCount, wantCount) {
+		t.Errorf("Exec: %v, want %v", gotCount, wantCount)
+	}
+
+	result, err = executorExec(executor, "explain analyze format = vitess select id from user", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The time is not deterministic.
+	result.Rows[0][len(result.Rows[0])-1] = sqltypes.NewInt64(0)
+	wantResult = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|parent|operator|keyspace|table|details|executions|rows|time_us",
+			"int64|int64|varchar|varchar|varchar|varchar|int64|int64|int64",
+		),
+		`1|null|SelectScatter|TestExecutor|user|{"FieldQuery":"select id from user where 1 != 1","Query":"select id from user"}|1|8|0`,
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("explain analyze:\n%+v, want\n%+v", result, wantResult)
+	}
+	gotCount = []int64{
+		sbc1.ExecCount.Get(),
+		sbc2.ExecCount.Get(),
+		sbclookup.ExecCount.Get(),
+	}
+	wantCount = []int64{1, 1, 0}
+	if !reflect.DeepEqual(gotCount, wantCount) {
+		t.Errorf("Exec: %v, want %v", gotCount, wantCount)
+	}
+
+	// Other formats are sent to a shard.
+	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded}), "explain format = json select id from music_user_map", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sbclookup.ExecCount.Get(), int64(1); got != want {
+		t.Errorf("sbclookup.ExecCount: %v, want %v", got, want)
+	}
+
+	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor/-20"}), "explain format = vitess select id from user", nil)
+	want := "unsupported: explain format = vitess with a shard or range target: TestExecutor/-20"
+	if err == nil || err.Error() != want {
+		t.Errorf("explain: %v, want %v", err, want)
+	}
+}
+
 func TestExecutorDDL(t *testing.T) {
 	logChan := QueryLogger.Subscribe("Test")
 	defer QueryLogger.Unsubscribe(logChan)
@@ -0,0 +1,137 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// LockSessionStats describes a connection reserved for the
+// advisory locks of a session. It is used to display a table
+// with the information in the status page.
+type LockSessionStats struct {
+	Target     string
+	ReservedID int64
+	Since      time.Time
+	LastUsed   time.Time
+	LastQuery  string
+}
+
+// lockSessions keeps track of the connections reserved for
+// advisory locks, for the status page.
+type lockSessions struct {
+	mu       sync.Mutex
+	sessions map[lockSessionKey]*LockSessionStats
+}
+
+type lockSessionKey struct {
+	target     string
+	reservedID int64
+}
+
+func newLockSessions() *lockSessions {
+	return &lockSessions{
+		sessions: make(map[lockSessionKey]*LockSessionStats),
+	}
+}
+
+// record records the query executed on the lock connection.
+// The connection gets added if it's not known yet.
+func (ls *lockSessions) record(target *querypb.Target, reservedID int64, query string) {
+	key := lockSessionKey{target: lockTargetString(target), reservedID: reservedID}
+	now := time.Now()
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	stats, ok := ls.sessions[key]
+	if !ok {
+		stats = &LockSessionStats{
+			Target:     key.target,
+			ReservedID: reservedID,
+			Since:      now,
+		}
+		ls.sessions[key] = stats
+	}
+	stats.LastUsed = now
+	stats.LastQuery = query
+}
+
+// remove forgets the lock connection once it's released.
+func (ls *lockSessions) remove(target *querypb.Target, reservedID int64) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	delete(ls.sessions, lockSessionKey{target: lockTargetString(target), reservedID: reservedID})
+}
+
+// stats returns the lock connections, from the oldest one.
+func (ls *lockSessions) stats() []*LockSessionStats {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	list := make([]*LockSessionStats, 0, len(ls.sessions))
+	for _, stats := range ls.sessions {
+		copied := *stats
+		list = append(list, &copied)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Since.Before(list[j].Since) })
+	return list
+}
+
+func lockTargetString(target *querypb.Target) string {
+	return target.Keyspace + "/" + target.Shard + "@" + topoproto.TabletTypeLString(target.TabletType)
+}
+
+const (
+	// LockSessionsTemplate is the HTML template to display LockSessionStats.
+	LockSessionsTemplate = `
+<style>
+  table {
+    border-collapse: collapse;
+  }
+  td, th {
+    border: 1px solid #999;
+    padding: 0.2rem;
+  }
+</style>
+<table>
+  <tr>
+    <th colspan="5">Advisory Lock Connections</th>
+  </tr>
+  <tr>
+    <th>Target</th>
+    <th>Reserved ID</th>
+    <th>Since</th>
+    <th>Last Used</th>
+    <th>Last Query</th>
+  </tr>
+{{range $i, $ls := .}}  <tr>
+    <td>{{$ls.Target}}</td>
+    <td>{{$ls.ReservedID}}</td>
+    <td>{{$ls.Since.Format "2006-01-02 15:04:05"}}</td>
+    <td>{{$ls.LastUsed.Format "2006-01-02 15:04:05"}}</td>
+    <td>{{$ls.LastQuery}}</td>
+  </tr>{{else}}  <tr>
+    <td colspan="5">No session holds a lock connection.</td>
+  </tr>{{end}}
+</table>
+`
+)
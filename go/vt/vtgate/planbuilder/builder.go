@@ -235,7 +235,7 @@ func (rsb *resultsBuilder) SupplyWeightString(colNumber int) (weightcolNumber in
 	}
 	weightcolNumber, err = rsb.input.SupplyWeightString(colNumber)
 	if err != nil {
-		return 0, nil
+		return 0, err
 	}
 	rsb.weightStrings[rc] = weightcolNumber
 	if weightcolNumber < len(rsb.resultColumns) {
@@ -268,7 +268,8 @@ func Build(query string, vschema ContextVSchema) (*engine.Plan, error) {
 func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchema) (*engine.Plan, error) {
 	var err error
 	plan := &engine.Plan{
-		Original: query,
+		Original:          query,
+		NeedsLastInsertID: rewriteLastInsertID(stmt),
 	}
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
@@ -299,6 +300,8 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 		return nil, errors.New("unsupported construct: commit")
 	case *sqlparser.Rollback:
 		return nil, errors.New("unsupported construct: rollback")
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		return nil, errors.New("unsupported construct: savepoint")
 	default:
 		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
 	}
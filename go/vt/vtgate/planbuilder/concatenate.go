@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*concatenate)(nil)
+
+// concatenate is the builder for engine.Concatenate.
+// This gets built for a UNION whose parts cannot be merged
+// into a single route. The result columns are the ones of
+// the first part, like in MySQL. A UNION DISTINCT builds a
+// distinct on top of the concatenate.
+type concatenate struct {
+	order   int
+	sources []builder
+}
+
+// newConcatenate builds a new concatenate. If left is already
+// a concatenate, right is added to its sources instead.
+func newConcatenate(left, right builder) *concatenate {
+	if cb, ok := left.(*concatenate); ok {
+		cb.sources = append(cb.sources, right)
+		return cb
+	}
+	return &concatenate{sources: []builder{left, right}}
+}
+
+// Order satisfies the builder interface.
+func (cb *concatenate) Order() int {
+	return cb.order
+}
+
+// Reorder satisfies the builder interface.
+func (cb *concatenate) Reorder(order int) {
+	for _, source := range cb.sources {
+		source.Reorder(order)
+		order = source.Order()
+	}
+	cb.order = order + 1
+}
+
+// Primitive satisfies the builder interface.
+func (cb *concatenate) Primitive() engine.Primitive {
+	ec := &engine.Concatenate{}
+	for _, source := range cb.sources {
+		ec.Sources = append(ec.Sources, source.Primitive())
+	}
+	return ec
+}
+
+// First satisfies the builder interface.
+func (cb *concatenate) First() builder {
+	return cb.sources[0].First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (cb *concatenate) ResultColumns() []*resultColumn {
+	return cb.sources[0].ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (cb *concatenate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("concatenate.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (cb *concatenate) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("concatenate.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (cb *concatenate) MakeDistinct() error {
+	return errors.New("concatenate.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (cb *concatenate) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("concatenate.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The rows of the sources have to be sorted by vtgate.
+func (cb *concatenate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return cb, nil
+	}
+	return newMemorySort(cb, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// None of the sources needs to return more rows than the limit.
+func (cb *concatenate) SetUpperLimit(count *sqlparser.SQLVal) {
+	for _, source := range cb.sources {
+		source.SetUpperLimit(count)
+	}
+}
+
+// PushMisc satisfies the builder interface.
+func (cb *concatenate) PushMisc(sel *sqlparser.Select) {
+	for _, source := range cb.sources {
+		source.PushMisc(sel)
+	}
+}
+
+// Wireup satisfies the builder interface.
+func (cb *concatenate) Wireup(bldr builder, jt *jointab) error {
+	for i := len(cb.sources) - 1; i >= 0; i-- {
+		if err := cb.sources[i].Wireup(bldr, jt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SupplyVar satisfies the builder interface.
+func (cb *concatenate) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	for _, source := range cb.sources {
+		if from <= source.Order() {
+			source.SupplyVar(from, to, col, varname)
+			return
+		}
+	}
+	panic("BUG: concatenate cannot supply a var it doesn't have")
+}
+
+// SupplyCol satisfies the builder interface.
+// Only the columns of the select list can be supplied, because
+// the other sources have no equivalent for the rest.
+func (cb *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range cb.ResultColumns() {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	panic("BUG: concatenate can only supply the columns of its select list")
+}
+
+// SupplyWeightString satisfies the builder interface.
+// The weight string is requested from every source. They must
+// all return it at the same position.
+func (cb *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	for i, source := range cb.sources {
+		if colNumber >= len(source.ResultColumns()) {
+			return 0, errors.New("unsupported: UNION parts have a different number of columns")
+		}
+		sourceCol, err := source.SupplyWeightString(colNumber)
+		if err != nil {
+			return 0, err
+		}
+		if i != 0 && sourceCol != weightcolNumber {
+			return 0, errors.New("unsupported: UNION parts have a different number of columns")
+		}
+		weightcolNumber = sourceCol
+	}
+	return weightcolNumber, nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// This file has functions to analyze the WITH clause.
+
+// processWith analyzes the WITH clause of a statement. The references
+// to non-recursive common table expressions are replaced by derived
+// tables, which are then planned like any other subquery in the FROM
+// clause. This allows them to be merged into the routes of the query.
+// The function returns true if the statement has a recursive WITH
+// clause. Such a statement cannot be broken up: a route that sends it
+// as is has been built instead.
+func (pb *primitiveBuilder) processWith(stmt sqlparser.SelectStatement, with *sqlparser.With) (recursive bool, err error) {
+	if with.Recursive {
+		return true, pb.processRecursiveWith(stmt, with)
+	}
+	// Every common table expression can reference the ones before it.
+	for i, cte := range with.CTEs {
+		if err := expandCTEs(cte.Subquery.Select, with.CTEs[:i]); err != nil {
+			return false, err
+		}
+	}
+	stmt.SetWith(nil)
+	return false, expandCTEs(stmt, with.CTEs)
+}
+
+// expandCTEs replaces the references to the common table
+// expressions in the node by derived tables.
+func expandCTEs(node sqlparser.SQLNode, ctes []*sqlparser.CommonTableExpr) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			return expandNestedWith(node, node.With, ctes)
+		case *sqlparser.Union:
+			return expandNestedWith(node, node.With, ctes)
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			cte := findCTE(ctes, tableName.Name)
+			if cte == nil {
+				return true, nil
+			}
+			subquery, err := newCTESubquery(cte)
+			if err != nil {
+				return false, err
+			}
+			node.Expr = subquery
+			if node.As.IsEmpty() {
+				node.As = cte.Name
+			}
+			return false, nil
+		}
+		return true, nil
+	}, node)
+}
+
+// expandNestedWith handles a nested statement that has its own
+// WITH clause. Its common table expressions hide the outer ones
+// that have the same name. If there are such, the nested statement
+// is expanded separately without them, and the walk of its subtree
+// is stopped. Its own WITH clause is processed when it's planned.
+func expandNestedWith(stmt sqlparser.SelectStatement, with *sqlparser.With, ctes []*sqlparser.CommonTableExpr) (kontinue bool, err error) {
+	if with == nil {
+		return true, nil
+	}
+	var visible []*sqlparser.CommonTableExpr
+	for _, cte := range ctes {
+		if with.Find(cte.Name) == nil {
+			visible = append(visible, cte)
+		}
+	}
+	if len(visible) == len(ctes) {
+		return true, nil
+	}
+	stmt.SetWith(nil)
+	defer stmt.SetWith(with)
+	return false, expandCTEs(stmt, visible)
+}
+
+func findCTE(ctes []*sqlparser.CommonTableExpr, name sqlparser.TableIdent) *sqlparser.CommonTableExpr {
+	for _, cte := range ctes {
+		if cte.Name == name {
+			return cte
+		}
+	}
+	return nil
+}
+
+// newCTESubquery returns a new copy of the subquery of the common
+// table expression. The select expressions are aliased with its
+// column list, if any. A new copy is required for every reference
+// because the AST gets modified as it's planned.
+func newCTESubquery(cte *sqlparser.CommonTableExpr) (*sqlparser.Subquery, error) {
+	stmt, err := sqlparser.Parse(sqlparser.String(cte.Subquery.Select))
+	if err != nil {
+		return nil, err
+	}
+	sel := stmt.(sqlparser.SelectStatement)
+	if len(cte.Columns) == 0 {
+		return &sqlparser.Subquery{Select: sel}, nil
+	}
+	// The column names of a UNION come from its first SELECT.
+	selectExprs := firstSelect(sel).SelectExprs
+	if len(selectExprs) != len(cte.Columns) {
+		return nil, fmt.Errorf("column count mismatch in common table expression: %s", cte.Name.String())
+	}
+	for i, selectExpr := range selectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: '*' expression in common table expression with column list: %s", cte.Name.String())
+		}
+		aliased.As = cte.Columns[i]
+	}
+	return &sqlparser.Subquery{Select: sel}, nil
+}
+
+// processRecursiveWith builds a route that sends the statement as is.
+// A recursive common table expression is evaluated by repeating its
+// query over the rows it produced. So, it can only be computed by
+// vttablet, and all the tables of the statement must resolve to the
+// same unsharded keyspace or pinned shard.
+func (pb *primitiveBuilder) processRecursiveWith(stmt sqlparser.SelectStatement, with *sqlparser.With) error {
+	tableExprs := findTableExprs(stmt, with)
+	if len(tableExprs) == 0 {
+		tableExprs = append(tableExprs, &sqlparser.AliasedTableExpr{
+			Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")},
+		})
+	}
+	var rb *route
+	for _, tableExpr := range tableExprs {
+		tpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+		if err := tpb.processAliasedTable(tableExpr); err != nil {
+			return err
+		}
+		trb, ok := tpb.bldr.(*route)
+		if !ok || !trb.removeMultishardOptions() {
+			return errors.New("unsupported: recursive common table expression in cross-shard query")
+		}
+		switch {
+		case rb == nil:
+		case rb.MergeUnion(trb):
+			continue
+		case trb.MergeUnion(rb):
+			// A reference table can only be merged into the
+			// other route, which may come after it.
+		default:
+			return errors.New("unsupported: recursive common table expression in cross-shard query")
+		}
+		rb, pb.st = trb, tpb.st
+	}
+
+	// The columns of the statement are not resolved by the symtab.
+	// Their metadata is directly set to make them local to the route.
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = &column{origin: rb}
+		}
+		return true, nil
+	}, stmt)
+	rb.Select = stmt
+	rb.resultColumns = nil
+	for _, selectExpr := range firstSelect(stmt).SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			rb.resultColumns = append(rb.resultColumns, &resultColumn{column: &column{origin: rb}})
+			continue
+		}
+		rb.resultColumns = append(rb.resultColumns, newResultColumn(aliased, rb))
+	}
+	pb.bldr = rb
+	return nil
+}
+
+// findTableExprs returns the table references of the statement
+// that are not references to its common table expressions.
+func findTableExprs(stmt sqlparser.SelectStatement, with *sqlparser.With) []*sqlparser.AliasedTableExpr {
+	var tableExprs []*sqlparser.AliasedTableExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		if tableName.Qualifier.IsEmpty() && with.Find(tableName.Name) != nil {
+			return true, nil
+		}
+		tableExprs = append(tableExprs, tableExpr)
+		return true, nil
+	}, stmt)
+	return tableExprs
+}
@@ -83,37 +83,33 @@ func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Del
 		edel.Opcode = engine.DeleteEqual
 	}
 
-	if edel.Opcode == engine.DeleteScatter {
-		if len(edel.Table.Owned) != 0 {
-			return edel, errors.New("unsupported: multi shard delete on a table with owned lookup vindexes")
-		}
+	multiShard := edel.Opcode == engine.DeleteScatter
+	if multiShard {
 		if del.Limit != nil {
-			return edel, errors.New("unsupported: multi shard delete with limit")
+			if edel.Limit, err = buildDMLLimit(del.Where, del.OrderBy, del.Limit, edel.Table); err != nil {
+				return nil, err
+			}
+		}
+		// A multi-shard delete needs the keyspace id of every row
+		// to delete its vindex entries. It's computed from the primary vindex.
+		if len(edel.Table.Owned) != 0 {
+			edel.KsidVindex = edel.Table.ColumnVindexes[0].Vindex
 		}
 	}
 
-	edel.OwnedVindexQuery = generateDeleteSubquery(del, edel.Table)
+	edel.OwnedVindexQuery = generateDeleteSubquery(del, edel.Table, multiShard)
 	return edel, nil
 }
 
 // generateDeleteSubquery generates the query to fetch the rows
 // that will be deleted. This allows VTGate to clean up any
 // owned vindexes as needed.
-func generateDeleteSubquery(del *sqlparser.Delete, table *vindexes.Table) string {
+func generateDeleteSubquery(del *sqlparser.Delete, table *vindexes.Table, multiShard bool) string {
 	if len(table.Owned) == 0 {
 		return ""
 	}
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.WriteString("select ")
-	for vIdx, cv := range table.Owned {
-		for cIdx, column := range cv.Columns {
-			if cIdx == 0 && vIdx == 0 {
-				buf.Myprintf("%v", column)
-			} else {
-				buf.Myprintf(", %v", column)
-			}
-		}
-	}
-	buf.Myprintf(" from %v%v for update", table.Name, del.Where)
+	writeOwnedVindexColumns(buf, table, multiShard)
+	buf.Myprintf(" from %v%v%v%v for update", table.Name, del.Where, del.OrderBy, del.Limit)
 	return buf.String()
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built on top of a concatenate for a UNION
+// DISTINCT whose parts cannot be merged into a single route.
+type distinct struct {
+	resultsBuilder
+	edistinct *engine.Distinct
+	// weightStringsAllowed is false if the columns of the
+	// UNION are not known, because a part selects '*'.
+	weightStringsAllowed bool
+}
+
+// newDistinct builds a new distinct.
+func newDistinct(bldr builder, weightStringsAllowed bool) *distinct {
+	edistinct := &engine.Distinct{}
+	return &distinct{
+		resultsBuilder:       newResultsBuilder(bldr, edistinct),
+		edistinct:            edistinct,
+		weightStringsAllowed: weightStringsAllowed,
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.edistinct.Input = d.input.Primitive()
+	return d.edistinct
+}
+
+// PushFilter satisfies the builder interface.
+func (d *distinct) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("distinct.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() error {
+	return errors.New("distinct.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (d *distinct) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("distinct.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return d, nil
+	}
+	return newMemorySort(d, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The limit cannot be pushed down because duplicate
+// rows are discarded.
+func (d *distinct) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// The weight_string of the columns that may contain text is
+// requested from the input. The engine.Distinct uses it for the
+// values whose collation cannot be compared by vtgate. If the
+// columns are not known, such values fail at execution time.
+func (d *distinct) Wireup(bldr builder, jt *jointab) error {
+	if !d.weightStringsAllowed {
+		return d.input.Wireup(bldr, jt)
+	}
+	isWeightString := make(map[int]bool)
+	for _, weightcolNumber := range d.weightStrings {
+		isWeightString[weightcolNumber] = true
+	}
+	for i, rc := range d.resultColumns {
+		if isWeightString[i] || !mayBeText(rc) {
+			continue
+		}
+		weightcolNumber, ok := d.weightStrings[rc]
+		if !ok {
+			var err error
+			weightcolNumber, err = d.input.SupplyWeightString(i)
+			if err != nil {
+				return err
+			}
+		}
+		if d.edistinct.WeightStrings == nil {
+			d.edistinct.WeightStrings = make(map[int]int)
+		}
+		d.edistinct.WeightStrings[i] = weightcolNumber
+		if weightcolNumber >= len(d.resultColumns) {
+			d.edistinct.TruncateColumnCount = len(d.resultColumns)
+		}
+	}
+	return d.input.Wireup(bldr, jt)
+}
@@ -59,6 +59,9 @@ type subqueryInfo struct {
 	ast    *sqlparser.Subquery
 	bldr   builder
 	origin builder
+	// externs are the references of the subquery
+	// to the columns of the outer queries.
+	externs []*sqlparser.ColName
 }
 
 // findOrigin identifies the right-most origin referenced by expr. In situations where
@@ -130,8 +133,9 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 				return false, fmt.Errorf("BUG: unexpected SELECT type: %T", node)
 			}
 			sqi := subqueryInfo{
-				ast:  node,
-				bldr: spb.bldr,
+				ast:     node,
+				bldr:    spb.bldr,
+				externs: spb.st.Externs,
 			}
 			for _, extern := range spb.st.Externs {
 				// No error expected. These are resolved externs.
@@ -152,7 +156,9 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			return false, nil
 		case *sqlparser.FuncExpr:
 			switch {
-			// If it's last_insert_id, ensure it's a single unsharded route.
+			// If it's last_insert_id with an argument, ensure it's a single
+			// unsharded route. The calls without one were already replaced
+			// by rewriteLastInsertID.
 			case node.Name.EqualString("last_insert_id"):
 				if rb, isRoute := pb.bldr.(*route); !isRoute || !rb.removeShardedOptions() {
 					return false, errors.New("unsupported: LAST_INSERT_ID is only allowed for unsharded keyspaces")
@@ -173,7 +179,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			return nil, nil, nil, &correlatedSubqueryError{sqi: sqi}
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -228,6 +234,70 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 	return pullouts, highestOrigin, expr, nil
 }
 
+// correlatedSubqueryError is returned by findOrigin if a subquery
+// references the current query and cannot be merged with it. The
+// caller can use the subquery to build a semiJoin instead.
+type correlatedSubqueryError struct {
+	sqi subqueryInfo
+}
+
+func (err *correlatedSubqueryError) Error() string {
+	return "unsupported: cross-shard correlated subquery"
+}
+
+// pushSemiJoin builds a semiJoin on top of the current builder if the
+// filter is an EXISTS, NOT EXISTS, IN or NOT IN condition on the
+// correlated subquery. For IN and NOT IN, the left operand must be a
+// column of the current query. It returns false if a semiJoin cannot
+// be built for the filter.
+func (pb *primitiveBuilder) pushSemiJoin(filter sqlparser.Expr, sqi subqueryInfo) bool {
+	var opcode engine.SemiJoinOpcode
+	var lhsKey *sqlparser.ColName
+	switch node := skipParenthesis(filter).(type) {
+	case *sqlparser.ExistsExpr:
+		if node.Subquery != sqi.ast {
+			return false
+		}
+		opcode = engine.SemiJoinExists
+	case *sqlparser.NotExpr:
+		exists, ok := skipParenthesis(node.Expr).(*sqlparser.ExistsExpr)
+		if !ok || exists.Subquery != sqi.ast {
+			return false
+		}
+		opcode = engine.AntiJoinNotExists
+	case *sqlparser.ComparisonExpr:
+		if node.Right != sqi.ast {
+			return false
+		}
+		switch node.Operator {
+		case sqlparser.InStr:
+			opcode = engine.SemiJoinIn
+		case sqlparser.NotInStr:
+			opcode = engine.AntiJoinNotIn
+		default:
+			return false
+		}
+		col, ok := node.Left.(*sqlparser.ColName)
+		if !ok {
+			return false
+		}
+		// No error expected. The column was resolved by findOrigin.
+		if _, isLocal, _ := pb.st.Find(col); !isLocal {
+			return false
+		}
+		lhsKey = col
+	default:
+		return false
+	}
+	sj := newSemiJoin(opcode, pb.bldr, sqi.bldr, lhsKey)
+	if bldr, batchKey := pb.buildBatchedSubquery(opcode, sqi); bldr != nil {
+		sj.setBatch(bldr, batchKey)
+	}
+	pb.bldr = sj
+	pb.bldr.Reorder(0)
+	return true
+}
+
 func hasSubquery(node sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -0,0 +1,162 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ builder = (*filter)(nil)
+
+// filter is the builder for engine.Filter.
+// This gets built on top of an orderedAggregate if a HAVING
+// clause cannot be pushed down. For example, for
+// 'select a, count(*) from t group by a having sum(b) > 10',
+// the route will be asked for 'select a, count(*), sum(b) ...',
+// and the filter will evaluate the condition on the aggregated
+// results. The extra columns needed by the condition are
+// truncated from the final result.
+type filter struct {
+	resultsBuilder
+	efilter *engine.Filter
+}
+
+// newFilter builds a new filter.
+func newFilter(bldr builder) *filter {
+	efilter := &engine.Filter{}
+	return &filter{
+		resultsBuilder: newResultsBuilder(bldr, efilter),
+		efilter:        efilter,
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (fb *filter) Primitive() engine.Primitive {
+	if len(fb.input.ResultColumns()) > len(fb.resultColumns) {
+		fb.efilter.TruncateColumnCount = len(fb.resultColumns)
+	}
+	fb.efilter.Input = fb.input.Primitive()
+	return fb.efilter
+}
+
+// PushFilter satisfies the builder interface.
+// The aggregates and columns referenced by the expression are
+// requested from the underlying orderedAggregate.
+func (fb *filter) PushFilter(_ *primitiveBuilder, expr sqlparser.Expr, whereType string, _ builder) error {
+	oa, ok := fb.input.(*orderedAggregate)
+	if !ok {
+		return errors.New("filter.PushFilter: unreachable")
+	}
+	expr, err := expandAvg(expr)
+	if err != nil {
+		return err
+	}
+	aggrs, err := findAggregates(expr)
+	if err != nil {
+		return err
+	}
+	for _, aggr := range aggrs {
+		if aggr.Distinct {
+			return fmt.Errorf("unsupported: in scatter query: distinct aggregate in having clause: %s", sqlparser.String(aggr))
+		}
+	}
+	// Verify upfront that the expression can be evaluated
+	// before pushing anything down.
+	if _, err := evalengine.Convert(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return nil, nil
+			}
+			return evalengine.NewColumn(0), nil
+		case *sqlparser.ColName:
+			return evalengine.NewColumn(0), nil
+		}
+		return nil, nil
+	}); err != nil {
+		return fmt.Errorf("unsupported: filtering on results of aggregates: %s", sqlparser.String(expr))
+	}
+
+	predicate, err := evalengine.Convert(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return nil, nil
+			}
+			colNumber, err := oa.supplyAggr(node)
+			if err != nil {
+				return nil, err
+			}
+			return evalengine.NewColumn(colNumber), nil
+		case *sqlparser.ColName:
+			_, colNumber := oa.SupplyCol(node)
+			return evalengine.NewColumn(colNumber), nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if fb.efilter.Predicate == nil {
+		fb.efilter.Predicate = predicate
+		return nil
+	}
+	fb.efilter.Predicate = &evalengine.Logical{
+		Op:    evalengine.LogicalAnd,
+		Left:  fb.efilter.Predicate,
+		Right: predicate,
+	}
+	return nil
+}
+
+// PushSelect satisfies the builder interface.
+func (fb *filter) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("filter.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (fb *filter) MakeDistinct() error {
+	return errors.New("filter.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (fb *filter) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("filter.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The order by is pushed into the input. The rows can be
+// filtered after they're sorted.
+func (fb *filter) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := fb.input.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	fb.input = bldr
+	return fb, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The limit cannot be pushed down because rows
+// may be discarded by the filter.
+func (fb *filter) SetUpperLimit(count *sqlparser.SQLVal) {
+}
@@ -55,6 +55,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) e
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.directives = pb.directives
 	if err := rpb.processTableExprs(tableExprs[1:]); err != nil {
 		return err
 	}
@@ -104,6 +105,11 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 			if err := spb.processUnion(stmt, nil); err != nil {
 				return err
 			}
+			// The columns of a '*' are only known if the
+			// union is sent to MySQL as a single query.
+			if _, ok := spb.bldr.(*route); !ok && hasStar(stmt) {
+				return errors.New("unsupported: '*' expression in cross-shard UNION subquery")
+			}
 		default:
 			return fmt.Errorf("BUG: unexpected SELECT type: %T", stmt)
 		}
@@ -270,6 +276,7 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.directives = pb.directives
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
 	}
@@ -26,6 +26,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // buildInsertPlan builds the route for an INSERT statement.
@@ -47,10 +49,7 @@ func buildInsertPlan(ins *sqlparser.Insert, vschema ContextVSchema) (*engine.Ins
 		}
 		return buildInsertUnshardedPlan(ins, ro.vschemaTable, vschema)
 	}
-	if ins.Action == sqlparser.ReplaceStr {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, ro.vschemaTable)
+	return buildInsertShardedPlan(ins, ro.vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (*engine.Insert, error) {
@@ -97,7 +96,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vsch
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engine.Insert, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (*engine.Insert, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -106,11 +105,33 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 	if ins.Ignore != "" {
 		eins.Opcode = engine.InsertShardedIgnore
 	}
-	if ins.OnDup != nil {
-		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
+	var ownedAssignments []*sqlparser.UpdateExpr
+	switch {
+	case ins.Action == sqlparser.ReplaceStr:
+		eins.Opcode = engine.InsertShardedReplace
+	case ins.OnDup != nil:
+		var unowned []*vindexes.ColumnVindex
+		for _, colVindex := range eins.Table.ColumnVindexes {
+			if !colVindex.Owned {
+				unowned = append(unowned, colVindex)
+			}
+		}
+		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), unowned) {
 			return nil, errors.New("unsupported: DML cannot change vindex column")
 		}
 		eins.Opcode = engine.InsertShardedIgnore
+		ownedAssignments = ownedVindexAssignments(sqlparser.UpdateExprs(ins.OnDup), eins.Table)
+		if ownedAssignments != nil {
+			eins.Opcode = engine.InsertShardedUpsert
+		}
+	}
+	if (eins.Opcode == engine.InsertShardedReplace || eins.Opcode == engine.InsertShardedUpsert) && len(eins.Table.Owned) != 0 {
+		// The existing rows are selected by the values of the
+		// primary vindex, which must identify them.
+		if _, ok := eins.Table.ColumnVindexes[0].Vindex.(vindexes.MultiColumn); ok {
+			return nil, fmt.Errorf("unsupported: REPLACE or ON DUPLICATE KEY UPDATE with owned vindexes and a multi-column primary vindex: %s", eins.Table.ColumnVindexes[0].Name)
+		}
+		eins.OwnedVindexQuery = generateExistingRowsQuery(eins.Table)
 	}
 	if len(ins.Columns) == 0 {
 		if table.ColumnListAuthoritative {
@@ -130,7 +151,13 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+		if ownedAssignments != nil {
+			return nil, errors.New("unsupported: ON DUPLICATE KEY UPDATE of owned vindex columns with INSERT ... SELECT")
+		}
+		if err := buildInsertSelectPlan(ins, eins, insertValues.(sqlparser.SelectStatement), vschema); err != nil {
+			return nil, err
+		}
+		return eins, nil
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -167,6 +194,13 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 			}
 		}
 	}
+	if ownedAssignments != nil {
+		upsertValues, err := buildUpsertValues(ins, rows, ownedAssignments)
+		if err != nil {
+			return nil, err
+		}
+		eins.UpsertValues = upsertValues
+	}
 	for _, colVindex := range eins.Table.ColumnVindexes {
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
@@ -183,6 +217,86 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the Input of an INSERT ... SELECT into a
+// sharded table. The vindex and auto-inc columns that are not in the
+// column list are added to it, and are selected as NULL. Their values
+// are computed for every row by the engine, like for a regular insert.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, sel sqlparser.SelectStatement, vschema ContextVSchema) error {
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			eins.VindexValueOffset[vIdx] = append(eins.VindexValueOffset[vIdx], findOrAddSelectColumn(ins, sel, col))
+		}
+	}
+	if eins.Table.AutoIncrement != nil {
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Offset:   findOrAddSelectColumn(ins, sel, eins.Table.AutoIncrement.Column),
+		}
+	}
+	if !hasStar(sel) && len(firstSelect(sel).SelectExprs) != len(ins.Columns) {
+		return errors.New("column list doesn't match values")
+	}
+
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
+	if err := pb.processPart(sel, nil); err != nil {
+		return err
+	}
+	if err := pb.bldr.Wireup(pb.bldr, pb.jt); err != nil {
+		return err
+	}
+	eins.Input = pb.bldr.Primitive()
+
+	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		ins.Action, ins.Comments, ins.Ignore,
+		ins.Table, ins.Columns)
+	eins.Prefix = prefixBuf.String()
+	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	suffixBuf.Myprintf("%v", ins.OnDup)
+	eins.Suffix = suffixBuf.String()
+	return nil
+}
+
+// findOrAddSelectColumn finds the position of a column in an INSERT ... SELECT.
+// If it's absent, it appends it to the column list and selects NULL for it.
+func findOrAddSelectColumn(ins *sqlparser.Insert, sel sqlparser.SelectStatement, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	ins.Columns = append(ins.Columns, col)
+	addNullSelectExpr(sel)
+	return len(ins.Columns) - 1
+}
+
+// addNullSelectExpr appends NULL to the select list
+// of every part of the statement.
+func addNullSelectExpr(sel sqlparser.SelectStatement) {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}})
+	case *sqlparser.Union:
+		addNullSelectExpr(sel.Left)
+		addNullSelectExpr(sel.Right)
+	case *sqlparser.ParenSelect:
+		addNullSelectExpr(sel.Select)
+	}
+}
+
+// firstSelect returns the left-most SELECT of the statement.
+func firstSelect(sel sqlparser.SelectStatement) *sqlparser.Select {
+	switch sel := sel.(type) {
+	case *sqlparser.Union:
+		return firstSelect(sel.Left)
+	case *sqlparser.ParenSelect:
+		return firstSelect(sel.Select)
+	}
+	return sel.(*sqlparser.Select)
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -196,8 +310,8 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore,
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		node.Action, node.Comments, node.Ignore,
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -273,3 +387,73 @@ func isVindexChanging(setClauses sqlparser.UpdateExprs, colVindexes []*vindexes.
 	}
 	return false
 }
+
+// ownedVindexAssignments returns the update expressions
+// that modify the columns of owned vindexes.
+func ownedVindexAssignments(setClauses sqlparser.UpdateExprs, table *vindexes.Table) []*sqlparser.UpdateExpr {
+	var assignments []*sqlparser.UpdateExpr
+	for _, assignment := range setClauses {
+	outer:
+		for _, colVindex := range table.Owned {
+			for _, col := range colVindex.Columns {
+				if col.Equal(assignment.Name.Name) {
+					assignments = append(assignments, assignment)
+					break outer
+				}
+			}
+		}
+	}
+	return assignments
+}
+
+// buildUpsertValues builds the values that the ON DUPLICATE KEY UPDATE
+// assignments set for every row. An assignment must either be a value,
+// or the VALUES() of a column that is set to a value in all rows.
+func buildUpsertValues(ins *sqlparser.Insert, rows sqlparser.Values, assignments []*sqlparser.UpdateExpr) (map[string]sqltypes.PlanValue, error) {
+	upsertValues := make(map[string]sqltypes.PlanValue, len(assignments))
+	for _, assignment := range assignments {
+		values := sqltypes.PlanValue{Values: make([]sqltypes.PlanValue, len(rows))}
+		valuesFunc, ok := assignment.Expr.(*sqlparser.ValuesFuncExpr)
+		if !ok {
+			pv, err := extractValueFromUpdate(assignment)
+			if err != nil {
+				return nil, err
+			}
+			for rowNum := range rows {
+				values.Values[rowNum] = pv
+			}
+			upsertValues[assignment.Name.Name.Lowered()] = values
+			continue
+		}
+		colNum := -1
+		for i, column := range ins.Columns {
+			if column.Equal(valuesFunc.Name.Name) {
+				colNum = i
+				break
+			}
+		}
+		for rowNum, row := range rows {
+			if colNum == -1 || (!sqlparser.IsValue(row[colNum]) && !sqlparser.IsNull(row[colNum])) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Only values are supported. Invalid update on column: %v", assignment.Name.Name)
+			}
+			pv, err := sqlparser.NewPlanValue(row[colNum])
+			if err != nil {
+				return nil, err
+			}
+			values.Values[rowNum] = pv
+		}
+		upsertValues[assignment.Name.Name.Lowered()] = values
+	}
+	return upsertValues, nil
+}
+
+// generateExistingRowsQuery generates the query that selects the owned
+// vindex columns of the rows that a REPLACE or an upsert can change,
+// followed by all their columns. The engine finds the primary key of
+// the table in the flags of the returned fields.
+func generateExistingRowsQuery(table *vindexes.Table) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	writeOwnedVindexColumns(buf, table, false)
+	buf.Myprintf(", %v.* from %v where %v in ::%s for update", table.Name, table.Name, table.ColumnVindexes[0].Columns[0], engine.ListVarName)
+	return buf.String()
+}
@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ builder = (*join)(nil)
@@ -64,6 +65,57 @@ type join struct {
 	Left, Right builder
 
 	ejoin *engine.Join
+
+	// allowHash is set if the join can be built as a hash join.
+	// It's cleared if the RHS needs values from the LHS.
+	allowHash bool
+	// hashKeys are the equality conditions of a hash join. If
+	// there are none, a nested loop join is built.
+	hashKeys []*hashKey
+	// hashMaxRows is the MaxRows of the hash join.
+	hashMaxRows int
+
+	// postFilters and postExprs are the parts of the query that
+	// reference the RHS of a left join. They cannot be pushed into
+	// the RHS because they must also apply to the LHS rows that have
+	// no match. Instead, they're evaluated by vtgate on the joined
+	// rows. Wireup converts them into the Filter and PostExprs of
+	// the primitive.
+	postFilters []sqlparser.Expr
+	postExprs   []*joinPostExpr
+	// exprCols and filter are the ExprCols and Filter of the primitive.
+	exprCols []int
+	filter   evalengine.Expr
+}
+
+// joinPostExpr is a select expression of a join that is computed by vtgate.
+type joinPostExpr struct {
+	// col is the column number of the expression in the result.
+	col  int
+	expr sqlparser.Expr
+	// eexpr is the converted expression. It's set by Wireup.
+	eexpr evalengine.Expr
+	alias string
+}
+
+// hashKey is an equality condition between a column of the LHS
+// and a column of the RHS of a join. For a nested loop join, it
+// would have been pushed into the RHS as a filter. Instead, it's
+// kept aside as long as the join can be built as a hash join.
+type hashKey struct {
+	pb     *primitiveBuilder
+	filter sqlparser.Expr
+	origin builder
+
+	lhs, rhs *sqlparser.ColName
+	// lhsCol and rhsCol are the column numbers of lhs and rhs
+	// in the results of the LHS and RHS. They're set by Wireup.
+	lhsCol, rhsCol int
+	// lhsWeightCol and rhsWeightCol are the column numbers of the
+	// weight_string of lhs and rhs, if hasWeightStrings is set. They're
+	// requested by Wireup if both columns may contain text.
+	lhsWeightCol, rhsWeightCol int
+	hasWeightStrings           bool
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -81,7 +133,28 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 		switch {
 		case ajoin.Join == sqlparser.LeftJoinStr:
 			opcode = engine.LeftJoin
-
+		case ajoin.Condition.Using != nil:
+			return errors.New("unsupported: join with USING(column_list) clause")
+		}
+	}
+	jb := &join{
+		weightStrings: make(map[*resultColumn]int),
+		Left:          lpb.bldr,
+		Right:         rpb.bldr,
+		ejoin: &engine.Join{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+		allowHash:   lpb.directives.IsSet(sqlparser.DirectiveAllowHashJoin),
+		hashMaxRows: hashJoinMaxRows(lpb.directives),
+	}
+	jb.Reorder(0)
+	if opcode == engine.LeftJoin {
+		ok, err := jb.pushHashLeftJoinOn(lpb, ajoin.Condition.On)
+		if err != nil {
+			return err
+		}
+		if !ok {
 			// For left joins, we have to push the ON clause into the RHS.
 			// We do this before creating the join primitive.
 			// However, variables of LHS need to be visible. To allow this,
@@ -94,26 +167,79 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			if err := rpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr); err != nil {
 				return err
 			}
-		case ajoin.Condition.Using != nil:
-			return errors.New("unsupported: join with USING(column_list) clause")
+			// The RHS may have changed after the push.
+			jb.Right = rpb.bldr
+			jb.Reorder(0)
 		}
 	}
-	lpb.bldr = &join{
-		weightStrings: make(map[*resultColumn]int),
-		Left:          lpb.bldr,
-		Right:         rpb.bldr,
-		ejoin: &engine.Join{
-			Opcode: opcode,
-			Vars:   make(map[string]int),
-		},
-	}
-	lpb.bldr.Reorder(0)
+	lpb.bldr = jb
 	if ajoin == nil || opcode == engine.LeftJoin {
 		return nil
 	}
 	return lpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr)
 }
 
+// pushHashLeftJoinOn pushes the ON clause of a left join that can be
+// built as a hash join. This is possible if every condition is either an
+// equality between a column of the LHS and a column of the RHS, or only
+// references the RHS. The equalities become the keys of the hash join,
+// and the rest of the conditions are pushed into the RHS. If this is not
+// possible, the function returns false, and nothing is pushed.
+func (jb *join) pushHashLeftJoinOn(pb *primitiveBuilder, on sqlparser.Expr) (bool, error) {
+	if !jb.allowHash || hasSubquery(on) {
+		return false, nil
+	}
+	var keys []*hashKey
+	var rhsFilters []sqlparser.Expr
+	for _, filter := range splitAndExpression(nil, on) {
+		if key := jb.findHashKey(pb, filter, jb.Right); key != nil {
+			keys = append(keys, key)
+			continue
+		}
+		if jb.referencesLeft(pb, filter) || !jb.referencesRight(pb, filter) {
+			keys = nil
+			break
+		}
+		rhsFilters = append(rhsFilters, filter)
+	}
+	if keys == nil {
+		// The symbols were resolved against the scope of the join.
+		// They have to be resolved again against the scope of the RHS.
+		clearMetadata(on)
+		return false, nil
+	}
+	for _, filter := range rhsFilters {
+		if err := jb.Right.PushFilter(pb, filter, sqlparser.WhereStr, jb.Right); err != nil {
+			return false, err
+		}
+	}
+	jb.hashKeys = keys
+	return true, nil
+}
+
+// clearMetadata clears the symbol references of the columns in node.
+func clearMetadata(node sqlparser.SQLNode) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = nil
+		}
+		return true, nil
+	}, node)
+}
+
+// hashJoinMaxRows returns the DirectiveHashJoinMaxRows value if set, otherwise returns 0.
+func hashJoinMaxRows(d sqlparser.CommentDirectives) int {
+	val, ok := d[sqlparser.DirectiveHashJoinMaxRows]
+	if !ok {
+		return 0
+	}
+	intVal, ok := val.(int)
+	if ok {
+		return intVal
+	}
+	return 0
+}
+
 // Order satisfies the builder interface.
 func (jb *join) Order() int {
 	return jb.order
@@ -129,8 +255,45 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	var postExprs []engine.PostExprParams
+	for _, pe := range jb.postExprs {
+		postExprs = append(postExprs, engine.PostExprParams{
+			Col:   pe.col,
+			Expr:  pe.eexpr,
+			Alias: pe.alias,
+		})
+	}
+	if jb.isHashJoin() {
+		ehj := &engine.HashJoin{
+			Opcode:    jb.ejoin.Opcode,
+			Left:      jb.Left.Primitive(),
+			Right:     jb.Right.Primitive(),
+			Cols:      jb.ejoin.Cols,
+			MaxRows:   jb.hashMaxRows,
+			ExprCols:  jb.exprCols,
+			Filter:    jb.filter,
+			PostExprs: postExprs,
+		}
+		for _, key := range jb.hashKeys {
+			ehj.LHSKeys = append(ehj.LHSKeys, key.lhsCol)
+			ehj.RHSKeys = append(ehj.RHSKeys, key.rhsCol)
+			if !key.hasWeightStrings {
+				continue
+			}
+			if ehj.LHSWeightStrings == nil {
+				ehj.LHSWeightStrings = make(map[int]int)
+				ehj.RHSWeightStrings = make(map[int]int)
+			}
+			ehj.LHSWeightStrings[key.lhsCol] = key.lhsWeightCol
+			ehj.RHSWeightStrings[key.rhsCol] = key.rhsWeightCol
+		}
+		return ehj
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
+	jb.ejoin.ExprCols = jb.exprCols
+	jb.ejoin.Filter = jb.filter
+	jb.ejoin.PostExprs = postExprs
 	return jb.ejoin
 }
 
@@ -150,7 +313,20 @@ func (jb *join) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereTyp
 		return jb.Left.PushFilter(pb, filter, whereType, origin)
 	}
 	if jb.ejoin.Opcode == engine.LeftJoin {
-		return errors.New("unsupported: cross-shard left join and where clause")
+		if !jb.canEvaluate(filter) {
+			return errors.New("unsupported: cross-shard left join and where clause")
+		}
+		jb.postFilters = append(jb.postFilters, filter)
+		return nil
+	}
+	if jb.allowHash && whereType == sqlparser.WhereStr {
+		if key := jb.findHashKey(pb, filter, origin); key != nil {
+			jb.hashKeys = append(jb.hashKeys, key)
+			return nil
+		}
+	}
+	if err := jb.checkHashRHS(pb, filter); err != nil {
+		return err
 	}
 	return jb.Right.PushFilter(pb, filter, whereType, origin)
 }
@@ -164,9 +340,14 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 		}
 		jb.ejoin.Cols = append(jb.ejoin.Cols, -colNumber-1)
 	} else {
-		// Pushing of non-trivial expressions not allowed for RHS of left joins.
+		// Non-trivial expressions are not pushed into the RHS of left joins,
+		// because they would not be evaluated for the LHS rows that have no
+		// match. They're evaluated by vtgate instead.
 		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+			return jb.pushPostExpr(expr)
+		}
+		if err := jb.checkHashRHS(pb, expr.Expr); err != nil {
+			return nil, 0, err
 		}
 
 		rc, colNumber, err = jb.Right.PushSelect(pb, expr, origin)
@@ -179,6 +360,105 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 	return rc, len(jb.resultColumns) - 1, nil
 }
 
+// pushPostExpr adds a select expression that's evaluated by vtgate.
+// The column is 0 in Cols.
+func (jb *join) pushPostExpr(expr *sqlparser.AliasedExpr) (rc *resultColumn, colNumber int, err error) {
+	if !jb.canEvaluate(expr.Expr) {
+		return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+	}
+	alias := expr.As.String()
+	if expr.As.IsEmpty() {
+		alias = sqlparser.String(expr.Expr)
+	}
+	rc = newResultColumn(expr, jb)
+	jb.resultColumns = append(jb.resultColumns, rc)
+	jb.ejoin.Cols = append(jb.ejoin.Cols, 0)
+	jb.postExprs = append(jb.postExprs, &joinPostExpr{
+		col:   len(jb.resultColumns) - 1,
+		expr:  expr.Expr,
+		alias: alias,
+	})
+	return rc, len(jb.resultColumns) - 1, nil
+}
+
+// canEvaluate returns true if vtgate can evaluate the expression on the
+// joined rows. It must only reference columns of the join.
+func (jb *join) canEvaluate(expr sqlparser.Expr) bool {
+	_, err := evalengine.Convert(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return nil, nil
+		}
+		if !jb.hasColumn(col.Metadata.(*column)) {
+			return nil, errors.New("column not in join")
+		}
+		return evalengine.NewColumn(0), nil
+	})
+	return err == nil
+}
+
+// hasColumn returns true if the column belongs to a node of the join,
+// or to the join itself.
+func (jb *join) hasColumn(c *column) bool {
+	order := c.Origin().Order()
+	return order >= jb.Left.First().Order() && order <= jb.order
+}
+
+// convertPostExprs converts the post filters and post expressions into
+// evalengine expressions. The columns they reference are supplied by
+// the LHS and RHS, and are added to exprCols.
+func (jb *join) convertPostExprs() error {
+	exprCols := make(map[*column]int)
+	convert := func(expr sqlparser.Expr) (evalengine.Expr, error) {
+		return evalengine.Convert(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+			col, ok := node.(*sqlparser.ColName)
+			if !ok {
+				return nil, nil
+			}
+			c := col.Metadata.(*column)
+			// References to a computed column are replaced by its expression.
+			for _, pe := range jb.postExprs {
+				if jb.resultColumns[pe.col].column == c && pe.eexpr != nil {
+					return pe.eexpr, nil
+				}
+			}
+			if i, ok := exprCols[c]; ok {
+				return evalengine.NewColumn(i), nil
+			}
+			var index int
+			if jb.isOnLeft(c.Origin().Order()) {
+				_, sourceCol := jb.Left.SupplyCol(col)
+				index = -sourceCol - 1
+			} else {
+				_, sourceCol := jb.Right.SupplyCol(col)
+				index = sourceCol + 1
+			}
+			exprCols[c] = len(jb.exprCols)
+			jb.exprCols = append(jb.exprCols, index)
+			return evalengine.NewColumn(exprCols[c]), nil
+		})
+	}
+	for _, pe := range jb.postExprs {
+		eexpr, err := convert(pe.expr)
+		if err != nil {
+			return err
+		}
+		pe.eexpr = eexpr
+	}
+	for _, filter := range jb.postFilters {
+		eexpr, err := convert(filter)
+		if err != nil {
+			return err
+		}
+		if jb.filter == nil {
+			jb.filter = eexpr
+			continue
+		}
+		jb.filter = &evalengine.Logical{Op: evalengine.LogicalAnd, Left: jb.filter, Right: eexpr}
+	}
+	return nil
+}
+
 // MakeDistinct satisfies the builder interface.
 func (jb *join) MakeDistinct() error {
 	return errors.New("unsupported: distinct on cross-shard join")
@@ -207,6 +487,10 @@ func (jb *join) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 			}
 		}
 	}
+	if !isSpecial && jb.isHashJoin() {
+		// A hash join returns the rows in the order of the RHS.
+		return newMemorySort(jb, orderBy)
+	}
 	if isSpecial {
 		l, err := jb.Left.PushOrderBy(orderBy)
 		if err != nil {
@@ -283,11 +567,41 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if jb.isHashJoin() {
+		for _, key := range jb.hashKeys {
+			var lrc, rrc *resultColumn
+			lrc, key.lhsCol = jb.Left.SupplyCol(key.lhs)
+			rrc, key.rhsCol = jb.Right.SupplyCol(key.rhs)
+			// Text values can only be compared by vtgate if their
+			// collation is modeled exactly. Otherwise, their
+			// weight_string is compared.
+			if !mayBeText(lrc) || !mayBeText(rrc) {
+				continue
+			}
+			var err error
+			if key.lhsWeightCol, err = jb.Left.SupplyWeightString(key.lhsCol); err != nil {
+				return err
+			}
+			if key.rhsWeightCol, err = jb.Right.SupplyWeightString(key.rhsCol); err != nil {
+				return err
+			}
+			key.hasWeightStrings = true
+		}
+	}
+	if err := jb.convertPostExprs(); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
 	}
-	return jb.Left.Wireup(bldr, jt)
+	if err := jb.Left.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	if jb.isHashJoin() && len(jb.ejoin.Vars) != 0 {
+		return errors.New("BUG: the RHS of a hash join cannot reference the LHS")
+	}
+	return nil
 }
 
 // SupplyVar satisfies the builder interface.
@@ -306,7 +620,7 @@ func (jb *join) SupplyVar(from, to int, col *sqlparser.ColName, varname string)
 	}
 	c := col.Metadata.(*column)
 	for i, rc := range jb.resultColumns {
-		if jb.ejoin.Cols[i] > 0 {
+		if jb.ejoin.Cols[i] >= 0 {
 			continue
 		}
 		if rc.column == c {
@@ -345,6 +659,9 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 	if weightcolNumber, ok := jb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	if jb.ejoin.Cols[colNumber] == 0 {
+		return 0, errors.New("unsupported: cannot compute the weight string of an expression evaluated by vtgate")
+	}
 	routeNumber := rc.column.Origin().Order()
 	if jb.isOnLeft(routeNumber) {
 		sourceCol, err := jb.Left.SupplyWeightString(-jb.ejoin.Cols[colNumber] - 1)
@@ -364,6 +681,104 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 	return len(jb.ejoin.Cols) - 1, nil
 }
 
+// isHashJoin returns true if the join must be built as a hash join.
+func (jb *join) isHashJoin() bool {
+	return jb.allowHash && len(jb.hashKeys) != 0
+}
+
+// findHashKey returns a hashKey if filter is an equality between
+// a column of the LHS and a column of the RHS. Otherwise, it returns nil.
+func (jb *join) findHashKey(pb *primitiveBuilder, filter sqlparser.Expr, origin builder) *hashKey {
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualStr {
+		return nil
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	switch {
+	case jb.isColOnLeft(pb, left) && jb.isColOnRight(pb, right):
+		return &hashKey{pb: pb, filter: filter, origin: origin, lhs: left, rhs: right}
+	case jb.isColOnLeft(pb, right) && jb.isColOnRight(pb, left):
+		return &hashKey{pb: pb, filter: filter, origin: origin, lhs: right, rhs: left}
+	}
+	return nil
+}
+
+// checkHashRHS verifies that expr, which is being pushed into the RHS,
+// doesn't reference the LHS. If it does, the RHS needs values from the
+// LHS, and the join is turned back into a nested loop join. The
+// conditions that were kept aside as hash keys are then pushed into the
+// RHS.
+func (jb *join) checkHashRHS(pb *primitiveBuilder, expr sqlparser.Expr) error {
+	if !jb.allowHash || !jb.referencesLeft(pb, expr) {
+		return nil
+	}
+	if jb.ejoin.Opcode == engine.LeftJoin {
+		return errors.New("BUG: the RHS of a hash left join cannot reference the LHS")
+	}
+	keys := jb.hashKeys
+	jb.allowHash = false
+	jb.hashKeys = nil
+	for _, key := range keys {
+		if err := jb.Right.PushFilter(key.pb, key.filter, sqlparser.WhereStr, key.origin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencesLeft returns true if expr references a column of the LHS.
+func (jb *join) referencesLeft(pb *primitiveBuilder, expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok && jb.isColOnLeft(pb, col) {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+// referencesRight returns true if expr references a column of the RHS.
+func (jb *join) referencesRight(pb *primitiveBuilder, expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok && jb.isColOnRight(pb, col) {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+// isColOnLeft returns true if col is a column of a node that's
+// on the left side of the join.
+func (jb *join) isColOnLeft(pb *primitiveBuilder, col *sqlparser.ColName) bool {
+	origin, isLocal, err := pb.st.Find(col)
+	if err != nil || !isLocal {
+		return false
+	}
+	return origin.Order() >= jb.Left.First().Order() && jb.isOnLeft(origin.Order())
+}
+
+// isColOnRight returns true if col is a column of a node that's
+// on the right side of the join.
+func (jb *join) isColOnRight(pb *primitiveBuilder, col *sqlparser.ColName) bool {
+	origin, isLocal, err := pb.st.Find(col)
+	if err != nil || !isLocal {
+		return false
+	}
+	return !jb.isOnLeft(origin.Order()) && origin.Order() <= jb.order
+}
+
 // isOnLeft returns true if the specified route number
 // is on the left side of the join. If false, it means
 // the node is on the right.
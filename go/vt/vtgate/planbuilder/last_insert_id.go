@@ -0,0 +1,95 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// rewriteLastInsertID replaces the LAST_INSERT_ID() calls of the
+// statement with the engine.LastInsertIDName bind variable. vtgate
+// sets it to the last value generated for the session, which
+// includes the values of its own sequences. This makes LAST_INSERT_ID()
+// work in sharded keyspaces, where the MySQL connection that generated
+// the value is not known. Calls with an argument are left as is.
+// It returns true if the statement had such calls.
+func rewriteLastInsertID(stmt sqlparser.Statement) bool {
+	found := false
+	rewrite := func(expr sqlparser.Expr) sqlparser.Expr {
+		if expr == nil {
+			return nil
+		}
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *sqlparser.Subquery:
+				// Subqueries are rewritten on their own.
+				return false, nil
+			case *sqlparser.FuncExpr:
+				if isLastInsertID(node) {
+					expr = sqlparser.ReplaceExpr(expr, node, sqlparser.NewValArg([]byte(":"+engine.LastInsertIDName)))
+					found = true
+				}
+			}
+			return true, nil
+		}, expr)
+		return expr
+	}
+
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedExpr:
+			orig := sqlparser.String(node.Expr)
+			node.Expr = rewrite(node.Expr)
+			// The column keeps the name MySQL would give it.
+			if node.As.IsEmpty() && sqlparser.String(node.Expr) != orig {
+				node.As = sqlparser.NewColIdent(orig)
+			}
+		case *sqlparser.Where:
+			if node != nil {
+				node.Expr = rewrite(node.Expr)
+			}
+		case *sqlparser.JoinTableExpr:
+			node.Condition.On = rewrite(node.Condition.On)
+		case *sqlparser.Order:
+			node.Expr = rewrite(node.Expr)
+		case *sqlparser.Limit:
+			if node != nil {
+				node.Offset = rewrite(node.Offset)
+				node.Rowcount = rewrite(node.Rowcount)
+			}
+		case *sqlparser.UpdateExpr:
+			node.Expr = rewrite(node.Expr)
+		case sqlparser.GroupBy:
+			for i, expr := range node {
+				node[i] = rewrite(expr)
+			}
+		case sqlparser.Values:
+			for _, row := range node {
+				for i, expr := range row {
+					row[i] = rewrite(expr)
+				}
+			}
+		}
+		return true, nil
+	}, stmt)
+	return found
+}
+
+func isLastInsertID(node *sqlparser.FuncExpr) bool {
+	return node.Qualifier.IsEmpty() && node.Name.EqualString("last_insert_id") && len(node.Exprs) == 0
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// lockFuncs are the MySQL advisory lock functions. MySQL keeps
+// the locks per connection, so the queries that use them must
+// all be sent to the same connection.
+var lockFuncs = map[string]bool{
+	"get_lock":          true,
+	"release_lock":      true,
+	"release_all_locks": true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+// hasLockFunc returns true if the select expressions
+// call one of the advisory lock functions.
+func hasLockFunc(sel *sqlparser.Select) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if fn, ok := node.(*sqlparser.FuncExpr); ok && fn.Qualifier.IsEmpty() && lockFuncs[fn.Name.Lowered()] {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, sel.SelectExprs)
+	return found
+}
+
+// buildLockPlan builds the plan for a select that calls the advisory
+// lock functions. It must select from dual. The locks are taken on
+// the shard of the keyspace that holds keyspace id 0x00, which is the
+// first shard of sharded keyspaces. A shard targeted by the session
+// is used instead.
+func buildLockPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	if sel.With != nil || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil || sel.Lock != "" {
+		return nil, errors.New("unsupported: lock functions in a query with clauses other than SELECT and FROM")
+	}
+	if len(sel.From) != 1 {
+		return nil, errors.New("unsupported: lock functions in a query that doesn't select from dual")
+	}
+	ate, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, errors.New("unsupported: lock functions in a query that doesn't select from dual")
+	}
+	tableName, ok := ate.Expr.(sqlparser.TableName)
+	if !ok || tableName.Name.String() != "dual" {
+		return nil, errors.New("unsupported: lock functions in a query that doesn't select from dual")
+	}
+	table, _, _, destination, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if destination == nil {
+		destination = key.DestinationKeyspaceID([]byte{0})
+	}
+
+	// The keyspace qualifier must not be sent to the tablet.
+	ate.Expr = sqlparser.TableName{Name: tableName.Name}
+	buf := sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery)
+	buf.Myprintf("%v", sel)
+	return &engine.Lock{
+		Keyspace:          table.Keyspace,
+		TargetDestination: destination,
+		Query:             sqlparser.String(sel),
+		FieldQuery:        buf.String(),
+	}, nil
+}
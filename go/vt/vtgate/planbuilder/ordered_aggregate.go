@@ -24,6 +24,8 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 var _ builder = (*orderedAggregate)(nil)
@@ -53,10 +55,45 @@ var _ builder = (*orderedAggregate)(nil)
 //      Keys: []int{0, 1},
 //      Input: (Scatter Route with the order by request),
 //    }
+// If the results cannot be ordered by the grouping columns, like
+// for 'select count(*) from t group by a+1', an engine.HashAggregate
+// is built instead. It has the same parameters, but doesn't need the
+// ordering. The aggregation parameters are still accumulated in eaggr,
+// and only copied into the HashAggregate by Primitive.
 type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+	postExprs     []*postExpr
+	// extraKeys contains the group by columns that are not in
+	// the select list, indexed by their column number in the input.
+	extraKeys map[int]*sqlparser.ColName
+	// hash is set if the aggregation must be performed
+	// by an engine.HashAggregate.
+	hash bool
+	// hashWeightStrings maps the input columns compared by the
+	// engine.HashAggregate to their weight_string columns.
+	hashWeightStrings map[int]int
+}
+
+// postExpr is a select expression that contains aggregates, but
+// cannot be pushed down as is. For example, for
+// 'select 1+count(*), avg(col) from t', the route will be asked for
+// 'select count(*), sum(col), count(col) from t', and oa will compute
+// 1+count(*) and sum(col)/count(col) after the aggregation is done.
+// Only the first aggregate of the expression is pushed down while
+// the select list is built. This allows the result of the expression
+// to occupy that column. The rest of its aggregates and columns are
+// pushed down after the select list is complete. They're truncated
+// from the final result.
+type postExpr struct {
+	// col is the column number of the expression.
+	col int
+	// expr is the expression, with AVG expanded into SUM/COUNT.
+	expr sqlparser.Expr
+	// first is the aggregate that was pushed down into col.
+	first *sqlparser.FuncExpr
+	alias string
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -106,7 +143,7 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 				switch selectExpr := selectExpr.(type) {
 				case *sqlparser.AliasedExpr:
 					vindex := ro.FindVindex(pb, selectExpr.Expr)
-					if vindex != nil && vindex.IsUnique() {
+					if vindex != nil && vindexes.MapsToKeyspaceID(vindex) {
 						return true
 					}
 				}
@@ -211,7 +248,7 @@ func (pb *primitiveBuilder) groupByHasUniqueVindex(sel *sqlparser.Select, rb *ro
 				continue
 			}
 			vindex := ro.FindVindex(pb, matchedExpr)
-			if vindex != nil && vindex.IsUnique() {
+			if vindex != nil && vindexes.MapsToKeyspaceID(vindex) {
 				return true
 			}
 		}
@@ -239,6 +276,17 @@ func findAlias(colname *sqlparser.ColName, selects sqlparser.SelectExprs) sqlpar
 
 // Primitive satisfies the builder interface.
 func (oa *orderedAggregate) Primitive() engine.Primitive {
+	if oa.hash {
+		return &engine.HashAggregate{
+			HasDistinct:         oa.eaggr.HasDistinct,
+			Aggregates:          oa.eaggr.Aggregates,
+			Keys:                oa.eaggr.Keys,
+			WeightStrings:       oa.hashWeightStrings,
+			PostExprs:           oa.eaggr.PostExprs,
+			TruncateColumnCount: oa.eaggr.TruncateColumnCount,
+			Input:               oa.input.Primitive(),
+		}
+	}
 	oa.eaggr.Input = oa.input.Primitive()
 	return oa.eaggr
 }
@@ -267,9 +315,9 @@ func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.Ali
 		}
 	}
 
-	// Ensure that there are no aggregates in the expression.
+	// Expressions that contain aggregates are evaluated by oa.
 	if nodeHasAggregates(expr.Expr) {
-		return nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
+		return oa.pushPostExpr(pb, expr, origin)
 	}
 
 	innerRC, _, _ := oa.input.PushSelect(pb, expr, origin)
@@ -278,26 +326,58 @@ func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.Ali
 }
 
 func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if _, err := oa.pushAggrFunc(pb, expr, origin); err != nil {
+		return nil, 0, err
+	}
+
+	// Build a new rc with oa as origin because it's semantically different
+	// from the expression we pushed down.
+	rc = newResultColumn(expr, oa)
+	oa.resultColumns = append(oa.resultColumns, rc)
+	return rc, len(oa.resultColumns) - 1, nil
+}
+
+// supplyAggr pushes an aggregate that's not in the select list, like
+// the ones referenced by a HAVING clause. The result is added as an
+// extra column that's truncated from the final result.
+func (oa *orderedAggregate) supplyAggr(funcExpr *sqlparser.FuncExpr) (colNumber int, err error) {
+	// It's ok to pass nil for pb and builder because distinct
+	// aggregates are not allowed here.
+	innerCol, err := oa.pushAggrFunc(nil, &sqlparser.AliasedExpr{Expr: funcExpr}, nil)
+	if err != nil {
+		return 0, err
+	}
+	// Add result columns from input until innerCol is reached.
+	for innerCol >= len(oa.resultColumns) {
+		oa.resultColumns = append(oa.resultColumns, oa.input.ResultColumns()[len(oa.resultColumns)])
+	}
+	oa.truncater.SetTruncateColumnCount(len(oa.resultColumns))
+	return innerCol, nil
+}
+
+// pushAggrFunc pushes the aggregate function of expr down into the
+// underlying route and adds the corresponding aggregate to the primitive.
+// It returns the column number of the value that has to be aggregated.
+func (oa *orderedAggregate) pushAggrFunc(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (innerCol int, err error) {
 	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
 	opcode := engine.SupportedAggregates[funcExpr.Name.Lowered()]
 	if len(funcExpr.Exprs) != 1 {
-		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
+		return 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
 	}
-	var innerCol int
 	handleDistinct, innerAliased, err := oa.needDistinctHandling(pb, funcExpr, opcode)
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
 	if handleDistinct {
 		if oa.extraDistinct != nil {
-			return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
+			return 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
 		}
 		// Push the expression that's inside the aggregate.
 		// The column will eventually get added to the group by and order by clauses.
 		_, innerCol, _ = oa.input.PushSelect(pb, innerAliased, origin)
 		col, err := BuildColName(oa.input.ResultColumns(), innerCol)
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		oa.extraDistinct = col
 		oa.eaggr.HasDistinct = true
@@ -318,21 +398,186 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			Col:    innerCol,
 			Alias:  alias,
 		})
+		return innerCol, nil
+	}
+	_, innerCol, _ = oa.input.PushSelect(pb, expr, origin)
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+		Opcode: opcode,
+		Col:    innerCol,
+	})
+	return innerCol, nil
+}
+
+// pushPostExpr pushes a select expression that contains aggregates,
+// but needs to be evaluated after aggregation. The first aggregate
+// of the expression is pushed down immediately. The rest is pushed
+// down by pushPostExprs.
+func (oa *orderedAggregate) pushPostExpr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	var alias string
+	if expr.As.IsEmpty() {
+		alias = sqlparser.String(expr.Expr)
 	} else {
-		_, innerCol, _ = oa.input.PushSelect(pb, expr, origin)
-		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
-			Opcode: opcode,
-			Col:    innerCol,
-		})
+		alias = expr.As.String()
+	}
+	pexpr, err := expandAvg(expr.Expr)
+	if err != nil {
+		return nil, 0, err
+	}
+	aggrs, err := findAggregates(pexpr)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, aggr := range aggrs[1:] {
+		if aggr.Distinct {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: distinct aggregate in complex expression: %s", alias)
+		}
+	}
+	// Verify upfront that the rest of the expression can be evaluated.
+	if _, err := evalengine.Convert(pexpr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		switch node.(type) {
+		case *sqlparser.FuncExpr, *sqlparser.ColName:
+			if node, ok := node.(*sqlparser.FuncExpr); ok && !node.IsAggregate() {
+				return nil, nil
+			}
+			return evalengine.NewColumn(0), nil
+		}
+		return nil, nil
+	}); err != nil {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: complex aggregate expression: %s", alias)
 	}
 
-	// Build a new rc with oa as origin because it's semantically different
-	// from the expression we pushed down.
+	innerCol, err := oa.pushAggrFunc(pb, &sqlparser.AliasedExpr{Expr: aggrs[0]}, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	oa.postExprs = append(oa.postExprs, &postExpr{
+		col:   innerCol,
+		expr:  pexpr,
+		first: aggrs[0],
+		alias: alias,
+	})
 	rc = newResultColumn(expr, oa)
 	oa.resultColumns = append(oa.resultColumns, rc)
 	return rc, len(oa.resultColumns) - 1, nil
 }
 
+// pushPostExprs pushes down the remaining aggregates and columns
+// needed by the post expressions, and builds the corresponding
+// engine expressions. It must be called after the select list is
+// complete.
+func (oa *orderedAggregate) pushPostExprs() error {
+	for _, pe := range oa.postExprs {
+		expr, err := evalengine.Convert(pe.expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+			switch node := node.(type) {
+			case *sqlparser.FuncExpr:
+				if !node.IsAggregate() {
+					return nil, nil
+				}
+				if node == pe.first {
+					return evalengine.NewColumn(pe.col), nil
+				}
+				// It's ok to pass nil for pb and builder because the aggregate
+				// doesn't need distinct handling.
+				innerCol, err := oa.pushAggrFunc(nil, &sqlparser.AliasedExpr{Expr: node}, nil)
+				if err != nil {
+					return nil, err
+				}
+				return evalengine.NewColumn(innerCol), nil
+			case *sqlparser.ColName:
+				_, colNumber := oa.input.SupplyCol(node)
+				return evalengine.NewColumn(colNumber), nil
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return err
+		}
+		oa.eaggr.PostExprs = append(oa.eaggr.PostExprs, engine.PostExprParams{
+			Col:   pe.col,
+			Expr:  expr,
+			Alias: pe.alias,
+		})
+	}
+	oa.postExprs = nil
+	if len(oa.input.ResultColumns()) > len(oa.resultColumns) {
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
+	return nil
+}
+
+// expandAvg rewrites the AVG functions of the expression as
+// SUM/COUNT, which can be aggregated across shards. AVG(DISTINCT)
+// is not supported because it would need two distinct aggregates.
+func expandAvg(expr sqlparser.Expr) (sqlparser.Expr, error) {
+	var avgs []*sqlparser.FuncExpr
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Name.Lowered() == "avg" {
+				if node.Distinct {
+					return false, fmt.Errorf("unsupported: in scatter query: distinct aggregate avg: %s", sqlparser.String(node))
+				}
+				avgs = append(avgs, node)
+				return false, nil
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+	for _, avg := range avgs {
+		expr = sqlparser.ReplaceExpr(expr, avg, &sqlparser.ParenExpr{
+			Expr: &sqlparser.BinaryExpr{
+				Operator: sqlparser.DivStr,
+				Left: &sqlparser.FuncExpr{
+					Name:  sqlparser.NewColIdent("sum"),
+					Exprs: avg.Exprs,
+				},
+				Right: &sqlparser.FuncExpr{
+					Name:  sqlparser.NewColIdent("count"),
+					Exprs: avg.Exprs,
+				},
+			},
+		})
+	}
+	return expr, nil
+}
+
+// findAggregates returns the aggregate functions of the expression
+// in the order in which they appear. It returns an error if any of
+// them cannot be aggregated by the engine.
+func findAggregates(expr sqlparser.Expr) ([]*sqlparser.FuncExpr, error) {
+	var aggrs []*sqlparser.FuncExpr
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true, nil
+			}
+			if _, ok := engine.SupportedAggregates[node.Name.Lowered()]; !ok {
+				return false, fmt.Errorf("unsupported: in scatter query: aggregation function '%s'", node.Name.Lowered())
+			}
+			if len(node.Exprs) != 1 {
+				return false, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(node))
+			}
+			aggrs = append(aggrs, node)
+			return false, nil
+		case *sqlparser.GroupConcatExpr:
+			return false, fmt.Errorf("unsupported: in scatter query: aggregation function '%s'", sqlparser.String(node))
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+	return aggrs, nil
+}
+
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
 // If true, it will also return the aliased expression that needs to be pushed
 // down into the underlying route.
@@ -354,7 +599,7 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 	}
 	success := rb.removeOptions(func(ro *routeOption) bool {
 		vindex := ro.FindVindex(pb, innerAliased.Expr)
-		if vindex != nil && vindex.IsUnique() {
+		if vindex != nil && vindexes.MapsToKeyspaceID(vindex) {
 			return true
 		}
 		return false
@@ -377,9 +622,15 @@ func (oa *orderedAggregate) MakeDistinct() error {
 }
 
 // PushGroupBy satisfies the builder interface.
+// Group by columns that are not in the select list are requested
+// from the route as extra columns, which get truncated from the
+// final result. For expressions that are not simple column
+// references, the route cannot order the rows by them. Such
+// expressions are also requested as extra columns, but oa
+// switches to hash aggregation.
 func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
-	colNumber := -1
 	for _, expr := range groupBy {
+		colNumber := -1
 		switch node := expr.(type) {
 		case *sqlparser.ColName:
 			c := node.Metadata.(*column)
@@ -393,7 +644,11 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 				}
 			}
 			if colNumber == -1 {
-				return errors.New("unsupported: in scatter query: group by column must reference column in SELECT list")
+				_, colNumber = oa.input.SupplyCol(node)
+				if oa.extraKeys == nil {
+					oa.extraKeys = make(map[int]*sqlparser.ColName)
+				}
+				oa.extraKeys[colNumber] = node
 			}
 		case *sqlparser.SQLVal:
 			num, err := ResultFromNumber(oa.resultColumns, node)
@@ -402,10 +657,22 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 			}
 			colNumber = num
 		default:
-			return errors.New("unsupported: in scatter query: only simple references allowed")
+			if nodeHasAggregates(node) {
+				return fmt.Errorf("group by expression cannot reference an aggregate function: %v", sqlparser.String(node))
+			}
+			colNumber = oa.findSelectExpr(node)
+			if colNumber == -1 {
+				// It's ok to pass nil for pb and builder because the expression
+				// is pushed as is into the route.
+				_, colNumber, _ = oa.input.PushSelect(nil, &sqlparser.AliasedExpr{Expr: node}, nil)
+			}
+			oa.hash = true
 		}
 		oa.eaggr.Keys = append(oa.eaggr.Keys, colNumber)
 	}
+	if len(oa.input.ResultColumns()) > len(oa.resultColumns) {
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
 	// Append the distinct aggregate if any.
 	if oa.extraDistinct != nil {
 		groupBy = append(groupBy, oa.extraDistinct)
@@ -416,6 +683,29 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 	return nil
 }
 
+// findSelectExpr returns the number of the select column that
+// contains the same expression as expr, or -1 if there's none.
+func (oa *orderedAggregate) findSelectExpr(expr sqlparser.Expr) int {
+	rb, ok := oa.input.(*route)
+	if !ok {
+		return -1
+	}
+	want := sqlparser.String(expr)
+	for i, selectExpr := range rb.Select.(*sqlparser.Select).SelectExprs[:len(oa.resultColumns)] {
+		if oa.resultColumns[i].column.Origin() == oa {
+			continue
+		}
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if sqlparser.String(aliased.Expr) == want {
+			return i
+		}
+	}
+	return -1
+}
+
 // PushOrderBy pushes the order by expression into the primitive.
 // The requested order must be such that the ordering can be done
 // before the group by, which will allow us to push it down to the
@@ -429,6 +719,12 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 // The following construct is not allowed:
 // 'select a, count(*) from t group by a order by count(*)'
 func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// The select list is complete. So, the post expressions can now
+	// push down their remaining columns.
+	if err := oa.pushPostExprs(); err != nil {
+		return nil, err
+	}
+
 	// Treat order by null as nil order by.
 	if len(orderBy) == 1 {
 		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
@@ -436,6 +732,15 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		}
 	}
 
+	// Hash aggregation doesn't preserve the order of the input.
+	// So, the ordering has to be done after aggregation.
+	if oa.hash {
+		if len(orderBy) == 0 {
+			return oa, nil
+		}
+		return newMemorySort(oa, orderBy)
+	}
+
 	// referenced tracks the keys referenced by the order by clause.
 	referenced := make([]bool, len(oa.eaggr.Keys))
 	postSort := false
@@ -478,10 +783,15 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		if referenced[i] {
 			continue
 		}
-		// Build a brand new reference for the key.
-		col, err := BuildColName(oa.input.ResultColumns(), key)
-		if err != nil {
-			return nil, fmt.Errorf("generating order by clause: %v", err)
+		// Build a brand new reference for the key. Extra keys are not
+		// in the select list. So, the group by column is used as is.
+		col, ok := oa.extraKeys[key]
+		if !ok {
+			var err error
+			col, err = BuildColName(oa.input.ResultColumns(), key)
+			if err != nil {
+				return nil, fmt.Errorf("generating order by clause: %v", err)
+			}
 		}
 		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr})
 	}
@@ -520,10 +830,22 @@ func (oa *orderedAggregate) PushMisc(sel *sqlparser.Select) {
 // If text columns are detected in the keys, then the function modifies
 // the primitive to pull a corresponding weight_string from mysql and
 // compare those instead. This is because we currently don't have the
-// ability to mimic mysql's collation behavior.
+// ability to mimic mysql's collation behavior. HashAggregate keeps
+// the keys, because their weight_string is only used for the text
+// values whose collation vtgate cannot compare. So, it's also
+// requested for the keys of unknown type, and for the distinct
+// aggregate.
 func (oa *orderedAggregate) Wireup(bldr builder, jt *jointab) error {
+	if oa.hash {
+		if err := oa.wireupHashWeightStrings(); err != nil {
+			return err
+		}
+		return oa.input.Wireup(bldr, jt)
+	}
 	for i, colNumber := range oa.eaggr.Keys {
-		rc := oa.resultColumns[colNumber]
+		// Keys can be extra columns that are not in oa's
+		// results. So, the column is looked up in the input.
+		rc := oa.input.ResultColumns()[colNumber]
 		if sqltypes.IsText(rc.column.typ) {
 			if weightcolNumber, ok := oa.weightStrings[rc]; ok {
 				oa.eaggr.Keys[i] = weightcolNumber
@@ -540,3 +862,41 @@ func (oa *orderedAggregate) Wireup(bldr builder, jt *jointab) error {
 	}
 	return oa.input.Wireup(bldr, jt)
 }
+
+// wireupHashWeightStrings requests the weight_string of the columns
+// compared by the engine.HashAggregate that may contain text.
+func (oa *orderedAggregate) wireupHashWeightStrings() error {
+	cols := append([]int(nil), oa.eaggr.Keys...)
+	for _, aggr := range oa.eaggr.Aggregates {
+		if aggr.Opcode == engine.AggregateCountDistinct || aggr.Opcode == engine.AggregateSumDistinct {
+			cols = append(cols, aggr.Col)
+		}
+	}
+	for _, colNumber := range cols {
+		if _, ok := oa.hashWeightStrings[colNumber]; ok {
+			continue
+		}
+		if !mayBeText(oa.input.ResultColumns()[colNumber]) {
+			continue
+		}
+		weightcolNumber, err := oa.input.SupplyWeightString(colNumber)
+		if err != nil {
+			return err
+		}
+		if oa.hashWeightStrings == nil {
+			oa.hashWeightStrings = make(map[int]int)
+		}
+		oa.hashWeightStrings[colNumber] = weightcolNumber
+		if weightcolNumber >= len(oa.resultColumns) {
+			oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+		}
+	}
+	return nil
+}
+
+// mayBeText returns true if the values of the result column can be
+// text: its type is a text type, or it's unknown because the column
+// is not in the vschema or is a computed expression.
+func mayBeText(rc *resultColumn) bool {
+	return rc.column.typ == sqltypes.Null || sqltypes.IsText(rc.column.typ)
+}
@@ -158,6 +158,12 @@ func TestPlan(t *testing.T) {
 	testFile(t, "vindex_func_cases.txt", vschema)
 	testFile(t, "wireup_cases.txt", vschema)
 	testFile(t, "memory_sort_cases.txt", vschema)
+	testFile(t, "hash_join_cases.txt", vschema)
+	testFile(t, "union_cases.txt", vschema)
+	testFile(t, "semi_join_cases.txt", vschema)
+	testFile(t, "window_cases.txt", vschema)
+	testFile(t, "cte_cases.txt", vschema)
+	testFile(t, "lock_cases.txt", vschema)
 }
 
 func TestOne(t *testing.T) {
@@ -221,8 +227,9 @@ func (vw *vschemaWrapper) TargetString() string {
 // For the purposes of this set of tests, just compare the actual plan
 // and ignore all the metrics.
 type testPlan struct {
-	Original     string           `json:",omitempty"`
-	Instructions engine.Primitive `json:",omitempty"`
+	Original          string           `json:",omitempty"`
+	Instructions      engine.Primitive `json:",omitempty"`
+	NeedsLastInsertID bool             `json:",omitempty"`
 }
 
 func testFile(t *testing.T, filename string, vschema *vindexes.VSchema) {
@@ -236,8 +243,9 @@ func testFile(t *testing.T, filename string, vschema *vindexes.VSchema) {
 				out = err.Error()
 			} else {
 				bout, _ := json.Marshal(testPlan{
-					Original:     plan.Original,
-					Instructions: plan.Instructions,
+					Original:          plan.Original,
+					Instructions:      plan.Instructions,
+					NeedsLastInsertID: plan.NeedsLastInsertID,
 				})
 				out = string(bout)
 			}
@@ -38,6 +38,20 @@ func (pb *primitiveBuilder) pushGroupBy(sel *sqlparser.Select) error {
 	return pb.bldr.PushGroupBy(sel.GroupBy)
 }
 
+// pushHaving pushes the having clause into the primitives.
+// If the aggregation is performed by vtgate, the clause cannot be
+// pushed down. A filter is built on top of the aggregation instead.
+func (pb *primitiveBuilder) pushHaving(having *sqlparser.Where) error {
+	if having == nil {
+		return nil
+	}
+	if _, ok := pb.bldr.(*orderedAggregate); ok {
+		pb.bldr = newFilter(pb.bldr)
+		pb.bldr.Reorder(0)
+	}
+	return pb.pushFilter(having.Expr, sqlparser.HavingStr)
+}
+
 // pushOrderBy pushes the order by clause into the primitives.
 // It resolves all symbols and ensures that there are no subqueries.
 func (pb *primitiveBuilder) pushOrderBy(orderBy sqlparser.OrderBy) error {
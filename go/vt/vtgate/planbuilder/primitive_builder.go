@@ -16,6 +16,8 @@ limitations under the License.
 
 package planbuilder
 
+import "vitess.io/vitess/go/vt/sqlparser"
+
 // primitiveBuilder is the top level type for building plans.
 // It contains the current builder tree, the symtab and
 // the jointab. It can create transient planBuilders due
@@ -25,6 +27,10 @@ type primitiveBuilder struct {
 	jt      *jointab
 	bldr    builder
 	st      *symtab
+
+	// directives are the comment directives of the query.
+	// They're used to enable optional features, like hash joins.
+	directives sqlparser.CommentDirectives
 }
 
 func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder {
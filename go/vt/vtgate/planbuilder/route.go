@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -423,11 +424,18 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return 0, errors.New("unsupported: cannot compute the weight string of a UNION column")
+	}
+	if _, ok := sel.SelectExprs[colNumber].(*sqlparser.AliasedExpr); !ok {
+		return 0, fmt.Errorf("unsupported: cannot compute the weight string of %s", sqlparser.String(sel.SelectExprs[colNumber]))
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
-				rb.Select.(*sqlparser.Select).SelectExprs[colNumber],
+				sel.SelectExprs[colNumber],
 			},
 		},
 	}
@@ -43,6 +43,11 @@ type routeOption struct {
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
 
+	// equalities stores the values that the filters bind the
+	// columns of the routeOption to. They're used to route
+	// through MultiColumn vindexes.
+	equalities map[*column]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 }
@@ -99,13 +104,19 @@ func (ro *routeOption) MergeJoin(rro *routeOption, isLeftJoin bool) {
 		}
 		ro.vindexMap[c] = v
 	}
+	for c, val := range rro.equalities {
+		if ro.equalities == nil {
+			ro.equalities = make(map[*column]sqlparser.Expr)
+		}
+		ro.equalities[c] = val
+	}
 }
 
 func (ro *routeOption) SubqueryCanMerge(pb *primitiveBuilder, inner *routeOption) bool {
 	return ro.canMerge(inner, func() bool {
 		switch vals := inner.condition.(type) {
 		case *sqlparser.ColName:
-			if ro.FindVindex(pb, vals) == inner.eroute.Vindex {
+			if ro.FindVindex(pb, vals) == inner.eroute.Vindex && vindexes.MapsToKeyspaceID(inner.eroute.Vindex) {
 				return true
 			}
 		}
@@ -130,6 +141,7 @@ func (ro *routeOption) SubqueryToTable(rb *route, vindexMap map[*column]vindexes
 	ro.rb = rb
 	ro.vschemaTable = nil
 	ro.vindexMap = vindexMap
+	ro.equalities = nil
 }
 
 func (ro *routeOption) canMerge(rro *routeOption, customCheck func() bool) bool {
@@ -180,7 +192,7 @@ func (ro *routeOption) canMergeOnFilter(pb *primitiveBuilder, rro *routeOption,
 		left, right = right, left
 		lVindex = ro.FindVindex(pb, left)
 	}
-	if lVindex == nil || !lVindex.IsUnique() {
+	if lVindex == nil || !vindexes.MapsToKeyspaceID(lVindex) {
 		return false
 	}
 	rVindex := rro.FindVindex(pb, right)
@@ -199,7 +211,12 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference:
 		return
 	}
-	opcode, vindex, values := ro.computePlan(pb, filter)
+	ro.improvePlan(ro.computePlan(pb, filter))
+	ro.improvePlan(ro.computeMultiColumnPlan(pb, filter))
+}
+
+// improvePlan updates the primitive if the plan is an improvement.
+func (ro *routeOption) improvePlan(opcode engine.RouteOpcode, vindex vindexes.Vindex, values sqlparser.Expr) {
 	if opcode == engine.SelectScatter {
 		return
 	}
@@ -271,12 +288,58 @@ func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlpar
 	if !ro.exprIsValue(right) {
 		return engine.SelectScatter, nil, nil
 	}
-	if vindex.IsUnique() {
+	if vindexes.MapsToKeyspaceID(vindex) {
 		return engine.SelectEqualUnique, vindex, right
 	}
 	return engine.SelectEqual, vindex, right
 }
 
+// computeMultiColumnPlan records the value the filter binds a column to,
+// if any. Once the values of all the columns of a MultiColumn vindex are
+// known, the vindex identifies a single keyspace id. The condition is
+// then the tuple of the values, in the order of the vindex columns.
+func (ro *routeOption) computeMultiColumnPlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	if paren, ok := filter.(*sqlparser.ParenExpr); ok {
+		return ro.computeMultiColumnPlan(pb, paren.Expr)
+	}
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return engine.SelectScatter, nil, nil
+	}
+	left, right := comparison.Left, comparison.Right
+	col := ro.findColumn(pb, left)
+	if col == nil || !ro.exprIsValue(right) {
+		left, right = right, left
+		col = ro.findColumn(pb, left)
+		if col == nil || !ro.exprIsValue(right) {
+			return engine.SelectScatter, nil, nil
+		}
+	}
+	if ro.equalities == nil {
+		ro.equalities = make(map[*column]sqlparser.Expr)
+	}
+	ro.equalities[col] = right
+
+	for first, vindex := range ro.vindexMap {
+		cols, ok := first.vindexColumns[vindex]
+		if !ok {
+			continue
+		}
+		values := make(sqlparser.ValTuple, 0, len(cols))
+		for _, c := range cols {
+			val, ok := ro.equalities[c]
+			if !ok {
+				break
+			}
+			values = append(values, val)
+		}
+		if len(values) == len(cols) {
+			return engine.SelectEqualUnique, vindex, values
+		}
+	}
+	return engine.SelectScatter, nil, nil
+}
+
 // computeINPlan computes the plan for an IN constraint.
 func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	vindex = ro.FindVindex(pb, comparison.Left)
@@ -324,6 +387,16 @@ func (ro *routeOption) isBetterThan(other *routeOption) bool {
 }
 
 func (ro *routeOption) FindVindex(pb *primitiveBuilder, expr sqlparser.Expr) vindexes.Vindex {
+	c := ro.findColumn(pb, expr)
+	if c == nil {
+		return nil
+	}
+	return ro.vindexMap[c]
+}
+
+// findColumn returns the column the expression refers to, if
+// it's a column of the routeOption.
+func (ro *routeOption) findColumn(pb *primitiveBuilder, expr sqlparser.Expr) *column {
 	col, ok := expr.(*sqlparser.ColName)
 	if !ok {
 		return nil
@@ -338,7 +411,7 @@ func (ro *routeOption) FindVindex(pb *primitiveBuilder, expr sqlparser.Expr) vin
 	if c.Origin() != ro.rb {
 		return nil
 	}
-	return ro.vindexMap[c]
+	return c
 }
 
 // exprIsValue returns true if the expression can be treated as a value
@@ -26,6 +26,9 @@ import (
 
 // buildSelectPlan is the new function to build a Select plan.
 func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (primitive engine.Primitive, err error) {
+	if hasLockFunc(sel) {
+		return buildLockPlan(sel, vschema)
+	}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
 		return nil, err
@@ -64,6 +67,14 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (primitive e
 // can also be handled by this combination of primitives. In this case,
 // the tree would consist of an orderedAggregate whose input is a route.
 //
+// Window functions are handled the same way: if a scatter route cannot
+// compute them, a window primitive is built on top of the route, which
+// orders the rows by the partitions of the window.
+//
+// A WITH clause is analyzed first. The references to its common table
+// expressions are turned into derived tables, which can then be merged
+// into the routes like other subqueries.
+//
 // If a query has an ORDER BY, but the route is a scatter, then the
 // ordering is pushed down into the route itself. This results in a simple
 // route primitive.
@@ -72,6 +83,20 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (primitive e
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab) error {
+	if sel.With != nil {
+		recursive, err := pb.processWith(sel, sel.With)
+		if err != nil {
+			return err
+		}
+		if recursive {
+			pb.st.Outer = outer
+			return nil
+		}
+	}
+	// The directives must be known before the FROM clause
+	// is processed because they affect how joins are built.
+	directives := sqlparser.ExtractCommentDirectives(sel.Comments)
+	pb.directives = directives
 	if err := pb.processTableExprs(sel.From); err != nil {
 		return err
 	}
@@ -79,7 +104,6 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if rb, ok := pb.bldr.(*route); ok {
 		// TODO(sougou): this can probably be improved.
 		for _, ro := range rb.routeOptions {
-			directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 			ro.eroute.QueryTimeout = queryTimeout(directives)
 			if ro.eroute.TargetDestination != nil {
 				return errors.New("unsupported: SELECT with a target destination")
@@ -102,13 +126,14 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
-	if sel.Having != nil {
-		if err := pb.pushFilter(sel.Having.Expr, sqlparser.HavingStr); err != nil {
-			return err
-		}
+	if err := pb.pushHaving(sel.Having); err != nil {
+		return err
 	}
 	if err := pb.pushOrderBy(sel.OrderBy); err != nil {
 		return err
@@ -123,11 +148,18 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 // pushFilter identifies the target route for the specified bool expr,
 // pushes it down, and updates the route info if the new constraint improves
 // the primitive. This function can push to a WHERE or HAVING clause.
+// A correlated subquery that cannot be merged is only supported as
+// an EXISTS, NOT EXISTS, IN or NOT IN condition of the WHERE clause.
 func (pb *primitiveBuilder) pushFilter(boolExpr sqlparser.Expr, whereType string) error {
 	filters := splitAndExpression(nil, boolExpr)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
 		pullouts, origin, expr, err := pb.findOrigin(filter)
+		if cerr, ok := err.(*correlatedSubqueryError); ok && whereType == sqlparser.WhereStr {
+			if pb.pushSemiJoin(filter, cerr.sqi) {
+				continue
+			}
+		}
 		if err != nil {
 			return err
 		}
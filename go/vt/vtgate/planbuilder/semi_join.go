@@ -0,0 +1,367 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*semiJoin)(nil)
+
+// semiJoin is the builder for engine.SemiJoin.
+// This gets built for a WHERE condition that is a correlated
+// EXISTS, NOT EXISTS, IN or NOT IN subquery that cannot be
+// merged with the outer query. The outer query is the LHS
+// and the subquery is the RHS. The values the subquery needs
+// from the outer query are supplied as join vars.
+type semiJoin struct {
+	order         int
+	leftOrder     int
+	resultColumns []*resultColumn
+	weightStrings map[*resultColumn]int
+
+	// Left and Right are the outer query and the subquery.
+	Left, Right builder
+
+	// lhsKey is the column of the outer query that's looked
+	// up in the results of the subquery, for IN and NOT IN.
+	lhsKey *sqlparser.ColName
+
+	// batchKey is the column of the outer query whose values
+	// are batched, if the subquery was planned for batching.
+	batchKey *sqlparser.ColName
+
+	esemiJoin *engine.SemiJoin
+}
+
+// newSemiJoin builds a new semiJoin.
+func newSemiJoin(opcode engine.SemiJoinOpcode, left, right builder, lhsKey *sqlparser.ColName) *semiJoin {
+	return &semiJoin{
+		weightStrings: make(map[*resultColumn]int),
+		Left:          left,
+		Right:         right,
+		lhsKey:        lhsKey,
+		esemiJoin: &engine.SemiJoin{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+}
+
+// semiJoinBatchVar is the list bind var that receives
+// the values of the outer query in batched subqueries.
+const semiJoinBatchVar = "__sj_vals"
+
+// setBatch replaces the subquery with one that's executed for the
+// values of batchKey in many outer rows at once.
+func (sj *semiJoin) setBatch(right builder, batchKey *sqlparser.ColName) {
+	sj.Right = right
+	sj.batchKey = batchKey
+	sj.esemiJoin.BatchVar = semiJoinBatchVar
+}
+
+// buildBatchedSubquery plans the correlated subquery in a form
+// that can be executed for many outer rows at once. This is only
+// possible if the only reference to the outer query is a top-level
+// 'inner_column = outer_column' condition of the WHERE clause, and
+// if the rows of the subquery are independent of each other, which
+// excludes aggregates, DISTINCT, LIMIT and nested subqueries,
+// including the ones that were already pulled out. The
+// condition is replaced by 'inner_column in ::__sj_vals', and
+// inner_column is appended to the select list so that the rows can
+// be matched with the outer rows. For EXISTS and NOT EXISTS, it's
+// the only column. It returns a nil builder if the subquery cannot
+// be batched.
+func (pb *primitiveBuilder) buildBatchedSubquery(opcode engine.SemiJoinOpcode, sqi subqueryInfo) (builder, *sqlparser.ColName) {
+	sel, ok := sqi.ast.Select.(*sqlparser.Select)
+	if !ok || sel.Distinct != "" || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || sel.Where == nil {
+		return nil, nil
+	}
+	if nodeHasAggregates(sel.SelectExprs) || hasSubquery(sel) || hasBindVars(sel) {
+		return nil, nil
+	}
+	hasKey := opcode == engine.SemiJoinIn || opcode == engine.AntiJoinNotIn
+	if hasKey {
+		if len(sel.SelectExprs) != 1 {
+			return nil, nil
+		}
+		if _, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr); !ok {
+			return nil, nil
+		}
+	}
+
+	// Find the only reference to the outer query.
+	isExtern := make(map[*sqlparser.ColName]bool)
+	for _, extern := range sqi.externs {
+		// No error expected. These are resolved externs.
+		if _, isLocal, _ := pb.st.Find(extern); !isLocal {
+			return nil, nil
+		}
+		isExtern[extern] = true
+	}
+	numExterns := 0
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && isExtern[col] {
+			numExterns++
+		}
+		return true, nil
+	}, sel)
+	if numExterns != 1 {
+		return nil, nil
+	}
+	filters := splitAndExpression(nil, sel.Where.Expr)
+	condIndex := -1
+	var outer *sqlparser.ColName
+	var innerOnLeft bool
+	for i, filter := range filters {
+		cmp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualStr {
+			continue
+		}
+		left, lok := cmp.Left.(*sqlparser.ColName)
+		right, rok := cmp.Right.(*sqlparser.ColName)
+		if !lok || !rok {
+			continue
+		}
+		switch {
+		case isExtern[right] && !isExtern[left]:
+			condIndex, outer, innerOnLeft = i, right, true
+		case isExtern[left] && !isExtern[right]:
+			condIndex, outer, innerOnLeft = i, left, false
+		}
+	}
+	if condIndex == -1 {
+		return nil, nil
+	}
+
+	// Rewrite a copy of the subquery, which doesn't reference
+	// the outer query anymore, and plan it on its own.
+	stmt, err := sqlparser.Parse(sqlparser.String(sel))
+	if err != nil {
+		return nil, nil
+	}
+	batchSel := stmt.(*sqlparser.Select)
+	batchFilters := splitAndExpression(nil, batchSel.Where.Expr)
+	cond := batchFilters[condIndex].(*sqlparser.ComparisonExpr)
+	inner := cond.Right.(*sqlparser.ColName)
+	if innerOnLeft {
+		inner = cond.Left.(*sqlparser.ColName)
+	}
+	innerCopy := *inner
+	batchFilters[condIndex] = &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     inner,
+		Right:    sqlparser.ListArg("::" + semiJoinBatchVar),
+	}
+	batchSel.Where = sqlparser.NewWhere(sqlparser.WhereStr, andExpressions(batchFilters))
+	if hasKey {
+		batchSel.SelectExprs = append(batchSel.SelectExprs, &sqlparser.AliasedExpr{Expr: &innerCopy})
+	} else {
+		batchSel.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: &innerCopy}}
+	}
+	bpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	if err := bpb.processSelect(batchSel, nil); err != nil {
+		return nil, nil
+	}
+	if len(bpb.bldr.ResultColumns()) != len(batchSel.SelectExprs) {
+		return nil, nil
+	}
+	return bpb.bldr, outer
+}
+
+// hasBindVars returns true if the node references bind variables.
+// Nested subqueries that were pulled out are replaced by bind
+// variables, which would not be set for the batched query.
+func hasBindVars(node sqlparser.SQLNode) bool {
+	has := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case sqlparser.ListArg:
+			has = true
+		case *sqlparser.SQLVal:
+			if node.Type == sqlparser.ValArg {
+				has = true
+			}
+		}
+		return !has, nil
+	}, node)
+	return has
+}
+
+// andExpressions combines the filters with AND.
+func andExpressions(filters []sqlparser.Expr) sqlparser.Expr {
+	expr := filters[0]
+	for _, filter := range filters[1:] {
+		expr = &sqlparser.AndExpr{Left: expr, Right: filter}
+	}
+	return expr
+}
+
+// Order satisfies the builder interface.
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder satisfies the builder interface.
+func (sj *semiJoin) Reorder(order int) {
+	sj.Left.Reorder(order)
+	sj.leftOrder = sj.Left.Order()
+	sj.Right.Reorder(sj.leftOrder)
+	sj.order = sj.Right.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.esemiJoin.Left = sj.Left.Primitive()
+	sj.esemiJoin.Right = sj.Right.Primitive()
+	return sj.esemiJoin
+}
+
+// First satisfies the builder interface.
+func (sj *semiJoin) First() builder {
+	return sj.Left.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.resultColumns
+}
+
+// PushFilter satisfies the builder interface.
+// The subquery has its own symbol table. So, filters
+// can only reference the outer query.
+func (sj *semiJoin) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return sj.Left.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (sj *semiJoin) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	rc, colNumber, err = sj.Left.PushSelect(pb, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, colNumber)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (sj *semiJoin) MakeDistinct() error {
+	return errors.New("unsupported: distinct on cross-shard correlated subquery")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (sj *semiJoin) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if (groupBy) == nil {
+		return nil
+	}
+	return errors.New("unsupported: group by on cross-shard correlated subquery")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The semiJoin preserves the order of the outer query.
+// So, the order by can be pushed into it.
+func (sj *semiJoin) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := sj.Left.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	sj.Left = bldr
+	return sj, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because the semiJoin discards rows.
+func (sj *semiJoin) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (sj *semiJoin) PushMisc(sel *sqlparser.Select) {
+	sj.Left.PushMisc(sel)
+	sj.Right.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+func (sj *semiJoin) Wireup(bldr builder, jt *jointab) error {
+	if sj.lhsKey != nil {
+		_, sj.esemiJoin.LHSKey = sj.Left.SupplyCol(sj.lhsKey)
+	}
+	if sj.batchKey != nil {
+		_, sj.esemiJoin.BatchKey = sj.Left.SupplyCol(sj.batchKey)
+	}
+	if err := sj.Right.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return sj.Left.Wireup(bldr, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if !sj.isOnLeft(from) {
+		sj.Right.SupplyVar(from, to, col, varname)
+		return
+	}
+	if sj.isOnLeft(to) {
+		sj.Left.SupplyVar(from, to, col, varname)
+		return
+	}
+	if _, ok := sj.esemiJoin.Vars[varname]; ok {
+		// Looks like somebody else already requested this.
+		return
+	}
+	_, sj.esemiJoin.Vars[varname] = sj.Left.SupplyCol(col)
+}
+
+// SupplyCol satisfies the builder interface.
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range sj.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+
+	rc, sourceCol := sj.Left.SupplyCol(col)
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := sj.resultColumns[colNumber]
+	if weightcolNumber, ok := sj.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	sourceCol, err := sj.Left.SupplyWeightString(sj.esemiJoin.Cols[colNumber])
+	if err != nil {
+		return 0, err
+	}
+	sj.esemiJoin.Cols = append(sj.esemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	sj.weightStrings[rc] = len(sj.resultColumns) - 1
+	return len(sj.resultColumns) - 1, nil
+}
+
+// isOnLeft returns true if the specified route number
+// is on the left side of the semiJoin.
+func (sj *semiJoin) isOnLeft(nodeNum int) bool {
+	return nodeNum <= sj.leftOrder
+}
@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ builder = (*subquery)(nil)
@@ -35,10 +36,23 @@ var _ builder = (*subquery)(nil)
 // a new route that keeps the subquery in the FROM
 // clause, because a route is more versatile than
 // a subquery.
+// Filters and expressions on the results of the subquery
+// are evaluated by vtgate, using an engine.Filter and an
+// engine.Projection built on top of the subquery.
 type subquery struct {
 	builderCommon
 	resultColumns []*resultColumn
 	esubquery     *engine.Subquery
+	filter        evalengine.Expr
+	exprs         []*subqueryExpr
+}
+
+// subqueryExpr is a select expression that's computed from
+// the results of the subquery. col is its result column number.
+type subqueryExpr struct {
+	col   int
+	eexpr evalengine.Expr
+	alias string
 }
 
 // newSubquery builds a new subquery.
@@ -69,9 +83,30 @@ func newSubquery(alias sqlparser.TableIdent, bldr builder) (*subquery, *symtab,
 }
 
 // Primitive satisfies the builder interface.
+// If there are computed columns, an engine.Projection is
+// returned instead of the engine.Subquery.
 func (sq *subquery) Primitive() engine.Primitive {
-	sq.esubquery.Subquery = sq.input.Primitive()
-	return sq.esubquery
+	input := sq.input.Primitive()
+	if sq.filter != nil {
+		input = &engine.Filter{
+			Predicate: sq.filter,
+			Input:     input,
+		}
+	}
+	if len(sq.exprs) == 0 {
+		sq.esubquery.Subquery = input
+		return sq.esubquery
+	}
+	eproj := &engine.Projection{Input: input}
+	for i, inner := range sq.esubquery.Cols {
+		eproj.Cols = append(eproj.Cols, sq.resultColumns[i].alias.String())
+		eproj.Exprs = append(eproj.Exprs, evalengine.NewColumn(inner))
+	}
+	for _, se := range sq.exprs {
+		eproj.Cols[se.col] = se.alias
+		eproj.Exprs[se.col] = se.eexpr
+	}
+	return eproj
 }
 
 // First satisfies the builder interface.
@@ -85,15 +120,48 @@ func (sq *subquery) ResultColumns() []*resultColumn {
 }
 
 // PushFilter satisfies the builder interface.
-func (sq *subquery) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of cross-shard subquery")
+// The filter is evaluated by vtgate on the results of the subquery.
+func (sq *subquery) PushFilter(_ *primitiveBuilder, expr sqlparser.Expr, whereType string, _ builder) error {
+	predicate, err := sq.convert(expr)
+	if err != nil {
+		return errors.New("unsupported: filtering on results of cross-shard subquery")
+	}
+	if sq.filter == nil {
+		sq.filter = predicate
+		return nil
+	}
+	sq.filter = &evalengine.Logical{
+		Op:    evalengine.LogicalAnd,
+		Left:  sq.filter,
+		Right: predicate,
+	}
+	return nil
 }
 
 // PushSelect satisfies the builder interface.
+// Expressions other than plain columns are evaluated by vtgate.
 func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, _ builder) (rc *resultColumn, colNumber int, err error) {
 	col, ok := expr.Expr.(*sqlparser.ColName)
 	if !ok {
-		return nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		eexpr, err := sq.convert(expr.Expr)
+		if err != nil {
+			return nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		}
+		alias := expr.As.String()
+		if expr.As.IsEmpty() {
+			alias = sqlparser.String(expr.Expr)
+		}
+		sq.exprs = append(sq.exprs, &subqueryExpr{
+			col:   len(sq.resultColumns),
+			eexpr: eexpr,
+			alias: alias,
+		})
+		// The column number is a placeholder. The value is
+		// computed by the projection.
+		sq.esubquery.Cols = append(sq.esubquery.Cols, 0)
+		rc = newResultColumn(expr, sq)
+		sq.resultColumns = append(sq.resultColumns, rc)
+		return rc, len(sq.resultColumns) - 1, nil
 	}
 
 	// colNumber should already be set for subquery columns.
@@ -107,6 +175,23 @@ func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr,
 	return rc, len(sq.resultColumns) - 1, nil
 }
 
+// convert converts an expression on the results of the subquery into
+// an evalengine expression. It can only reference the subquery columns.
+func (sq *subquery) convert(expr sqlparser.Expr) (evalengine.Expr, error) {
+	return evalengine.Convert(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return nil, nil
+		}
+		c := col.Metadata.(*column)
+		if c.Origin() != sq {
+			return nil, errors.New("column not in subquery")
+		}
+		// colNumber should already be set for subquery columns.
+		return evalengine.NewColumn(c.colNumber), nil
+	})
+}
+
 // MakeDistinct satisfies the builder interface.
 func (sq *subquery) MakeDistinct() error {
 	return errors.New("unsupported: distinct on cross-shard subquery")
@@ -143,3 +228,13 @@ func (sq *subquery) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumb
 	sq.resultColumns = append(sq.resultColumns, &resultColumn{column: c})
 	return rc, len(sq.resultColumns) - 1
 }
+
+// SetUpperLimit satisfies the builder interface.
+// The limit is not pushed down if rows can be
+// discarded by a filter.
+func (sq *subquery) SetUpperLimit(count *sqlparser.SQLVal) {
+	if sq.filter != nil {
+		return
+	}
+	sq.input.SetUpperLimit(count)
+}
@@ -117,6 +117,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 
 		var vindexMap map[*column]vindexes.Vindex
 		for _, cv := range vst.ColumnVindexes {
+			cols := make([]*column, len(cv.Columns))
 			for j, cvcol := range cv.Columns {
 				col, err := t.mergeColumn(cvcol, &column{
 					origin: rb,
@@ -125,6 +126,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 				if err != nil {
 					return nil, err
 				}
+				cols[j] = col
 				if j == 0 {
 					// For now, only the first column is used for vindex Map functions.
 					if vindexMap == nil {
@@ -135,6 +137,12 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 					}
 				}
 			}
+			if _, ok := cv.Vindex.(vindexes.MultiColumn); ok && len(cols) > 1 {
+				if cols[0].vindexColumns == nil {
+					cols[0].vindexColumns = make(map[vindexes.Vindex][]*column)
+				}
+				cols[0].vindexColumns[cv.Vindex] = cols
+			}
 		}
 		vindexMaps[i] = vindexMap
 
@@ -509,6 +517,10 @@ type column struct {
 	st        *symtab
 	typ       querypb.Type
 	colNumber int
+
+	// vindexColumns lists the columns of the MultiColumn vindexes
+	// the column is the first column of.
+	vindexColumns map[vindexes.Vindex][]*column
 }
 
 // Origin returns the route that originates the column.
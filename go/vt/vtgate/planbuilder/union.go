@@ -37,6 +37,16 @@ func buildUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (primitive e
 }
 
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
+	if union.With != nil {
+		recursive, err := pb.processWith(union, union.With)
+		if err != nil {
+			return err
+		}
+		if recursive {
+			pb.st.Outer = outer
+			return nil
+		}
+	}
 	if err := pb.processPart(union.Left, outer); err != nil {
 		return err
 	}
@@ -45,8 +55,15 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 		return err
 	}
 
-	if err := unionRouteMerge(union, pb.bldr, rpb.bldr); err != nil {
-		return err
+	if !unionRouteMerge(union, pb.bldr, rpb.bldr) {
+		if union.Lock != "" {
+			return errors.New("unsupported: lock clause on cross-shard UNION")
+		}
+		pb.bldr = newConcatenate(pb.bldr, rpb.bldr)
+		if union.Type != sqlparser.UnionAllStr {
+			pb.bldr = newDistinct(pb.bldr, !hasStar(union))
+		}
+		pb.bldr.Reorder(0)
 	}
 	pb.st.Outer = outer
 
@@ -68,18 +85,39 @@ func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *s
 	return fmt.Errorf("BUG: unexpected SELECT type: %T", part)
 }
 
-func unionRouteMerge(union *sqlparser.Union, left, right builder) error {
+// hasStar returns true if a '*' expression is in
+// the select list of any part of the statement.
+func hasStar(part sqlparser.SelectStatement) bool {
+	switch part := part.(type) {
+	case *sqlparser.Union:
+		return hasStar(part.Left) || hasStar(part.Right)
+	case *sqlparser.ParenSelect:
+		return hasStar(part.Select)
+	case *sqlparser.Select:
+		for _, expr := range part.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unionRouteMerge merges the parts of the union into a single route
+// if possible. It returns false if the parts have to be executed
+// separately and combined by vtgate.
+func unionRouteMerge(union *sqlparser.Union, left, right builder) bool {
 	lroute, ok := left.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	rroute, ok := right.(*route)
 	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+		return false
 	}
 	if !lroute.MergeUnion(rroute) {
-		return errors.New("unsupported: UNION cannot be executed as a single route")
+		return false
 	}
 	lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
-	return nil
+	return true
 }
@@ -90,20 +90,32 @@ func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Upd
 		eupd.Opcode = engine.UpdateEqual
 	}
 
-	if eupd.Opcode == engine.UpdateScatter {
-		if len(eupd.Table.Owned) != 0 {
-			return eupd, errors.New("unsupported: multi shard update on a table with owned lookup vindexes")
-		}
-		if upd.Limit != nil {
-			return eupd, errors.New("unsupported: multi shard update with limit")
+	if eupd.Opcode == engine.UpdateScatter && upd.Limit != nil {
+		if eupd.Limit, err = buildDMLLimit(upd.Where, upd.OrderBy, upd.Limit, eupd.Table); err != nil {
+			return nil, err
 		}
 	}
 
 	if eupd.ChangedVindexValues, err = buildChangedVindexesValues(eupd, upd, eupd.Table.ColumnVindexes); err != nil {
 		return nil, err
 	}
+	if _, ok := eupd.ChangedVindexValues[eupd.Table.ColumnVindexes[0].Name]; ok {
+		// An update of the primary vindex moves the rows to another
+		// shard. Their new values must be computed by vtgate.
+		if eupd.ChangedColumnValues, err = buildChangedColumnValues(upd); err != nil {
+			return nil, err
+		}
+		eupd.SelectRowsQuery, eupd.DeleteRowsQuery = generateRelocateQueries(upd, eupd.Table)
+		return eupd, nil
+	}
 	if len(eupd.ChangedVindexValues) != 0 {
-		eupd.OwnedVindexQuery = generateUpdateSubquery(upd, eupd.Table)
+		// A multi-shard update needs the keyspace id of every row
+		// to update the vindexes. It's computed from the primary vindex.
+		multiShard := eupd.Opcode == engine.UpdateScatter
+		if multiShard {
+			eupd.KsidVindex = eupd.Table.ColumnVindexes[0].Vindex
+		}
+		eupd.OwnedVindexQuery = generateUpdateSubquery(upd, eupd.Table, multiShard)
 	}
 	return eupd, nil
 }
@@ -145,7 +157,16 @@ func buildChangedVindexesValues(eupd *engine.Update, update *sqlparser.Update, c
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", vindex.Name)
 		}
 		if i == 0 {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", vindex.Name)
+			// The keyspace id of a lookup vindex can't be
+			// computed for the new values.
+			if _, ok := vindex.Vindex.(vindexes.Lookup); ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns of a lookup vindex. Invalid update on vindex: %v", vindex.Name)
+			}
+			if _, ok := vindex.Vindex.(vindexes.MultiColumn); ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns of a multi-column vindex. Invalid update on vindex: %v", vindex.Name)
+			}
+			changedVindexes[vindex.Name] = vindexValues
+			continue
 		}
 		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
@@ -159,9 +180,48 @@ func buildChangedVindexesValues(eupd *engine.Update, update *sqlparser.Update, c
 	return changedVindexes, nil
 }
 
-func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table) string {
+// buildChangedColumnValues returns the values of all the columns
+// changed by the update.
+func buildChangedColumnValues(update *sqlparser.Update) (map[string]sqltypes.PlanValue, error) {
+	changedColumns := make(map[string]sqltypes.PlanValue, len(update.Exprs))
+	for _, assignment := range update.Exprs {
+		pv, err := extractValueFromUpdate(assignment)
+		if err != nil {
+			return nil, err
+		}
+		changedColumns[assignment.Name.Name.Lowered()] = pv
+	}
+	return changedColumns, nil
+}
+
+// generateRelocateQueries returns the queries that select and
+// delete the rows moved by an update of the primary vindex.
+func generateRelocateQueries(upd *sqlparser.Update, table *vindexes.Table) (selectQuery, deleteQuery string) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select * from %v%v%v%v for update", table.Name, upd.Where, upd.OrderBy, upd.Limit)
+	selectQuery = buf.String()
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v%v%v%v", table.Name, upd.Where, upd.OrderBy, upd.Limit)
+	return selectQuery, buf.String()
+}
+
+func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table, multiShard bool) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
+	writeOwnedVindexColumns(buf, table, multiShard)
+	buf.Myprintf(" from %v%v%v%v for update", table.Name, upd.Where, upd.OrderBy, upd.Limit)
+	return buf.String()
+}
+
+// writeOwnedVindexColumns writes the select list of the query that
+// fetches the owned vindex columns of the rows changed by a DML.
+// For a multi-shard DML, the columns of the primary vindex come first.
+func writeOwnedVindexColumns(buf *sqlparser.TrackedBuffer, table *vindexes.Table, multiShard bool) {
 	buf.WriteString("select ")
+	if multiShard {
+		for _, column := range table.ColumnVindexes[0].Columns {
+			buf.Myprintf("%v, ", column)
+		}
+	}
 	for vIdx, cv := range table.Owned {
 		for cIdx, column := range cv.Columns {
 			if cIdx == 0 && vIdx == 0 {
@@ -171,8 +231,6 @@ func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table) string
 			}
 		}
 	}
-	buf.Myprintf(" from %v%v%v%v for update", table.Name, upd.Where, upd.OrderBy, upd.Limit)
-	return buf.String()
 }
 
 // extractValueFromUpdate given an UpdateExpr attempts to extracts the Value
@@ -209,7 +267,7 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (vindexes.Vind
 		return nil, nil, errors.New("unsupported: multi-shard where clause in DML")
 	}
 	for _, index := range table.Ordered {
-		if !index.Vindex.IsUnique() {
+		if !vindexes.MapsToKeyspaceID(index.Vindex) {
 			continue
 		}
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
@@ -219,6 +277,33 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (vindexes.Vind
 	return nil, nil, errors.New("unsupported: multi-shard where clause in DML")
 }
 
+// buildDMLLimit builds the instructions that apply the LIMIT of
+// a multi-shard DML. The engine locks the rows with the query, and
+// restricts the queries of the DML to their primary keys.
+func buildDMLLimit(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table) (*engine.DMLLimit, error) {
+	if limit.Offset != nil {
+		return nil, errors.New("unsupported: offset in multi-shard DML")
+	}
+	count, err := sqlparser.NewPlanValue(limit.Rowcount)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unsupported: limit of multi-shard DML")
+	}
+	dmlLimit := &engine.DMLLimit{Count: count}
+	var selectExprs sqlparser.SelectExprs
+	for i, order := range orderBy {
+		selectExprs = append(selectExprs, &sqlparser.AliasedExpr{Expr: order.Expr})
+		dmlLimit.OrderBy = append(dmlLimit.OrderBy, engine.OrderbyParams{
+			Col:  i,
+			Desc: order.Direction == sqlparser.DescScr,
+		})
+	}
+	selectExprs = append(selectExprs, &sqlparser.StarExpr{TableName: sqlparser.TableName{Name: table.Name}})
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v%v%v%v for update", selectExprs, table.Name, where, orderBy, limit)
+	dmlLimit.Query = buf.String()
+	return dmlLimit, nil
+}
+
 // getMatch returns the matched value if there is an equality
 // constraint on the specified column that can be used to
 // decide on a route.
@@ -0,0 +1,370 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var _ builder = (*window)(nil)
+
+// window is the builder for engine.Window.
+// This gets built if a SelectScatter route has window functions
+// whose PARTITION BY doesn't reference a unique vindex. Like the
+// orderedAggregate, it requests the underlying route to order the
+// rows, by the PARTITION BY columns followed by the ORDER BY of
+// the window. For example:
+// 'select col, row_number() over (partition by a order by b) from t'
+// will be sent to the scatter route as:
+// 'select col, 1, a, b from t order by a asc, b asc'
+// The window primitive built for this will be:
+//
+//	&engine.Window {
+//	  // The value of row_number replaces column 1.
+//	  Functions: []WindowParams{{
+//	    Opcode: WindowRowNumber,
+//	    Col: 1,
+//	  }},
+//	  PartitionBy: []int{2},
+//	  OrderBy: []int{3},
+//	  TruncateColumnCount: 2,
+//	  Input: (Scatter Route with the order by request),
+//	}
+//
+// All the window functions of the query must have the same window
+// specification because the rows can only be ordered one way.
+type window struct {
+	resultsBuilder
+	over    *sqlparser.OverClause
+	ewindow *engine.Window
+}
+
+// checkWindowFunctions analyzes the select expressions for window
+// functions. If they cannot be computed by the underlying route, it
+// builds a window primitive on top of it.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	overs := findOverClauses(sel.SelectExprs)
+	if len(overs) == 0 {
+		return nil
+	}
+	rb, isRoute := pb.bldr.(*route)
+	if isRoute && rb.removeMultishardOptions() {
+		return nil
+	}
+	// The window primitive can only be built on top of a route because
+	// it needs the ability to push down the order by clause.
+	if !isRoute {
+		return errors.New("unsupported: cross-shard query with window functions")
+	}
+
+	// If every partition contains a unique vindex column, all its rows
+	// come from the same shard. So, the window functions can be computed
+	// by the shards.
+	if pb.partitionByHasUniqueVindex(overs, rb) {
+		return nil
+	}
+
+	over := overs[0]
+	for _, other := range overs[1:] {
+		if sqlparser.String(other.PartitionBy) != sqlparser.String(over.PartitionBy) || sqlparser.String(other.OrderBy) != sqlparser.String(over.OrderBy) {
+			return errors.New("unsupported: in scatter query: window functions with different window specifications")
+		}
+	}
+	if over.Frame != nil {
+		return fmt.Errorf("unsupported: in scatter query: window frame clause: %s", sqlparser.String(over.Frame))
+	}
+
+	ewindow := &engine.Window{}
+	pb.bldr = &window{
+		resultsBuilder: newResultsBuilder(rb, ewindow),
+		over:           over,
+		ewindow:        ewindow,
+	}
+	pb.bldr.Reorder(0)
+	return nil
+}
+
+// findOverClauses returns the window specifications of the
+// window functions of the node.
+func findOverClauses(node sqlparser.SQLNode) []*sqlparser.OverClause {
+	var overs []*sqlparser.OverClause
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunction() {
+				overs = append(overs, node.Over)
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return overs
+}
+
+// partitionByHasUniqueVindex returns true if the PARTITION BY
+// of every window specification references a unique vindex.
+func (pb *primitiveBuilder) partitionByHasUniqueVindex(overs []*sqlparser.OverClause, rb *route) bool {
+	return rb.removeOptions(func(ro *routeOption) bool {
+		for _, over := range overs {
+			found := false
+			for _, expr := range over.PartitionBy {
+				vindex := ro.FindVindex(pb, expr)
+				if vindex != nil && vindexes.MapsToKeyspaceID(vindex) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Primitive satisfies the builder interface.
+func (w *window) Primitive() engine.Primitive {
+	w.ewindow.Input = w.input.Primitive()
+	return w.ewindow
+}
+
+// PushFilter satisfies the builder interface.
+// The HAVING clause is evaluated before the window functions.
+// So, it can be pushed down as long as it doesn't reference them.
+func (w *window) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	if origin == w {
+		return errors.New("unsupported: filtering on results of window functions")
+	}
+	return w.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+// Window functions are computed by w. Only their argument, if any, is
+// pushed down into the route. Its column receives the value of the
+// function. Other expressions are pushed through to the route.
+func (w *window) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	funcExpr, ok := expr.Expr.(*sqlparser.FuncExpr)
+	if !ok || !funcExpr.IsWindowFunction() {
+		if len(findOverClauses(expr.Expr)) != 0 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: complex window function expression: %s", sqlparser.String(expr))
+		}
+		innerRC, _, _ := w.input.PushSelect(pb, expr, origin)
+		w.resultColumns = append(w.resultColumns, innerRC)
+		return innerRC, len(w.resultColumns) - 1, nil
+	}
+
+	params, inner, err := newWindowParams(funcExpr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if expr.As.IsEmpty() {
+		params.Alias = sqlparser.String(expr.Expr)
+	} else {
+		params.Alias = expr.As.String()
+	}
+	_, params.Col, _ = w.input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: inner}, origin)
+	w.ewindow.Functions = append(w.ewindow.Functions, params)
+
+	// Build a new rc with w as origin because it's semantically different
+	// from the expression we pushed down.
+	rc = newResultColumn(expr, w)
+	w.resultColumns = append(w.resultColumns, rc)
+	return rc, len(w.resultColumns) - 1, nil
+}
+
+// newWindowParams builds the WindowParams of the window function.
+// It returns the expression that needs to be pushed down. For the
+// functions that have no argument, a literal is pushed down as a
+// placeholder for the column that receives the value.
+func newWindowParams(funcExpr *sqlparser.FuncExpr) (params engine.WindowParams, inner sqlparser.Expr, err error) {
+	name := funcExpr.Name.Lowered()
+	opcode, ok := engine.SupportedWindowFunctions[name]
+	if !ok {
+		return params, nil, fmt.Errorf("unsupported: in scatter query: window function '%s'", name)
+	}
+	params.Opcode = opcode
+	if funcExpr.Distinct {
+		return params, nil, fmt.Errorf("unsupported: in scatter query: distinct window function: %s", sqlparser.String(funcExpr))
+	}
+
+	var args []sqlparser.Expr
+	for _, selectExpr := range funcExpr.Exprs {
+		switch selectExpr := selectExpr.(type) {
+		case *sqlparser.AliasedExpr:
+			args = append(args, selectExpr.Expr)
+		case *sqlparser.StarExpr:
+			// count(*) counts the placeholder.
+			if opcode != engine.WindowCount || len(funcExpr.Exprs) != 1 {
+				return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+			}
+		default:
+			return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+	}
+
+	// minArgs and maxArgs are the numbers of arguments the
+	// function accepts, including the one that's pushed down.
+	minArgs, maxArgs := 1, 1
+	switch opcode {
+	case engine.WindowRowNumber, engine.WindowRank, engine.WindowDenseRank, engine.WindowPercentRank, engine.WindowCumeDist:
+		minArgs, maxArgs = 0, 0
+	case engine.WindowLag, engine.WindowLead:
+		maxArgs = 3
+	case engine.WindowNthValue:
+		minArgs, maxArgs = 2, 2
+	case engine.WindowCount:
+		if len(funcExpr.Exprs) == 1 && len(args) == 0 {
+			minArgs = 0
+		}
+	}
+	if len(args) < minArgs || len(args) > maxArgs {
+		return params, nil, fmt.Errorf("incorrect arguments to %s: %s", name, sqlparser.String(funcExpr))
+	}
+
+	// The constant arguments are the ones that follow the
+	// pushed down argument. ntile only has a constant argument.
+	inner = sqlparser.NewIntVal([]byte("1"))
+	consts := args
+	if opcode.HasArgument() && len(args) != 0 {
+		inner = args[0]
+		consts = args[1:]
+	}
+	for i, arg := range consts {
+		pv, err := sqlparser.NewPlanValue(arg)
+		if err != nil {
+			return params, nil, fmt.Errorf("unsupported: in scatter query: window function argument must be a constant: %s", sqlparser.String(arg))
+		}
+		if i == 0 {
+			params.Offset = pv
+		} else {
+			params.Default = pv
+		}
+	}
+	return params, inner, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (w *window) MakeDistinct() error {
+	return errors.New("unsupported: distinct cannot be combined with window functions in scatter query")
+}
+
+// PushGroupBy satisfies the builder interface.
+// A group by is only possible here if it references a unique vindex,
+// which allows it to be pushed down. The window functions are computed
+// after the grouping.
+func (w *window) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return w.input.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The route is requested to order the rows by the PARTITION BY
+// columns followed by the ORDER BY of the window. Those columns
+// are supplied as extra columns if they're not in the select list.
+// The ORDER BY of the query is performed by a memory sort after
+// the window functions are computed.
+func (w *window) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+
+	var windowOrderBy sqlparser.OrderBy
+	for _, expr := range w.over.PartitionBy {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex partition by expression: %s", sqlparser.String(expr))
+		}
+		colNumber := w.supplyKey(col)
+		w.ewindow.PartitionBy = append(w.ewindow.PartitionBy, colNumber)
+		windowOrderBy = append(windowOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr})
+	}
+	for _, order := range w.over.OrderBy {
+		col, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex window order by expression: %s", sqlparser.String(order.Expr))
+		}
+		colNumber := w.supplyKey(col)
+		w.ewindow.OrderBy = append(w.ewindow.OrderBy, colNumber)
+		windowOrderBy = append(windowOrderBy, order)
+	}
+	bldr, err := w.input.PushOrderBy(windowOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	w.input = bldr
+	if len(orderBy) == 0 {
+		return w, nil
+	}
+	return newMemorySort(w, orderBy)
+}
+
+// supplyKey returns the column number of the partition or order by
+// column in the input. If it's not in the select list, the column
+// is requested from the route as an extra column, which gets
+// truncated from the final result.
+func (w *window) supplyKey(col *sqlparser.ColName) int {
+	_, colNumber := w.input.SupplyCol(col)
+	if colNumber >= len(w.resultColumns) {
+		w.ewindow.TruncateColumnCount = len(w.resultColumns)
+	}
+	return colNumber
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because the window functions need
+// all the rows of a partition.
+func (w *window) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the partition or order by
+// columns, then the function modifies the primitive to pull a
+// corresponding weight_string from mysql and compare those instead.
+func (w *window) Wireup(bldr builder, jt *jointab) error {
+	for _, cols := range [][]int{w.ewindow.PartitionBy, w.ewindow.OrderBy} {
+		for i, colNumber := range cols {
+			// Keys can be extra columns that are not in w's
+			// results. So, the column is looked up in the input.
+			rc := w.input.ResultColumns()[colNumber]
+			if !sqltypes.IsText(rc.column.typ) {
+				continue
+			}
+			if weightcolNumber, ok := w.weightStrings[rc]; ok {
+				cols[i] = weightcolNumber
+				continue
+			}
+			weightcolNumber, err := w.input.SupplyWeightString(colNumber)
+			if err != nil {
+				return err
+			}
+			w.weightStrings[rc] = weightcolNumber
+			cols[i] = weightcolNumber
+			w.ewindow.TruncateColumnCount = len(w.resultColumns)
+		}
+	}
+	return w.input.Wireup(bldr, jt)
+}
@@ -110,6 +110,10 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
 	_, _, _ = vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
+	if len(session.ReservedSessions) != 0 || session.LockSession != nil {
+		// Release the reserved connections. Ignore error.
+		_ = vh.vtg.CloseSession(ctx, session)
+	}
 }
 
 // Regexp to extract parent span id over the sql query
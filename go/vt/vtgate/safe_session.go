@@ -17,9 +17,14 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -82,10 +87,14 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	newSession.InReservedConn = false
+	newSession.ReservedSessions = nil
+	newSession.HasTemporaryTables = false
 	return NewSafeSession(newSession)
 }
 
-// Reset clears the session
+// Reset clears the session. The reserved connections are kept,
+// they outlive the transactions.
 func (session *SafeSession) Reset() {
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -96,6 +105,7 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.Savepoints = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 }
 
@@ -169,6 +179,232 @@ func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.T
 	return 0
 }
 
+// forEachShardTransaction calls f for the shard sessions
+// that are in a transaction.
+func (session *SafeSession) forEachShardTransaction(f func(*vtgatepb.Session_ShardSession)) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, shardSession := range sessions {
+			if shardSession.TransactionId != 0 {
+				f(shardSession)
+			}
+		}
+	}
+}
+
+// InReservedConn returns true if the session must use reserved connections.
+func (session *SafeSession) InReservedConn() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.InReservedConn
+}
+
+// SetTemporaryTables records that the session created a temporary
+// table, and pins it to reserved connections.
+func (session *SafeSession) SetTemporaryTables() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.HasTemporaryTables = true
+	session.Session.InReservedConn = true
+}
+
+// FindReserved returns the reservedID, if any, for a session
+func (session *SafeSession) FindReserved(keyspace, shard string, tabletType topodatapb.TabletType) int64 {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, shardSession := range session.ReservedSessions {
+		if keyspace == shardSession.Target.Keyspace && tabletType == shardSession.Target.TabletType && shard == shardSession.Target.Shard {
+			return shardSession.ReservedId
+		}
+	}
+	return 0
+}
+
+// GetLockSession returns the connection reserved for
+// the advisory locks of the session, if any.
+func (session *SafeSession) GetLockSession() *vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.LockSession
+}
+
+// SetLockSession sets the connection reserved for the
+// advisory locks of the session. nil clears it.
+func (session *SafeSession) SetLockSession(lockSession *vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.LockSession = lockSession
+}
+
+// AppendReserved adds a new reserved ShardSession
+func (session *SafeSession) AppendReserved(shardSession *vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ReservedSessions = append(session.ReservedSessions, shardSession)
+}
+
+// GetSystemVariables returns a copy of the MySQL system variables
+// that must be set on the reserved connections.
+func (session *SafeSession) GetSystemVariables() map[string]string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	vars := make(map[string]string, len(session.SystemVariables))
+	for name, value := range session.SystemVariables {
+		vars[name] = value
+	}
+	return vars
+}
+
+// SetSystemVariables replaces the MySQL system variables of the
+// session. The session is pinned to reserved connections as long
+// as it has some, or temporary tables.
+func (session *SafeSession) SetSystemVariables(vars map[string]string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(vars) == 0 {
+		vars = nil
+	}
+	session.SystemVariables = vars
+	session.Session.InReservedConn = len(vars) != 0 || session.Session.HasTemporaryTables
+}
+
+// SystemVariablesQueries returns the statements that set
+// the system variables of the session on a new connection.
+// Sessions are sent by clients. So, the statements are
+// rebuilt from validated names and literal values.
+func (session *SafeSession) SystemVariablesQueries() ([]string, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	names := make([]string, 0, len(session.SystemVariables))
+	for name := range session.SystemVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	queries := make([]string, 0, len(names))
+	for _, name := range names {
+		expr, err := parseSystemVariableValue(name, session.SystemVariables[name])
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, "set "+formatSystemVariable(name, expr))
+	}
+	return queries, nil
+}
+
+// systemVariableName matches the names of the system
+// variables that can be set on the connections of a session.
+var systemVariableName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// checkSystemVariableName returns an error if name is not
+// a plain identifier.
+func checkSystemVariableName(name string) error {
+	if !systemVariableName.MatchString(name) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid system variable name: %q", name)
+	}
+	return nil
+}
+
+// parseSystemVariableValue parses the value of a system variable
+// recorded in the session. Only literals are accepted.
+func parseSystemVariableValue(name, value string) (sqlparser.Expr, error) {
+	if err := checkSystemVariableName(name); err != nil {
+		return nil, err
+	}
+	stmt, err := sqlparser.Parse(fmt.Sprintf("set %s = %s", name, value))
+	if err == nil {
+		if set, ok := stmt.(*sqlparser.Set); ok && set.Scope == "" && len(set.Exprs) == 1 && set.Exprs[0].Name.EqualString(name) {
+			switch expr := set.Exprs[0].Expr.(type) {
+			case *sqlparser.SQLVal:
+				if expr.Type == sqlparser.StrVal || expr.Type == sqlparser.IntVal {
+					return expr, nil
+				}
+			case *sqlparser.NullVal:
+				return expr, nil
+			}
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid value for system variable %s: %s", name, value)
+}
+
+// formatSystemVariable returns the assignment of the value
+// to the system variable, as used in a SET statement.
+func formatSystemVariable(name string, expr sqlparser.Expr) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%v = %v", sqlparser.NewColIdent(name), expr)
+	return buf.String()
+}
+
+// AddSavepoint records a new savepoint of the transaction. Like in
+// MySQL, an older savepoint with the same name is replaced.
+func (session *SafeSession) AddSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, name)
+}
+
+// RollbackToSavepoint forgets the savepoints created after
+// the one with the given name. It returns false if the
+// savepoint doesn't exist.
+func (session *SafeSession) RollbackToSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	i := session.findSavepoint(name)
+	if i == -1 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:i+1]
+	return true
+}
+
+// ReleaseSavepoint forgets the savepoint with the given name,
+// along with the ones created after it. It returns false if
+// the savepoint doesn't exist.
+func (session *SafeSession) ReleaseSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	i := session.findSavepoint(name)
+	if i == -1 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:i]
+	return true
+}
+
+// HasSavepoint returns true if the transaction has a
+// savepoint with the given name.
+func (session *SafeSession) HasSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) != -1
+}
+
+// SavepointQueries returns the statements that create the
+// savepoints of the transaction on a shard that joins it.
+func (session *SafeSession) SavepointQueries() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	queries := make([]string, 0, len(session.Savepoints))
+	for _, name := range session.Savepoints {
+		queries = append(queries, sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name)}))
+	}
+	return queries
+}
+
+// findSavepoint returns the position of the savepoint, or -1.
+// Savepoint names are not case sensitive.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		if strings.EqualFold(savepoint, name) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Append adds a new ShardSession
 func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession, txMode vtgatepb.TransactionMode) error {
 	session.mu.Lock()
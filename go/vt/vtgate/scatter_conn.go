@@ -18,8 +18,10 @@ package vtgate
 
 import (
 	"flag"
+	"fmt"
 	"io"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -140,19 +143,20 @@ func (stc *ScatterConn) Execute(
 		session,
 		notInTransaction,
 		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
-			var innerqr *sqltypes.Result
-			if shouldBegin {
-				var err error
+			var (
+				innerqr *sqltypes.Result
+				err     error
+			)
+			switch {
+			case session.InReservedConn():
+				innerqr, transactionID, err = stc.executeReserved(ctx, rs, &querypb.BoundQuery{Sql: query, BindVariables: bindVars}, session, shouldBegin, transactionID, options)
+			case shouldBegin:
 				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, options)
-				if err != nil {
-					return transactionID, err
-				}
-			} else {
-				var err error
+			default:
 				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, query, bindVars, transactionID, options)
-				if err != nil {
-					return transactionID, err
-				}
+			}
+			if err != nil {
+				return transactionID, err
 			}
 
 			mu.Lock()
@@ -209,6 +213,19 @@ func (stc *ScatterConn) ExecuteMultiShard(
 				opts = session.Session.Options
 			}
 
+			if session.InReservedConn() {
+				innerqr, transactionID, err = stc.executeReserved(ctx, rs, queries[i], session, shouldBegin, transactionID, opts)
+				if err != nil {
+					return transactionID, err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if len(qr.Rows) <= *maxMemoryRows {
+					qr.AppendResult(innerqr)
+				}
+				return transactionID, nil
+			}
+
 			switch {
 			case autocommit:
 				innerqr, err = stc.executeAutocommit(ctx, rs, queries[i].Sql, queries[i].BindVariables, opts)
@@ -238,6 +255,181 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	return qr, allErrors.GetErrors()
 }
 
+// executeReserved executes the query on the reserved connection of
+// the shard. If the session doesn't have one yet, it gets reserved
+// with the system variables of the session. A transaction that was
+// begun before the session got pinned has its connection reserved.
+func (stc *ScatterConn) executeReserved(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession, shouldBegin bool, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID := session.FindReserved(rs.Target.Keyspace, rs.Target.Shard, rs.Target.TabletType)
+	if transactionID != 0 && reservedID != 0 {
+		qr, err := rs.QueryService.Execute(ctx, rs.Target, query.Sql, query.BindVariables, transactionID, options)
+		return qr, transactionID, err
+	}
+
+	var preQueries []string
+	if reservedID == 0 {
+		var err error
+		if preQueries, err = session.SystemVariablesQueries(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var (
+		qr  *sqltypes.Result
+		id  int64
+		err error
+	)
+	switch {
+	case transactionID != 0:
+		qr, id, err = rs.QueryService.ReserveExecute(ctx, rs.Target, preQueries, query.Sql, query.BindVariables, transactionID, options)
+	case shouldBegin:
+		qr, id, err = rs.QueryService.ReserveBeginExecute(ctx, rs.Target, preQueries, query.Sql, query.BindVariables, reservedID, options)
+		transactionID = id
+	case reservedID != 0:
+		qr, err = rs.QueryService.Execute(ctx, rs.Target, query.Sql, query.BindVariables, reservedID, options)
+	default:
+		qr, id, err = rs.QueryService.ReserveExecute(ctx, rs.Target, preQueries, query.Sql, query.BindVariables, 0, options)
+	}
+	if reservedID == 0 && id != 0 {
+		session.AppendReserved(&vtgatepb.Session_ShardSession{
+			Target:     rs.Target,
+			ReservedId: id,
+		})
+	}
+	return qr, transactionID, err
+}
+
+// ExecuteLock executes the query on the connection that the session
+// reserves for its advisory locks. The connection gets reserved by the
+// first query. All the locks of a session must be taken on the same
+// shard, as MySQL keeps them per connection.
+func (stc *ScatterConn) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error) {
+	var opts *querypb.ExecuteOptions
+	if session.Session != nil {
+		opts = session.Session.Options
+	}
+	ls := session.GetLockSession()
+	if ls == nil {
+		qr, reservedID, err := rs.QueryService.ReserveExecute(ctx, rs.Target, nil, query.Sql, query.BindVariables, 0, opts)
+		if err != nil {
+			// The query failed, so the connection holds no locks.
+			if reservedID != 0 {
+				if releaseErr := rs.QueryService.Release(ctx, rs.Target, reservedID); releaseErr != nil {
+					log.Warningf("Release of the lock connection failed: %v", releaseErr)
+				}
+			}
+			return nil, err
+		}
+		session.SetLockSession(&vtgatepb.Session_ShardSession{
+			Target:     rs.Target,
+			ReservedId: reservedID,
+		})
+		stc.txConn.locks.record(rs.Target, reservedID, query.Sql)
+		return qr, nil
+	}
+	if ls.Target.Keyspace != rs.Target.Keyspace || ls.Target.Shard != rs.Target.Shard || ls.Target.TabletType != rs.Target.TabletType {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the locks of the session are held on %s, not on %s", lockTargetString(ls.Target), lockTargetString(rs.Target))
+	}
+	stc.txConn.locks.record(ls.Target, ls.ReservedId, query.Sql)
+	qr, err := rs.QueryService.Execute(ctx, rs.Target, query.Sql, query.BindVariables, ls.ReservedId, opts)
+	if err != nil && isLockConnectionLost(err, ls.ReservedId) {
+		// The locks went away with the connection. The next
+		// lock query reserves a new one.
+		session.SetLockSession(nil)
+		stc.txConn.locks.remove(ls.Target, ls.ReservedId)
+		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "the connection holding the advisory locks of the session was lost, and its locks were released: %v", err)
+	}
+	return qr, err
+}
+
+// isLockConnectionLost returns true if the error says that the
+// tablet doesn't know the reserved connection any more, because
+// it was closed or the tablet restarted.
+func isLockConnectionLost(err error, reservedID int64) bool {
+	return vterrors.Code(err) == vtrpcpb.Code_ABORTED && strings.Contains(err.Error(), fmt.Sprintf("transaction %d: ", reservedID))
+}
+
+// SetSystemVariables executes the statement that changes the system
+// variables on the connections of the session. If reserve is set, the
+// connections of its transactions get reserved, as they'll need the new
+// values after the transactions end.
+func (stc *ScatterConn) SetSystemVariables(ctx context.Context, session *SafeSession, sql string, reserve bool) error {
+	var opts *querypb.ExecuteOptions
+	if session.Session != nil {
+		opts = session.Session.Options
+	}
+	allErrors := new(concurrency.AllErrorRecorder)
+	for _, shardSession := range session.ReservedSessions {
+		if _, err := stc.gateway.Execute(ctx, shardSession.Target, sql, nil, shardSession.ReservedId, opts); err != nil {
+			allErrors.RecordError(err)
+		}
+	}
+	if !reserve {
+		return allErrors.AggrError(vterrors.Aggregate)
+	}
+	for _, shardSession := range session.ShardSessions {
+		target := shardSession.Target
+		if session.FindReserved(target.Keyspace, target.Shard, target.TabletType) != 0 {
+			continue
+		}
+		_, reservedID, err := stc.gateway.ReserveExecute(ctx, target, nil, sql, nil, shardSession.TransactionId, opts)
+		if err != nil {
+			allErrors.RecordError(err)
+			continue
+		}
+		session.AppendReserved(&vtgatepb.Session_ShardSession{
+			Target:     target,
+			ReservedId: reservedID,
+		})
+	}
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// ExecuteSavepoint executes a SAVEPOINT, ROLLBACK TO or RELEASE SAVEPOINT
+// statement on all the shards that participate in the transaction.
+func (stc *ScatterConn) ExecuteSavepoint(ctx context.Context, session *SafeSession, sql string) error {
+	var opts *querypb.ExecuteOptions
+	if session.Session != nil {
+		opts = session.Session.Options
+	}
+	allErrors := new(concurrency.AllErrorRecorder)
+	for _, shardSessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, shardSession := range shardSessions {
+			if _, err := stc.gateway.Execute(ctx, shardSession.Target, sql, nil, shardSession.TransactionId, opts); err != nil {
+				allErrors.RecordError(err)
+			}
+		}
+	}
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// beginSavepoints begins the transaction on a shard that joins it
+// after savepoints were created, and creates them on the shard.
+func (stc *ScatterConn) beginSavepoints(ctx context.Context, rs *srvtopo.ResolvedShard, queries []string, session *SafeSession) (int64, error) {
+	var opts *querypb.ExecuteOptions
+	if session.Session != nil {
+		opts = session.Session.Options
+	}
+	var (
+		transactionID int64
+		err           error
+	)
+	if session.InReservedConn() {
+		_, transactionID, err = stc.executeReserved(ctx, rs, &querypb.BoundQuery{Sql: queries[0]}, session, true, 0, opts)
+	} else {
+		_, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, queries[0], nil, opts)
+	}
+	if err != nil {
+		return transactionID, err
+	}
+	for _, sql := range queries[1:] {
+		if _, err := rs.QueryService.Execute(ctx, rs.Target, sql, nil, transactionID, opts); err != nil {
+			return transactionID, err
+		}
+	}
+	return transactionID, nil
+}
+
 func (stc *ScatterConn) executeAutocommit(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	queries := []*querypb.BoundQuery{{
 		Sql:           sql,
@@ -812,8 +1004,19 @@ func (stc *ScatterConn) multiGoTransaction(
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 		shouldBegin, transactionID := transactionInfo(rs.Target, session, notInTransaction)
-		transactionID, err = action(rs, i, shouldBegin, transactionID)
-		if shouldBegin && transactionID != 0 {
+		joined := shouldBegin
+		if shouldBegin {
+			// The shard joins the transaction after savepoints were
+			// created. They're created on the shard before the query.
+			if savepoints := session.SavepointQueries(); len(savepoints) != 0 {
+				transactionID, err = stc.beginSavepoints(ctx, rs, savepoints, session)
+				shouldBegin = false
+			}
+		}
+		if err == nil {
+			transactionID, err = action(rs, i, shouldBegin, transactionID)
+		}
+		if joined && transactionID != 0 {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 				Target:        rs.Target,
 				TransactionId: transactionID,
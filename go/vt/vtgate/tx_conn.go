@@ -37,6 +37,12 @@ import (
 type TxConn struct {
 	gateway gateway.Gateway
 	mode    vtgatepb.TransactionMode
+	// locks keeps track of the connections reserved
+	// for advisory locks.
+	locks *lockSessions
+	// hooks keeps the functions to call at the end
+	// of the transactions.
+	hooks *txHooks
 }
 
 // NewTxConn builds a new TxConn.
@@ -44,6 +50,8 @@ func NewTxConn(gw gateway.Gateway, txMode vtgatepb.TransactionMode) *TxConn {
 	return &TxConn{
 		gateway: gw,
 		mode:    txMode,
+		locks:   newLockSessions(),
+		hooks:   newTxHooks(),
 	}
 }
 
@@ -74,9 +82,11 @@ func (txc *TxConn) Begin(ctx context.Context, session *SafeSession) error {
 // best effort or 2pc depending on the session setting.
 func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	defer session.Reset()
+	defer txc.releaseUnused(ctx, session)
 	if !session.InTransaction() {
 		return nil
 	}
+	defer txc.hooks.run(txHookKeys(session))
 
 	twopc := false
 	switch session.TransactionMode {
@@ -182,6 +192,8 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.Reset()
+	defer txc.releaseUnused(ctx, session)
+	defer txc.hooks.run(txHookKeys(session))
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -194,6 +206,43 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Release releases the reserved connections of the session. Their
+// transactions, if any, are rolled back.
+func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
+	if len(session.ReservedSessions) == 0 {
+		return nil
+	}
+	defer func() {
+		session.ReservedSessions = nil
+	}()
+	return txc.runSessions(session.ReservedSessions, func(s *vtgatepb.Session_ShardSession) error {
+		return txc.gateway.Release(ctx, s.Target, s.ReservedId)
+	})
+}
+
+// ReleaseLock releases the connection reserved for the advisory locks
+// of the session. MySQL releases the locks when the connection closes.
+func (txc *TxConn) ReleaseLock(ctx context.Context, session *SafeSession) error {
+	ls := session.GetLockSession()
+	if ls == nil {
+		return nil
+	}
+	session.SetLockSession(nil)
+	txc.locks.remove(ls.Target, ls.ReservedId)
+	return txc.gateway.Release(ctx, ls.Target, ls.ReservedId)
+}
+
+// releaseUnused releases the reserved connections that the session
+// doesn't need any more, once its transaction is over.
+func (txc *TxConn) releaseUnused(ctx context.Context, session *SafeSession) {
+	if session.InReservedConn() {
+		return
+	}
+	if err := txc.Release(ctx, session); err != nil {
+		log.Warningf("Release of reserved connections failed: %v", err)
+	}
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
@@ -142,6 +142,36 @@ func TestTxConnCommitSuccess(t *testing.T) {
 	}
 }
 
+func TestTxConnHooks(t *testing.T) {
+	sc, _, _, rss0, _, _ := newTestTxConnEnv(t, "TestTxConn")
+
+	calls := 0
+	hook := func() { calls++ }
+	session := NewSafeSession(&vtgatepb.Session{})
+	sc.txConn.hooks.add(session, hook)
+	if calls != 1 {
+		t.Errorf("hook calls without a transaction: %d, want 1", calls)
+	}
+
+	for _, end := range []func(context.Context, *SafeSession) error{sc.txConn.Commit, sc.txConn.Rollback} {
+		calls = 0
+		session = NewSafeSession(&vtgatepb.Session{InTransaction: true})
+		sc.Execute(context.Background(), "query1", nil, rss0, topodatapb.TabletType_MASTER, session, false, nil)
+		sc.txConn.hooks.add(session, hook)
+		if calls != 0 {
+			t.Errorf("hook calls in the transaction: %d, want 0", calls)
+		}
+		// The session comes back from the client for the next request.
+		session = NewSafeSession(proto.Clone(session.Session).(*vtgatepb.Session))
+		if err := end(context.Background(), session); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 1 {
+			t.Errorf("hook calls after the transaction: %d, want 1", calls)
+		}
+	}
+}
+
 func TestTxConnCommitOrderFailure1(t *testing.T) {
 	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
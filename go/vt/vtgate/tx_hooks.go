@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"sync"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// txHooks keeps the functions to call once the transactions of
+// the sessions are over. The session is sent back and forth with
+// the client, so the hooks can't be kept in it. They are instead
+// keyed by one of the shard transactions of the session, which
+// the session keeps until it commits or rolls back.
+type txHooks struct {
+	mu    sync.Mutex
+	hooks map[txHookKey][]func()
+}
+
+type txHookKey struct {
+	keyspace      string
+	shard         string
+	transactionID int64
+}
+
+func newTxHooks() *txHooks {
+	return &txHooks{
+		hooks: make(map[txHookKey][]func()),
+	}
+}
+
+// add registers hook to be called at the end of the transaction
+// of the session. It's called right away if the session has no
+// shard transaction.
+func (th *txHooks) add(session *SafeSession, hook func()) {
+	keys := txHookKeys(session)
+	if len(keys) == 0 {
+		hook()
+		return
+	}
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.hooks[keys[0]] = append(th.hooks[keys[0]], hook)
+}
+
+// run calls the hooks of the shard transactions, and forgets them.
+func (th *txHooks) run(keys []txHookKey) {
+	var hooks []func()
+	th.mu.Lock()
+	for _, key := range keys {
+		hooks = append(hooks, th.hooks[key]...)
+		delete(th.hooks, key)
+	}
+	th.mu.Unlock()
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
+// txHookKeys returns the keys of the shard transactions of the session.
+func txHookKeys(session *SafeSession) []txHookKey {
+	var keys []txHookKey
+	session.forEachShardTransaction(func(shardSession *vtgatepb.Session_ShardSession) {
+		keys = append(keys, txHookKey{
+			keyspace:      shardSession.Target.Keyspace,
+			shard:         shardSession.Target.Shard,
+			transactionID: shardSession.TransactionId,
+		})
+	})
+	return keys
+}
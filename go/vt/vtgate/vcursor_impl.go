@@ -162,6 +162,16 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 	return qr, err
 }
 
+// InTransaction is part of the engine.VCursor interface.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
+// AfterTransaction is part of the engine.VCursor interface.
+func (vc *vcursorImpl) AfterTransaction(hook func()) {
+	vc.executor.txConn.hooks.add(vc.safeSession, hook)
+}
+
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, isDML, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(queries)))
@@ -193,6 +203,13 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	return qr, vterrors.Aggregate(errs)
 }
 
+// ExecuteLock is part of the engine.VCursor interface.
+func (vc *vcursorImpl) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	atomic.AddUint32(&vc.logStats.ShardQueries, 1)
+	query.Sql = vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing
+	return vc.executor.scatterConn.ExecuteLock(vc.ctx, rs, query, vc.safeSession)
+}
+
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/proto/vtgate"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -57,6 +58,11 @@ type ConsistentLookup struct {
 //   table: name of the backing table. It can be qualified by the keyspace.
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewConsistentLookup(name string, m map[string]string) (Vindex, error) {
 	clc, err := newCLCommon(name, m)
 	if err != nil {
@@ -78,6 +84,12 @@ func (lu *ConsistentLookup) IsUnique() bool {
 // Map can map ids to key.Destination objects.
 func (lu *ConsistentLookup) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
+	if lu.writeOnly {
+		for range ids {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
 
 	results, err := lu.lkp.Lookup(vcursor, ids)
 	if err != nil {
@@ -111,6 +123,11 @@ type ConsistentLookupUnique struct {
 //   table: name of the backing table. It can be qualified by the keyspace.
 //   from: list of columns in the table that have the 'from' values of the lookup vindex.
 //   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewConsistentLookupUnique(name string, m map[string]string) (Vindex, error) {
 	clc, err := newCLCommon(name, m)
 	if err != nil {
@@ -132,6 +149,12 @@ func (lu *ConsistentLookupUnique) IsUnique() bool {
 // Map can map ids to key.Destination objects.
 func (lu *ConsistentLookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
+	if lu.writeOnly {
+		for range ids {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
 	results, err := lu.lkp.Lookup(vcursor, ids)
 	if err != nil {
 		return nil, err
@@ -156,6 +179,7 @@ func (lu *ConsistentLookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]
 // Unique and a Lookup.
 type clCommon struct {
 	name         string
+	writeOnly    bool
 	lkp          lookupInternal
 	keyspace     string
 	ownerTable   string
@@ -170,8 +194,13 @@ type clCommon struct {
 // newCLCommon is commone code for the consistent lookup vindexes.
 func newCLCommon(name string, m map[string]string) (*clCommon, error) {
 	lu := &clCommon{name: name}
+	var err error
+	lu.writeOnly, err = boolFromMap(m, "write_only")
+	if err != nil {
+		return nil, err
+	}
 
-	if err := lu.lkp.Init(m, false /* autocommit */, false /* upsert */); err != nil {
+	if err := lu.lkp.Init(name, m, false /* autocommit */, false /* upsert */); err != nil {
 		return nil, err
 	}
 	return lu, nil
@@ -206,6 +235,13 @@ func (lu *clCommon) IsFunctional() bool {
 
 // Verify returns true if ids maps to ksids.
 func (lu *clCommon) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	if lu.writeOnly {
+		out := make([]bool, len(ids))
+		for i := range ids {
+			out[i] = true
+		}
+		return out, nil
+	}
 	return lu.lkp.VerifyCustom(vcursor, ids, ksidsToValues(ksids), vtgate.CommitOrder_PRE)
 }
 
@@ -218,6 +254,8 @@ func (lu *clCommon) Create(vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 	if !strings.Contains(err.Error(), "Duplicate entry") {
 		return err
 	}
+	// handleDup can change the keyspace ids of the rows.
+	defer lu.lkp.endWrite(vcursor, lu.lkp.cache.startWrite(rowsColValues), vtgatepb.CommitOrder_PRE)
 	for i, row := range rowsColValues {
 		if err := lu.handleDup(vcursor, row, ksids[i]); err != nil {
 			return err
@@ -28,6 +28,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
@@ -144,6 +145,53 @@ func TestConsistentLookupUniqueMap(t *testing.T) {
 	}
 }
 
+func TestConsistentLookupMapWriteOnly(t *testing.T) {
+	for _, name := range []string{"consistent_lookup", "consistent_lookup_unique"} {
+		lookup, err := CreateVindex(name, name, map[string]string{
+			"table":      "t",
+			"from":       "fromc1,fromc2",
+			"to":         "toc",
+			"write_only": "true",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		vc := &loggingVCursor{}
+
+		got, err := lookup.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+		if err != nil {
+			t.Error(err)
+		}
+		want := []key.Destination{
+			key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+			key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.Map(): %#v, want %+v", name, got, want)
+		}
+
+		gotBools, err := lookup.Verify(vc, []sqltypes.Value{sqltypes.NewInt64(1)}, [][]byte{[]byte("test1")})
+		if err != nil {
+			t.Error(err)
+		}
+		if wantBools := []bool{true}; !reflect.DeepEqual(gotBools, wantBools) {
+			t.Errorf("%s.Verify(): %v, want %v", name, gotBools, wantBools)
+		}
+		vc.verifyLog(t, []string{})
+	}
+
+	_, err := CreateVindex("consistent_lookup", "consistent_lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc1",
+		"to":         "toc",
+		"write_only": "invalid",
+	})
+	want := "write_only value must be 'true' or 'false': 'invalid'"
+	if err == nil || err.Error() != want {
+		t.Errorf("Create(bad_write_only): %v, want %s", err, want)
+	}
+}
+
 func TestConsistentLookupMapAbsent(t *testing.T) {
 	lookup := createConsistentLookup(t, "consistent_lookup")
 	vc := &loggingVCursor{}
@@ -474,6 +522,14 @@ func (vc *loggingVCursor) ExecuteKeyspaceID(keyspace string, ksid []byte, query
 	return vc.execute("ExecuteKeyspaceID", query, bindVars, isDML)
 }
 
+func (vc *loggingVCursor) InTransaction() bool {
+	return false
+}
+
+func (vc *loggingVCursor) AfterTransaction(hook func()) {
+	hook()
+}
+
 func (vc *loggingVCursor) execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool) (*sqltypes.Result, error) {
 	if vc.index >= len(vc.results) {
 		return nil, fmt.Errorf("ran out of results to return: %s", query)
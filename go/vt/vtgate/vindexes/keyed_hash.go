@@ -0,0 +1,217 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/des"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cespare/xxhash/v2"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	_ Vindex     = (*KeyedHash)(nil)
+	_ Vindex     = (*ReversibleKeyedHash)(nil)
+	_ Reversible = (*ReversibleKeyedHash)(nil)
+)
+
+// A Hasher computes the keyspace ids of a keyed hash vindex.
+type Hasher interface {
+	Hash(id sqltypes.Value) ([]byte, error)
+}
+
+// A ReversibleHasher is a Hasher that can also compute
+// the ids back from the keyspace ids.
+type ReversibleHasher interface {
+	Hasher
+	Unhash(ksid []byte) (sqltypes.Value, error)
+}
+
+// A NewHasherFunc creates the Hasher of a keyed hash vindex
+// from the decoded key param of the vindex.
+type NewHasherFunc func(key []byte) (Hasher, error)
+
+// RegisterKeyedHash registers a keyed hash vindex under vindexType.
+// The vindexes of the type require the key param, a hex encoded key,
+// and compute the keyspace ids with the Hasher that newHasher creates
+// for the key. They are Unique and Functional, and also Reversible
+// if the Hasher is a ReversibleHasher.
+func RegisterKeyedHash(vindexType string, newHasher NewHasherFunc) {
+	Register(vindexType, func(name string, m map[string]string) (Vindex, error) {
+		hexKey, ok := m["key"]
+		if !ok {
+			return nil, fmt.Errorf("%s vindex %s: key param is required", vindexType, name)
+		}
+		k, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s vindex %s: invalid key: %v", vindexType, name, err)
+		}
+		hasher, err := newHasher(k)
+		if err != nil {
+			return nil, fmt.Errorf("%s vindex %s: %v", vindexType, name, err)
+		}
+		kh := &KeyedHash{name: name, hasher: hasher}
+		if _, ok := hasher.(ReversibleHasher); ok {
+			return &ReversibleKeyedHash{KeyedHash: kh}, nil
+		}
+		return kh, nil
+	})
+}
+
+// KeyedHash defines a vindex that hashes ids to keyspace ids
+// with a keyed Hasher. It's Unique and Functional.
+type KeyedHash struct {
+	name   string
+	hasher Hasher
+}
+
+// String returns the name of the vindex.
+func (vind *KeyedHash) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this index as 1.
+func (vind *KeyedHash) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *KeyedHash) IsUnique() bool {
+	return true
+}
+
+// IsFunctional returns true since the Vindex is functional.
+func (vind *KeyedHash) IsFunctional() bool {
+	return true
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *KeyedHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i, id := range ids {
+		ksid, err := vind.hasher.Hash(id)
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = key.DestinationKeyspaceID(ksid)
+	}
+	return out, nil
+}
+
+// Verify returns true if ids maps to ksids.
+func (vind *KeyedHash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i, id := range ids {
+		ksid, err := vind.hasher.Hash(id)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "%s.Verify", vind.name)
+		}
+		out[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// ReversibleKeyedHash is a KeyedHash whose Hasher is
+// a ReversibleHasher. It's also Reversible.
+type ReversibleKeyedHash struct {
+	*KeyedHash
+}
+
+// ReverseMap returns the ids from ksids.
+func (vind *ReversibleKeyedHash) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	hasher := vind.hasher.(ReversibleHasher)
+	reverseIds := make([]sqltypes.Value, 0, len(ksids))
+	for _, ksid := range ksids {
+		id, err := hasher.Unhash(ksid)
+		if err != nil {
+			return reverseIds, err
+		}
+		reverseIds = append(reverseIds, id)
+	}
+	return reverseIds, nil
+}
+
+// xxhashHasher hashes the bytes of any sql type
+// prefixed with the key by using xxhash64.
+type xxhashHasher struct {
+	key []byte
+}
+
+func newXXHashHasher(k []byte) (Hasher, error) {
+	if len(k) == 0 {
+		return nil, errors.New("key must not be empty")
+	}
+	return &xxhashHasher{key: k}, nil
+}
+
+func (h *xxhashHasher) Hash(id sqltypes.Value) ([]byte, error) {
+	d := xxhash.New()
+	d.Write(h.key)
+	d.Write(id.ToBytes())
+	var hashed [8]byte
+	binary.BigEndian.PutUint64(hashed[:], d.Sum64())
+	return hashed[:], nil
+}
+
+// tripleDESHasher encrypts 64 bit unsigned ints with 3DES.
+// It's the keyed variant of the hash vindex, and is reversible.
+type tripleDESHasher struct {
+	block cipher.Block
+}
+
+func newTripleDESHasher(k []byte) (Hasher, error) {
+	block, err := des.NewTripleDESCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return &tripleDESHasher{block: block}, nil
+}
+
+func (h *tripleDESHasher) Hash(id sqltypes.Value) ([]byte, error) {
+	num, err := sqltypes.ToUint64(id)
+	if err != nil {
+		return nil, err
+	}
+	var keybytes, hashed [8]byte
+	binary.BigEndian.PutUint64(keybytes[:], num)
+	h.block.Encrypt(hashed[:], keybytes[:])
+	return hashed[:], nil
+}
+
+func (h *tripleDESHasher) Unhash(ksid []byte) (sqltypes.Value, error) {
+	if len(ksid) != 8 {
+		return sqltypes.NULL, fmt.Errorf("invalid keyspace id: %v", hex.EncodeToString(ksid))
+	}
+	var unhashed [8]byte
+	h.block.Decrypt(unhashed[:], ksid)
+	return sqltypes.NewUint64(binary.BigEndian.Uint64(unhashed[:])), nil
+}
+
+func init() {
+	RegisterKeyedHash("keyed_xxhash", newXXHashHasher)
+	RegisterKeyedHash("keyed_3des", newTripleDESHasher)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+const testTripleDESKey = "000102030405060708090a0b0c0d0e0f1011121314151617"
+
+func TestKeyedXXHash(t *testing.T) {
+	vindex, err := CreateVindex("keyed_xxhash", "kx", map[string]string{"key": "0102"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vindex.(Reversible); ok {
+		t.Errorf("keyed_xxhash is Reversible, want not Reversible")
+	}
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("test1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\xb9\xe1\x1a\xb7\x3b\x51\x5f")),
+		key.DestinationKeyspaceID([]byte("\xf2\xc8\xa0\x94\x61\x7f\x66\x2f")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %v, want %v", got, want)
+	}
+
+	ksids := [][]byte{[]byte("\x00\xb9\xe1\x1a\xb7\x3b\x51\x5f"), []byte("\xb7\xb4\x12\x76\x36\x05\x64\xd4")}
+	verified, err := vindex.Verify(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1)}, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wantVerified := []bool{true, false}; !reflect.DeepEqual(verified, wantVerified) {
+		t.Errorf("Verify(): %v, want %v", verified, wantVerified)
+	}
+}
+
+func TestKeyed3DES(t *testing.T) {
+	vindex, err := CreateVindex("keyed_3des", "k3", map[string]string{"key": testTripleDESKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewVarChar("abcd")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x74\x76\x8b\xeb\x02\x84\x6c\x44")),
+		key.DestinationKeyspaceID([]byte("\xa5\xc5\x62\x8c\x7b\xb0\x95\x39")),
+		key.DestinationNone{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %v, want %v", got, want)
+	}
+
+	reversible, ok := vindex.(Reversible)
+	if !ok {
+		t.Fatalf("keyed_3des is not Reversible")
+	}
+	ids, err := reversible.ReverseMap(nil, [][]byte{[]byte("\x74\x76\x8b\xeb\x02\x84\x6c\x44"), []byte("\xa5\xc5\x62\x8c\x7b\xb0\x95\x39")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantIds := []sqltypes.Value{sqltypes.NewUint64(1), sqltypes.NewUint64(2)}
+	if !reflect.DeepEqual(ids, wantIds) {
+		t.Errorf("ReverseMap(): %v, want %v", ids, wantIds)
+	}
+
+	_, err = reversible.ReverseMap(nil, [][]byte{[]byte("\x74")})
+	wantErr := "invalid keyspace id: 74"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("ReverseMap(): %v, want %s", err, wantErr)
+	}
+}
+
+func TestKeyedHashCreateErrors(t *testing.T) {
+	tcases := []struct {
+		vindexType string
+		params     map[string]string
+		err        string
+	}{{
+		vindexType: "keyed_xxhash",
+		params:     nil,
+		err:        "keyed_xxhash vindex kh: key param is required",
+	}, {
+		vindexType: "keyed_xxhash",
+		params:     map[string]string{"key": "xyz"},
+		err:        "keyed_xxhash vindex kh: invalid key: encoding/hex: invalid byte: U+0078 'x'",
+	}, {
+		vindexType: "keyed_xxhash",
+		params:     map[string]string{"key": ""},
+		err:        "keyed_xxhash vindex kh: key must not be empty",
+	}, {
+		vindexType: "keyed_3des",
+		params:     map[string]string{"key": "0102"},
+		err:        "keyed_3des vindex kh: crypto/des: invalid key size 2",
+	}}
+	for _, tcase := range tcases {
+		_, err := CreateVindex(tcase.vindexType, "kh", tcase.params)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("CreateVindex(%s, %v): %v, want %s", tcase.vindexType, tcase.params, err, tcase.err)
+		}
+	}
+}
@@ -135,6 +135,8 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -148,7 +150,7 @@ func NewLookup(name string, m map[string]string) (Vindex, error) {
 	}
 
 	// if autocommit is on for non-unique lookup, upsert should also be on.
-	if err := lookup.lkp.Init(m, autocommit, autocommit /* upsert */); err != nil {
+	if err := lookup.lkp.Init(name, m, autocommit, autocommit /* upsert */); err != nil {
 		return nil, err
 	}
 	return lookup, nil
@@ -182,6 +184,8 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -195,7 +199,7 @@ func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	}
 
 	// Don't allow upserts for unique vindexes.
-	if err := lu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
+	if err := lu.lkp.Init(name, m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
 	return lu, nil
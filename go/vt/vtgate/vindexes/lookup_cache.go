@@ -0,0 +1,188 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+)
+
+// defaultLookupCacheTTL is the ttl of the lookup cache
+// entries if the cache_ttl param is not specified.
+const defaultLookupCacheTTL = 10 * time.Second
+
+var (
+	lookupCacheHits   = stats.NewCountersWithSingleLabel("VindexLookupCacheHits", "Lookup vindex cache hits", "Vindex")
+	lookupCacheMisses = stats.NewCountersWithSingleLabel("VindexLookupCacheMisses", "Lookup vindex cache misses", "Vindex")
+)
+
+// lookupCache caches the results of the lookup queries of a vindex.
+// It holds at most size entries, which expire after the ttl. The
+// entries of the ids that this vtgate writes to the lookup table are
+// invalidated, and are not cached again until the writes are committed
+// or rolled back. Writes of other vtgates are only seen after the ttl.
+// A nil lookupCache caches nothing.
+type lookupCache struct {
+	name string
+	ttl  time.Duration
+	lru  *cache.LRUCache
+
+	mu sync.Mutex
+	// gen changes whenever a write starts or ends. A result is only
+	// cached if gen didn't change while it was read, or else it may
+	// be older than the write.
+	gen uint64
+	// pending counts the writes of each id that are not over yet.
+	pending map[string]int
+
+	// now is overridden by tests.
+	now func() time.Time
+}
+
+// lookupCacheEntry is the cached result of the lookup query of an id.
+type lookupCacheEntry struct {
+	result  *sqltypes.Result
+	expires time.Time
+}
+
+// Size is part of the cache.Value interface.
+// The capacity of the cache is a number of entries.
+func (lce *lookupCacheEntry) Size() int {
+	return 1
+}
+
+// newLookupCache creates the lookup cache of a vindex from its params.
+// cache_size is the maximum number of entries. The cache is disabled if
+// it's not specified. cache_ttl is how long the entries are valid, like
+// "1m". It defaults to 10s.
+func newLookupCache(name string, m map[string]string) (*lookupCache, error) {
+	sizeStr, ok := m["cache_size"]
+	if !ok {
+		return nil, nil
+	}
+	size, err := strconv.ParseInt(sizeStr, 10, 64)
+	if err != nil || size <= 0 {
+		return nil, fmt.Errorf("cache_size value must be a positive integer: '%s'", sizeStr)
+	}
+	ttl := defaultLookupCacheTTL
+	if ttlStr, ok := m["cache_ttl"]; ok {
+		ttl, err = time.ParseDuration(ttlStr)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("cache_ttl value must be a positive duration: '%s'", ttlStr)
+		}
+	}
+	return &lookupCache{
+		name:    name,
+		ttl:     ttl,
+		lru:     cache.NewLRUCache(size),
+		pending: make(map[string]int),
+		now:     time.Now,
+	}, nil
+}
+
+// get returns the cached result of the lookup query of id, if it has not expired.
+// The result is shared by all the callers, and must not be modified.
+func (lc *lookupCache) get(id sqltypes.Value) (*sqltypes.Result, bool) {
+	if lc == nil {
+		return nil, false
+	}
+	key := id.ToString()
+	if v, ok := lc.lru.Get(key); ok {
+		entry := v.(*lookupCacheEntry)
+		if lc.now().Before(entry.expires) {
+			lookupCacheHits.Add(lc.name, 1)
+			return entry.result, true
+		}
+		lc.lru.Delete(key)
+	}
+	lookupCacheMisses.Add(lc.name, 1)
+	return nil, false
+}
+
+// generation returns the value to pass to set for a result
+// that is about to be read.
+func (lc *lookupCache) generation() uint64 {
+	if lc == nil {
+		return 0
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.gen
+}
+
+// set caches the result of the lookup query of id. gen is the generation
+// from before the query. The result is dropped if a write of the lookup
+// table started or ended since then.
+func (lc *lookupCache) set(id sqltypes.Value, result *sqltypes.Result, gen uint64) {
+	if lc == nil {
+		return
+	}
+	key := id.ToString()
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if gen != lc.gen || lc.pending[key] != 0 {
+		return
+	}
+	lc.lru.Set(key, &lookupCacheEntry{
+		result:  result,
+		expires: lc.now().Add(lc.ttl),
+	})
+}
+
+// startWrite removes the cached results of the lookup queries of the ids
+// of the rows, and keeps them out of the cache until the returned function
+// is called, once the write is committed or rolled back. The lookup
+// queries only use the first column of the rows.
+func (lc *lookupCache) startWrite(rowsColValues [][]sqltypes.Value) func() {
+	if lc == nil {
+		return func() {}
+	}
+	keys := make([]string, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		if len(row) != 0 {
+			keys = append(keys, row[0].ToString())
+		}
+	}
+
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.gen++
+	for _, key := range keys {
+		lc.pending[key]++
+		lc.lru.Delete(key)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			lc.mu.Lock()
+			defer lc.mu.Unlock()
+			lc.gen++
+			for _, key := range keys {
+				if lc.pending[key]--; lc.pending[key] <= 0 {
+					delete(lc.pending, key)
+				}
+				lc.lru.Delete(key)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func TestLookupCacheParams(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{"cache_size": "0"},
+		err:    "cache_size value must be a positive integer: '0'",
+	}, {
+		params: map[string]string{"cache_size": "a"},
+		err:    "cache_size value must be a positive integer: 'a'",
+	}, {
+		params: map[string]string{"cache_size": "1", "cache_ttl": "1"},
+		err:    "cache_ttl value must be a positive duration: '1'",
+	}, {
+		params: map[string]string{"cache_size": "1", "cache_ttl": "-1s"},
+		err:    "cache_ttl value must be a positive duration: '-1s'",
+	}}
+	for _, tcase := range testcases {
+		params := map[string]string{
+			"table": "t",
+			"from":  "fromc",
+			"to":    "toc",
+		}
+		for k, v := range tcase.params {
+			params[k] = v
+		}
+		_, err := CreateVindex("lookup", "lookup", params)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("CreateVindex(%v): %v, want %s", tcase.params, err, tcase.err)
+		}
+	}
+
+	lc, err := newLookupCache("lookup", map[string]string{"cache_size": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.ttl != defaultLookupCacheTTL {
+		t.Errorf("ttl: %v, want %v", lc.ttl, defaultLookupCacheTTL)
+	}
+	lc, err = newLookupCache("lookup", map[string]string{})
+	if err != nil || lc != nil {
+		t.Errorf("newLookupCache(no cache_size): %v, %v, want nil, nil", lc, err)
+	}
+}
+
+func TestLookupCacheMap(t *testing.T) {
+	lookupNonUnique := createCachedLookup(t, "lookup_cache_map")
+	lc := lookupNonUnique.(*LookupNonUnique).lkp.cache
+	now := time.Now()
+	lc.now = func() time.Time { return now }
+	vc := &vcursor{numRows: 2}
+
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{
+			[]byte("1"),
+			[]byte("2"),
+		}),
+		key.DestinationKeyspaceIDs([][]byte{
+			[]byte("1"),
+			[]byte("2"),
+		}),
+	}
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	for i := 0; i < 2; i++ {
+		got, err := lookupNonUnique.Map(vc, ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Map(%d): %#v, want %+v", i, got, want)
+		}
+	}
+	// The second Map must be served from the cache.
+	if len(vc.queries) != 2 {
+		t.Errorf("Map queries: %d, want 2", len(vc.queries))
+	}
+	if got := lookupCacheHits.Counts()["lookup_cache_map"]; got != 2 {
+		t.Errorf("cache hits: %d, want 2", got)
+	}
+	if got := lookupCacheMisses.Counts()["lookup_cache_map"]; got != 2 {
+		t.Errorf("cache misses: %d, want 2", got)
+	}
+
+	// The entries expire after the ttl.
+	now = now.Add(time.Minute)
+	if _, err := lookupNonUnique.Map(vc, ids[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if len(vc.queries) != 3 {
+		t.Errorf("Map queries after the ttl: %d, want 3", len(vc.queries))
+	}
+
+	// The least recently used entry is evicted.
+	if _, err := lookupNonUnique.Map(vc, []sqltypes.Value{sqltypes.NewInt64(3)}); err != nil {
+		t.Fatal(err)
+	}
+	vc.queries = nil
+	if _, err := lookupNonUnique.Map(vc, ids); err != nil {
+		t.Fatal(err)
+	}
+	if len(vc.queries) != 1 {
+		t.Errorf("Map queries after the eviction: %d, want 1", len(vc.queries))
+	}
+}
+
+func TestLookupCacheInvalidate(t *testing.T) {
+	lookupNonUnique := createCachedLookup(t, "lookup_cache_invalidate")
+	vc := &vcursor{numRows: 1}
+	id := []sqltypes.Value{sqltypes.NewInt64(1)}
+
+	testcases := []struct {
+		name  string
+		write func() error
+	}{{
+		name: "Create",
+		write: func() error {
+			return lookupNonUnique.(Lookup).Create(vc, [][]sqltypes.Value{id}, [][]byte{[]byte("test")}, false /* ignoreMode */)
+		},
+	}, {
+		name: "Delete",
+		write: func() error {
+			return lookupNonUnique.(Lookup).Delete(vc, [][]sqltypes.Value{id}, []byte("test"))
+		},
+	}, {
+		name: "Update",
+		write: func() error {
+			return lookupNonUnique.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewInt64(2)}, []byte("test"), id)
+		},
+	}}
+	for _, tcase := range testcases {
+		if _, err := lookupNonUnique.Map(vc, id); err != nil {
+			t.Fatal(err)
+		}
+		if err := tcase.write(); err != nil {
+			t.Fatal(err)
+		}
+		vc.queries = nil
+		if _, err := lookupNonUnique.Map(vc, id); err != nil {
+			t.Fatal(err)
+		}
+		if len(vc.queries) != 1 {
+			t.Errorf("%s: Map queries: %d, want 1", tcase.name, len(vc.queries))
+		}
+	}
+}
+
+func TestLookupCacheWriteFailure(t *testing.T) {
+	lookupNonUnique := createCachedLookup(t, "lookup_cache_failure")
+	vc := &vcursor{numRows: 1}
+	id := []sqltypes.Value{sqltypes.NewInt64(1)}
+	if _, err := lookupNonUnique.Map(vc, id); err != nil {
+		t.Fatal(err)
+	}
+
+	// The write may have been applied even if it failed.
+	vc.mustFail = true
+	if err := lookupNonUnique.(Lookup).Create(vc, [][]sqltypes.Value{id}, [][]byte{[]byte("test")}, false /* ignoreMode */); err == nil {
+		t.Fatal("Create: nil, want error")
+	}
+	vc.mustFail = false
+	vc.queries = nil
+	if _, err := lookupNonUnique.Map(vc, id); err != nil {
+		t.Fatal(err)
+	}
+	if len(vc.queries) != 1 {
+		t.Errorf("Map queries: %d, want 1", len(vc.queries))
+	}
+}
+
+func TestLookupCacheTransaction(t *testing.T) {
+	lookupNonUnique := createCachedLookup(t, "lookup_cache_transaction")
+	txvc := &vcursor{numRows: 1, inTransaction: true}
+	vc := &vcursor{numRows: 1}
+	id := []sqltypes.Value{sqltypes.NewInt64(1)}
+
+	// The lookups of a transaction are not cached.
+	for i := 0; i < 2; i++ {
+		if _, err := lookupNonUnique.Map(txvc, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(txvc.queries) != 2 {
+		t.Errorf("Map queries in a transaction: %d, want 2", len(txvc.queries))
+	}
+
+	// The ids written by a transaction are not cached until it's over.
+	if err := lookupNonUnique.(Lookup).Create(txvc, [][]sqltypes.Value{id}, [][]byte{[]byte("test")}, false /* ignoreMode */); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := lookupNonUnique.Map(vc, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(vc.queries) != 2 {
+		t.Errorf("Map queries before the commit: %d, want 2", len(vc.queries))
+	}
+	txvc.endTransaction()
+	vc.queries = nil
+	for i := 0; i < 2; i++ {
+		if _, err := lookupNonUnique.Map(vc, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(vc.queries) != 1 {
+		t.Errorf("Map queries after the commit: %d, want 1", len(vc.queries))
+	}
+}
+
+func TestLookupCacheConcurrentWrite(t *testing.T) {
+	lc, err := newLookupCache("lookup_cache_concurrent", map[string]string{"cache_size": "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := sqltypes.NewInt64(1)
+	rows := [][]sqltypes.Value{{id}}
+	result := &sqltypes.Result{}
+
+	// A result read before the end of a write is not cached.
+	gen := lc.generation()
+	done := lc.startWrite(rows)
+	lc.set(id, result, gen)
+	if _, ok := lc.get(id); ok {
+		t.Error("get during the write: found, want not found")
+	}
+	done()
+	lc.set(id, result, gen)
+	if _, ok := lc.get(id); ok {
+		t.Error("get after a write that ended during the read: found, want not found")
+	}
+
+	lc.set(id, result, lc.generation())
+	if _, ok := lc.get(id); !ok {
+		t.Error("get after the write: not found, want found")
+	}
+}
+
+func createCachedLookup(t *testing.T, name string) Vindex {
+	t.Helper()
+	l, err := CreateVindex("lookup", name, map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "2",
+		"cache_ttl":  "1m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
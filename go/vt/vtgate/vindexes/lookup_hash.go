@@ -59,6 +59,8 @@ type LookupHash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookupHash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupHash{name: name}
 
@@ -72,7 +74,7 @@ func NewLookupHash(name string, m map[string]string) (Vindex, error) {
 	}
 
 	// if autocommit is on for non-unique lookup, upsert should also be on.
-	if err := lh.lkp.Init(m, autocommit, autocommit /* upsert */); err != nil {
+	if err := lh.lkp.Init(name, m, autocommit, autocommit /* upsert */); err != nil {
 		return nil, err
 	}
 	return lh, nil
@@ -215,6 +217,8 @@ type LookupHashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupHashUnique{name: name}
 
@@ -228,7 +232,7 @@ func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	}
 
 	// Don't allow upserts for unique vindexes.
-	if err := lhu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
+	if err := lhu.lkp.Init(name, m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
 	return lhu, nil
@@ -37,9 +37,10 @@ type lookupInternal struct {
 	Autocommit    bool     `json:"autocommit,omitempty"`
 	Upsert        bool     `json:"upsert,omitempty"`
 	sel, ver, del string
+	cache         *lookupCache
 }
 
-func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
+func (lkp *lookupInternal) Init(name string, lookupQueryParams map[string]string, autocommit, upsert bool) error {
 	lkp.Table = lookupQueryParams["table"]
 	lkp.To = lookupQueryParams["to"]
 	var fromColumns []string
@@ -57,13 +58,27 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s from %s where %s = :%s", lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
-	return nil
+
+	var err error
+	lkp.cache, err = newLookupCache(name, lookupQueryParams)
+	return err
 }
 
 // Lookup performs a lookup for the ids.
 func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sqltypes.Result, error) {
 	results := make([]*sqltypes.Result, 0, len(ids))
+	// The lookups of a transaction see its uncommitted writes,
+	// which must not be cached.
+	useCache := lkp.Autocommit || !vcursor.InTransaction()
 	for _, id := range ids {
+		var gen uint64
+		if useCache {
+			if result, ok := lkp.cache.get(id); ok {
+				results = append(results, result)
+				continue
+			}
+			gen = lkp.cache.generation()
+		}
 		bindVars := map[string]*querypb.BindVariable{
 			lkp.FromColumns[0]: sqltypes.ValueBindVariable(id),
 		}
@@ -77,6 +92,9 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value) ([]*sql
 		if err != nil {
 			return nil, fmt.Errorf("lookup.Map: %v", err)
 		}
+		if useCache {
+			lkp.cache.set(id, result, gen)
+		}
 		results = append(results, result)
 	}
 	return results, nil
@@ -203,6 +221,7 @@ func (lkp *lookupInternal) createCustom(vcursor VCursor, rowsColValues [][]sqlty
 		fmt.Fprintf(buf, "%s=values(%s)", lkp.To, lkp.To)
 	}
 
+	defer lkp.endWrite(vcursor, lkp.cache.startWrite(rowsColValues), co)
 	if _, err := vcursor.Execute("VindexCreate", buf.String(), bindVars, true /* isDML */, co); err != nil {
 		return fmt.Errorf("lookup.Create: %v", err)
 	}
@@ -238,6 +257,7 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	defer lkp.endWrite(vcursor, lkp.cache.startWrite(rowsColValues), co)
 	for _, column := range rowsColValues {
 		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
 		for colIdx, columnValue := range column {
@@ -260,6 +280,17 @@ func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return lkp.Create(vcursor, [][]sqltypes.Value{newValues}, []sqltypes.Value{toValue}, false /* ignoreMode */)
 }
 
+// endWrite calls done once the write of the lookup table is committed
+// or rolled back. Until then, the other sessions may still read the
+// old rows.
+func (lkp *lookupInternal) endWrite(vcursor VCursor, done func(), co vtgatepb.CommitOrder) {
+	if co == vtgatepb.CommitOrder_AUTOCOMMIT {
+		done()
+		return
+	}
+	vcursor.AfterTransaction(done)
+}
+
 func (lkp *lookupInternal) initDelStmt() string {
 	var delBuffer bytes.Buffer
 	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
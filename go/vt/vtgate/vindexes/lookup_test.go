@@ -41,6 +41,10 @@ type vcursor struct {
 	queries     []*querypb.BoundQuery
 	autocommits int
 	pre, post   int
+
+	inTransaction bool
+	// txHooks are the functions to call at the end of the transaction.
+	txHooks []func()
 }
 
 func (vc *vcursor) Execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
@@ -59,6 +63,27 @@ func (vc *vcursor) ExecuteKeyspaceID(keyspace string, ksid []byte, query string,
 	return vc.execute("ExecuteKeyspaceID", query, bindVars, isDML)
 }
 
+func (vc *vcursor) InTransaction() bool {
+	return vc.inTransaction
+}
+
+func (vc *vcursor) AfterTransaction(hook func()) {
+	if !vc.inTransaction {
+		hook()
+		return
+	}
+	vc.txHooks = append(vc.txHooks, hook)
+}
+
+// endTransaction calls the functions registered for the end of the transaction.
+func (vc *vcursor) endTransaction() {
+	vc.inTransaction = false
+	for _, hook := range vc.txHooks {
+		hook()
+	}
+	vc.txHooks = nil
+}
+
 func (vc *vcursor) execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool) (*sqltypes.Result, error) {
 	vc.queries = append(vc.queries, &querypb.BoundQuery{
 		Sql:           query,
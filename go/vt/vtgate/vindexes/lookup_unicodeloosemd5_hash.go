@@ -60,6 +60,8 @@ type LookupUnicodeLooseMD5Hash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookupUnicodeLooseMD5Hash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupUnicodeLooseMD5Hash{name: name}
 
@@ -73,7 +75,7 @@ func NewLookupUnicodeLooseMD5Hash(name string, m map[string]string) (Vindex, err
 	}
 
 	// if autocommit is on for non-unique lookup, upsert should also be on.
-	if err := lh.lkp.Init(m, autocommit, autocommit /* upsert */); err != nil {
+	if err := lh.lkp.Init(name, m, autocommit, autocommit /* upsert */); err != nil {
 		return nil, err
 	}
 	return lh, nil
@@ -227,6 +229,8 @@ type LookupUnicodeLooseMD5HashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: number of lookup results cached by this vtgate. The cache is disabled if not specified.
+//   cache_ttl: how long the cached results are valid, like "1m". The default is 10s.
 func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupUnicodeLooseMD5HashUnique{name: name}
 
@@ -240,7 +244,7 @@ func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vinde
 	}
 
 	// Don't allow upserts for unique vindexes.
-	if err := lhu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
+	if err := lhu.lkp.Init(name, m, autocommit, false /* upsert */); err != nil {
 		return nil, err
 	}
 	return lhu, nil
@@ -0,0 +1,214 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ Vindex      = (*RegionExperimental)(nil)
+	_ MultiColumn = (*RegionExperimental)(nil)
+)
+
+func init() {
+	Register("region_experimental", NewRegionExperimental)
+}
+
+// RegionExperimental defines a vindex that uses two columns, a region
+// and an id. The region is the prefix of the keyspace id, and the
+// hash of the id is the rest of it. The rows of a region are therefore
+// stored in the shards that cover its prefix. It's Unique and Functional.
+// This vindex is experimental: its keyspace ids may change.
+type RegionExperimental struct {
+	name        string
+	regionBytes int
+}
+
+// NewRegionExperimental creates a RegionExperimental vindex.
+// The supplied map requires the region_bytes param, which
+// must be 1 or 2.
+func NewRegionExperimental(name string, m map[string]string) (Vindex, error) {
+	rb, err := parseRegionBytes(m["region_bytes"])
+	if err != nil {
+		return nil, err
+	}
+	return &RegionExperimental{
+		name:        name,
+		regionBytes: rb,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *RegionExperimental) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this index as 1.
+func (vind *RegionExperimental) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique over the region
+// and the id. Map, which only receives the region, maps to a KeyRange.
+func (vind *RegionExperimental) IsUnique() bool {
+	return true
+}
+
+// IsFunctional returns true since the Vindex is functional.
+func (vind *RegionExperimental) IsFunctional() bool {
+	return true
+}
+
+// Map maps each region to the KeyRange of its keyspace ids.
+func (vind *RegionExperimental) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i, id := range ids {
+		prefix, err := vind.prefix(id)
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = regionKeyRange(prefix)
+	}
+	return out, nil
+}
+
+// Verify returns true if the keyspace ids start with the prefix of the regions.
+func (vind *RegionExperimental) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i, id := range ids {
+		prefix, err := vind.prefix(id)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "region_experimental.Verify")
+		}
+		out[i] = bytes.HasPrefix(ksids[i], prefix)
+	}
+	return out, nil
+}
+
+// MapMulti maps each (region, id) row to a keyspace id, and each
+// row that only has a region to the KeyRange of its keyspace ids.
+func (vind *RegionExperimental) MapMulti(cursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) == 0 || len(row) > 2 {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		prefix, err := vind.prefix(row[0])
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		if len(row) == 1 {
+			out[i] = regionKeyRange(prefix)
+			continue
+		}
+		ksid, err := regionKeyspaceID(prefix, row[1])
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = key.DestinationKeyspaceID(ksid)
+	}
+	return out, nil
+}
+
+// VerifyMulti returns true if the (region, id) rows map to the keyspace ids.
+func (vind *RegionExperimental) VerifyMulti(_ VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("region_experimental.VerifyMulti: wrong number of column values: %d", len(row))
+		}
+		prefix, err := vind.prefix(row[0])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "region_experimental.VerifyMulti")
+		}
+		ksid, err := regionKeyspaceID(prefix, row[1])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "region_experimental.VerifyMulti")
+		}
+		out[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// prefix returns the keyspace id prefix of a region.
+func (vind *RegionExperimental) prefix(region sqltypes.Value) ([]byte, error) {
+	num, err := sqltypes.ToUint64(region)
+	if err != nil {
+		return nil, err
+	}
+	return regionPrefix(num, vind.regionBytes)
+}
+
+// parseRegionBytes parses the region_bytes param of region vindexes.
+func parseRegionBytes(rbs string) (int, error) {
+	switch rbs {
+	case "1":
+		return 1, nil
+	case "2":
+		return 2, nil
+	}
+	return 0, fmt.Errorf("region_bytes must be 1 or 2: %v", rbs)
+}
+
+// regionPrefix returns the regionBytes long keyspace id prefix of a region number.
+func regionPrefix(num uint64, regionBytes int) ([]byte, error) {
+	if num >= 1<<uint(8*regionBytes) {
+		return nil, fmt.Errorf("region %d does not fit in %d byte(s)", num, regionBytes)
+	}
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], uint16(num))
+	return buf[2-regionBytes:], nil
+}
+
+// regionKeyspaceID returns the keyspace id made of the
+// region prefix followed by the hash of the id.
+func regionKeyspaceID(prefix []byte, id sqltypes.Value) ([]byte, error) {
+	num, err := sqltypes.ToUint64(id)
+	if err != nil {
+		return nil, err
+	}
+	ksid := make([]byte, 0, len(prefix)+8)
+	ksid = append(ksid, prefix...)
+	return append(ksid, vhash(num)...), nil
+}
+
+// regionKeyRange returns the KeyRange of the keyspace ids
+// that start with the region prefix.
+func regionKeyRange(prefix []byte) key.DestinationKeyRange {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: prefix, End: end}}
+		}
+	}
+	// The prefix is the last one: the range has no end.
+	return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: prefix}}
+}
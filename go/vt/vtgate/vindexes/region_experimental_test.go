@@ -0,0 +1,163 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRegionExperimentalMapMulti1(t *testing.T) {
+	ge, err := createRegionVindex(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ge.(MultiColumn).MapMulti(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(256), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("abcd"), sqltypes.NewInt64(1),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x01\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\xff\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationNone{},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01"), End: []byte("\x02")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\xff")}},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapMulti(): %v, want %v", got, want)
+	}
+}
+
+func TestRegionExperimentalMapMulti2(t *testing.T) {
+	ge, err := createRegionVindex(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ge.(MultiColumn).MapMulti(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(256), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(65536), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\x01\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x00\xff\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x01\x00\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationNone{},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x00\xff"), End: []byte("\x01\x00")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapMulti(): %v, want %v", got, want)
+	}
+}
+
+func TestRegionExperimentalMap(t *testing.T) {
+	ge, err := createRegionVindex(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ge.Map(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(256)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01"), End: []byte("\x02")}},
+		key.DestinationNone{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %v, want %v", got, want)
+	}
+	// The vindex is unique over both columns only.
+	if !ge.IsUnique() || MapsToKeyspaceID(ge) {
+		t.Errorf("IsUnique(): %v, MapsToKeyspaceID(): %v, want true, false", ge.IsUnique(), MapsToKeyspaceID(ge))
+	}
+}
+
+func TestRegionExperimentalVerify(t *testing.T) {
+	ge, err := createRegionVindex(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vals := [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(1)}, {sqltypes.NewInt64(1), sqltypes.NewInt64(1)}}
+	ksids := [][]byte{[]byte("\x01\x16k@\xb4J\xbaK\xd6"), []byte("\x02\x16k@\xb4J\xbaK\xd6")}
+	got, err := ge.(MultiColumn).VerifyMulti(nil, vals, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []bool{true, false}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("VerifyMulti(): %v, want %v", got, want)
+	}
+
+	got, err = ge.Verify(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1)}, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Verify(): %v, want %v", got, want)
+	}
+
+	_, err = ge.(MultiColumn).VerifyMulti(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, ksids[:1])
+	wantErr := "region_experimental.VerifyMulti: wrong number of column values: 1"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("VerifyMulti(): %v, want %s", err, wantErr)
+	}
+}
+
+func TestRegionExperimentalCreateErrors(t *testing.T) {
+	_, err := createRegionVindex(3)
+	want := "region_bytes must be 1 or 2: 3"
+	if err == nil || err.Error() != want {
+		t.Errorf("createRegionVindex(): %v, want %s", err, want)
+	}
+}
+
+func createRegionVindex(rb int) (Vindex, error) {
+	return CreateVindex("region_experimental", "region_experimental", map[string]string{
+		"region_bytes": strconv.Itoa(rb),
+	})
+}
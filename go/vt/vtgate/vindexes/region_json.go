@@ -0,0 +1,207 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	_ Vindex      = (*RegionJSON)(nil)
+	_ MultiColumn = (*RegionJSON)(nil)
+)
+
+func init() {
+	Register("region_json", NewRegionJSON)
+}
+
+// RegionMap maps the values of a region column, like countries,
+// to the keyspace id prefix of the region they belong to. Its keys
+// are upper case, since the values are compared case-insensitively,
+// like the ones of a column with a case-insensitive collation.
+type RegionMap map[string][]byte
+
+// RegionJSON defines a vindex that uses two columns, a region
+// and an id. The region is mapped to a keyspace id prefix by the
+// JSON file of the region_map param, and the hash of the id is the
+// rest of the keyspace id. This keeps the rows of a region, like the
+// countries of the EU, in the shards that cover its prefix.
+// It's Unique and Functional.
+type RegionJSON struct {
+	name        string
+	regionMap   RegionMap
+	regionBytes int
+}
+
+// NewRegionJSON creates a RegionJSON vindex.
+// The supplied map requires the region_map param, the path of a
+// JSON file like {"US": 1, "DE": 2}, and the region_bytes param,
+// which must be 1 or 2. The regions must fit in region_bytes.
+func NewRegionJSON(name string, m map[string]string) (Vindex, error) {
+	rmPath, ok := m["region_map"]
+	if !ok {
+		return nil, fmt.Errorf("region_json vindex %s: region_map param is required", name)
+	}
+	rb, err := parseRegionBytes(m["region_bytes"])
+	if err != nil {
+		return nil, fmt.Errorf("region_json vindex %s: %v", name, err)
+	}
+	rm, err := loadRegionMap(rmPath, rb)
+	if err != nil {
+		return nil, fmt.Errorf("region_json vindex %s: region_map %s: %v", name, rmPath, err)
+	}
+	return &RegionJSON{
+		name:        name,
+		regionMap:   rm,
+		regionBytes: rb,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *RegionJSON) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this index as 1.
+func (vind *RegionJSON) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique over the region
+// and the id. Map, which only receives the region, maps to a KeyRange.
+func (vind *RegionJSON) IsUnique() bool {
+	return true
+}
+
+// IsFunctional returns true since the Vindex is functional.
+func (vind *RegionJSON) IsFunctional() bool {
+	return true
+}
+
+// Map maps each region to the KeyRange of its keyspace ids.
+func (vind *RegionJSON) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	for i, id := range ids {
+		prefix, ok := vind.prefix(id)
+		if !ok {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = regionKeyRange(prefix)
+	}
+	return out, nil
+}
+
+// Verify returns true if the keyspace ids start with the prefix of the regions.
+func (vind *RegionJSON) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i, id := range ids {
+		prefix, ok := vind.prefix(id)
+		out[i] = ok && bytes.HasPrefix(ksids[i], prefix)
+	}
+	return out, nil
+}
+
+// MapMulti maps each (region, id) row to a keyspace id, and each
+// row that only has a region to the KeyRange of its keyspace ids.
+func (vind *RegionJSON) MapMulti(cursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) == 0 || len(row) > 2 {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		prefix, ok := vind.prefix(row[0])
+		if !ok {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		if len(row) == 1 {
+			out[i] = regionKeyRange(prefix)
+			continue
+		}
+		ksid, err := regionKeyspaceID(prefix, row[1])
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		out[i] = key.DestinationKeyspaceID(ksid)
+	}
+	return out, nil
+}
+
+// VerifyMulti returns true if the (region, id) rows map to the keyspace ids.
+func (vind *RegionJSON) VerifyMulti(_ VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		if len(row) != 2 {
+			return nil, fmt.Errorf("region_json.VerifyMulti: wrong number of column values: %d", len(row))
+		}
+		prefix, ok := vind.prefix(row[0])
+		if !ok {
+			continue
+		}
+		ksid, err := regionKeyspaceID(prefix, row[1])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "region_json.VerifyMulti")
+		}
+		out[i] = bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// prefix returns the keyspace id prefix of a region.
+func (vind *RegionJSON) prefix(region sqltypes.Value) ([]byte, bool) {
+	prefix, ok := vind.regionMap[strings.ToUpper(region.ToString())]
+	return prefix, ok
+}
+
+func loadRegionMap(path string, regionBytes int) (RegionMap, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]uint64
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, errors.New("no regions defined")
+	}
+	rm := make(RegionMap, len(m))
+	for k, v := range m {
+		prefix, err := regionPrefix(v, regionBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
+		region := strings.ToUpper(k)
+		if other, ok := rm[region]; ok && !bytes.Equal(other, prefix) {
+			return nil, fmt.Errorf("%s: defined more than once with different cases", region)
+		}
+		rm[region] = prefix
+	}
+	return rm, nil
+}
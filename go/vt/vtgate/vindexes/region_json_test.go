@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createRegionJSON(t *testing.T) Vindex {
+	t.Helper()
+	vindex, err := CreateVindex("region_json", "region_json", map[string]string{
+		"region_map":   "testdata/region_map_test.json",
+		"region_bytes": "1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vindex
+}
+
+func TestRegionJSONMapMulti(t *testing.T) {
+	vindex := createRegionJSON(t)
+	got, err := vindex.(MultiColumn).MapMulti(nil, [][]sqltypes.Value{{
+		sqltypes.NewVarChar("US"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("CA"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("DE"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("XX"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("US"), sqltypes.NewVarChar("abcd"),
+	}, {
+		sqltypes.NewVarChar("FR"),
+	}, {
+		sqltypes.NewVarChar("de"), sqltypes.NewInt64(1),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x01\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x01\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyspaceID([]byte("\x02\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x02"), End: []byte("\x03")}},
+		// Regions are case-insensitive.
+		key.DestinationKeyspaceID([]byte("\x02\x16k@\xb4J\xbaK\xd6")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapMulti(): %v, want %v", got, want)
+	}
+}
+
+func TestRegionJSONMap(t *testing.T) {
+	vindex := createRegionJSON(t)
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("US"), sqltypes.NewVarChar("XX"), sqltypes.NewVarChar("Us")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01"), End: []byte("\x02")}},
+		key.DestinationNone{},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01"), End: []byte("\x02")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %v, want %v", got, want)
+	}
+	// The vindex is unique over both columns only.
+	if !vindex.IsUnique() || MapsToKeyspaceID(vindex) {
+		t.Errorf("IsUnique(): %v, MapsToKeyspaceID(): %v, want true, false", vindex.IsUnique(), MapsToKeyspaceID(vindex))
+	}
+}
+
+func TestRegionJSONVerify(t *testing.T) {
+	vindex := createRegionJSON(t)
+	vals := [][]sqltypes.Value{{
+		sqltypes.NewVarChar("US"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("DE"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewVarChar("XX"), sqltypes.NewInt64(1),
+	}}
+	ksids := [][]byte{
+		[]byte("\x01\x16k@\xb4J\xbaK\xd6"),
+		[]byte("\x01\x16k@\xb4J\xbaK\xd6"),
+		[]byte("\x01\x16k@\xb4J\xbaK\xd6"),
+	}
+	got, err := vindex.(MultiColumn).VerifyMulti(nil, vals, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []bool{true, false, false}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("VerifyMulti(): %v, want %v", got, want)
+	}
+
+	ids := []sqltypes.Value{sqltypes.NewVarChar("US"), sqltypes.NewVarChar("DE"), sqltypes.NewVarChar("XX")}
+	got, err = vindex.Verify(nil, ids, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Verify(): %v, want %v", got, want)
+	}
+}
+
+func TestRegionJSONCreateErrors(t *testing.T) {
+	tcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{"region_bytes": "1"},
+		err:    "region_json vindex rj: region_map param is required",
+	}, {
+		params: map[string]string{"region_map": "testdata/region_map_test.json"},
+		err:    "region_json vindex rj: region_bytes must be 1 or 2: ",
+	}, {
+		params: map[string]string{"region_map": "testdata/no_such_file.json", "region_bytes": "1"},
+		err:    "region_json vindex rj: region_map testdata/no_such_file.json: open testdata/no_such_file.json: no such file or directory",
+	}, {
+		params: map[string]string{"region_map": "testdata/region_map_empty_test.json", "region_bytes": "1"},
+		err:    "region_json vindex rj: region_map testdata/region_map_empty_test.json: no regions defined",
+	}, {
+		params: map[string]string{"region_map": "testdata/region_map_bad_test.json", "region_bytes": "1"},
+		err:    "region_json vindex rj: region_map testdata/region_map_bad_test.json: DE: region 256 does not fit in 1 byte(s)",
+	}, {
+		params: map[string]string{"region_map": "testdata/region_map_case_test.json", "region_bytes": "1"},
+		err:    "region_json vindex rj: region_map testdata/region_map_case_test.json: US: defined more than once with different cases",
+	}}
+	for _, tcase := range tcases {
+		_, err := CreateVindex("region_json", "rj", tcase.params)
+		if err == nil || !strings.HasPrefix(err.Error(), tcase.err) {
+			t.Errorf("CreateVindex(%v): %v, want %s", tcase.params, err, tcase.err)
+		}
+	}
+
+	vindex, err := CreateVindex("region_json", "rj", map[string]string{
+		"region_map":   "testdata/region_map_bad_test.json",
+		"region_bytes": "2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("DE")})
+	want := []key.Destination{
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01\x00"), End: []byte("\x01\x01")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %v, want %v", got, want)
+	}
+}
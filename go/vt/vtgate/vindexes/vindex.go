@@ -35,6 +35,12 @@ import (
 type VCursor interface {
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, isDML, autocommit bool) (*sqltypes.Result, error)
+
+	// InTransaction returns true if the session is in a transaction.
+	InTransaction() bool
+	// AfterTransaction calls hook once the transaction of the session
+	// is committed or rolled back, or right away if there is none.
+	AfterTransaction(hook func())
 }
 
 // Vindex defines the interface required to register a vindex.
@@ -55,6 +61,8 @@ type Vindex interface {
 
 	// IsUnique returns true if the Vindex is unique.
 	// Which means Map() maps to either a KeyRange or a single KeyspaceID.
+	// A MultiColumn vindex is unique over all its columns: Map() maps the
+	// first one to a KeyRange. See MapsToKeyspaceID.
 	IsUnique() bool
 
 	// IsFunctional returns true if the Vindex can compute
@@ -85,6 +93,31 @@ type Reversible interface {
 	ReverseMap(cursor VCursor, ks [][]byte) ([]sqltypes.Value, error)
 }
 
+// A MultiColumn vindex is one that computes the keyspace id
+// from the values of more than one column. Map and Verify receive
+// the values of the first column only, which a MultiColumn vindex
+// maps to the KeyRange of the keyspace ids they are a prefix of.
+// MapMulti and VerifyMulti receive the values of all the columns.
+type MultiColumn interface {
+	// MapMulti maps each row of column values to a key.Destination.
+	// A row that has only the leading columns maps to a KeyRange.
+	MapMulti(cursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
+
+	// VerifyMulti returns true for each row of column values
+	// that maps to the corresponding keyspace id.
+	VerifyMulti(cursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+}
+
+// MapsToKeyspaceID returns true if Map maps each id to a single
+// keyspace id, which is the case of the unique vindexes other
+// than the MultiColumn ones.
+func MapsToKeyspaceID(vindex Vindex) bool {
+	if _, ok := vindex.(MultiColumn); ok {
+		return false
+	}
+	return vindex.IsUnique()
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
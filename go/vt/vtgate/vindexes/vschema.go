@@ -301,6 +301,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 					columns = append(columns, sqlparser.NewColIdent(indCol))
 				}
 			}
+			if _, ok := vindex.(MultiColumn); ok && len(columns) < 2 {
+				return fmt.Errorf("multi-column vindex %s needs more than one column for table %s", ind.Name, tname)
+			}
 			columnVindex := &ColumnVindex{
 				Columns: columns,
 				Type:    vindexInfo.Type,
@@ -614,17 +617,19 @@ func LoadFormalKeyspace(filename string) (*vschemapb.Keyspace, error) {
 // primary vindex is always unique
 // if two have the same cost, use the one that occurs earlier in the definition
 // if the final result is too expensive, return nil
+// The vindex must map the value of its column to a keyspace id,
+// so a MultiColumn vindex can't be used.
 func FindVindexForSharding(tableName string, colVindexes []*ColumnVindex) (*ColumnVindex, error) {
 	if len(colVindexes) == 0 {
 		return nil, fmt.Errorf("no vindex definition for table %v", tableName)
 	}
 	result := colVindexes[0]
 	for _, colVindex := range colVindexes {
-		if colVindex.Vindex.Cost() < result.Vindex.Cost() && colVindex.Vindex.IsUnique() {
+		if colVindex.Vindex.Cost() < result.Vindex.Cost() && MapsToKeyspaceID(colVindex.Vindex) {
 			result = colVindex
 		}
 	}
-	if result.Vindex.Cost() > 1 || !result.Vindex.IsUnique() {
+	if result.Vindex.Cost() > 1 || !MapsToKeyspaceID(result.Vindex) {
 		return nil, fmt.Errorf("could not find a vindex to use for sharding table %v", tableName)
 	}
 	return result, nil
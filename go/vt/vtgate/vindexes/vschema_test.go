@@ -852,6 +852,25 @@ func TestFindVindexForShardingError(t *testing.T) {
 	}
 }
 
+func TestFindVindexForShardingMultiColumn(t *testing.T) {
+	region, err := CreateVindex("region_experimental", "region", map[string]string{"region_bytes": "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	colVindexes := []*ColumnVindex{{
+		Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("c1"), sqlparser.NewColIdent("c2")},
+		Type:    "region_experimental",
+		Name:    "region",
+		Vindex:  region,
+	}}
+	// The value of one column only maps to a KeyRange.
+	_, err = FindVindexForSharding("t1", colVindexes)
+	want := `could not find a vindex to use for sharding table t1`
+	if err == nil || err.Error() != want {
+		t.Errorf("FindVindexForSharding: %v, want %v", err, want)
+	}
+}
+
 func TestFindVindexForSharding2(t *testing.T) {
 	ks := &Keyspace{
 		Name:    "sharded",
@@ -1590,6 +1609,110 @@ func TestBuildVSchemaPrimaryNonFunctionalFail(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaMultiColumnPrimaryOwned(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"region": {
+						Type: "region_experimental",
+						Params: map[string]string{
+							"region_bytes": "1",
+						},
+					},
+					"stln": {
+						Type:  "stln",
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{
+							{
+								Columns: []string{"c1", "c2"},
+								Name:    "region",
+							},
+							{
+								Column: "c3",
+								Name:   "stln",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&good)
+	ks := got.Keyspaces["sharded"]
+	if ks.Error != nil {
+		t.Fatalf("BuildVSchema: %v", ks.Error)
+	}
+	t1 := ks.Tables["t1"]
+	if len(t1.Owned) != 1 || t1.Owned[0].Name != "stln" {
+		t.Errorf("BuildVSchema: owned vindexes of t1: %v, want [stln]", t1.Owned)
+	}
+}
+
+func TestBuildVSchemaMultiColumnOneColumnFail(t *testing.T) {
+	bad := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"region": {
+						Type: "region_experimental",
+						Params: map[string]string{
+							"region_bytes": "1",
+						},
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{
+							{
+								Column: "c1",
+								Name:   "region",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&bad)
+	err := got.Keyspaces["sharded"].Error
+	want := "multi-column vindex region needs more than one column for table t1"
+	if err == nil || err.Error() != want {
+		t.Errorf("BuildVSchema: %v, want %v", err, want)
+	}
+}
+
+func TestBuildVSchemaBadRegionMapFail(t *testing.T) {
+	bad := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"region": {
+						Type: "region_json",
+						Params: map[string]string{
+							"region_map":   "testdata/region_map_bad_test.json",
+							"region_bytes": "1",
+						},
+					},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&bad)
+	err := got.Keyspaces["sharded"].Error
+	want := "region_json vindex region: region_map testdata/region_map_bad_test.json: DE: region 256 does not fit in 1 byte(s)"
+	if err == nil || err.Error() != want {
+		t.Errorf("BuildVSchema: %v, want %v", err, want)
+	}
+}
+
 func TestSequence(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -0,0 +1,136 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var xxHash Vindex
+
+func init() {
+	hv, err := CreateVindex("xxhash", "xxhash_name", map[string]string{"Table": "t", "Column": "c"})
+	if err != nil {
+		panic(err)
+	}
+	xxHash = hv
+}
+
+func TestXXHashCost(t *testing.T) {
+	if xxHash.Cost() != 1 {
+		t.Errorf("Cost(): %d, want 1", xxHash.Cost())
+	}
+}
+
+func TestXXHashString(t *testing.T) {
+	if strings.Compare("xxhash_name", xxHash.String()) != 0 {
+		t.Errorf("String(): %s, want xxhash_name", xxHash.String())
+	}
+}
+
+func TestXXHashMap(t *testing.T) {
+	got, err := xxHash.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar("test1"),
+		sqltypes.NewVarBinary("abcdefghijklmnopqrstuvwxyz"),
+		sqltypes.NewVarChar(""),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\xb7\xb4\x12\x76\x36\x05\x64\xd4")),
+		key.DestinationKeyspaceID([]byte("\x0b\x8f\x97\xd6\xe4\xb7\x1a\xd0")),
+		key.DestinationKeyspaceID([]byte("\xcf\xe1\xf2\x78\xfa\x89\x83\x5c")),
+		key.DestinationKeyspaceID([]byte("\xef\x46\xdb\x37\x51\xd8\xe9\x99")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map(): %#v, want %+v", got, want)
+	}
+}
+
+func TestXXHashVerify(t *testing.T) {
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("test1")}
+	ksids := [][]byte{[]byte("\xb7\xb4\x12\x76\x36\x05\x64\xd4"), []byte("\xb7\xb4\x12\x76\x36\x05\x64\xd4")}
+	got, err := xxHash.Verify(nil, ids, ksids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []bool{true, false}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("xxHash.Verify: %v, want %v", got, want)
+	}
+}
+
+// benchmarkVindexMap measures the Map of vindexType for id.
+func benchmarkVindexMap(b *testing.B, vindexType string, params map[string]string, id sqltypes.Value) {
+	vindex, err := CreateVindex(vindexType, vindexType, params)
+	if err != nil {
+		b.Fatal(err)
+	}
+	ids := []sqltypes.Value{id}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := vindex.Map(nil, ids); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkVarCharMap measures the Map of vindexType
+// for varchar ids of increasing lengths.
+func benchmarkVarCharMap(b *testing.B, vindexType string, params map[string]string) {
+	for _, n := range []int{8, 64, 512} {
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			benchmarkVindexMap(b, vindexType, params, sqltypes.NewVarChar(strings.Repeat("a", n)))
+		})
+	}
+}
+
+func BenchmarkMapIntHash(b *testing.B) {
+	benchmarkVindexMap(b, "hash", nil, sqltypes.NewInt64(1234567890))
+}
+
+func BenchmarkMapIntXXHash(b *testing.B) {
+	benchmarkVindexMap(b, "xxhash", nil, sqltypes.NewInt64(1234567890))
+}
+
+func BenchmarkMapIntKeyed3DES(b *testing.B) {
+	benchmarkVindexMap(b, "keyed_3des", map[string]string{"key": testTripleDESKey}, sqltypes.NewInt64(1234567890))
+}
+
+func BenchmarkMapVarCharUnicodeLooseMD5(b *testing.B) {
+	benchmarkVarCharMap(b, "unicode_loose_md5", nil)
+}
+
+func BenchmarkMapVarCharBinaryMD5(b *testing.B) {
+	benchmarkVarCharMap(b, "binary_md5", nil)
+}
+
+func BenchmarkMapVarCharXXHash(b *testing.B) {
+	benchmarkVarCharMap(b, "xxhash", nil)
+}
+
+func BenchmarkMapVarCharKeyedXXHash(b *testing.B) {
+	benchmarkVarCharMap(b, "keyed_xxhash", map[string]string{"key": "0102"})
+}
@@ -62,6 +62,7 @@ var (
 	disableLocalGateway = flag.Bool("disable_local_gateway", false, "if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows       = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows      = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	sysVarSetEnabled    = flag.Bool("enable_system_settings", false, "This will enable the system settings to be changed per session at the database connection level. The session is then pinned to reserved connections to the tablets, that are set up with the same settings.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -833,6 +834,12 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
 }
 
+// CloseSession closes the session, rolling back its transaction and
+// releasing its reserved connections, if any.
+func (vtg *VTGate) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	return formatError(vtg.executor.CloseSession(ctx, NewSafeSession(session)))
+}
+
 // Prepare supports non-streaming prepare statement query with multi shards
 func (vtg *VTGate) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (newSession *vtgatepb.Session, fld []*querypb.Field, err error) {
 	// In this context, we don't care if we can't fully parse destination
@@ -1087,6 +1094,12 @@ func (vtg *VTGate) VSchemaStats() *VSchemaStats {
 	return vtg.executor.VSchemaStats()
 }
 
+// LockSessionStats returns the connections reserved for the advisory
+// locks of the sessions. It's used to display the status page.
+func (vtg *VTGate) LockSessionStats() []*LockSessionStats {
+	return vtg.txConn.locks.stats()
+}
+
 func truncateErrorStrings(data map[string]interface{}) map[string]interface{} {
 	ret := map[string]interface{}{}
 	if *terseErrors {
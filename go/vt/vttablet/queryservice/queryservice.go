@@ -88,6 +88,25 @@ type QueryService interface {
 	BeginExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
 	BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error)
 
+	// Reserved connection methods. A reserved connection keeps the session
+	// state of the caller across requests. Its id is also the id of the
+	// transaction that is open on it, if any: further queries are executed
+	// on it by passing the reserved id as transactionID, and Commit or Rollback
+	// end its transaction while keeping it reserved.
+
+	// ReserveExecute reserves a connection, executes the preQueries to set up
+	// its session state and then the query. If transactionID is not 0, the
+	// connection of that transaction or reserved connection is used instead
+	// of a new one. The reserved id may be non-zero even if err != nil.
+	ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+	// ReserveBeginExecute is like ReserveExecute, but it begins a transaction
+	// on the connection before executing the query. If reservedID is 0, a
+	// new connection is reserved.
+	ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+	// Release rolls back the transaction of a reserved connection, if any,
+	// and closes it.
+	Release(ctx context.Context, target *querypb.Target, reservedID int64) error
+
 	// Messaging methods.
 	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
 	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
@@ -219,6 +219,37 @@ func (ws *wrappedService) BeginExecuteBatch(ctx context.Context, target *querypb
 	return qrs, transactionID, err
 }
 
+func (ws *wrappedService) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (qr *sqltypes.Result, reservedID int64, err error) {
+	inTransaction := (transactionID != 0)
+	err = ws.wrapper(ctx, target, ws.impl, "ReserveExecute", inTransaction, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		qr, reservedID, innerErr = conn.ReserveExecute(ctx, target, preQueries, query, bindVars, transactionID, options)
+		// You cannot retry if you're in a transaction.
+		retryable := canRetry(ctx, innerErr) && (!inTransaction) && reservedID == 0
+		return retryable, innerErr
+	})
+	return qr, reservedID, err
+}
+
+func (ws *wrappedService) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (qr *sqltypes.Result, transactionID int64, err error) {
+	inReservedConn := (reservedID != 0)
+	err = ws.wrapper(ctx, target, ws.impl, "ReserveBeginExecute", inReservedConn, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		var innerErr error
+		qr, transactionID, innerErr = conn.ReserveBeginExecute(ctx, target, preQueries, query, bindVars, reservedID, options)
+		// You cannot retry on a reserved connection.
+		retryable := canRetry(ctx, innerErr) && (!inReservedConn) && transactionID == 0
+		return retryable, innerErr
+	})
+	return qr, transactionID, err
+}
+
+func (ws *wrappedService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	return ws.wrapper(ctx, target, ws.impl, "Release", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		innerErr := conn.Release(ctx, target, reservedID)
+		return canRetry(ctx, innerErr), innerErr
+	})
+}
+
 func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "MessageStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.MessageStream(ctx, target, name, callback)
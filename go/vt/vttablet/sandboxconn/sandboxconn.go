@@ -50,6 +50,10 @@ type SandboxConn struct {
 	MustFailSetRollback         int
 	MustFailConcludeTransaction int
 
+	// LostReservedIDs are the reserved connections that Execute
+	// doesn't find, like a tablet once they're closed.
+	LostReservedIDs map[int64]bool
+
 	// These Count vars report how often the corresponding
 	// functions were called.
 	ExecCount                sync2.AtomicInt64
@@ -65,6 +69,8 @@ type SandboxConn struct {
 	SetRollbackCount         sync2.AtomicInt64
 	ConcludeTransactionCount sync2.AtomicInt64
 	ReadTransactionCount     sync2.AtomicInt64
+	ReserveCount             sync2.AtomicInt64
+	ReleaseCount             sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -89,7 +95,8 @@ type SandboxConn struct {
 	VStreamEvents [][]*binlogdatapb.VEvent
 	VStreamErrors []error
 
-	// transaction id generator
+	// transaction id generator. It also generates the ids
+	// of the reserved connections.
 	TransactionID sync2.AtomicInt64
 }
 
@@ -134,6 +141,9 @@ func (sbc *SandboxConn) Execute(ctx context.Context, target *querypb.Target, que
 	if err := sbc.getError(); err != nil {
 		return nil, err
 	}
+	if sbc.LostReservedIDs[transactionID] {
+		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction %d: not found", transactionID)
+	}
 	return sbc.getNextResult(), nil
 }
 
@@ -303,6 +313,55 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 	return results, transactionID, err
 }
 
+// ReserveExecute is part of the QueryService interface.
+func (sbc *SandboxConn) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := sbc.reserve(transactionID, preQueries)
+	if err != nil {
+		return nil, 0, err
+	}
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute is part of the QueryService interface.
+func (sbc *SandboxConn) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		reservedID, err = sbc.reserve(0, preQueries)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	sbc.BeginCount.Add(1)
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+// reserve returns the id of the reserved connection, and records
+// the preQueries executed on it. If id is 0, a new id is generated.
+func (sbc *SandboxConn) reserve(id int64, preQueries []string) (int64, error) {
+	sbc.ReserveCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return 0, err
+	}
+	for _, query := range preQueries {
+		sbc.Queries = append(sbc.Queries, &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: make(map[string]*querypb.BindVariable),
+		})
+	}
+	if id != 0 {
+		return id, nil
+	}
+	return sbc.TransactionID.Add(1), nil
+}
+
+// Release is part of the QueryService interface.
+func (sbc *SandboxConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	sbc.ReleaseCount.Add(1)
+	return sbc.getError()
+}
+
 // MessageStream is part of the QueryService interface.
 func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
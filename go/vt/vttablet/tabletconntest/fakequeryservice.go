@@ -41,10 +41,11 @@ type FakeQueryService struct {
 	TestingGateway bool
 
 	// these fields are used to simulate and synchronize on errors
-	HasError      bool
-	HasBeginError bool
-	TabletError   error
-	ErrorWait     chan struct{}
+	HasError        bool
+	HasBeginError   bool
+	HasReserveError bool
+	TabletError     error
+	ErrorWait       chan struct{}
 
 	// these fields are used to simulate and synchronize on panics
 	Panics                   bool
@@ -643,6 +644,65 @@ func (f *FakeQueryService) BeginExecuteBatch(ctx context.Context, target *queryp
 	return results, transactionID, err
 }
 
+// ReservePreQueries are test pre-queries for ReserveExecute and ReserveBeginExecute.
+var ReservePreQueries = []string{"set sql_mode = ''"}
+
+// ReservedID is a test reserved id for ReserveExecute and ReserveBeginExecute.
+const ReservedID int64 = 9991
+
+// ReserveExecute is part of the queryservice.QueryService interface
+func (f *FakeQueryService) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if err := f.reserve(ctx, "ReserveExecute", target, preQueries); err != nil {
+		return nil, 0, err
+	}
+	if transactionID != 0 {
+		f.t.Errorf("ReserveExecute: invalid TransactionId: got %v expected 0", transactionID)
+	}
+	result, err := f.Execute(ctx, target, sql, bindVariables, ReservedID, options)
+	return result, ReservedID, err
+}
+
+// ReserveBeginExecute is part of the queryservice.QueryService interface
+func (f *FakeQueryService) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if err := f.reserve(ctx, "ReserveBeginExecute", target, preQueries); err != nil {
+		return nil, 0, err
+	}
+	if reservedID != 0 {
+		f.t.Errorf("ReserveBeginExecute: invalid ReservedId: got %v expected 0", reservedID)
+	}
+	result, err := f.Execute(ctx, target, sql, bindVariables, ReservedID, options)
+	return result, ReservedID, err
+}
+
+func (f *FakeQueryService) reserve(ctx context.Context, name string, target *querypb.Target, preQueries []string) error {
+	if f.HasReserveError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, name, target)
+	if !reflect.DeepEqual(preQueries, ReservePreQueries) {
+		f.t.Errorf("%s: invalid PreQueries: got %v expected %v", name, preQueries, ReservePreQueries)
+	}
+	return nil
+}
+
+// Release is part of the queryservice.QueryService interface
+func (f *FakeQueryService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "Release", target)
+	if reservedID != ReservedID {
+		f.t.Errorf("Release: invalid ReservedId: got %v expected %v", reservedID, ReservedID)
+	}
+	return nil
+}
+
 var (
 	// MessageName is a test message name.
 	MessageName = "vitess_message"
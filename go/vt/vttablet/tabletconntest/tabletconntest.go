@@ -474,6 +474,109 @@ func testBeginExecutePanics(t *testing.T, conn queryservice.QueryService, f *Fak
 	})
 }
 
+func testReserveExecute(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveExecute")
+	f.ExpectedTransactionID = ReservedID
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	qr, reservedID, err := conn.ReserveExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+	if err != nil {
+		t.Fatalf("ReserveExecute failed: %v", err)
+	}
+	if reservedID != ReservedID {
+		t.Errorf("Unexpected result from ReserveExecute: got %v wanted %v", reservedID, ReservedID)
+	}
+	if !qr.Equal(&ExecuteQueryResult) {
+		t.Errorf("Unexpected result from ReserveExecute: got %v wanted %v", qr, ExecuteQueryResult)
+	}
+}
+
+func testReserveExecuteErrorInReserve(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveExecuteErrorInReserve")
+	f.HasReserveError = true
+	testErrorHelper(t, f, "ReserveExecute.Reserve", func(ctx context.Context) error {
+		_, reservedID, err := conn.ReserveExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+		if reservedID != 0 {
+			t.Errorf("Unexpected reservedID from ReserveExecute: got %v wanted 0", reservedID)
+		}
+		return err
+	})
+	f.HasReserveError = false
+}
+
+func testReserveExecuteErrorInExecute(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveExecuteErrorInExecute")
+	f.HasError = true
+	testErrorHelper(t, f, "ReserveExecute.Execute", func(ctx context.Context) error {
+		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+		_, reservedID, err := conn.ReserveExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+		if reservedID != ReservedID {
+			t.Errorf("Unexpected reservedID from ReserveExecute: got %v wanted %v", reservedID, ReservedID)
+		}
+		return err
+	})
+	f.HasError = false
+}
+
+func testReserveExecutePanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveExecutePanics")
+	testPanicHelper(t, f, "ReserveExecute", func(ctx context.Context) error {
+		_, _, err := conn.ReserveExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+		return err
+	})
+}
+
+func testReserveBeginExecute(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveBeginExecute")
+	f.ExpectedTransactionID = ReservedID
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	qr, transactionID, err := conn.ReserveBeginExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+	if err != nil {
+		t.Fatalf("ReserveBeginExecute failed: %v", err)
+	}
+	if transactionID != ReservedID {
+		t.Errorf("Unexpected result from ReserveBeginExecute: got %v wanted %v", transactionID, ReservedID)
+	}
+	if !qr.Equal(&ExecuteQueryResult) {
+		t.Errorf("Unexpected result from ReserveBeginExecute: got %v wanted %v", qr, ExecuteQueryResult)
+	}
+}
+
+func testReserveBeginExecutePanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReserveBeginExecutePanics")
+	testPanicHelper(t, f, "ReserveBeginExecute", func(ctx context.Context) error {
+		_, _, err := conn.ReserveBeginExecute(ctx, TestTarget, ReservePreQueries, ExecuteQuery, ExecuteBindVars, 0, TestExecuteOptions)
+		return err
+	})
+}
+
+func testRelease(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testRelease")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	err := conn.Release(ctx, TestTarget, ReservedID)
+	if err != nil {
+		t.Fatalf("Release failed: %v", err)
+	}
+}
+
+func testReleaseError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReleaseError")
+	f.HasError = true
+	testErrorHelper(t, f, "Release", func(ctx context.Context) error {
+		return conn.Release(ctx, TestTarget, ReservedID)
+	})
+	f.HasError = false
+}
+
+func testReleasePanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReleasePanics")
+	testPanicHelper(t, f, "Release", func(ctx context.Context) error {
+		return conn.Release(ctx, TestTarget, ReservedID)
+	})
+}
+
 func testStreamExecute(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testStreamExecute")
 	ctx := context.Background()
@@ -938,6 +1041,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testReadTransaction,
 		testExecute,
 		testBeginExecute,
+		testReserveExecute,
+		testReserveBeginExecute,
+		testRelease,
 		testStreamExecute,
 		testExecuteBatch,
 		testBeginExecuteBatch,
@@ -961,6 +1067,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testExecuteError,
 		testBeginExecuteErrorInBegin,
 		testBeginExecuteErrorInExecute,
+		testReserveExecuteErrorInReserve,
+		testReserveExecuteErrorInExecute,
+		testReleaseError,
 		testStreamExecuteError,
 		testExecuteBatchError,
 		testBeginExecuteBatchErrorInBegin,
@@ -984,6 +1093,9 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testReadTransactionPanics,
 		testExecutePanics,
 		testBeginExecutePanics,
+		testReserveExecutePanics,
+		testReserveBeginExecutePanics,
+		testReleasePanics,
 		testStreamExecutePanics,
 		testExecuteBatchPanics,
 		testBeginExecuteBatchPanics,
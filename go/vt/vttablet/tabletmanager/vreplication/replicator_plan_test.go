@@ -411,6 +411,56 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// keyspace_id
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, keyspace_id() as c2 from t1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, keyspace_id() from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_keyspace_id)",
+					Insert:       "insert into t1(c1,c2) values (:a_c1,:a_keyspace_id)",
+					Update:       "update t1 set c2=:a_keyspace_id where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, keyspace_id(), pk1, pk2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_keyspace_id)",
+					Insert:       "insert into t1(c1,c2) select :a_c1, :a_keyspace_id from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=:a_keyspace_id where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		// Keywords as names.
 		input: &binlogdatapb.Filter{
@@ -587,6 +637,15 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "unexpected: sum(a + b)",
+	}, {
+		// keyspace_id should have no arguments
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select keyspace_id(a) as c from t1",
+			}},
+		},
+		err: "unexpected: keyspace_id(a)",
 	}, {
 		// no complex expr in group by
 		input: &binlogdatapb.Filter{
@@ -678,6 +737,29 @@ func TestBuildPlayerPlanNoDup(t *testing.T) {
 	}
 }
 
+// TestBuildPlayerPlanLookupVindex verifies the filter that backfills the
+// table of a lookup vindex whose from column differs from the owner column.
+// The keyrange filter must survive the rewrite into the vstreamer filter.
+func TestBuildPlayerPlanLookupVindex(t *testing.T) {
+	tableKeys := map[string][]string{
+		"lkp": {"c2"},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "lkp",
+			Filter: "select col2 as c2, keyspace_id() as keyspace_id from t1 where in_keyrange(col2, 'hash', '-80') group by c2",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, tableKeys, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "select col2, keyspace_id() from t1 where in_keyrange(col2, 'hash', '-80')"
+	if got := plan.VStreamFilter.Rules[0].Filter; got != want {
+		t.Errorf("VStreamFilter: %s, want %s", got, want)
+	}
+}
+
 func TestBuildPlayerPlanExclude(t *testing.T) {
 	tableKeys := map[string][]string{
 		"t1": {"c1"},
@@ -87,6 +87,8 @@ const (
 	PlanMessageStream
 	// PlanSelectImpossible is used for where or having clauses that can never be true.
 	PlanSelectImpossible
+	// PlanSavepoint is for SAVEPOINT, ROLLBACK TO and RELEASE SAVEPOINT statements.
+	PlanSavepoint
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -111,6 +113,7 @@ var planName = [NumPlans]string{
 	"OTHER_ADMIN",
 	"MESSAGE_STREAM",
 	"SELECT_IMPOSSIBLE",
+	"SAVEPOINT",
 }
 
 func (pt PlanType) String() string {
@@ -229,6 +232,11 @@ type Plan struct {
 
 	// For PlanInsertSubquery: pk columns in the subquery result.
 	SubqueryPKColumns []int
+
+	// GetLock is set if the query calls get_lock. MySQL keeps the
+	// advisory locks per connection, so the query is only allowed
+	// on reserved connections.
+	GetLock bool
 }
 
 // TableName returns the table name for the plan.
@@ -250,11 +258,7 @@ func (plan *Plan) setTable(tableName sqlparser.TableIdent, tables map[string]*sc
 // Build builds a plan based on the schema.
 func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Plan, error) {
 	var plan *Plan
-
-	err := checkForPoolingUnsafeConstructs(statement)
-	if err != nil {
-		return nil, err
-	}
+	var err error
 
 	switch stmt := statement.(type) {
 	case *sqlparser.Union:
@@ -277,10 +281,12 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		plan, err = analyzeDDL(stmt, tables), nil
 	case *sqlparser.Show:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
-	case *sqlparser.OtherRead:
+	case *sqlparser.OtherRead, *sqlparser.Explain:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		plan, err = &Plan{PlanID: PlanSavepoint, FullQuery: GenerateFullQuery(stmt)}, nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
@@ -288,6 +294,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.GetLock = hasGetLock(statement)
 	return plan, nil
 }
 
@@ -317,7 +324,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 		if tableName := analyzeFrom(stmt.From); !tableName.IsEmpty() {
 			plan.setTable(tableName, tables)
 		}
-	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union:
+	case *sqlparser.OtherRead, *sqlparser.Explain, *sqlparser.Show, *sqlparser.Union:
 		// pass
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "'%v' not allowed for streaming", sqlparser.String(stmt))
@@ -349,15 +356,26 @@ func BuildMessageStreaming(name string, tables map[string]*schema.Table) (*Plan,
 // a call to GET_LOCK(), which is unsafe with server-side connection pooling.
 // For more background, see https://github.com/vitessio/vitess/issues/3631.
 func checkForPoolingUnsafeConstructs(expr sqlparser.SQLNode) error {
-	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+	if hasGetLock(expr) {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed")
+	}
+	return nil
+}
+
+// hasGetLock returns true if the SQL expression calls get_lock.
+func hasGetLock(expr sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		if f, ok := node.(*sqlparser.FuncExpr); ok {
 			if f.Name.Lowered() == "get_lock" {
-				return false, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed")
+				found = true
+				return false, nil
 			}
 		}
 
 		// TODO: This could be smarter about not walking down parts of the AST that can't contain
 		// function calls.
-		return true, nil
+		return !found, nil
 	}, expr)
+	return found
 }
@@ -53,6 +53,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues []sqltypes.PlanValue   `json:",omitempty"`
 		WhereClause       *sqlparser.ParsedQuery `json:",omitempty"`
 		SubqueryPKColumns []int                  `json:",omitempty"`
+		GetLock           bool                   `json:",omitempty"`
 	}{
 		PlanID:            p.PlanID,
 		Reason:            p.Reason,
@@ -68,6 +69,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues: p.SecondaryPKValues,
 		WhereClause:       p.WhereClause,
 		SubqueryPKColumns: p.SubqueryPKColumns,
+		GetLock:           p.GetLock,
 	}
 	return json.Marshal(&mplan)
 }
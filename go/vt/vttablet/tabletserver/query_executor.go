@@ -64,6 +64,11 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
+// errGetLockNotReserved is returned for the queries that take advisory
+// locks outside of a reserved connection. The locks would stay on the
+// pooled connection.
+var errGetLockNotReserved = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed outside of a reserved connection")
+
 // Execute performs a non-streaming query execution.
 func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	qre.logStats.TransactionID = qre.transactionID
@@ -95,6 +100,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if qre.plan.GetLock && qre.transactionID == 0 {
+		return nil, errGetLockNotReserved
+	}
 
 	switch qre.plan.PlanID {
 	case planbuilder.PlanDDL:
@@ -120,6 +128,13 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		defer conn.Recycle()
+		if qre.plan.GetLock {
+			if !conn.Reserved {
+				return nil, errGetLockNotReserved
+			}
+			// The connection must not be reaped while it holds the locks.
+			conn.HoldsLocks = true
+		}
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassDML:
 			if !qre.tsv.qe.allowUnsafeDMLs && (qre.tsv.qe.binlogFormat != connpool.BinlogFormatRow) {
@@ -140,7 +155,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.execSQL(conn, qre.query, true)
 		case planbuilder.PlanUpsertPK:
 			return qre.execUpsertPK(conn)
-		case planbuilder.PlanSet:
+		case planbuilder.PlanSet, planbuilder.PlanSavepoint:
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, true)
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectImpossible:
 			return qre.execDirect(conn)
@@ -158,7 +173,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectImpossible:
 			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 		case planbuilder.PlanSet:
 			return qre.execSet()
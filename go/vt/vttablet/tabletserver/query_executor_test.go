@@ -1337,6 +1337,35 @@ func TestQueryExecutorPlanSet(t *testing.T) {
 	tsv.StopService()
 }
 
+func TestQueryExecutorPlanSavepoint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "savepoint a"
+	db.AddQuery(query, &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	// Test inside transaction.
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	wantqueries := []string{"savepoint a"}
+	gotqueries := fetchRecordedQueries(qre)
+	if !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+	testCommitHelper(t, tsv, qre)
+}
+
 func TestQueryExecutorPlanOther(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
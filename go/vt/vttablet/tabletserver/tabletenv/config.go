@@ -69,6 +69,7 @@ func init() {
 	flag.Float64Var(&Config.QueryPoolTimeout, "queryserver-config-query-pool-timeout", DefaultQsConfig.QueryPoolTimeout, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	flag.Float64Var(&Config.TxPoolTimeout, "queryserver-config-txpool-timeout", DefaultQsConfig.TxPoolTimeout, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	flag.Float64Var(&Config.IdleTimeout, "queryserver-config-idle-timeout", DefaultQsConfig.IdleTimeout, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
+	flag.Float64Var(&Config.ReservedConnIdleTimeout, "queryserver-config-reserved-conn-idle-timeout", DefaultQsConfig.ReservedConnIdleTimeout, "query server reserved connection idle timeout (in seconds), a reserved connection will be released if it stays out of a transaction for longer than this value. A value of 0 disables it.")
 	flag.IntVar(&Config.QueryPoolWaiterCap, "queryserver-config-query-pool-waiter-cap", DefaultQsConfig.QueryPoolWaiterCap, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&Config.TxPoolWaiterCap, "queryserver-config-txpool-waiter-cap", DefaultQsConfig.TxPoolWaiterCap, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
 	// tableacl related configurations.
@@ -151,6 +152,7 @@ type TabletConfig struct {
 	QueryPoolTimeout              float64
 	TxPoolTimeout                 float64
 	IdleTimeout                   float64
+	ReservedConnIdleTimeout       float64
 	QueryPoolWaiterCap            int
 	TxPoolWaiterCap               int
 	StrictTableACL                bool
@@ -226,6 +228,7 @@ var DefaultQsConfig = TabletConfig{
 	QueryPoolTimeout:              0,
 	TxPoolTimeout:                 1,
 	IdleTimeout:                   30 * 60,
+	ReservedConnIdleTimeout:       30 * 60,
 	QueryPoolWaiterCap:            50000,
 	TxPoolWaiterCap:               50000,
 	StreamBufferSize:              32 * 1024,
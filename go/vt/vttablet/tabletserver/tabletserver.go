@@ -248,6 +248,19 @@ type TxPoolController interface {
 
 	// Rollback rolls back the specified transaction.
 	Rollback(ctx context.Context, transactionID int64) error
+
+	// Reserve reserves a connection for the session, after executing the
+	// preQueries on it. If transactionID is not 0, the connection of that
+	// transaction gets reserved. It returns the reserved id.
+	Reserve(ctx context.Context, options *querypb.ExecuteOptions, transactionID int64, preQueries []string) (int64, error)
+
+	// BeginReserved begins a transaction on the reserved connection, and returns
+	// the statement(s) used to execute the begin (if any).
+	BeginReserved(ctx context.Context, options *querypb.ExecuteOptions, reservedID int64) (string, error)
+
+	// Release rolls back the transaction of the reserved connection, if any,
+	// and releases it.
+	Release(ctx context.Context, reservedID int64) error
 }
 
 var tsOnce sync.Once
@@ -1291,6 +1304,91 @@ func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.
 	return results, transactionID, err
 }
 
+// ReserveExecute reserves a connection for the session, executes the
+// preQueries on it to set up its state, and then executes the query.
+// If transactionID is not 0, the connection of that transaction gets
+// reserved, and the reserved id is the transaction id.
+func (tsv *TabletServer) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := tsv.reserve(ctx, target, preQueries, transactionID, options)
+	if err != nil {
+		return nil, transactionID, err
+	}
+
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute begins a transaction on the reserved connection and
+// executes the query. If reservedID is 0, a connection is reserved first,
+// and the preQueries are executed on it. The transaction id is the
+// reserved id.
+func (tsv *TabletServer) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		reservedID, err = tsv.reserve(ctx, target, preQueries, 0, options)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	err := tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"BeginReserved", "begin", nil,
+		target, options, true /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			startTime := time.Now()
+			if tsv.txThrottler.Throttle() {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "Transaction throttled")
+			}
+			beginSQL, err := tsv.teCtrl.BeginReserved(ctx, options, reservedID)
+			logStats.TransactionID = reservedID
+			logStats.OriginalSQL = beginSQL
+			if beginSQL != "" {
+				tabletenv.QueryStats.Record("BEGIN", startTime)
+			} else {
+				logStats.Method = ""
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, reservedID, err
+	}
+
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+func (tsv *TabletServer) reserve(ctx context.Context, target *querypb.Target, preQueries []string, transactionID int64, options *querypb.ExecuteOptions) (reservedID int64, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Reserve", "reserve", nil,
+		target, options, transactionID == 0 /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RESERVE", time.Now())
+			reservedID, err = tsv.teCtrl.Reserve(ctx, options, transactionID, preQueries)
+			logStats.TransactionID = reservedID
+			return err
+		},
+	)
+	return reservedID, err
+}
+
+// Release rolls back the transaction of the reserved connection, if any,
+// and releases the connection.
+func (tsv *TabletServer) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	return tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Release", "release", nil,
+		target, nil, false /* isBegin */, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RELEASE", time.Now())
+			logStats.TransactionID = reservedID
+			return tsv.teCtrl.Release(ctx, reservedID)
+		},
+	)
+}
+
 // MessageStream streams messages from the requested table.
 func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
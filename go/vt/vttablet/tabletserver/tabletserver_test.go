@@ -1069,6 +1069,135 @@ func TestTabletServerRollback(t *testing.T) {
 	}
 }
 
+func TestTabletServerReserveExecute(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	// sql that will be executed in this test
+	executeSQL := "select * from test_table limit 1000"
+	executeSQLResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarBinary},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary("row01")},
+		},
+	}
+	db.AddQuery(executeSQL, executeSQLResult)
+	db.AddQuery("set sql_mode = ''", &sqltypes.Result{})
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbcfgs)
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+	preQueries := []string{"set sql_mode = ''"}
+	_, reservedID, err := tsv.ReserveExecute(ctx, &target, preQueries, executeSQL, nil, 0, nil)
+	if err != nil {
+		t.Fatalf("call TabletServer.ReserveExecute failed: %v", err)
+	}
+	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, reservedID, nil); err != nil {
+		t.Fatalf("failed to execute query: %s: %v", executeSQL, err)
+	}
+
+	// A transaction on the reserved connection keeps its id.
+	_, transactionID, err := tsv.ReserveBeginExecute(ctx, &target, nil, executeSQL, nil, reservedID, nil)
+	if err != nil {
+		t.Fatalf("call TabletServer.ReserveBeginExecute failed: %v", err)
+	}
+	if transactionID != reservedID {
+		t.Errorf("ReserveBeginExecute transaction id: %d, want %d", transactionID, reservedID)
+	}
+	if err := tsv.Commit(ctx, &target, transactionID); err != nil {
+		t.Fatalf("call TabletServer.Commit failed: %v", err)
+	}
+	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, reservedID, nil); err != nil {
+		t.Fatalf("failed to execute query after commit: %s: %v", executeSQL, err)
+	}
+	if err := tsv.Release(ctx, &target, reservedID); err != nil {
+		t.Fatalf("call TabletServer.Release failed: %v", err)
+	}
+	want := "reserved connection released"
+	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, reservedID, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Execute after Release: %v, want %s", err, want)
+	}
+
+	// Reserving the connection of a transaction keeps its id.
+	transactionID, err = tsv.Begin(ctx, &target, nil)
+	if err != nil {
+		t.Fatalf("call TabletServer.Begin failed: %v", err)
+	}
+	_, reservedID, err = tsv.ReserveExecute(ctx, &target, preQueries, executeSQL, nil, transactionID, nil)
+	if err != nil {
+		t.Fatalf("call TabletServer.ReserveExecute failed: %v", err)
+	}
+	if reservedID != transactionID {
+		t.Errorf("ReserveExecute reserved id: %d, want %d", reservedID, transactionID)
+	}
+	if err := tsv.Rollback(ctx, &target, transactionID); err != nil {
+		t.Fatalf("call TabletServer.Rollback failed: %v", err)
+	}
+	if err := tsv.Release(ctx, &target, reservedID); err != nil {
+		t.Fatalf("call TabletServer.Release failed: %v", err)
+	}
+}
+
+func TestTabletServerReserveGetLock(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	lockSQL := "select get_lock('l', 10) from dual"
+	db.AddQuery("select get_lock('l', 10) from dual limit 10001", &sqltypes.Result{
+		Fields:       []*querypb.Field{{Type: sqltypes.Int64}},
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt64(1)}},
+	})
+	db.AddQuery("select get_lock('l', 10) from dual where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.Int64}},
+	})
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	// The locks would stay on a pooled connection.
+	want := "get_lock() not allowed outside of a reserved connection"
+	if _, err := tsv.Execute(ctx, &target, lockSQL, nil, 0, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Execute: %v, want %s", err, want)
+	}
+	if _, transactionID, err := tsv.BeginExecute(ctx, &target, lockSQL, nil, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("BeginExecute: %v, want %s", err, want)
+	} else {
+		_ = tsv.Rollback(ctx, &target, transactionID)
+	}
+
+	_, reservedID, err := tsv.ReserveExecute(ctx, &target, nil, lockSQL, nil, 0, nil)
+	if err != nil {
+		t.Fatalf("ReserveExecute: %v", err)
+	}
+	conn, err := tsv.te.txPool.Get(reservedID, "for test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !conn.HoldsLocks {
+		t.Error("HoldsLocks: false, want true")
+	}
+	conn.Recycle()
+	if err := tsv.Release(ctx, &target, reservedID); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+}
+
 func TestTabletServerPrepare(t *testing.T) {
 	// Reuse code from tx_executor_test.
 	_, tsv, db := newTestTxExecutor(t)
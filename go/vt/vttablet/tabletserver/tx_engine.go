@@ -120,6 +120,7 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 		time.Duration(config.TransactionTimeout*1e9),
 		time.Duration(config.TxPoolTimeout*1e9),
 		time.Duration(config.IdleTimeout*1e9),
+		time.Duration(config.ReservedConnIdleTimeout*1e9),
 		config.TxPoolWaiterCap,
 		checker,
 		limiter,
@@ -274,28 +275,102 @@ func (te *TxEngine) AcceptReadOnly() error {
 func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Begin")
 	defer span.Finish()
+
+	if err := te.beginRequest(isWriteTransaction(options)); err != nil {
+		return 0, "", err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Begin(ctx, options)
+}
+
+// Reserve reserves a connection for the session, and returns its id. The
+// preQueries are executed on it first. If transactionID is not 0, the
+// connection of that transaction gets reserved, and it keeps its id.
+func (te *TxEngine) Reserve(ctx context.Context, options *querypb.ExecuteOptions, transactionID int64, preQueries []string) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Reserve")
+	defer span.Finish()
+
+	if transactionID != 0 {
+		conn, err := te.txPool.Get(transactionID, "for reserve")
+		if err != nil {
+			return 0, err
+		}
+		defer conn.Recycle()
+		for _, query := range preQueries {
+			if _, err := conn.Exec(ctx, query, 1, false); err != nil {
+				return 0, err
+			}
+		}
+		te.txPool.ReserveTransaction(conn)
+		return transactionID, nil
+	}
+
+	// Reserving a connection doesn't open a transaction,
+	// so it's allowed on read-only tablets.
+	if err := te.beginRequest(false /* isWrite */); err != nil {
+		return 0, err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Reserve(ctx, options, preQueries)
+}
+
+// BeginReserved begins a transaction on the reserved connection, and returns
+// the statement(s) used to execute the begin (if any). The transaction id
+// is the reserved id.
+func (te *TxEngine) BeginReserved(ctx context.Context, options *querypb.ExecuteOptions, reservedID int64) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.BeginReserved")
+	defer span.Finish()
+
+	if err := te.beginRequest(isWriteTransaction(options)); err != nil {
+		return "", err
+	}
+	defer te.beginRequests.Done()
+
+	conn, err := te.txPool.Get(reservedID, "for begin")
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+	if !conn.Reserved {
+		return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "transaction %d is not a reserved connection", reservedID)
+	}
+	return te.txPool.BeginReserved(ctx, conn, options)
+}
+
+// Release rolls back the transaction of the reserved connection, if any,
+// and releases it.
+func (te *TxEngine) Release(ctx context.Context, reservedID int64) error {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Release")
+	defer span.Finish()
+
+	return te.txPool.Release(ctx, reservedID)
+}
+
+// beginRequest checks that the engine can open a new transaction or
+// reserved connection. If it can, it's registered in beginRequests to
+// block state changes, and the caller must call beginRequests.Done().
+func (te *TxEngine) beginRequest(isWrite bool) error {
 	te.stateLock.Lock()
+	defer te.stateLock.Unlock()
 
 	canOpenTransactions := te.state == AcceptingReadOnly || te.state == AcceptingReadAndWrite
 	if !canOpenTransactions {
 		// We are not in a state where we can start new transactions. Abort.
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
 	}
 
-	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
-	if te.state == AcceptingReadOnly && isWriteTransaction {
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
+	if te.state == AcceptingReadOnly && isWrite {
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
 	}
 
 	// By Add() to beginRequests, we block others from initiating state
 	// changes until we have finished adding this transaction
 	te.beginRequests.Add(1)
-	te.stateLock.Unlock()
+	return nil
+}
 
-	defer te.beginRequests.Done()
-	return te.txPool.Begin(ctx, options)
+func isWriteTransaction(options *querypb.ExecuteOptions) bool {
+	return options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
 }
 
 // Commit commits the specified transaction.
@@ -55,6 +55,10 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 	if err != nil {
 		return err
 	}
+	if conn.Reserved {
+		conn.Recycle()
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "prepare failed for transaction %d: 2pc is not supported on reserved connections", transactionID)
+	}
 
 	// If no queries were executed, we just rollback.
 	if len(conn.Queries) == 0 {
@@ -51,6 +51,7 @@ const (
 	TxRollback = "rollback"
 	TxPrepare  = "prepare"
 	TxKill     = "kill"
+	TxRelease  = "release"
 )
 
 const txLogInterval = time.Duration(1 * time.Minute)
@@ -88,11 +89,20 @@ type TxPool struct {
 	// connections with CLIENT_FOUND_ROWS flag set. A separate
 	// pool is needed because this option can only be set at
 	// connection time.
-	foundRowsPool          *connpool.Pool
-	activePool             *pools.Numbered
+	foundRowsPool *connpool.Pool
+	activePool    *pools.Numbered
+	// reservedPool tracks the connections reserved for a session.
+	// They share their ids with the transactions, and can have
+	// a transaction open on them.
+	reservedPool *pools.Numbered
+	// draining is set while the pool waits for its connections
+	// to be released. Reserved connections are released as soon
+	// as they're not in a transaction any more.
+	draining               sync2.AtomicBool
 	lastID                 sync2.AtomicInt64
 	transactionTimeout     sync2.AtomicDuration
 	transactionPoolTimeout sync2.AtomicDuration
+	reservedIdleTimeout    sync2.AtomicDuration
 	ticks                  *timer.Timer
 	checker                connpool.MySQLChecker
 	limiter                txlimiter.TxLimiter
@@ -112,6 +122,7 @@ func NewTxPool(
 	transactionTimeout time.Duration,
 	transactionPoolTimeout time.Duration,
 	idleTimeout time.Duration,
+	reservedIdleTimeout time.Duration,
 	waiterCap int,
 	checker connpool.MySQLChecker,
 	limiter txlimiter.TxLimiter) *TxPool {
@@ -119,9 +130,11 @@ func NewTxPool(
 		conns:                  connpool.New(prefix+"TransactionPool", capacity, prefillParallelism, idleTimeout, checker),
 		foundRowsPool:          connpool.New(prefix+"FoundRowsPool", foundRowsCapacity, prefillParallelism, idleTimeout, checker),
 		activePool:             pools.NewNumbered(),
+		reservedPool:           pools.NewNumbered(),
 		lastID:                 sync2.NewAtomicInt64(time.Now().UnixNano()),
 		transactionTimeout:     sync2.NewAtomicDuration(transactionTimeout),
 		transactionPoolTimeout: sync2.NewAtomicDuration(transactionPoolTimeout),
+		reservedIdleTimeout:    sync2.NewAtomicDuration(reservedIdleTimeout),
 		waiterCap:              sync2.NewAtomicInt64(int64(waiterCap)),
 		waiters:                sync2.NewAtomicInt64(0),
 		ticks:                  timer.NewTimer(transactionTimeout / 10),
@@ -142,6 +155,7 @@ func NewTxPool(
 // that will kill long-running transactions.
 func (axp *TxPool) Open(appParams, dbaParams, appDebugParams *mysql.ConnParams) {
 	log.Infof("Starting transaction id: %d", axp.lastID)
+	axp.draining.Set(false)
 	axp.conns.Open(appParams, dbaParams, appDebugParams)
 	foundRowsParam := *appParams
 	foundRowsParam.EnableClientFoundRows()
@@ -159,6 +173,13 @@ func (axp *TxPool) Close() {
 		conn.Close()
 		conn.conclude(TxClose, "pool closed")
 	}
+	for _, v := range axp.reservedPool.GetOutdated(time.Duration(0), "for closing") {
+		conn := v.(*TxConnection)
+		log.Warningf("killing reserved connection for shutdown: %s", conn.Format(nil))
+		warnLocksReleased(conn, "for shutdown")
+		conn.Close()
+		conn.conclude(TxClose, "pool closed")
+	}
 	axp.conns.Close()
 	axp.foundRowsPool.Close()
 }
@@ -180,6 +201,11 @@ func (axp *TxPool) RollbackNonBusy(ctx context.Context) {
 	for _, v := range axp.activePool.GetOutdated(time.Duration(0), "for transition") {
 		axp.LocalConclude(ctx, v.(*TxConnection))
 	}
+	for _, v := range axp.reservedPool.GetOutdated(time.Duration(0), "for transition") {
+		conn := v.(*TxConnection)
+		warnLocksReleased(conn, "for transition")
+		axp.LocalConclude(ctx, conn)
+	}
 }
 
 func (axp *TxPool) transactionKiller() {
@@ -191,11 +217,63 @@ func (axp *TxPool) transactionKiller() {
 		conn.Close()
 		conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", axp.Timeout()))
 	}
+	// Reserved connections live as long as their session. Their
+	// transactions are subject to the transaction timeout, and they're
+	// only killed outside of a transaction if they've been idle for
+	// longer than the reserved connection idle timeout, unless they
+	// hold advisory locks.
+	now := time.Now()
+	timeout := axp.Timeout()
+	idleTimeout := axp.ReservedIdleTimeout()
+	expired := func(val interface{}, timeUsed time.Time) bool {
+		conn := val.(*TxConnection)
+		if conn.inTransaction {
+			return now.Sub(conn.TxStartTime) >= timeout
+		}
+		return !conn.HoldsLocks && idleTimeout > 0 && now.Sub(timeUsed) >= idleTimeout
+	}
+	for _, v := range axp.reservedPool.GetByFilter(expired, "for reserved connection killer") {
+		conn := v.(*TxConnection)
+		if conn.inTransaction {
+			log.Warningf("killing transaction on reserved connection (exceeded timeout: %v): %s", timeout, conn.Format(nil))
+			tabletenv.KillStats.Add("Transactions", 1)
+			conn.Close()
+			conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", timeout))
+			continue
+		}
+		log.Warningf("killing reserved connection (exceeded idle timeout: %v): %s", idleTimeout, conn.Format(nil))
+		tabletenv.KillStats.Add("ReservedConnections", 1)
+		conn.Close()
+		conn.conclude(TxKill, fmt.Sprintf("exceeded idle timeout: %v", idleTimeout))
+	}
 }
 
-// WaitForEmpty waits until all active transactions are completed.
+// WaitForEmpty waits until all active transactions are completed,
+// and all reserved connections are released. Reserved connections
+// that are not in a transaction are released right away.
 func (axp *TxPool) WaitForEmpty() {
+	axp.draining.Set(true)
+	ctx := tabletenv.LocalContext()
+	for _, v := range axp.reservedPool.GetOutdated(time.Duration(0), "for draining") {
+		conn := v.(*TxConnection)
+		if conn.inTransaction {
+			conn.Recycle()
+			continue
+		}
+		warnLocksReleased(conn, "for draining")
+		axp.release(ctx, conn)
+	}
 	axp.activePool.WaitForEmpty()
+	axp.reservedPool.WaitForEmpty()
+}
+
+// warnLocksReleased logs that the advisory locks held by the reserved
+// connection get released with it. The session finds out that they're
+// lost when it uses the connection again.
+func warnLocksReleased(conn *TxConnection, reason string) {
+	if conn.HoldsLocks {
+		log.Warningf("releasing the advisory locks of reserved connection %d %s", conn.TransactionID, reason)
+	}
 }
 
 // Begin begins a transaction, and returns the associated transaction id and
@@ -206,34 +284,154 @@ func (axp *TxPool) WaitForEmpty() {
 func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Begin")
 	defer span.Finish()
-	var conn *connpool.DBConn
+
+	conn, err := axp.getConn(ctx, options)
+	if err != nil {
+		return 0, "", err
+	}
+	beginQueries, autocommitTransaction, err := axp.begin(ctx, conn, options)
+	if err != nil {
+		axp.putConn(ctx, conn)
+		return 0, "", err
+	}
+
+	transactionID := axp.lastID.Add(1)
+	axp.activePool.Register(
+		transactionID,
+		newTxConnection(
+			conn,
+			transactionID,
+			axp,
+			callerid.ImmediateCallerIDFromContext(ctx),
+			callerid.EffectiveCallerIDFromContext(ctx),
+			autocommitTransaction,
+		),
+		options.GetWorkload() != querypb.ExecuteOptions_DBA,
+	)
+	return transactionID, beginQueries, nil
+}
+
+// Reserve reserves a connection for a session, and returns its id. The
+// preQueries are executed on the connection to set up its session state.
+// The connection is not in a transaction.
+//
+// Subsequent statements can access the connection through the reserved id,
+// which is also the id of the transactions opened on it with BeginReserved.
+// The connection must be released with Release.
+func (axp *TxPool) Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.Reserve")
+	defer span.Finish()
+
+	conn, err := axp.getConn(ctx, options)
+	if err != nil {
+		return 0, err
+	}
+	for _, query := range preQueries {
+		if _, err := conn.Exec(ctx, query, 1, false); err != nil {
+			// The session state of the connection is unknown.
+			conn.Close()
+			axp.putConn(ctx, conn)
+			return 0, err
+		}
+	}
+
+	reservedID := axp.lastID.Add(1)
+	txc := newTxConnection(
+		conn,
+		reservedID,
+		axp,
+		callerid.ImmediateCallerIDFromContext(ctx),
+		callerid.EffectiveCallerIDFromContext(ctx),
+		false,
+	)
+	txc.Reserved = true
+	txc.inTransaction = false
+	axp.reservedPool.Register(reservedID, txc, true)
+	return reservedID, nil
+}
+
+// ReserveTransaction reserves the connection of the transaction for
+// the session. The connection keeps its id, and it stays reserved
+// after the transaction ends.
+func (axp *TxPool) ReserveTransaction(conn *TxConnection) {
+	if conn.Reserved {
+		return
+	}
+	axp.activePool.Unregister(conn.TransactionID, "reserved")
+	conn.Reserved = true
+	axp.reservedPool.Register(conn.TransactionID, conn, true)
+	axp.reservedPool.Get(conn.TransactionID, "for reserve")
+}
+
+// BeginReserved begins a transaction on the reserved connection, and returns
+// the statements (if any) executed to initiate the transaction.
+func (axp *TxPool) BeginReserved(ctx context.Context, conn *TxConnection, options *querypb.ExecuteOptions) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.BeginReserved")
+	defer span.Finish()
+
+	if conn.inTransaction {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "reserved connection %d is already in a transaction", conn.TransactionID)
+	}
+	beginQueries, autocommitTransaction, err := axp.begin(ctx, conn.DBConn, options)
+	if err != nil {
+		return "", err
+	}
+	conn.inTransaction = true
+	conn.TxStartTime = time.Now()
+	conn.Autocommit = autocommitTransaction
+	return beginQueries, nil
+}
+
+// Release rolls back the transaction of the reserved connection, if any,
+// and closes it.
+func (axp *TxPool) Release(ctx context.Context, reservedID int64) error {
+	span, ctx := trace.NewSpan(ctx, "TxPool.Release")
+	defer span.Finish()
+
+	conn, err := axp.Get(reservedID, "for release")
+	if err != nil {
+		return err
+	}
+	if !conn.Reserved {
+		conn.Recycle()
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "transaction %d is not a reserved connection", reservedID)
+	}
+	return axp.release(ctx, conn)
+}
+
+func (axp *TxPool) release(ctx context.Context, conn *TxConnection) error {
 	var err error
+	if conn.inTransaction && !conn.Autocommit {
+		_, err = conn.Exec(ctx, "rollback", 1, false)
+	}
+	// The session state of the connection must not leak
+	// to the other users of the pool.
+	conn.Close()
+	conn.conclude(TxRelease, "reserved connection released")
+	return err
+}
+
+// getConn returns a connection from the pool for a new transaction
+// or reserved connection. If the connection doesn't get registered,
+// it must be returned with putConn.
+func (axp *TxPool) getConn(ctx context.Context, options *querypb.ExecuteOptions) (*connpool.DBConn, error) {
 	immediateCaller := callerid.ImmediateCallerIDFromContext(ctx)
 	effectiveCaller := callerid.EffectiveCallerIDFromContext(ctx)
 
 	if !axp.limiter.Get(immediateCaller, effectiveCaller) {
-		return 0, "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "per-user transaction pool connection limit exceeded")
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "per-user transaction pool connection limit exceeded")
 	}
 
 	waiterCount := axp.waiters.Add(1)
 	defer axp.waiters.Add(-1)
 
 	if waiterCount > axp.waiterCap.Get() {
-		return 0, "", vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool waiter count exceeded")
-	}
-
-	var beginSucceeded bool
-	defer func() {
-		if beginSucceeded {
-			return
-		}
-
-		if conn != nil {
-			conn.Recycle()
-		}
 		axp.limiter.Release(immediateCaller, effectiveCaller)
-	}()
+		return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool waiter count exceeded")
+	}
 
+	var conn *connpool.DBConn
+	var err error
 	poolCtx, poolCancel := context.WithTimeout(ctx, axp.transactionPoolTimeout.Get())
 	defer poolCancel()
 	if options.GetClientFoundRows() {
@@ -242,52 +440,50 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 		conn, err = axp.conns.Get(poolCtx)
 	}
 	if err != nil {
+		axp.limiter.Release(immediateCaller, effectiveCaller)
 		switch err {
 		case connpool.ErrConnPoolClosed:
-			return 0, "", err
+			return nil, err
 		case pools.ErrTimeout:
 			axp.LogActive()
-			return 0, "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
 		}
-		return 0, "", err
+		return nil, err
 	}
+	return conn, nil
+}
 
-	autocommitTransaction := false
-	beginQueries := ""
-	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
-		if queries.setIsolationLevel != "" {
-			if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
-				return 0, "", err
-			}
+// putConn returns a connection obtained with getConn to the pool.
+func (axp *TxPool) putConn(ctx context.Context, conn *connpool.DBConn) {
+	conn.Recycle()
+	axp.limiter.Release(callerid.ImmediateCallerIDFromContext(ctx), callerid.EffectiveCallerIDFromContext(ctx))
+}
 
-			beginQueries = queries.setIsolationLevel + "; "
+// begin executes the statements that open a transaction on the
+// connection. It returns them, and whether the transaction is an
+// autocommit one.
+func (axp *TxPool) begin(ctx context.Context, conn *connpool.DBConn, options *querypb.ExecuteOptions) (string, bool, error) {
+	queries, ok := txIsolations[options.GetTransactionIsolation()]
+	if !ok {
+		if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
+			return "", true, nil
 		}
+		return "", false, fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+	}
 
-		if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
-			return 0, "", err
+	beginQueries := ""
+	if queries.setIsolationLevel != "" {
+		if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+			return "", false, err
 		}
-		beginQueries = beginQueries + queries.openTransaction
-	} else if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
-		autocommitTransaction = true
-	} else {
-		return 0, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+
+		beginQueries = queries.setIsolationLevel + "; "
 	}
 
-	beginSucceeded = true
-	transactionID := axp.lastID.Add(1)
-	axp.activePool.Register(
-		transactionID,
-		newTxConnection(
-			conn,
-			transactionID,
-			axp,
-			immediateCaller,
-			effectiveCaller,
-			autocommitTransaction,
-		),
-		options.GetWorkload() != querypb.ExecuteOptions_DBA,
-	)
-	return transactionID, beginQueries, nil
+	if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+		return "", false, err
+	}
+	return beginQueries + queries.openTransaction, false, nil
 }
 
 // Commit commits the specified transaction.
@@ -313,14 +509,22 @@ func (axp *TxPool) Rollback(ctx context.Context, transactionID int64) error {
 	return axp.localRollback(ctx, conn)
 }
 
-// Get fetches the connection associated to the transactionID.
+// Get fetches the connection associated to the transactionID, which
+// can also be the id of a reserved connection.
 // You must call Recycle on TxConnection once done.
 func (axp *TxPool) Get(transactionID int64, reason string) (*TxConnection, error) {
 	v, err := axp.activePool.Get(transactionID, reason)
-	if err != nil {
-		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction %d: %v", transactionID, err)
+	if err == nil {
+		return v.(*TxConnection), nil
+	}
+	v, reservedErr := axp.reservedPool.Get(transactionID, reason)
+	if reservedErr == nil {
+		return v.(*TxConnection), nil
+	}
+	if reservedErr.Error() != "not found" {
+		err = reservedErr
 	}
-	return v.(*TxConnection), nil
+	return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction %d: %v", transactionID, err)
 }
 
 // LocalBegin is equivalent to Begin->Get.
@@ -342,7 +546,7 @@ func (axp *TxPool) LocalBegin(ctx context.Context, options *querypb.ExecuteOptio
 func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messageCommitter) (string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalCommit")
 	defer span.Finish()
-	defer conn.conclude(TxCommit, "transaction committed")
+	defer conn.endTransaction(TxCommit, "transaction committed")
 	defer mc.LockDB(conn.NewMessages, conn.ChangedMessages)()
 
 	if conn.Autocommit {
@@ -360,16 +564,22 @@ func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messa
 
 // LocalConclude concludes a transaction started by LocalBegin.
 // If the transaction was not previously concluded, it's rolled back.
+// Reserved connections are released.
 func (axp *TxPool) LocalConclude(ctx context.Context, conn *TxConnection) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalConclude")
 	defer span.Finish()
-	if conn.DBConn != nil {
-		_ = axp.localRollback(ctx, conn)
+	if conn.DBConn == nil {
+		return
+	}
+	if conn.Reserved {
+		_ = axp.release(ctx, conn)
+		return
 	}
+	_ = axp.localRollback(ctx, conn)
 }
 
 func (axp *TxPool) localRollback(ctx context.Context, conn *TxConnection) error {
-	defer conn.conclude(TxRollback, "transaction rolled back")
+	defer conn.endTransaction(TxRollback, "transaction rolled back")
 	if _, err := conn.Exec(ctx, "rollback", 1, false); err != nil {
 		conn.Close()
 		return err
@@ -413,14 +623,29 @@ func (axp *TxPool) SetPoolTimeout(timeout time.Duration) {
 	axp.transactionPoolTimeout.Set(timeout)
 }
 
+// ReservedIdleTimeout returns the reserved connection idle timeout.
+func (axp *TxPool) ReservedIdleTimeout() time.Duration {
+	return axp.reservedIdleTimeout.Get()
+}
+
+// SetReservedIdleTimeout sets the reserved connection idle timeout.
+// A timeout of 0 disables it.
+func (axp *TxPool) SetReservedIdleTimeout(timeout time.Duration) {
+	axp.reservedIdleTimeout.Set(timeout)
+}
+
 // TxConnection is meant for executing transactions. It can return itself to
 // the tx pool correctly. It also does not retry statements if there
 // are failures.
 type TxConnection struct {
 	*connpool.DBConn
-	TransactionID     int64
-	pool              *TxPool
-	StartTime         time.Time
+	TransactionID int64
+	pool          *TxPool
+	StartTime     time.Time
+	// TxStartTime is when the current transaction began. It differs
+	// from StartTime for reserved connections, which outlive their
+	// transactions.
+	TxStartTime       time.Time
 	EndTime           time.Time
 	Queries           []string
 	NewMessages       map[string][]*messager.MessageRow
@@ -430,19 +655,33 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+	// Reserved is set if the connection is reserved for a session.
+	// It then outlives its transactions, and it's concluded only
+	// when it's released.
+	Reserved bool
+	// HoldsLocks is set once the session takes advisory locks on the
+	// reserved connection. MySQL releases them when the connection
+	// closes, so it's not reaped when it's idle.
+	HoldsLocks bool
+	// inTransaction is set while a transaction is open on the
+	// connection. It's always set for non-reserved connections.
+	inTransaction bool
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
+	now := time.Now()
 	return &TxConnection{
 		DBConn:            conn,
 		TransactionID:     transactionID,
 		pool:              pool,
-		StartTime:         time.Now(),
+		StartTime:         now,
+		TxStartTime:       now,
 		NewMessages:       make(map[string][]*messager.MessageRow),
 		ChangedMessages:   make(map[string][]string),
 		ImmediateCallerID: immediate,
 		EffectiveCallerID: effective,
 		Autocommit:        autocommit,
+		inTransaction:     true,
 	}
 }
 
@@ -478,11 +717,37 @@ func (txc *TxConnection) BeginAgain(ctx context.Context) error {
 // Recycle returns the connection to the pool. The transaction remains
 // active.
 func (txc *TxConnection) Recycle() {
-	if txc.IsClosed() {
+	switch {
+	case txc.IsClosed():
 		txc.conclude(TxClose, "closed")
-	} else {
-		txc.pool.activePool.Put(txc.TransactionID)
+	case txc.Reserved && !txc.inTransaction && txc.pool.draining.Get():
+		txc.Close()
+		txc.conclude(TxRelease, "pool draining")
+	default:
+		txc.numbered().Put(txc.TransactionID)
+	}
+}
+
+// endTransaction concludes the connection once its transaction is over.
+// Reserved connections are kept, and they're recycled instead.
+func (txc *TxConnection) endTransaction(conclusion, reason string) {
+	if !txc.Reserved || txc.IsClosed() {
+		txc.conclude(conclusion, reason)
+		return
+	}
+	txc.inTransaction = false
+	txc.Autocommit = false
+	txc.NewMessages = make(map[string][]*messager.MessageRow)
+	txc.ChangedMessages = make(map[string][]string)
+	txc.Recycle()
+}
+
+// numbered returns the pool that tracks the connection.
+func (txc *TxConnection) numbered() *pools.Numbered {
+	if txc.Reserved {
+		return txc.pool.reservedPool
 	}
+	return txc.pool.activePool
 }
 
 // RecordQuery records the query against this transaction.
@@ -491,7 +756,7 @@ func (txc *TxConnection) RecordQuery(query string) {
 }
 
 func (txc *TxConnection) conclude(conclusion, reason string) {
-	txc.pool.activePool.Unregister(txc.TransactionID, reason)
+	txc.numbered().Unregister(txc.TransactionID, reason)
 	txc.DBConn.Recycle()
 	txc.DBConn = nil
 	txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
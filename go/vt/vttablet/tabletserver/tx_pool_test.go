@@ -688,6 +688,222 @@ func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
 	}
 }
 
+func TestTxPoolReserve(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("set sql_mode = ''", &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	reservedID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, []string{"set sql_mode = ''"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := db.GetQueryCalledNum("set sql_mode = ''"), 1; got != want {
+		t.Errorf("pre-query calls: %d, want %d", got, want)
+	}
+
+	txConn, err := txPool.Get(reservedID, "for begin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	beginSQL, err := txPool.BeginReserved(ctx, txConn, &querypb.ExecuteOptions{})
+	txConn.Recycle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beginSQL != "begin" {
+		t.Errorf("beginSQL got %q want 'begin'", beginSQL)
+	}
+
+	// The connection stays reserved after the transaction ends.
+	if _, err := txPool.Commit(ctx, reservedID, &fakeMessageCommitter{}); err != nil {
+		t.Fatal(err)
+	}
+	txConn, err = txPool.Get(reservedID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txConn.inTransaction {
+		t.Errorf("reserved connection is still in a transaction after commit")
+	}
+	txConn.Recycle()
+
+	if err := txPool.Release(ctx, reservedID); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := db.GetQueryCalledNum("rollback"), 0; got != want {
+		t.Errorf("rollback calls: %d, want %d", got, want)
+	}
+	if _, err := txPool.Get(reservedID, "for query"); err == nil {
+		t.Errorf("Get after Release: nil, want error")
+	}
+	if got, want := txPool.reservedPool.Size(), int64(0); got != want {
+		t.Errorf("reserved connections: %d, want %d", got, want)
+	}
+}
+
+func TestTxPoolReserveTransaction(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txConn, err := txPool.Get(transactionID, "for reserve")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txPool.ReserveTransaction(txConn)
+	txConn.Recycle()
+
+	if err := txPool.Rollback(ctx, transactionID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := txPool.Get(transactionID, "for query"); err != nil {
+		t.Errorf("Get after Rollback: %v, want the reserved connection", err)
+	} else {
+		txPool.reservedPool.Put(transactionID)
+	}
+
+	// Release rolls back the transaction it's in.
+	txConn, _ = txPool.Get(transactionID, "for begin")
+	if _, err := txPool.BeginReserved(ctx, txConn, &querypb.ExecuteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	txConn.Recycle()
+	if err := txPool.Release(ctx, transactionID); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := db.GetQueryCalledNum("rollback"), 2; got != want {
+		t.Errorf("rollback calls: %d, want %d", got, want)
+	}
+}
+
+func TestTxPoolReservedConnKiller(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	// make sure transaction killer will run frequent enough
+	txPool.SetTimeout(1 * time.Millisecond)
+	txPool.SetReservedIdleTimeout(0)
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+
+	idleID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockConn, err := txPool.Get(lockID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockConn.HoldsLocks = true
+	lockConn.Recycle()
+	txID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txConn, err := txPool.Get(txID, "for begin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = txPool.BeginReserved(ctx, txConn, &querypb.ExecuteOptions{})
+	txConn.Recycle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The transaction timeout applies to the transaction of the
+	// reserved connection.
+	waitForSize := func(want int64) {
+		timeoutCh := time.After(5 * time.Second)
+		for txPool.reservedPool.Size() != want {
+			select {
+			case <-timeoutCh:
+				t.Fatalf("waited too long for the reserved connection killer: %d reserved connections, want %d", txPool.reservedPool.Size(), want)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	waitForSize(2)
+	if _, err := txPool.Get(txID, "for query"); err == nil {
+		t.Errorf("Get of the killed transaction: nil, want error")
+	}
+
+	// Idle reserved connections are kept until the idle timeout is set.
+	time.Sleep(50 * time.Millisecond)
+	if _, err := txPool.Get(idleID, "for query"); err != nil {
+		t.Fatalf("Get of the idle reserved connection: %v", err)
+	}
+	txPool.reservedPool.Put(idleID)
+	txPool.SetReservedIdleTimeout(1 * time.Millisecond)
+	waitForSize(1)
+
+	// The connections that hold advisory locks are not reaped.
+	time.Sleep(50 * time.Millisecond)
+	if _, err := txPool.Get(lockID, "for query"); err != nil {
+		t.Fatalf("Get of the reserved connection holding locks: %v", err)
+	}
+	txPool.reservedPool.Put(lockID)
+}
+
+func TestTxPoolReservePreQueryFails(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	_, err := txPool.Reserve(context.Background(), &querypb.ExecuteOptions{}, []string{"set sql_mode = 'bad'"})
+	if err == nil {
+		t.Fatal("Reserve: nil, want error")
+	}
+	if got, want := txPool.reservedPool.Size(), int64(0); got != want {
+		t.Errorf("reserved connections: %d, want %d", got, want)
+	}
+}
+
+func TestTxPoolReleaseNonReserved(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "is not a reserved connection"
+	if err := txPool.Release(ctx, transactionID); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Release: %v, want %s", err, want)
+	}
+	txPool.RollbackNonBusy(ctx)
+}
+
 func newTxPool() *TxPool {
 	randID := rand.Int63()
 	poolName := fmt.Sprintf("TestTransactionPool-%d", randID)
@@ -696,6 +912,7 @@ func newTxPool() *TxPool {
 	transactionPoolTimeout := time.Duration(40 * time.Second)
 	waiterCap := 500000
 	idleTimeout := time.Duration(30 * time.Second)
+	reservedIdleTimeout := time.Duration(30 * time.Second)
 	limiter := &txlimiter.TxAllowAll{}
 	return NewTxPool(
 		poolName,
@@ -705,6 +922,7 @@ func newTxPool() *TxPool {
 		transactionTimeout,
 		transactionPoolTimeout,
 		idleTimeout,
+		reservedIdleTimeout,
 		waiterCap,
 		DummyChecker,
 		limiter,
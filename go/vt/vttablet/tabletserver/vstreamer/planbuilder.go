@@ -35,18 +35,27 @@ import (
 
 // Plan represents the plan for a table.
 type Plan struct {
-	Table        *Table
-	ColExprs     []ColExpr
-	VindexColumn int
-	Vindex       vindexes.Vindex
-	KeyRange     *topodatapb.KeyRange
+	Table    *Table
+	ColExprs []ColExpr
+	// VindexColumns, Vindex and KeyRange, if set, filter the rows
+	// by the keyspace id computed from the result columns.
+	VindexColumns []int
+	Vindex        vindexes.Vindex
+	KeyRange      *topodatapb.KeyRange
 }
 
 // ColExpr represents a column expression.
 type ColExpr struct {
+	// ColNum specifies the source column value.
 	ColNum int
-	Alias  sqlparser.ColIdent
-	Type   querypb.Type
+
+	// Vindex and VindexColumns, if set, will be used to generate
+	// a keyspace_id. If so, ColNum is ignored.
+	Vindex        vindexes.Vindex
+	VindexColumns []int
+
+	Alias sqlparser.ColIdent
+	Type  querypb.Type
 }
 
 // Table contains the metadata for a table.
@@ -72,6 +81,14 @@ func (plan *Plan) fields() []*querypb.Field {
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Vindex != nil {
+			ksid, err := getKeyspaceID(values, colExpr.Vindex, colExpr.VindexColumns)
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = sqltypes.MakeTrusted(sqltypes.VarBinary, ksid)
+			continue
+		}
 		if colExpr.ColNum >= len(values) {
 			return false, nil, fmt.Errorf("index out of range, colExpr.ColNum: %d, len(values):%d", colExpr.ColNum, len(values))
 		}
@@ -82,21 +99,44 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 	}
 
 	// Filter by Vindex.
-	destinations, err := plan.Vindex.Map(nil, []sqltypes.Value{result[plan.VindexColumn]})
+	ksid, err := getKeyspaceID(result, plan.Vindex, plan.VindexColumns)
 	if err != nil {
 		return false, nil, err
 	}
+	if !key.KeyRangeContains(plan.KeyRange, ksid) {
+		return false, nil, nil
+	}
+	return true, result, nil
+}
+
+// getKeyspaceID computes the keyspace id of a row from the values
+// of the vindex columns. A MultiColumn vindex receives all of them.
+func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int) (key.DestinationKeyspaceID, error) {
+	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
+	for _, colnum := range vindexColumns {
+		if colnum >= len(values) {
+			return nil, fmt.Errorf("index out of range, colnum: %d, len(values):%d", colnum, len(values))
+		}
+		vindexValues = append(vindexValues, values[colnum])
+	}
+	var destinations []key.Destination
+	var err error
+	if mc, ok := vindex.(vindexes.MultiColumn); ok {
+		destinations, err = mc.MapMulti(nil, [][]sqltypes.Value{vindexValues})
+	} else {
+		destinations, err = vindex.Map(nil, vindexValues[:1])
+	}
+	if err != nil {
+		return nil, err
+	}
 	if len(destinations) != 1 {
-		return false, nil, fmt.Errorf("mapping row to keyspace id returned an invalid array of destinations: %v", key.DestinationsString(destinations))
+		return nil, fmt.Errorf("mapping row to keyspace id returned an invalid array of destinations: %v", key.DestinationsString(destinations))
 	}
 	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
 	if !ok || len(ksid) == 0 {
-		return false, nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", result[plan.VindexColumn], destinations[0])
-	}
-	if !key.KeyRangeContains(plan.KeyRange, ksid) {
-		return false, nil, nil
+		return nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", vindexValues, destinations[0])
 	}
-	return true, result, nil
+	return ksid, nil
 }
 
 func mustSendDDL(query mysql.Query, dbname string, filter *binlogdatapb.Filter) bool {
@@ -189,7 +229,7 @@ func buildREPlan(ti *Table, kschema *vindexes.KeyspaceSchema, filter string) (*P
 		return plan, nil
 	}
 
-	// We need to additionally set VindexColumn, Vindex and KeyRange
+	// We need to additionally set VindexColumns, Vindex and KeyRange
 	// based on the Primary Vindex of the table.
 	// Find table in kschema.
 	table := kschema.Tables[ti.Name]
@@ -202,11 +242,13 @@ func buildREPlan(ti *Table, kschema *vindexes.KeyspaceSchema, filter string) (*P
 	}
 	// findColumn can be used here because result column list is same
 	// as source.
-	colnum, err := findColumn(ti, table.ColumnVindexes[0].Columns[0])
-	if err != nil {
-		return nil, err
+	for _, col := range table.ColumnVindexes[0].Columns {
+		colnum, err := findColumn(ti, col)
+		if err != nil {
+			return nil, err
+		}
+		plan.VindexColumns = append(plan.VindexColumns, colnum)
 	}
-	plan.VindexColumn = colnum
 	plan.Vindex = table.ColumnVindexes[0].Vindex
 
 	// Parse keyrange.
@@ -233,7 +275,7 @@ func buildTablePlan(ti *Table, kschema *vindexes.KeyspaceSchema, query string) (
 	plan := &Plan{
 		Table: ti,
 	}
-	if err := plan.analyzeExprs(sel.SelectExprs); err != nil {
+	if err := plan.analyzeExprs(kschema, sel.SelectExprs); err != nil {
 		return nil, err
 	}
 
@@ -277,10 +319,10 @@ func analyzeSelect(query string) (sel *sqlparser.Select, fromTable sqlparser.Tab
 	return sel, fromTable, nil
 }
 
-func (plan *Plan) analyzeExprs(selExprs sqlparser.SelectExprs) error {
+func (plan *Plan) analyzeExprs(kschema *vindexes.KeyspaceSchema, selExprs sqlparser.SelectExprs) error {
 	if _, ok := selExprs[0].(*sqlparser.StarExpr); !ok {
 		for _, expr := range selExprs {
-			cExpr, err := plan.analyzeExpr(expr)
+			cExpr, err := plan.analyzeExpr(kschema, expr)
 			if err != nil {
 				return err
 			}
@@ -300,12 +342,21 @@ func (plan *Plan) analyzeExprs(selExprs sqlparser.SelectExprs) error {
 	return nil
 }
 
-func (plan *Plan) analyzeExpr(selExpr sqlparser.SelectExpr) (cExpr ColExpr, err error) {
+func (plan *Plan) analyzeExpr(kschema *vindexes.KeyspaceSchema, selExpr sqlparser.SelectExpr) (cExpr ColExpr, err error) {
 	aliased, ok := selExpr.(*sqlparser.AliasedExpr)
 	if !ok {
 		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(selExpr))
 	}
 	as := aliased.As
+	if funcExpr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok && funcExpr.Name.EqualString("keyspace_id") {
+		if len(funcExpr.Exprs) != 0 {
+			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(funcExpr))
+		}
+		if as.IsEmpty() {
+			as = sqlparser.NewColIdent("keyspace_id")
+		}
+		return plan.analyzeKeyspaceID(kschema, as)
+	}
 	if as.IsEmpty() {
 		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
 	}
@@ -323,8 +374,37 @@ func (plan *Plan) analyzeExpr(selExpr sqlparser.SelectExpr) (cExpr ColExpr, err
 	return ColExpr{ColNum: colnum, Alias: as, Type: plan.Table.Columns[colnum].Type}, nil
 }
 
+// analyzeKeyspaceID builds the ColExpr of a keyspace_id() expression,
+// which computes the keyspace id of the row from the primary vindex
+// of the table.
+func (plan *Plan) analyzeKeyspaceID(kschema *vindexes.KeyspaceSchema, as sqlparser.ColIdent) (ColExpr, error) {
+	table := kschema.Tables[plan.Table.Name]
+	if table == nil {
+		return ColExpr{}, fmt.Errorf("no vschema definition for table %s", plan.Table.Name)
+	}
+	// Get Primary Vindex.
+	if len(table.ColumnVindexes) == 0 {
+		return ColExpr{}, fmt.Errorf("table %s has no primary vindex", plan.Table.Name)
+	}
+	colVindex := table.ColumnVindexes[0]
+	vindexColumns := make([]int, 0, len(colVindex.Columns))
+	for _, col := range colVindex.Columns {
+		colnum, err := findColumn(plan.Table, col)
+		if err != nil {
+			return ColExpr{}, err
+		}
+		vindexColumns = append(vindexColumns, colnum)
+	}
+	return ColExpr{
+		Vindex:        colVindex.Vindex,
+		VindexColumns: vindexColumns,
+		Alias:         as,
+		Type:          sqltypes.VarBinary,
+	}, nil
+}
+
 func (plan *Plan) analyzeInKeyRange(kschema *vindexes.KeyspaceSchema, exprs sqlparser.SelectExprs) error {
-	var colname sqlparser.ColIdent
+	var colnames []sqlparser.ColIdent
 	var krExpr sqlparser.SelectExpr
 	switch len(exprs) {
 	case 1:
@@ -336,7 +416,7 @@ func (plan *Plan) analyzeInKeyRange(kschema *vindexes.KeyspaceSchema, exprs sqlp
 		if len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("table %s has no primary vindex", plan.Table.Name)
 		}
-		colname = table.ColumnVindexes[0].Columns[0]
+		colnames = table.ColumnVindexes[0].Columns
 		plan.Vindex = table.ColumnVindexes[0].Vindex
 		krExpr = exprs[0]
 	case 3:
@@ -349,9 +429,9 @@ func (plan *Plan) analyzeInKeyRange(kschema *vindexes.KeyspaceSchema, exprs sqlp
 			return fmt.Errorf("unexpected: %v", sqlparser.String(exprs[0]))
 		}
 		if !qualifiedName.Qualifier.IsEmpty() {
-			return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(colname))
+			return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
 		}
-		colname = qualifiedName.Name
+		colnames = []sqlparser.ColIdent{qualifiedName.Name}
 		vtype, err := selString(exprs[1])
 		if err != nil {
 			return err
@@ -360,23 +440,25 @@ func (plan *Plan) analyzeInKeyRange(kschema *vindexes.KeyspaceSchema, exprs sqlp
 		if err != nil {
 			return err
 		}
-		if !plan.Vindex.IsUnique() || !plan.Vindex.IsFunctional() {
+		if !vindexes.MapsToKeyspaceID(plan.Vindex) || !plan.Vindex.IsFunctional() {
 			return fmt.Errorf("vindex must be Unique and Functional to be used for VReplication: %s", vtype)
 		}
 		krExpr = exprs[2]
 	default:
 		return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(exprs))
 	}
-	found := false
-	for i, cExpr := range plan.ColExprs {
-		if cExpr.Alias.Equal(colname) {
-			found = true
-			plan.VindexColumn = i
-			break
+	for _, colname := range colnames {
+		found := false
+		for i, cExpr := range plan.ColExprs {
+			if cExpr.Alias.Equal(colname) {
+				found = true
+				plan.VindexColumns = append(plan.VindexColumns, i)
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("keyrange expression does not reference a column in the select list: %v", sqlparser.String(colname))
 		}
-	}
-	if !found {
-		return fmt.Errorf("keyrange expression does not reference a column in the select list: %v", sqlparser.String(colname))
 	}
 	kr, err := selString(krExpr)
 	if err != nil {
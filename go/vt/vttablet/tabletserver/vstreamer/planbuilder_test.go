@@ -43,6 +43,12 @@ func init() {
     },
     "lookup": {
       "type": "lookup"
+    },
+    "region": {
+      "type": "region_experimental",
+      "params": {
+        "region_bytes": "1"
+      }
     }
   },
   "tables": {
@@ -53,6 +59,14 @@ func init() {
           "name": "hash"
         }
       ]
+    },
+    "regional": {
+      "column_vindexes": [
+        {
+          "columns": ["region", "id"],
+          "name": "region"
+        }
+      ]
     }
   }
 }`
@@ -178,6 +192,17 @@ func TestPlanbuilder(t *testing.T) {
 		}},
 	}
 
+	regional := &Table{
+		Name: "regional",
+		Columns: []schema.TableColumn{{
+			Name: sqlparser.NewColIdent("id"),
+			Type: sqltypes.Int64,
+		}, {
+			Name: sqlparser.NewColIdent("region"),
+			Type: sqltypes.Int64,
+		}},
+	}
+
 	testcases := []struct {
 		inTable *Table
 		inRule  *binlogdatapb.Rule
@@ -210,7 +235,7 @@ func TestPlanbuilder(t *testing.T) {
 				Alias:  sqlparser.NewColIdent("val"),
 				Type:   sqltypes.VarBinary,
 			}},
-			VindexColumn: 0,
+			VindexColumns: []int{0},
 		},
 	}, {
 		inTable: t1,
@@ -267,7 +292,7 @@ func TestPlanbuilder(t *testing.T) {
 				Alias:  sqlparser.NewColIdent("id"),
 				Type:   sqltypes.Int64,
 			}},
-			VindexColumn: 1,
+			VindexColumns: []int{1},
 		},
 	}, {
 		inTable: t1,
@@ -282,8 +307,93 @@ func TestPlanbuilder(t *testing.T) {
 				Alias:  sqlparser.NewColIdent("id"),
 				Type:   sqltypes.Int64,
 			}},
-			VindexColumn: 1,
+			VindexColumns: []int{1},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select val, keyspace_id() from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}, {
+				Vindex:        testKSChema.Tables["t1"].ColumnVindexes[0].Vindex,
+				VindexColumns: []int{0},
+				Alias:         sqlparser.NewColIdent("keyspace_id"),
+				Type:          sqltypes.VarBinary,
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select val, keyspace_id() as ksid from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}, {
+				Vindex:        testKSChema.Tables["t1"].ColumnVindexes[0].Vindex,
+				VindexColumns: []int{0},
+				Alias:         sqlparser.NewColIdent("ksid"),
+				Type:          sqltypes.VarBinary,
+			}},
+		},
+	}, {
+		// The filter of the streams that backfill a lookup vindex,
+		// as rewritten by vreplication: the keyrange is computed
+		// from the owner column, which is not the primary vindex.
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select val, keyspace_id() from t1 where in_keyrange(val, 'hash', '-80')"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}, {
+				Vindex:        testKSChema.Tables["t1"].ColumnVindexes[0].Vindex,
+				VindexColumns: []int{0},
+				Alias:         sqlparser.NewColIdent("keyspace_id"),
+				Type:          sqltypes.VarBinary,
+			}},
+			VindexColumns: []int{0},
+		},
+	}, {
+		// The keyspace id of a MultiColumn vindex is computed
+		// from all of its columns.
+		inTable: regional,
+		inRule:  &binlogdatapb.Rule{Match: "/.*/", Filter: "-80"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}, {
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("region"),
+				Type:   sqltypes.Int64,
+			}},
+			VindexColumns: []int{1, 0},
+		},
+	}, {
+		inTable: regional,
+		inRule:  &binlogdatapb.Rule{Match: "regional", Filter: "select id, region from regional where in_keyrange('-80')"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}, {
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("region"),
+				Type:   sqltypes.Int64,
+			}},
+			VindexColumns: []int{1, 0},
 		},
+	}, {
+		inTable: regional,
+		inRule:  &binlogdatapb.Rule{Match: "regional", Filter: "select id from regional where in_keyrange('-80')"},
+		outErr:  "keyrange expression does not reference a column in the select list: region",
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -392,6 +502,14 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
 		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, keyspace_id(id) from t1"},
+		outErr:  `unexpected: keyspace_id(id)`,
+	}, {
+		inTable: t2,
+		inRule:  &binlogdatapb.Rule{Match: "t2", Filter: "select id, keyspace_id() from t2"},
+		outErr:  `no vschema definition for table t2`,
 	}, {
 		// selString
 		inTable: t1,
@@ -427,3 +545,43 @@ func TestPlanbuilder(t *testing.T) {
 
 	}
 }
+
+func TestPlanFilterMultiColumn(t *testing.T) {
+	regional := &Table{
+		Name: "regional",
+		Columns: []schema.TableColumn{{
+			Name: sqlparser.NewColIdent("id"),
+			Type: sqltypes.Int64,
+		}, {
+			Name: sqlparser.NewColIdent("region"),
+			Type: sqltypes.Int64,
+		}},
+	}
+	plan, err := buildPlan(regional, testKSChema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "regional", Filter: "select id, region from regional where in_keyrange('-80')"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The region is the first byte of the keyspace id.
+	testcases := []struct {
+		region int64
+		want   bool
+	}{{
+		region: 0x10,
+		want:   true,
+	}, {
+		region: 0x90,
+		want:   false,
+	}}
+	for _, tcase := range testcases {
+		ok, _, err := plan.filter([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(tcase.region)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != tcase.want {
+			t.Errorf("filter(region %d): %v, want %v", tcase.region, ok, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// lookupVindexMaxLag is the maximum replication lag of the backfill streams
+// for ExternalizeVindex to stop them.
+const lookupVindexMaxLag = 10 * time.Second
+
+// lookupVindex contains the metadata of a lookup vindex
+// that is being created from the specs of CreateLookupVindex.
+type lookupVindex struct {
+	name           string
+	vindex         *vschemapb.Vindex
+	columnVindex   *vschemapb.ColumnVindex
+	ownerTable     string
+	ownerColumns   []string
+	targetKeyspace string
+	targetTable    string
+	fromColumns    []string
+	toColumn       string
+}
+
+// CreateLookupVindex adds the lookup vindex of the specs to the keyspace in
+// write only mode, and starts the vreplication streams that backfill its table
+// from the owner table. The specs must contain exactly one lookup vindex, and
+// the owner table with the column vindex that uses it. The lookup table must
+// already exist in the target keyspace. If that keyspace is sharded, its vschema
+// must define the table with a primary vindex on one of the from columns.
+// If a stream cannot be created, or the vschema cannot be saved, the streams
+// are deleted and the vschema is left unchanged.
+// Once the backfill is done, ExternalizeVindex makes the vindex usable for routing.
+func (wr *Wrangler) CreateLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace) error {
+	lv, err := parseLookupVindexSpecs(specs)
+	if err != nil {
+		return err
+	}
+
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if !sourceVSchema.Sharded {
+		return fmt.Errorf("keyspace %s is not sharded", keyspace)
+	}
+	if _, ok := sourceVSchema.Vindexes[lv.name]; ok {
+		return fmt.Errorf("vindex %s already exists in keyspace %s", lv.name, keyspace)
+	}
+	sourceTable := sourceVSchema.Tables[lv.ownerTable]
+	if sourceTable == nil {
+		return fmt.Errorf("table %s not found in the vschema of keyspace %s", lv.ownerTable, keyspace)
+	}
+	if len(sourceTable.ColumnVindexes) == 0 {
+		return fmt.Errorf("table %s has no primary vindex", lv.ownerTable)
+	}
+
+	targetVSchema := sourceVSchema
+	if lv.targetKeyspace != keyspace {
+		targetVSchema, err = wr.ts.GetVSchema(ctx, lv.targetKeyspace)
+		if err != nil {
+			return err
+		}
+	}
+	// If the target keyspace is sharded, each stream only copies
+	// the rows that belong to the keyrange of its target shard.
+	var ownerVindexColumn, targetVindexType string
+	if targetVSchema.Sharded {
+		ownerVindexColumn, targetVindexType, err = lv.targetVindex(targetVSchema)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The vindex is added in write only mode. Its vschema is validated
+	// now, but only saved once the streams are created, so that a failure
+	// to create them does not leave a vindex that is never backfilled.
+	vindex := proto.Clone(lv.vindex).(*vschemapb.Vindex)
+	if vindex.Params == nil {
+		vindex.Params = make(map[string]string)
+	}
+	vindex.Params["write_only"] = "true"
+	if sourceVSchema.Vindexes == nil {
+		sourceVSchema.Vindexes = make(map[string]*vschemapb.Vindex)
+	}
+	sourceVSchema.Vindexes[lv.name] = vindex
+	sourceTable.ColumnVindexes = append(sourceTable.ColumnVindexes, lv.columnVindex)
+	if _, err := vindexes.BuildKeyspaceSchema(sourceVSchema, keyspace); err != nil {
+		return err
+	}
+
+	sourceShards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	targetShards, err := wr.ts.GetShardNames(ctx, lv.targetKeyspace)
+	if err != nil {
+		return err
+	}
+	targetMasters := make([]*topo.TabletInfo, 0, len(targetShards))
+	targetKeyRanges := make([]*topodatapb.KeyRange, 0, len(targetShards))
+	for _, targetShard := range targetShards {
+		targetsi, err := wr.ts.GetShard(ctx, lv.targetKeyspace, targetShard)
+		if err != nil {
+			return err
+		}
+		targetMaster, err := wr.ts.GetTablet(ctx, targetsi.MasterAlias)
+		if err != nil {
+			return err
+		}
+		targetMasters = append(targetMasters, targetMaster)
+		targetKeyRanges = append(targetKeyRanges, targetsi.KeyRange)
+	}
+
+	workflow := lookupVindexWorkflow(lv.name)
+	for i, targetMaster := range targetMasters {
+		var where string
+		if targetVindexType != "" {
+			where = fmt.Sprintf(" where in_keyrange(%s, %s, %s)", sqlparser.String(sqlparser.NewColIdent(ownerVindexColumn)), encodeString(targetVindexType), encodeString(key.KeyRangeString(targetKeyRanges[i])))
+		}
+		filter := lv.filter(where)
+		for _, sourceShard := range sourceShards {
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: keyspace,
+				Shard:    sourceShard,
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  lv.targetTable,
+						Filter: filter,
+					}},
+				},
+			}
+			query := binlogplayer.CreateVReplicationState(workflow, bls, "", binlogplayer.BlpRunning, targetMaster.DbName())
+			if _, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+				wr.deleteLookupVindexStreams(ctx, targetMasters[:i+1], workflow)
+				return err
+			}
+		}
+	}
+
+	// From now on, vtgate maintains the lookup entries of the rows it writes.
+	if err := wr.ts.SaveVSchema(ctx, keyspace, sourceVSchema); err != nil {
+		wr.deleteLookupVindexStreams(ctx, targetMasters, workflow)
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// deleteLookupVindexStreams deletes the streams of the workflow from the
+// target masters. It is used to undo a failed CreateLookupVindex. So, it
+// only logs its own errors.
+func (wr *Wrangler) deleteLookupVindexStreams(ctx context.Context, targetMasters []*topo.TabletInfo, workflow string) {
+	for _, targetMaster := range targetMasters {
+		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(targetMaster.DbName()), encodeString(workflow))
+		if _, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+			wr.Logger().Errorf("Could not delete the streams of workflow %v from %v: %v", workflow, topoproto.TabletAliasString(targetMaster.Alias), err)
+		}
+	}
+}
+
+// ExternalizeVindex makes a lookup vindex created by CreateLookupVindex usable
+// for routing. It fails if the backfill streams have not finished copying the
+// owner table, or if they lag behind it by more than lookupVindexMaxLag.
+// Otherwise, it deletes them and removes the write only mode of the vindex.
+func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return fmt.Errorf("vindex name must be of the form <keyspace>.<vindex>: %s", qualifiedVindexName)
+	}
+	keyspace, vindexName := splits[0], splits[1]
+
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	vindex := sourceVSchema.Vindexes[vindexName]
+	if vindex == nil {
+		return fmt.Errorf("vindex %s not found in the vschema of keyspace %s", vindexName, keyspace)
+	}
+	if vindex.Params["write_only"] != "true" {
+		return fmt.Errorf("vindex %s is not in write only mode", qualifiedVindexName)
+	}
+	targetKeyspace, _, err := splitLookupTable(vindexName, vindex.Params["table"])
+	if err != nil {
+		return err
+	}
+
+	workflow := lookupVindexWorkflow(vindexName)
+	targetShards, err := wr.ts.GetShardNames(ctx, targetKeyspace)
+	if err != nil {
+		return err
+	}
+	targetMasters := make([]*topo.TabletInfo, 0, len(targetShards))
+	for _, targetShard := range targetShards {
+		targetsi, err := wr.ts.GetShard(ctx, targetKeyspace, targetShard)
+		if err != nil {
+			return err
+		}
+		targetMaster, err := wr.ts.GetTablet(ctx, targetsi.MasterAlias)
+		if err != nil {
+			return err
+		}
+		p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select id, state, time_updated, transaction_timestamp, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(workflow), encodeString(targetMaster.DbName())))
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		if len(qr.Rows) == 0 {
+			return fmt.Errorf("no streams found for workflow %s in %v.%v", workflow, targetKeyspace, targetShard)
+		}
+		ids := make([]string, 0, len(qr.Rows))
+		for _, row := range qr.Rows {
+			// The streams are in the Copying state until the
+			// owner table is copied, and Running after that.
+			if state := row[1].ToString(); state != binlogplayer.BlpRunning {
+				return fmt.Errorf("stream %v for %v.%v is not in Running state: %v", row[0].ToString(), targetKeyspace, targetShard, state)
+			}
+			timeUpdated, err := sqltypes.ToInt64(row[2])
+			if err != nil {
+				return err
+			}
+			transactionTimestamp, err := sqltypes.ToInt64(row[3])
+			if err != nil {
+				return err
+			}
+			if lag := time.Duration(timeUpdated-transactionTimestamp) * time.Second; lag > lookupVindexMaxLag {
+				return fmt.Errorf("stream %v for %v.%v is lagging by %v, more than %v", row[0].ToString(), targetKeyspace, targetShard, lag, lookupVindexMaxLag)
+			}
+			ids = append(ids, row[0].ToString())
+		}
+		// A stream is Running before it starts copying, and it
+		// only finishes copying once it has no copy state left.
+		p3qr, err = wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", ")))
+		if err != nil {
+			return err
+		}
+		if len(p3qr.Rows) != 0 {
+			qr := sqltypes.Proto3ToResult(p3qr)
+			return fmt.Errorf("stream %v for %v.%v has not finished copying", qr.Rows[0][0].ToString(), targetKeyspace, targetShard)
+		}
+		targetMasters = append(targetMasters, targetMaster)
+	}
+
+	for _, targetMaster := range targetMasters {
+		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(targetMaster.DbName()), encodeString(workflow))
+		if _, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+			return err
+		}
+	}
+
+	delete(vindex.Params, "write_only")
+	if err := wr.ts.SaveVSchema(ctx, keyspace, sourceVSchema); err != nil {
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// parseLookupVindexSpecs validates the specs of CreateLookupVindex.
+func parseLookupVindexSpecs(specs *vschemapb.Keyspace) (*lookupVindex, error) {
+	if len(specs.Vindexes) != 1 {
+		return nil, fmt.Errorf("only one vindex must be specified in the specs: %v", specs.Vindexes)
+	}
+	lv := &lookupVindex{}
+	for name, vindex := range specs.Vindexes {
+		lv.name, lv.vindex = name, vindex
+	}
+	switch lv.vindex.Type {
+	case "lookup", "lookup_unique", "consistent_lookup", "consistent_lookup_unique":
+	default:
+		return nil, fmt.Errorf("vindex %s: type %s does not support the write only mode", lv.name, lv.vindex.Type)
+	}
+	if lv.vindex.Owner == "" {
+		return nil, fmt.Errorf("vindex %s: the owner table must be specified", lv.name)
+	}
+	lv.ownerTable = lv.vindex.Owner
+
+	var err error
+	lv.targetKeyspace, lv.targetTable, err = splitLookupTable(lv.name, lv.vindex.Params["table"])
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range strings.Split(lv.vindex.Params["from"], ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			lv.fromColumns = append(lv.fromColumns, col)
+		}
+	}
+	lv.toColumn = lv.vindex.Params["to"]
+	if len(lv.fromColumns) == 0 || lv.toColumn == "" {
+		return nil, fmt.Errorf("vindex %s: the from and to params must be specified", lv.name)
+	}
+
+	table := specs.Tables[lv.ownerTable]
+	if len(specs.Tables) != 1 || table == nil {
+		return nil, fmt.Errorf("vindex %s: the specs must only contain the owner table %s", lv.name, lv.ownerTable)
+	}
+	for _, cv := range table.ColumnVindexes {
+		if cv.Name == lv.name {
+			lv.columnVindex = cv
+			break
+		}
+	}
+	if lv.columnVindex == nil {
+		return nil, fmt.Errorf("vindex %s: no column vindex of table %s uses it", lv.name, lv.ownerTable)
+	}
+	lv.ownerColumns = lv.columnVindex.Columns
+	if lv.columnVindex.Column != "" {
+		lv.ownerColumns = []string{lv.columnVindex.Column}
+	}
+	if len(lv.ownerColumns) != len(lv.fromColumns) {
+		return nil, fmt.Errorf("vindex %s: the columns %v of table %s do not match the from columns %v", lv.name, lv.ownerColumns, lv.ownerTable, lv.fromColumns)
+	}
+	return lv, nil
+}
+
+// splitLookupTable splits the table param of a lookup vindex
+// into its keyspace and table names.
+func splitLookupTable(vindexName, qualifiedTable string) (keyspace, table string, err error) {
+	splits := strings.Split(qualifiedTable, ".")
+	if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+		return "", "", fmt.Errorf("vindex %s: table param must be of the form <keyspace>.<table>: %s", vindexName, qualifiedTable)
+	}
+	return splits[0], splits[1], nil
+}
+
+// filter returns the query that copies the lookup entries of the owner table.
+// Rows are grouped by lookup entry: this turns the inserts into upserts,
+// which makes the copy succeed even if vtgate already wrote the entries.
+func (lv *lookupVindex) filter(where string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, col := range lv.ownerColumns {
+		buf.Myprintf("%v as %v, ", sqlparser.NewColIdent(col), sqlparser.NewColIdent(lv.fromColumns[i]))
+	}
+	buf.Myprintf("keyspace_id() as %v from %v", sqlparser.NewColIdent(lv.toColumn), sqlparser.NewTableIdent(lv.ownerTable))
+	buf.WriteString(where)
+	buf.WriteString(" group by ")
+	prefix := ""
+	for _, col := range lv.fromColumns {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	if !strings.HasSuffix(lv.vindex.Type, "_unique") {
+		buf.Myprintf(", %v", sqlparser.NewColIdent(lv.toColumn))
+	}
+	return buf.String()
+}
+
+// targetVindex returns the type of the primary vindex of the lookup table
+// in the sharded target keyspace, and the owner column that maps to its
+// column. The vindex must be on one of the from columns, and be Unique and
+// Functional for vreplication to filter the rows by keyrange. The streams
+// filter on the owner column because vstreamer only sees the owner table.
+func (lv *lookupVindex) targetVindex(targetVSchema *vschemapb.Keyspace) (ownerColumn, vindexType string, err error) {
+	targetTable := targetVSchema.Tables[lv.targetTable]
+	if targetTable == nil {
+		return "", "", fmt.Errorf("table %s not found in the vschema of keyspace %s", lv.targetTable, lv.targetKeyspace)
+	}
+	if len(targetTable.ColumnVindexes) == 0 {
+		return "", "", fmt.Errorf("table %s has no primary vindex", lv.targetTable)
+	}
+	primary := targetTable.ColumnVindexes[0]
+	if len(primary.Columns) > 1 {
+		return "", "", fmt.Errorf("the primary vindex of table %s must be on a single column: %s", lv.targetTable, primary.Name)
+	}
+	column := primary.Column
+	if column == "" && len(primary.Columns) != 0 {
+		column = primary.Columns[0]
+	}
+	for i, col := range lv.fromColumns {
+		if strings.EqualFold(col, column) {
+			ownerColumn = lv.ownerColumns[i]
+			break
+		}
+	}
+	if ownerColumn == "" {
+		return "", "", fmt.Errorf("the primary vindex of table %s must be on one of the from columns %v", lv.targetTable, lv.fromColumns)
+	}
+	vindex := targetVSchema.Vindexes[primary.Name]
+	if vindex == nil {
+		return "", "", fmt.Errorf("vindex %s not found in the vschema of keyspace %s", primary.Name, lv.targetKeyspace)
+	}
+	vdx, err := vindexes.CreateVindex(vindex.Type, vindex.Type, map[string]string{})
+	if err != nil {
+		return "", "", err
+	}
+	if !vindexes.MapsToKeyspaceID(vdx) || !vdx.IsFunctional() {
+		return "", "", fmt.Errorf("the primary vindex of table %s must be Unique and Functional: %s", lv.targetTable, vindex.Type)
+	}
+	return ownerColumn, vindex.Type, nil
+}
+
+// lookupVindexWorkflow returns the name of the workflow
+// that backfills the table of a lookup vindex.
+func lookupVindexWorkflow(vindexName string) string {
+	return vindexName + "_vdx"
+}
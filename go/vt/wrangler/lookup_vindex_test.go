@@ -0,0 +1,391 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/sqltypes"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+const vreplQueryLookup = "select id, state, time_updated, transaction_timestamp, message from _vt.vreplication where workflow='v_vdx' and db_name='vt_ks2'"
+
+func TestCreateLookupVindex(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	addLookupTable(ctx, t, tme)
+
+	for i, targetShard := range tme.targetShards {
+		for j, sourceShard := range tme.sourceShards {
+			query := `insert into _vt.vreplication.*v_vdx.*keyspace:.*ks1.*shard:.*"` + sourceShard + `.*match:.*lkp.*select col2 as c2, keyspace_id\(\) as keyspace_id from t1 where in_keyrange\(col2, .*hash.*` + targetShard + `.*\) group by c2.*Running`
+			tme.dbTargetClients[i].addQueryRE(query, &sqltypes.Result{InsertID: uint64(j + 1)}, nil)
+		}
+		tme.dbTargetClients[i].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		tme.dbTargetClients[i].addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+	}
+
+	if err := tme.wr.CreateLookupVindex(ctx, "ks1", lookupVindexSpecs("lookup_unique")); err != nil {
+		t.Fatal(err)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	vs, err := tme.ts.GetVSchema(ctx, "ks1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantVindex := &vschemapb.Vindex{
+		Type: "lookup_unique",
+		Params: map[string]string{
+			"table":      "ks2.lkp",
+			"from":       "c2",
+			"to":         "keyspace_id",
+			"write_only": "true",
+		},
+		Owner: "t1",
+	}
+	if got := vs.Vindexes["v"]; !reflect.DeepEqual(got, wantVindex) {
+		t.Errorf("vindex v: %v, want %v", got, wantVindex)
+	}
+	wantColumnVindexes := []*vschemapb.ColumnVindex{{
+		Column: "c1",
+		Name:   "hash",
+	}, {
+		Column: "col2",
+		Name:   "v",
+	}}
+	if got := vs.Tables["t1"].ColumnVindexes; !reflect.DeepEqual(got, wantColumnVindexes) {
+		t.Errorf("t1 column vindexes: %v, want %v", got, wantColumnVindexes)
+	}
+
+	err = tme.wr.CreateLookupVindex(ctx, "ks1", lookupVindexSpecs("lookup_unique"))
+	want := "vindex v already exists in keyspace ks1"
+	if err == nil || err.Error() != want {
+		t.Errorf("CreateLookupVindex(again): %v, want %s", err, want)
+	}
+}
+
+func TestCreateLookupVindexErrors(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	// The lookup table is not in the vschema of ks2.
+	err := tme.wr.CreateLookupVindex(ctx, "ks1", lookupVindexSpecs("lookup"))
+	want := "table lkp not found in the vschema of keyspace ks2"
+	if err == nil || err.Error() != want {
+		t.Errorf("CreateLookupVindex: %v, want %s", err, want)
+	}
+
+	specs := lookupVindexSpecs("lookup")
+	specs.Tables["t3"] = specs.Tables["t1"]
+	delete(specs.Tables, "t1")
+	specs.Vindexes["v"].Owner = "t3"
+	err = tme.wr.CreateLookupVindex(ctx, "ks1", specs)
+	want = "table t3 not found in the vschema of keyspace ks1"
+	if err == nil || err.Error() != want {
+		t.Errorf("CreateLookupVindex: %v, want %s", err, want)
+	}
+
+	// Nothing must have been saved.
+	vs, err := tme.ts.GetVSchema(ctx, "ks1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vs.Vindexes["v"]; ok {
+		t.Errorf("vindex v was added to ks1: %v", vs)
+	}
+}
+
+func TestCreateLookupVindexStreamError(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	addLookupTable(ctx, t, tme)
+
+	// The last stream cannot be created. The ones
+	// that were created must be deleted.
+	for i, targetShard := range tme.targetShards {
+		for j, sourceShard := range tme.sourceShards {
+			query := `insert into _vt.vreplication.*v_vdx.*keyspace:.*ks1.*shard:.*"` + sourceShard + `.*` + targetShard + `.*Running`
+			if i == len(tme.targetShards)-1 && j == len(tme.sourceShards)-1 {
+				tme.dbTargetClients[i].addQueryRE(query, nil, fmt.Errorf("insert failed"))
+				continue
+			}
+			tme.dbTargetClients[i].addQueryRE(query, &sqltypes.Result{InsertID: uint64(j + 1)}, nil)
+			tme.dbTargetClients[i].addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", j+1), stoppedResult(j+1), nil)
+		}
+	}
+	tme.dbTargetClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'v_vdx'", resultid12, nil)
+	tme.dbTargetClients[0].addQuery("delete from _vt.vreplication where id in (1, 2)", &sqltypes.Result{}, nil)
+	tme.dbTargetClients[0].addQuery("delete from _vt.copy_state where vrepl_id in (1, 2)", &sqltypes.Result{}, nil)
+	tme.dbTargetClients[1].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'v_vdx'", resultid1, nil)
+	tme.dbTargetClients[1].addQuery("delete from _vt.vreplication where id in (1)", &sqltypes.Result{}, nil)
+	tme.dbTargetClients[1].addQuery("delete from _vt.copy_state where vrepl_id in (1)", &sqltypes.Result{}, nil)
+
+	err := tme.wr.CreateLookupVindex(ctx, "ks1", lookupVindexSpecs("lookup_unique"))
+	want := "insert failed"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("CreateLookupVindex: %v, must contain %s", err, want)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	vs, err := tme.ts.GetVSchema(ctx, "ks1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vs.Vindexes["v"]; ok {
+		t.Errorf("vindex v was added to ks1: %v", vs)
+	}
+}
+
+func TestParseLookupVindexSpecs(t *testing.T) {
+	testcases := []struct {
+		update func(specs *vschemapb.Keyspace)
+		err    string
+	}{{
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Vindexes["v2"] = &vschemapb.Vindex{Type: "lookup"}
+		},
+		err: "only one vindex must be specified in the specs",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Vindexes["v"].Type = "lookup_hash"
+		},
+		err: "vindex v: type lookup_hash does not support the write only mode",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Vindexes["v"].Owner = ""
+		},
+		err: "vindex v: the owner table must be specified",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Vindexes["v"].Params["table"] = "lkp"
+		},
+		err: "vindex v: table param must be of the form <keyspace>.<table>: lkp",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			delete(specs.Vindexes["v"].Params, "to")
+		},
+		err: "vindex v: the from and to params must be specified",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Tables["t2"] = &vschemapb.Table{}
+		},
+		err: "vindex v: the specs must only contain the owner table t1",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Tables["t1"].ColumnVindexes[0].Name = "hash"
+		},
+		err: "vindex v: no column vindex of table t1 uses it",
+	}, {
+		update: func(specs *vschemapb.Keyspace) {
+			specs.Vindexes["v"].Params["from"] = "c2,c3"
+		},
+		err: "vindex v: the columns [col2] of table t1 do not match the from columns [c2 c3]",
+	}}
+	for _, tcase := range testcases {
+		specs := lookupVindexSpecs("lookup")
+		tcase.update(specs)
+		_, err := parseLookupVindexSpecs(specs)
+		if err == nil || !strings.HasPrefix(err.Error(), tcase.err) {
+			t.Errorf("parseLookupVindexSpecs: %v, want %s", err, tcase.err)
+		}
+	}
+
+	lv, err := parseLookupVindexSpecs(lookupVindexSpecs("consistent_lookup"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "select col2 as c2, keyspace_id() as keyspace_id from t1 group by c2, keyspace_id"
+	if got := lv.filter(""); got != want {
+		t.Errorf("filter: %s, want %s", got, want)
+	}
+}
+
+func TestLookupVindexTargetVindex(t *testing.T) {
+	lv := &lookupVindex{
+		targetKeyspace: "ks2",
+		targetTable:    "lkp",
+		fromColumns:    []string{"c2"},
+		ownerColumns:   []string{"col2"},
+	}
+	targetVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":   {Type: "hash"},
+			"region": {Type: "region_experimental", Params: map[string]string{"region_bytes": "1"}},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"lkp": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c2", Name: "hash"}}},
+		},
+	}
+	ownerColumn, vindexType, err := lv.targetVindex(targetVSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ownerColumn != "col2" || vindexType != "hash" {
+		t.Errorf("targetVindex: %s, %s, want col2, hash", ownerColumn, vindexType)
+	}
+
+	// The keyrange filter of the streams maps a single column.
+	targetVSchema.Tables["lkp"] = &vschemapb.Table{ColumnVindexes: []*vschemapb.ColumnVindex{{Columns: []string{"c2", "c3"}, Name: "region"}}}
+	_, _, err = lv.targetVindex(targetVSchema)
+	want := "the primary vindex of table lkp must be on a single column: region"
+	if err == nil || err.Error() != want {
+		t.Errorf("targetVindex: %v, want %s", err, want)
+	}
+}
+
+func TestExternalizeVindex(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	addLookupTable(ctx, t, tme)
+
+	vs, err := tme.ts.GetVSchema(ctx, "ks1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs := lookupVindexSpecs("lookup_unique")
+	vs.Vindexes["v"] = specs.Vindexes["v"]
+	vs.Vindexes["v"].Params["write_only"] = "true"
+	vs.Tables["t1"].ColumnVindexes = append(vs.Tables["t1"].ColumnVindexes, specs.Tables["t1"].ColumnVindexes...)
+	if err := tme.ts.SaveVSchema(ctx, "ks1", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	streamsResult := func(rows ...string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|state|time_updated|transaction_timestamp|message",
+			"int64|varchar|int64|int64|varchar"),
+			rows...,
+		)
+	}
+	copyStateQuery := "select vrepl_id from _vt.copy_state where vrepl_id in (1, 2)"
+	copyStateResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id", "int64"), "2")
+
+	// A stream is still copying.
+	tme.dbTargetClients[0].addQuery(vreplQueryLookup, streamsResult("1|Running|100|100|", "2|Running|100|100|"), nil)
+	tme.dbTargetClients[0].addQuery(copyStateQuery, &sqltypes.Result{}, nil)
+	tme.dbTargetClients[1].addQuery(vreplQueryLookup, streamsResult("1|Running|100|100|", "2|Copying|100|100|"), nil)
+	err = tme.wr.ExternalizeVindex(ctx, "ks1.v")
+	want := "stream 2 for ks2.80- is not in Running state: Copying"
+	if err == nil || err.Error() != want {
+		t.Errorf("ExternalizeVindex: %v, want %s", err, want)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	// A stream is Running, but it has not started copying yet.
+	tme.dbTargetClients[0].addQuery(vreplQueryLookup, streamsResult("1|Running|100|100|", "2|Running|100|100|"), nil)
+	tme.dbTargetClients[0].addQuery(copyStateQuery, copyStateResult, nil)
+	err = tme.wr.ExternalizeVindex(ctx, "ks1.v")
+	want = "stream 2 for ks2.-80 has not finished copying"
+	if err == nil || err.Error() != want {
+		t.Errorf("ExternalizeVindex: %v, want %s", err, want)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	// A stream is lagging.
+	tme.dbTargetClients[0].addQuery(vreplQueryLookup, streamsResult("1|Running|100|100|", "2|Running|100|60|"), nil)
+	err = tme.wr.ExternalizeVindex(ctx, "ks1.v")
+	want = "stream 2 for ks2.-80 is lagging by 40s, more than 10s"
+	if err == nil || err.Error() != want {
+		t.Errorf("ExternalizeVindex: %v, want %s", err, want)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery(vreplQueryLookup, streamsResult("1|Running|100|100|", "2|Running|100|95|"), nil)
+		dbclient.addQuery(copyStateQuery, &sqltypes.Result{}, nil)
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'v_vdx'", resultid12, nil)
+		dbclient.addQuery("delete from _vt.vreplication where id in (1, 2)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("delete from _vt.copy_state where vrepl_id in (1, 2)", &sqltypes.Result{}, nil)
+	}
+	if err := tme.wr.ExternalizeVindex(ctx, "ks1.v"); err != nil {
+		t.Fatal(err)
+	}
+	verifyQueries(t, tme.allDBClients)
+
+	vs, err = tme.ts.GetVSchema(ctx, "ks1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vs.Vindexes["v"].Params["write_only"]; ok {
+		t.Errorf("vindex v is still write only: %v", vs.Vindexes["v"])
+	}
+
+	err = tme.wr.ExternalizeVindex(ctx, "ks1.v")
+	want = "vindex ks1.v is not in write only mode"
+	if err == nil || err.Error() != want {
+		t.Errorf("ExternalizeVindex(again): %v, want %s", err, want)
+	}
+	err = tme.wr.ExternalizeVindex(ctx, "v")
+	want = "vindex name must be of the form <keyspace>.<vindex>: v"
+	if err == nil || err.Error() != want {
+		t.Errorf("ExternalizeVindex(v): %v, want %s", err, want)
+	}
+}
+
+// addLookupTable adds the lookup table of lookupVindexSpecs to the vschema of ks2.
+func addLookupTable(ctx context.Context, t *testing.T, tme *testMigraterEnv) {
+	t.Helper()
+	vs, err := tme.ts.GetVSchema(ctx, "ks2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs.Tables["lkp"] = &vschemapb.Table{
+		ColumnVindexes: []*vschemapb.ColumnVindex{{
+			Column: "c2",
+			Name:   "hash",
+		}},
+	}
+	if err := tme.ts.SaveVSchema(ctx, "ks2", vs); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// lookupVindexSpecs returns the specs of a lookup vindex
+// on the col2 column of ks1.t1, backed by ks2.lkp.
+func lookupVindexSpecs(vindexType string) *vschemapb.Keyspace {
+	return &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{
+			"v": {
+				Type: vindexType,
+				Params: map[string]string{
+					"table": "ks2.lkp",
+					"from":  "c2",
+					"to":    "keyspace_id",
+				},
+				Owner: "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "col2",
+					Name:   "v",
+				}},
+			},
+		},
+	}
+}